@@ -2,11 +2,18 @@ package main
 
 import (
 	"log"
+	"os"
 
+	"github.com/strawberry-code/mcp-curator/internal/cli"
 	"github.com/strawberry-code/mcp-curator/internal/ui"
 )
 
 func main() {
+	// Con un sottocomando lavora in modalità headless, senza display
+	if cli.IsCommand(os.Args[1:]) {
+		os.Exit(cli.Run(os.Args[1:], os.Stdout, os.Stderr))
+	}
+
 	app, err := ui.NewApp()
 	if err != nil {
 		log.Fatalf("Errore avvio applicazione: %v", err)
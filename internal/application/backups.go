@@ -0,0 +1,124 @@
+package application
+
+import (
+	"fmt"
+	"path/filepath"
+
+	"github.com/strawberry-code/mcp-curator/internal/domain"
+	"github.com/strawberry-code/mcp-curator/internal/infrastructure"
+)
+
+// GetSettings restituisce le preferenze correnti
+func (s *MCPService) GetSettings() infrastructure.Settings {
+	return s.settings
+}
+
+// GetSettingsPath restituisce il path del file delle preferenze ("" se non disponibile)
+func (s *MCPService) GetSettingsPath() string {
+	if s.settingsRepo == nil {
+		return ""
+	}
+	return s.settingsRepo.GetPath()
+}
+
+// SaveSettings valida, applica e salva le preferenze
+func (s *MCPService) SaveSettings(settings infrastructure.Settings) error {
+	if settings.Backup.MaxCount < 0 || settings.Backup.MaxAgeDays < 0 {
+		return fmt.Errorf("numero e età massima dei backup non possono essere negativi")
+	}
+	if settings.Discovery.MaxDepth < 0 {
+		return fmt.Errorf("la profondità di ricerca dei progetti non può essere negativa")
+	}
+	if s.settingsRepo == nil {
+		return fmt.Errorf("directory di configurazione utente non disponibile")
+	}
+	if err := s.settingsRepo.Save(settings); err != nil {
+		return err
+	}
+
+	s.applySettings(settings)
+	s.claudeRepo.Backups().Prune()
+	return nil
+}
+
+// loadSettings carica le preferenze da disco
+func (s *MCPService) loadSettings() error {
+	settings := infrastructure.DefaultSettings()
+	if s.settingsRepo != nil {
+		var err error
+		if settings, err = s.settingsRepo.Load(); err != nil {
+			return err
+		}
+	}
+	s.applySettings(settings)
+	return nil
+}
+
+// applySettings rende effettive le preferenze
+func (s *MCPService) applySettings(settings infrastructure.Settings) {
+	s.settings = settings
+	s.claudeRepo.Backups().SetPolicy(settings.Backup.Policy())
+}
+
+// ListBackups restituisce i backup con timestamp di ~/.claude.json, dal più recente
+func (s *MCPService) ListBackups() ([]infrastructure.Backup, error) {
+	return s.claudeRepo.Backups().List()
+}
+
+// DiffBackup confronta i server di un backup con quelli attuali di ~/.claude.json.
+// Le differenze descrivono cosa cambierebbe ripristinando il backup.
+func (s *MCPService) DiffBackup(path string) ([]domain.ServerChange, error) {
+	current, err := s.claudeRepo.LoadFile(s.claudeRepo.GetConfigPath())
+	if err != nil {
+		current = domain.NewConfiguration(s.claudeRepo.GetConfigPath())
+	}
+	backup, err := s.claudeRepo.LoadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	return domain.DiffConfigurations(current, backup), nil
+}
+
+// RestoreBackup ripristina dal backup i server indicati (tutte le differenze se refs è vuoto).
+// Si ripristinano solo i server MCP: il resto di ~/.claude.json resta quello attuale.
+func (s *MCPService) RestoreBackup(path string, refs []domain.ServerRef) error {
+	if s.config == nil {
+		return fmt.Errorf("configurazione non caricata")
+	}
+
+	backup, err := s.claudeRepo.LoadFile(path)
+	if err != nil {
+		return err
+	}
+
+	if len(refs) == 0 {
+		changes, err := s.DiffBackup(path)
+		if err != nil {
+			return err
+		}
+		for _, change := range changes {
+			refs = append(refs, change.Ref)
+		}
+	}
+	if len(refs) == 0 {
+		return nil
+	}
+
+	for _, ref := range refs {
+		if ref.Scope.FileName() != "" {
+			return fmt.Errorf("il server '%s' non appartiene a ~/.claude.json", ref.Name)
+		}
+	}
+
+	changes := s.snapshot(refs...)
+	for _, ref := range refs {
+		if server, ok := backup.GetServer(ref.Location, ref.Name); ok {
+			s.ensureProject(ref.Location)
+			s.config.SetServer(ref.Location, ref.Name, server)
+		} else {
+			s.config.RemoveServer(ref.Location, ref.Name)
+		}
+	}
+
+	return s.record(OpRestore, filepath.Base(path), changes, s.persist(refs...))
+}
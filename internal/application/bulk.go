@@ -0,0 +1,181 @@
+package application
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/strawberry-code/mcp-curator/internal/domain"
+)
+
+// BulkAction è il tipo di un'operazione di gruppo
+type BulkAction string
+
+const (
+	BulkRemove BulkAction = "remove"
+	BulkMove   BulkAction = "move"
+	BulkClone  BulkAction = "clone"
+)
+
+// BulkOperation descrive un'operazione su più server: rimozione, spostamento verso una
+// destinazione o copia verso più destinazioni
+type BulkOperation struct {
+	Action  BulkAction
+	Servers []domain.ServerRef
+	// Targets è la destinazione dello spostamento o le destinazioni della copia
+	Targets []domain.Location
+	// Overwrite sostituisce i server omonimi nelle destinazioni invece di saltarli
+	Overwrite bool
+}
+
+// BulkPlan è l'effetto di un'operazione di gruppo
+type BulkPlan struct {
+	// Changes sono i server aggiunti, sostituiti o rimossi, ordinati per posizione e nome
+	Changes []domain.ServerChange
+	// Skipped sono i motivi per cui alcuni server non vengono toccati
+	// (es. *ServerExistsError per un omonimo nella destinazione)
+	Skipped []error
+}
+
+// bulkWrite è la scrittura di un server prevista da un'operazione di gruppo (nil = rimozione)
+type bulkWrite struct {
+	ref    domain.ServerRef
+	server *domain.MCPServer
+}
+
+// PlanBulk calcola cosa cambierebbe un'operazione di gruppo senza modificare nulla
+func (s *MCPService) PlanBulk(op BulkOperation) (BulkPlan, error) {
+	writes, skipped, err := s.bulkWrites(op)
+	if err != nil {
+		return BulkPlan{}, err
+	}
+	return s.bulkPlan(writes, skipped), nil
+}
+
+// ApplyBulk esegue un'operazione di gruppo con un solo backup e un solo salvataggio di
+// ~/.claude.json (e al più una scrittura per file di progetto), registrandola come
+// un'unica operazione annullabile. Restituisce il piano eseguito; i server saltati sono
+// riportati anche nell'errore.
+func (s *MCPService) ApplyBulk(op BulkOperation) (BulkPlan, error) {
+	writes, skipped, err := s.bulkWrites(op)
+	if err != nil {
+		return BulkPlan{}, err
+	}
+	plan := s.bulkPlan(writes, skipped)
+	if len(writes) == 0 {
+		return plan, errors.Join(skipped...)
+	}
+
+	refs := make([]domain.ServerRef, len(writes))
+	for i, w := range writes {
+		refs[i] = w.ref
+	}
+	changes := s.snapshot(refs...)
+	for _, w := range writes {
+		if w.server == nil {
+			s.config.RemoveServer(w.ref.Location, w.ref.Name)
+			continue
+		}
+		s.ensureProject(w.ref.Location)
+		s.config.SetServer(w.ref.Location, w.ref.Name, *w.server)
+	}
+
+	kind := map[BulkAction]OperationKind{BulkRemove: OpBulkRemove, BulkMove: OpBulkMove, BulkClone: OpBulkClone}[op.Action]
+	if err := s.record(kind, strconv.Itoa(len(op.Servers)), changes, s.persist(refs...)); err != nil {
+		return plan, err
+	}
+	return plan, errors.Join(skipped...)
+}
+
+// bulkPlan descrive le scritture rispetto alla configurazione attuale
+func (s *MCPService) bulkPlan(writes []bulkWrite, skipped []error) BulkPlan {
+	kinds := make(map[domain.ServerRef]domain.ChangeKind, len(writes))
+	refs := make([]domain.ServerRef, 0, len(writes))
+	for _, w := range writes {
+		kind := domain.ChangeRemoved
+		if w.server != nil {
+			kind = domain.ChangeAdded
+			if _, exists := s.config.GetServer(w.ref.Location, w.ref.Name); exists {
+				kind = domain.ChangeModified
+			}
+		}
+		kinds[w.ref] = kind
+		refs = append(refs, w.ref)
+	}
+
+	plan := BulkPlan{Skipped: skipped}
+	for _, ref := range domain.SortedServerRefs(refs) {
+		plan.Changes = append(plan.Changes, domain.ServerChange{Ref: ref, Kind: kinds[ref]})
+	}
+	return plan
+}
+
+// bulkWrites traduce un'operazione di gruppo nelle scritture da eseguire, una per server
+// toccato. Due server omonimi copiati nella stessa destinazione sono in conflitto anche
+// con overwrite, che vale solo per i server già presenti.
+func (s *MCPService) bulkWrites(op BulkOperation) ([]bulkWrite, []error, error) {
+	if s.config == nil {
+		return nil, nil, fmt.Errorf("configurazione non caricata")
+	}
+	switch op.Action {
+	case BulkRemove:
+	case BulkMove:
+		if len(op.Targets) != 1 {
+			return nil, nil, fmt.Errorf("lo spostamento richiede una sola destinazione")
+		}
+	case BulkClone:
+		if len(op.Targets) == 0 {
+			return nil, nil, fmt.Errorf("nessuna destinazione per la copia")
+		}
+	default:
+		return nil, nil, fmt.Errorf("operazione '%s' non valida", op.Action)
+	}
+	for _, to := range op.Targets {
+		if err := to.Validate(); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	var writes []bulkWrite
+	var skipped []error
+	written := make(map[domain.ServerRef]bool)
+	write := func(ref domain.ServerRef, server *domain.MCPServer) {
+		written[ref] = true
+		writes = append(writes, bulkWrite{ref: ref, server: server})
+	}
+
+	seen := make(map[domain.ServerRef]bool, len(op.Servers))
+	for _, ref := range domain.SortedServerRefs(op.Servers) {
+		if seen[ref] {
+			continue
+		}
+		seen[ref] = true
+		server, ok := s.config.GetServer(ref.Location, ref.Name)
+		if !ok {
+			return nil, nil, fmt.Errorf("server '%s' non trovato in %s", ref.Name, ref.Location)
+		}
+		if op.Action == BulkRemove {
+			write(ref, nil)
+			continue
+		}
+
+		copied := false
+		for _, to := range op.Targets {
+			target := domain.ServerRef{Location: to, Name: ref.Name}
+			if to == ref.Location {
+				continue
+			}
+			if _, exists := s.config.GetServer(to, ref.Name); written[target] || (exists && !op.Overwrite) {
+				skipped = append(skipped, &ServerExistsError{Name: ref.Name, Location: to})
+				continue
+			}
+			clone := server.Clone()
+			write(target, &clone)
+			copied = true
+		}
+		if op.Action == BulkMove && copied {
+			write(ref, nil)
+		}
+	}
+	return writes, skipped, nil
+}
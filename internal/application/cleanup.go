@@ -0,0 +1,105 @@
+package application
+
+import (
+	"fmt"
+
+	"github.com/strawberry-code/mcp-curator/internal/domain"
+	"github.com/strawberry-code/mcp-curator/internal/infrastructure"
+)
+
+// StaleProjects restituisce i progetti di ~/.claude.json la cui cartella non esiste più
+func (s *MCPService) StaleProjects() []*domain.Project {
+	if s.config == nil {
+		return nil
+	}
+	return s.config.StaleProjects()
+}
+
+// ArchiveProjects salva le voci complete dei progetti in un file nel formato di
+// ~/.claude.json, ripristinabile come un backup. Senza includeSecrets i segreti dei
+// server e quelli riconoscibili nel resto della voce diventano [REDACTED].
+func (s *MCPService) ArchiveProjects(paths []string, path string, includeSecrets bool) error {
+	if s.config == nil {
+		return fmt.Errorf("configurazione non caricata")
+	}
+	entries, err := s.claudeRepo.ProjectEntries(paths)
+	if err != nil {
+		return err
+	}
+	if !includeSecrets {
+		for projectPath, entry := range entries {
+			entries[projectPath] = s.redactProjectEntry(entry)
+		}
+	}
+	return infrastructure.WriteProjectArchive(path, entries)
+}
+
+// RemoveProjects elimina da ~/.claude.json le voci dei progetti indicati, con un backup
+// del file. Non è un'operazione annullabile: la voce contiene anche history e stato di
+// Claude Code, che si recuperano dal backup o dall'archivio.
+func (s *MCPService) RemoveProjects(paths []string) error {
+	if s.config == nil {
+		return fmt.Errorf("configurazione non caricata")
+	}
+	for _, path := range paths {
+		if _, ok := s.config.GetProject(path); !ok {
+			return fmt.Errorf("progetto '%s' non trovato", path)
+		}
+	}
+	if err := s.claudeRepo.RemoveProjects(paths); err != nil {
+		return err
+	}
+	for _, path := range paths {
+		delete(s.config.Projects, path)
+	}
+	return nil
+}
+
+// redactProjectEntry rimuove i segreti da una voce di progetto: i server passano da
+// RedactServer, le altre stringhe (es. prompt nella history) dal rilevatore di segreti
+func (s *MCPService) redactProjectEntry(entry interface{}) interface{} {
+	project, ok := entry.(map[string]interface{})
+	if !ok {
+		return s.redactRaw(entry)
+	}
+	result := make(map[string]interface{}, len(project))
+	for key, value := range project {
+		servers, ok := value.(map[string]interface{})
+		if key != "mcpServers" || !ok {
+			result[key] = s.redactRaw(value)
+			continue
+		}
+		redacted := make(map[string]interface{}, len(servers))
+		for name, data := range servers {
+			server, err := infrastructure.ParseServer(data)
+			if err != nil {
+				redacted[name] = s.redactRaw(data)
+				continue
+			}
+			redacted[name] = infrastructure.ServerToMap(s.RedactServer(server))
+		}
+		result[key] = redacted
+	}
+	return result
+}
+
+// redactRaw applica RedactText a tutte le stringhe di un valore JSON decodificato
+func (s *MCPService) redactRaw(value interface{}) interface{} {
+	switch v := value.(type) {
+	case string:
+		return s.RedactText(v)
+	case map[string]interface{}:
+		result := make(map[string]interface{}, len(v))
+		for key, item := range v {
+			result[key] = s.redactRaw(item)
+		}
+		return result
+	case []interface{}:
+		result := make([]interface{}, len(v))
+		for i, item := range v {
+			result[i] = s.redactRaw(item)
+		}
+		return result
+	}
+	return value
+}
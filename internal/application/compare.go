@@ -0,0 +1,129 @@
+package application
+
+import (
+	"fmt"
+
+	"github.com/strawberry-code/mcp-curator/internal/domain"
+)
+
+// CompareKind indica cosa rappresenta un lato del confronto
+type CompareKind string
+
+const (
+	// CompareServer è un singolo server
+	CompareServer CompareKind = "server"
+	// CompareGlobal sono i server globali
+	CompareGlobal CompareKind = "global"
+	// CompareProject sono i server effettivi di un progetto (GetEffectiveServers)
+	CompareProject CompareKind = "project"
+)
+
+// CompareSide è un lato del confronto: un server, i server globali o la configurazione
+// effettiva di un progetto
+type CompareSide struct {
+	Kind CompareKind
+	// Ref è il server confrontato (CompareServer)
+	Ref domain.ServerRef
+	// ProjectPath è il progetto confrontato (CompareProject)
+	ProjectPath string
+}
+
+// Location restituisce dove vanno copiati i server presenti solo sull'altro lato:
+// i server globali o ~/.claude.json del progetto
+func (c CompareSide) Location() domain.Location {
+	switch c.Kind {
+	case CompareProject:
+		return domain.ProjectLocation(c.ProjectPath, domain.ScopeProject)
+	case CompareServer:
+		return c.Ref.Location
+	}
+	return domain.GlobalLocation()
+}
+
+// Compare confronta due lati. Due server danno un solo confronto anche se i nomi sono
+// diversi; due insiemi di server sono confrontati per nome. Ogni definizione indica la
+// posizione in cui è scritta, dove vanno applicate le copie.
+func (s *MCPService) Compare(left, right CompareSide) ([]domain.ServerComparison, error) {
+	if s.config == nil {
+		return nil, fmt.Errorf("configurazione non caricata")
+	}
+	if (left.Kind == CompareServer) != (right.Kind == CompareServer) {
+		return nil, fmt.Errorf("un server si confronta solo con un altro server")
+	}
+	l, err := s.compareLayers(left)
+	if err != nil {
+		return nil, err
+	}
+	r, err := s.compareLayers(right)
+	if err != nil {
+		return nil, err
+	}
+	if left.Kind == CompareServer {
+		return []domain.ServerComparison{domain.CompareServers(l[left.Ref.Name], r[right.Ref.Name])}, nil
+	}
+	return domain.CompareServerLayers(l, r), nil
+}
+
+// compareLayers restituisce i server di un lato del confronto per nome, con la posizione
+// da cui provengono
+func (s *MCPService) compareLayers(side CompareSide) (map[string]domain.ServerLayer, error) {
+	layers := make(map[string]domain.ServerLayer)
+	switch side.Kind {
+	case CompareServer:
+		server, ok := s.config.GetServer(side.Ref.Location, side.Ref.Name)
+		if !ok {
+			return nil, fmt.Errorf("server '%s' non trovato in %s", side.Ref.Name, side.Ref.Location)
+		}
+		server.Name = side.Ref.Name
+		layers[side.Ref.Name] = domain.ServerLayer{Location: side.Ref.Location, Server: server}
+	case CompareGlobal:
+		for name, server := range s.config.GlobalServers {
+			server = server.Clone()
+			server.Name = name
+			layers[name] = domain.ServerLayer{Location: domain.GlobalLocation(), Server: server}
+		}
+	case CompareProject:
+		if _, ok := s.config.Projects[side.ProjectPath]; !ok {
+			return nil, fmt.Errorf("progetto '%s' non trovato", side.ProjectPath)
+		}
+		for name, effective := range s.config.GetEffectiveConfig(side.ProjectPath) {
+			layers[name] = effective.ServerLayer
+		}
+	default:
+		return nil, fmt.Errorf("lato del confronto '%s' non valido", side.Kind)
+	}
+	return layers, nil
+}
+
+// CopyServerField copia un campo (come in FieldDiff.Field) dal server from al server to,
+// come una modifica di to; un campo assente in from viene rimosso da to
+func (s *MCPService) CopyServerField(from, to domain.ServerRef, field string) error {
+	if s.config == nil {
+		return fmt.Errorf("configurazione non caricata")
+	}
+	source, ok := s.config.GetServer(from.Location, from.Name)
+	if !ok {
+		return fmt.Errorf("server '%s' non trovato in %s", from.Name, from.Location)
+	}
+	target, ok := s.config.GetServer(to.Location, to.Name)
+	if !ok {
+		return fmt.Errorf("server '%s' non trovato in %s", to.Name, to.Location)
+	}
+	updated, err := domain.CopyServerField(source, target, field)
+	if err != nil {
+		return err
+	}
+	return s.UpdateServer(to.Location, to.Name, updated)
+}
+
+// CopyServerDefinition sostituisce la definizione del server to con quella di from
+func (s *MCPService) CopyServerDefinition(from, to domain.ServerRef) error {
+	if s.config == nil {
+		return fmt.Errorf("configurazione non caricata")
+	}
+	source, ok := s.config.GetServer(from.Location, from.Name)
+	if !ok {
+		return fmt.Errorf("server '%s' non trovato in %s", from.Name, from.Location)
+	}
+	return s.UpdateServer(to.Location, to.Name, source.Clone())
+}
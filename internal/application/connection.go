@@ -0,0 +1,80 @@
+package application
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/strawberry-code/mcp-curator/internal/domain"
+	"github.com/strawberry-code/mcp-curator/internal/infrastructure"
+)
+
+// ConnectionReport è l'esito di un test di connessione a un server MCP
+type ConnectionReport struct {
+	// Initialize è la risposta all'handshake (nil se fallito)
+	Initialize *infrastructure.InitializeResult
+	// Inventory contiene strumenti, risorse e prompt (solo InspectServer)
+	Inventory *infrastructure.Inventory
+	// Stderr è l'output di errore del processo (solo server stdio)
+	Stderr   string
+	Duration time.Duration
+	Timeout  time.Duration
+	// Err è nil se l'handshake è riuscito, altrimenti un *infrastructure.ConnectionError
+	Err error
+}
+
+// OK indica se l'handshake è riuscito
+func (r ConnectionReport) OK() bool {
+	return r.Err == nil
+}
+
+// FailureReason restituisce il motivo del fallimento ("" se riuscito)
+func (r ConnectionReport) FailureReason() infrastructure.FailureReason {
+	var connErr *infrastructure.ConnectionError
+	if errors.As(r.Err, &connErr) {
+		return connErr.Reason
+	}
+	if r.Err != nil {
+		return infrastructure.FailureProtocol
+	}
+	return ""
+}
+
+// TestServer avvia o contatta il server ed esegue l'handshake MCP initialize
+// entro il timeout configurato (MCPServer.Timeout), poi chiude la connessione.
+// Il server non deve essere salvato: si può testare anche una configurazione in modifica.
+func (s *MCPService) TestServer(ctx context.Context, server domain.MCPServer) ConnectionReport {
+	return s.connect(ctx, server, func(_ context.Context, session *infrastructure.MCPSession, report *ConnectionReport) {
+		result := session.Initialize
+		report.Initialize = &result
+	})
+}
+
+// connect avvia o contatta il server entro il timeout configurato ed esegue l'handshake.
+// Se riesce, use completa il report con la sessione ancora aperta, poi la connessione
+// viene chiusa; la durata comprende il lavoro di use.
+func (s *MCPService) connect(ctx context.Context, server domain.MCPServer, use func(context.Context, *infrastructure.MCPSession, *ConnectionReport)) ConnectionReport {
+	report := ConnectionReport{Timeout: infrastructure.ServerTimeout(server)}
+	ctx, cancel := context.WithTimeout(ctx, report.Timeout)
+	defer cancel()
+
+	start := time.Now()
+	// Come Claude Code, il server viene avviato con le variabili ${VAR} espanse
+	expanded, _ := s.ExpandServer(server)
+	session, err := infrastructure.ConnectMCP(ctx, expanded)
+	if err != nil {
+		report.Duration = time.Since(start)
+		report.Err = err
+		var connErr *infrastructure.ConnectionError
+		if errors.As(err, &connErr) {
+			report.Stderr = connErr.Stderr
+		}
+		return report
+	}
+
+	use(ctx, session, &report)
+	report.Duration = time.Since(start)
+	session.Close()
+	report.Stderr = session.Stderr()
+	return report
+}
@@ -0,0 +1,121 @@
+package application
+
+import (
+	"fmt"
+	"os"
+	"slices"
+
+	"github.com/strawberry-code/mcp-curator/internal/domain"
+	"github.com/strawberry-code/mcp-curator/internal/infrastructure"
+)
+
+// DiscoveryRoots restituisce le cartelle in cui cercare progetti non registrati
+func (s *MCPService) DiscoveryRoots() []string {
+	return slices.Clone(s.settings.Discovery.Roots)
+}
+
+// AddDiscoveryRoot aggiunge alle preferenze una cartella in cui cercare progetti
+func (s *MCPService) AddDiscoveryRoot(path string) error {
+	abs, err := infrastructure.ExpandPath(path)
+	if err != nil {
+		return err
+	}
+	settings := s.settings
+	if slices.Contains(settings.Discovery.Roots, abs) {
+		return nil
+	}
+	settings.Discovery.Roots = append(slices.Clone(settings.Discovery.Roots), abs)
+	return s.SaveSettings(settings)
+}
+
+// RemoveDiscoveryRoot toglie una cartella dalle preferenze
+func (s *MCPService) RemoveDiscoveryRoot(path string) error {
+	settings := s.settings
+	index := slices.Index(settings.Discovery.Roots, path)
+	if index < 0 {
+		return fmt.Errorf("cartella non presente nelle preferenze: %s", path)
+	}
+	settings.Discovery.Roots = slices.Delete(slices.Clone(settings.Discovery.Roots), index, index+1)
+	return s.SaveSettings(settings)
+}
+
+// DiscoverProjects cerca sotto le cartelle radice (quelle delle preferenze se roots è vuoto)
+// i progetti con .mcp.json o .mcp.local.json non ancora presenti in ~/.claude.json, con i
+// server dei loro file. Con maxDepth 0 vale la profondità delle preferenze. Il risultato
+// resta disponibile in Discovered fino alla ricerca successiva.
+func (s *MCPService) DiscoverProjects(roots []string, maxDepth int) ([]*domain.Project, error) {
+	if s.config == nil {
+		return nil, fmt.Errorf("configurazione non caricata")
+	}
+	if len(roots) == 0 {
+		roots = s.settings.Discovery.Roots
+	}
+	if len(roots) == 0 {
+		return nil, fmt.Errorf("nessuna cartella in cui cercare progetti")
+	}
+
+	if maxDepth == 0 {
+		maxDepth = s.settings.Discovery.MaxDepth
+	}
+	paths, scanErr := infrastructure.ScanProjectFolders(roots, maxDepth)
+	var discovered []*domain.Project
+	for _, path := range paths {
+		if _, ok := s.config.GetProject(path); ok {
+			continue
+		}
+		project := domain.NewProject(path)
+		_ = s.projectRepo.LoadProject(project)
+		discovered = append(discovered, project)
+	}
+	s.discovered = discovered
+	return discovered, scanErr
+}
+
+// Discovered restituisce i progetti trovati dall'ultima ricerca e non ancora registrati
+func (s *MCPService) Discovered() []*domain.Project {
+	// Un progetto può essere stato registrato nel frattempo da Claude Code
+	s.discovered = slices.DeleteFunc(s.discovered, func(project *domain.Project) bool {
+		_, ok := s.config.GetProject(project.Path)
+		return ok
+	})
+	return s.discovered
+}
+
+// GetDiscovered restituisce un progetto trovato dall'ultima ricerca
+func (s *MCPService) GetDiscovered(path string) (*domain.Project, bool) {
+	for _, project := range s.discovered {
+		if project.Path == path {
+			return project, true
+		}
+	}
+	return nil, false
+}
+
+// RegisterProjects aggiunge le cartelle come progetti a ~/.claude.json con una voce vuota (con backup del
+// file) e li carica nella configurazione con i server dei loro file
+func (s *MCPService) RegisterProjects(paths []string) error {
+	if s.config == nil {
+		return fmt.Errorf("configurazione non caricata")
+	}
+	abs := make([]string, len(paths))
+	for i, path := range paths {
+		var err error
+		if abs[i], err = infrastructure.ExpandPath(path); err != nil {
+			return err
+		}
+		if info, err := os.Stat(abs[i]); err != nil || !info.IsDir() {
+			return fmt.Errorf("cartella non trovata: %s", abs[i])
+		}
+	}
+	paths = abs
+	if err := s.claudeRepo.RegisterProjects(paths); err != nil {
+		return err
+	}
+	for _, path := range paths {
+		s.ensureProject(domain.ProjectLocation(path, domain.ScopeProject))
+	}
+	s.discovered = slices.DeleteFunc(s.discovered, func(project *domain.Project) bool {
+		return slices.Contains(paths, project.Path)
+	})
+	return nil
+}
@@ -0,0 +1,52 @@
+package application
+
+import (
+	"fmt"
+
+	"github.com/strawberry-code/mcp-curator/internal/domain"
+)
+
+// FindDuplicates restituisce i server presenti in più copie nella configurazione
+func (s *MCPService) FindDuplicates() []domain.DuplicateGroup {
+	if s.config == nil {
+		return nil
+	}
+	return domain.FindDuplicates(s.config)
+}
+
+// PromoteToGlobal definisce server come globale con il nome indicato e rimuove le copie
+// di progetto in remove, con un solo salvataggio e un'unica operazione annullabile.
+// Un server globale omonimo è accettato solo se identico.
+func (s *MCPService) PromoteToGlobal(name string, server domain.MCPServer, remove []domain.ServerRef) error {
+	if s.config == nil {
+		return fmt.Errorf("configurazione non caricata")
+	}
+	server = server.Clone()
+	server.Name = name
+	if issues := server.Validate(); issues.HasErrors() {
+		return &domain.ValidationError{Name: name, Issues: issues}
+	}
+	if existing, ok := s.config.GetGlobalServer(name); ok && !domain.SameDefinition(existing, server) {
+		return &ServerExistsError{Name: name, Location: domain.GlobalLocation()}
+	}
+	for _, ref := range remove {
+		if ref.IsGlobal() {
+			return fmt.Errorf("'%s' è già un server globale", ref.Name)
+		}
+		if _, ok := s.config.GetServer(ref.Location, ref.Name); !ok {
+			return fmt.Errorf("server '%s' non trovato in %s", ref.Name, ref.Location)
+		}
+	}
+
+	global := domain.ServerRef{Location: domain.GlobalLocation(), Name: name}
+	refs := append([]domain.ServerRef{global}, remove...)
+	changes := s.snapshot(refs...)
+	if _, ok := s.config.GetGlobalServer(name); !ok {
+		s.config.AddGlobalServer(name, server)
+	}
+	for _, ref := range remove {
+		s.config.RemoveServer(ref.Location, ref.Name)
+	}
+
+	return s.record(OpPromote, name, changes, s.persist(refs...))
+}
@@ -0,0 +1,49 @@
+package application
+
+import (
+	"fmt"
+
+	"github.com/strawberry-code/mcp-curator/internal/domain"
+	"github.com/strawberry-code/mcp-curator/internal/infrastructure"
+)
+
+// ExportServers converte i server nel formato di un altro client. Senza includeSecrets
+// i valori che sembrano segreti diventano [REDACTED], come in tutto ciò che esce
+// dal curator; i riferimenti ${VAR} restano invariati.
+func (s *MCPService) ExportServers(format domain.ExportFormat, servers map[string]domain.MCPServer, includeSecrets bool) (domain.ExportResult, error) {
+	if len(servers) == 0 {
+		return domain.ExportResult{}, fmt.Errorf("nessun server da esportare")
+	}
+	if !includeSecrets {
+		redacted := make(map[string]domain.MCPServer, len(servers))
+		for name, server := range servers {
+			redacted[name] = s.RedactServer(server)
+		}
+		servers = redacted
+	}
+	return infrastructure.EncodeClientConfig(format, servers)
+}
+
+// ExportLocation esporta tutti i server di una posizione
+func (s *MCPService) ExportLocation(format domain.ExportFormat, loc domain.Location, includeSecrets bool) (domain.ExportResult, error) {
+	if err := s.checkLocation(loc); err != nil {
+		return domain.ExportResult{}, err
+	}
+	servers, _ := s.config.Servers(loc)
+	return s.ExportServers(format, servers, includeSecrets)
+}
+
+// ExportEffective esporta i server effettivi di un progetto (globali e di progetto,
+// con la precedenza usata da Claude Code)
+func (s *MCPService) ExportEffective(format domain.ExportFormat, projectPath string, includeSecrets bool) (domain.ExportResult, error) {
+	servers, err := s.GetEffectiveServers(projectPath)
+	if err != nil {
+		return domain.ExportResult{}, err
+	}
+	return s.ExportServers(format, servers, includeSecrets)
+}
+
+// WriteExport salva un'esportazione su file
+func (s *MCPService) WriteExport(path string, result domain.ExportResult) error {
+	return infrastructure.WriteExportFile(path, result.Data)
+}
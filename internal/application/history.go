@@ -0,0 +1,287 @@
+package application
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/strawberry-code/mcp-curator/internal/domain"
+	"github.com/strawberry-code/mcp-curator/internal/infrastructure"
+)
+
+// historyLimit è il numero massimo di operazioni annullabili conservate
+const historyLimit = 100
+
+// OperationKind identifica il tipo di operazione registrata nella cronologia
+type OperationKind string
+
+const (
+	OpAdd     OperationKind = "add"
+	OpUpdate  OperationKind = "update"
+	OpRemove  OperationKind = "remove"
+	OpMove    OperationKind = "move"
+	OpClone   OperationKind = "clone"
+	OpRestore OperationKind = "restore"
+	OpBind    OperationKind = "bind"
+	OpRotate  OperationKind = "rotate"
+	OpImport  OperationKind = "import"
+	OpPromote OperationKind = "promote"
+
+	// Operazioni di gruppo: il nome è il numero di server coinvolti
+	OpBulkRemove OperationKind = "bulk_remove"
+	OpBulkMove   OperationKind = "bulk_move"
+	OpBulkClone  OperationKind = "bulk_clone"
+)
+
+// Change descrive la modifica di un singolo server (nil = server assente)
+type Change struct {
+	Ref    domain.ServerRef
+	Before *domain.MCPServer
+	After  *domain.MCPServer
+}
+
+// SecretChange descrive la modifica del valore di un segreto del vault
+type SecretChange struct {
+	Name   string
+	Before string
+	After  string
+}
+
+// Operation è un'operazione annullabile composta da una o più modifiche
+type Operation struct {
+	Kind    OperationKind
+	Name    string
+	Time    time.Time
+	Changes []Change
+	// Secret è il segreto del vault modificato insieme ai server (rotazione), nil se nessuno
+	Secret *SecretChange
+}
+
+// History conserva le operazioni da annullare e ripetere.
+// Vive nel servizio e non viene toccata da Load, quindi sopravvive ai ricaricamenti.
+type History struct {
+	undo []Operation
+	redo []Operation
+
+	// rollback annulla l'ultimo spostamento nella cronologia se il salvataggio
+	// è in attesa della risoluzione di un conflitto
+	rollback func()
+}
+
+// push registra una nuova operazione e svuota le operazioni ripetibili
+func (h *History) push(op Operation) {
+	h.undo = append(h.undo, op)
+	if len(h.undo) > historyLimit {
+		h.undo = h.undo[len(h.undo)-historyLimit:]
+	}
+	h.redo = nil
+}
+
+// HistoryEntries restituisce le operazioni eseguite (dalla più vecchia) e quelle annullate
+// ripetibili (dalla prossima da ripetere)
+func (s *MCPService) HistoryEntries() (done, undone []Operation) {
+	done = append([]Operation(nil), s.history.undo...)
+	for i := len(s.history.redo) - 1; i >= 0; i-- {
+		undone = append(undone, s.history.redo[i])
+	}
+	return done, undone
+}
+
+// CanUndo verifica se c'è un'operazione da annullare
+func (s *MCPService) CanUndo() bool {
+	return len(s.history.undo) > 0
+}
+
+// CanRedo verifica se c'è un'operazione da ripetere
+func (s *MCPService) CanRedo() bool {
+	return len(s.history.redo) > 0
+}
+
+// Undo annulla l'ultima operazione ripristinando lo stato precedente dei server coinvolti
+func (s *MCPService) Undo() (Operation, error) {
+	if !s.CanUndo() {
+		return Operation{}, fmt.Errorf("nessuna operazione da annullare")
+	}
+	op := s.history.undo[len(s.history.undo)-1]
+
+	err := s.applyStates(op, false)
+	if err != nil && !isConflict(err) {
+		return op, err
+	}
+	h := &s.history
+	h.undo = h.undo[:len(h.undo)-1]
+	h.redo = append(h.redo, op)
+	h.rollback = nil
+	if err != nil {
+		h.rollback = func() {
+			h.redo = h.redo[:len(h.redo)-1]
+			h.undo = append(h.undo, op)
+		}
+		if op.Secret != nil {
+			s.restoreSecretOnDiscard(op.Secret.Name, op.Secret.After)
+		}
+	}
+	return op, err
+}
+
+// Redo ripete l'ultima operazione annullata
+func (s *MCPService) Redo() (Operation, error) {
+	if !s.CanRedo() {
+		return Operation{}, fmt.Errorf("nessuna operazione da ripetere")
+	}
+	op := s.history.redo[len(s.history.redo)-1]
+
+	err := s.applyStates(op, true)
+	if err != nil && !isConflict(err) {
+		return op, err
+	}
+	h := &s.history
+	h.redo = h.redo[:len(h.redo)-1]
+	h.undo = append(h.undo, op)
+	h.rollback = nil
+	if err != nil {
+		h.rollback = func() {
+			h.undo = h.undo[:len(h.undo)-1]
+			h.redo = append(h.redo, op)
+		}
+		if op.Secret != nil {
+			s.restoreSecretOnDiscard(op.Secret.Name, op.Secret.Before)
+		}
+	}
+	return op, err
+}
+
+// applyStates porta i server dell'operazione allo stato After (redo) o Before (undo),
+// insieme all'eventuale segreto del vault. Se nel frattempo un server o il segreto è
+// stato modificato da altri l'operazione viene rifiutata.
+func (s *MCPService) applyStates(op Operation, forward bool) error {
+	if s.config == nil {
+		return fmt.Errorf("configurazione non caricata")
+	}
+	if op.Secret != nil {
+		if err := s.checkSecretState(*op.Secret, forward); err != nil {
+			return err
+		}
+	}
+
+	refs := make([]domain.ServerRef, 0, len(op.Changes))
+	for _, change := range op.Changes {
+		from := change.Before
+		if !forward {
+			from = change.After
+		}
+		if !sameState(s.currentState(change.Ref), from) {
+			return fmt.Errorf("il server '%s' in %s è stato modificato dopo l'operazione", change.Ref.Name, change.Ref.Location)
+		}
+		refs = append(refs, change.Ref)
+	}
+
+	s.setStates(op.Changes, forward)
+
+	var err error
+	if op.Secret != nil {
+		value, previous := op.Secret.Before, op.Secret.After
+		if forward {
+			value, previous = previous, value
+		}
+		err = s.persistSecret(op.Secret.Name, value, previous, refs...)
+	} else {
+		err = s.persist(refs...)
+	}
+	if err != nil && !isConflict(err) {
+		// Il salvataggio è fallito: la memoria torna allineata al disco e alla cronologia
+		s.setStates(op.Changes, !forward)
+	}
+	return err
+}
+
+// setStates porta in memoria i server delle modifiche allo stato After (forward) o Before
+func (s *MCPService) setStates(changes []Change, forward bool) {
+	for _, change := range changes {
+		target := change.Before
+		if forward {
+			target = change.After
+		}
+		if target == nil {
+			s.config.RemoveServer(change.Ref.Location, change.Ref.Name)
+			continue
+		}
+		s.ensureProject(change.Ref.Location)
+		s.config.SetServer(change.Ref.Location, change.Ref.Name, target.Clone())
+	}
+}
+
+// snapshot registra lo stato attuale dei server prima di un'operazione
+func (s *MCPService) snapshot(refs ...domain.ServerRef) []Change {
+	changes := make([]Change, 0, len(refs))
+	for _, ref := range refs {
+		changes = append(changes, Change{Ref: ref, Before: s.currentState(ref)})
+	}
+	return changes
+}
+
+// record completa le modifiche con lo stato finale e le aggiunge alla cronologia.
+// Con un conflitto di salvataggio l'operazione resta registrata: verrà salvata dalla risoluzione.
+// Con un altro errore i server tornano in memoria allo stato precedente all'operazione.
+func (s *MCPService) record(kind OperationKind, name string, changes []Change, err error) error {
+	if err != nil && !isConflict(err) {
+		s.setStates(changes, false)
+		return err
+	}
+
+	for i := range changes {
+		changes[i].After = s.currentState(changes[i].Ref)
+	}
+
+	h := &s.history
+	previousRedo := h.redo
+	h.push(Operation{Kind: kind, Name: name, Time: time.Now(), Changes: changes})
+	h.rollback = nil
+	if err != nil {
+		h.rollback = func() {
+			h.undo = h.undo[:len(h.undo)-1]
+			h.redo = previousRedo
+		}
+	}
+	return err
+}
+
+// DiscardPendingChanges abbandona un salvataggio rimasto in conflitto: annulla la sua
+// registrazione nella cronologia e ricarica la configurazione da disco. Il salvataggio non
+// ha scritto nessun file (nemmeno quelli di progetto), quindi il disco è già coerente.
+func (s *MCPService) DiscardPendingChanges() error {
+	if s.history.rollback != nil {
+		s.history.rollback()
+		s.history.rollback = nil
+	}
+	s.pendingRefs = nil
+	return s.Load()
+}
+
+// currentState restituisce una copia del server in memoria (nil se assente)
+func (s *MCPService) currentState(ref domain.ServerRef) *domain.MCPServer {
+	server, ok := s.config.GetServer(ref.Location, ref.Name)
+	if !ok {
+		return nil
+	}
+	clone := server.Clone()
+	return &clone
+}
+
+// sameState confronta due stati di un server nella forma in cui vengono salvati
+func sameState(a, b *domain.MCPServer) bool {
+	if a == nil || b == nil {
+		return a == nil && b == nil
+	}
+	dataA, errA := json.Marshal(infrastructure.ServerToMap(*a))
+	dataB, errB := json.Marshal(infrastructure.ServerToMap(*b))
+	return errA == nil && errB == nil && bytes.Equal(dataA, dataB)
+}
+
+// isConflict verifica se l'errore è un conflitto con modifiche esterne
+func isConflict(err error) bool {
+	var conflictErr *domain.ConflictError
+	return errors.As(err, &conflictErr)
+}
@@ -0,0 +1,95 @@
+package application
+
+import (
+	"errors"
+	"fmt"
+	"path/filepath"
+
+	"github.com/strawberry-code/mcp-curator/internal/domain"
+	"github.com/strawberry-code/mcp-curator/internal/infrastructure"
+)
+
+// ImportSources restituisce i file di configurazione degli altri client trovati:
+// quelli dell'utente e quelli nelle directory dei progetti conosciuti
+func (s *MCPService) ImportSources() []domain.ImportSource {
+	sources := infrastructure.ClientConfigPaths()
+	if s.config == nil {
+		return sources
+	}
+	for _, path := range s.config.ProjectPaths() {
+		sources = append(sources, infrastructure.ProjectClientConfigPaths(path)...)
+	}
+	return sources
+}
+
+// DetectImportFormat riconosce il formato di un file di un altro client dal suo percorso
+func (s *MCPService) DetectImportFormat(path string) domain.ImportFormat {
+	return infrastructure.DetectImportFormat(path)
+}
+
+// ReadImport legge i server dal file di un altro client; con format vuoto il formato
+// viene dedotto dal percorso
+func (s *MCPService) ReadImport(format domain.ImportFormat, path string) ([]domain.ImportedServer, error) {
+	if format == "" {
+		format = infrastructure.DetectImportFormat(path)
+		if format == "" {
+			return nil, fmt.Errorf("formato di %s non riconosciuto: indicarlo esplicitamente", path)
+		}
+	}
+	return infrastructure.ReadClientConfig(format, path)
+}
+
+// PlanImport confronta i server da importare con quelli già presenti in loc
+func (s *MCPService) PlanImport(loc domain.Location, servers []domain.ImportedServer) ([]domain.ImportCandidate, error) {
+	if err := s.checkLocation(loc); err != nil {
+		return nil, err
+	}
+	s.ensureProject(loc)
+	existing, _ := s.config.Servers(loc)
+	return domain.PlanImport(existing, servers), nil
+}
+
+// ImportServers aggiunge i server in loc con un solo salvataggio e una sola voce di
+// cronologia, e restituisce quanti ne sono stati importati. I server identici a quelli
+// presenti vengono saltati; quelli in conflitto vengono sostituiti solo con overwrite,
+// altrimenti sono segnalati con un *ServerExistsError. source (il file di origine) dà
+// il nome all'operazione.
+func (s *MCPService) ImportServers(loc domain.Location, servers []domain.ImportedServer, overwrite bool, source string) (int, error) {
+	candidates, err := s.PlanImport(loc, servers)
+	if err != nil {
+		return 0, err
+	}
+
+	var errs []error
+	var refs []domain.ServerRef
+	var changes []Change
+	for _, c := range candidates {
+		switch {
+		case c.Status == domain.ImportIdentical:
+			continue
+		case c.Issues.HasErrors():
+			errs = append(errs, &domain.ValidationError{Name: c.Name, Issues: c.Issues.Errors()})
+			continue
+		case c.Status == domain.ImportConflict && !overwrite:
+			errs = append(errs, &ServerExistsError{Name: c.Name, Location: loc})
+			continue
+		}
+		server := c.Server.Clone()
+		server.Name = c.Name
+		if issues := server.Validate(); issues.HasErrors() {
+			errs = append(errs, &domain.ValidationError{Name: c.Name, Issues: issues})
+			continue
+		}
+		ref := domain.ServerRef{Location: loc, Name: c.Name}
+		changes = append(changes, s.snapshot(ref)...)
+		s.config.SetServer(loc, c.Name, server)
+		refs = append(refs, ref)
+	}
+
+	if len(refs) > 0 {
+		if err := s.record(OpImport, filepath.Base(source), changes, s.persist(refs...)); err != nil {
+			return 0, err
+		}
+	}
+	return len(refs), errors.Join(errs...)
+}
@@ -0,0 +1,55 @@
+package application
+
+import (
+	"context"
+
+	"github.com/strawberry-code/mcp-curator/internal/domain"
+	"github.com/strawberry-code/mcp-curator/internal/infrastructure"
+)
+
+// newInventoryCache crea la cache degli inventari (nil se la directory di cache
+// utente non è determinabile: gli inventari restano solo in memoria)
+func newInventoryCache() *infrastructure.InventoryCache {
+	cache, err := infrastructure.NewInventoryCache()
+	if err != nil {
+		return nil
+	}
+	return cache
+}
+
+// InspectServer si connette al server, legge strumenti, risorse e prompt e salva
+// l'inventario in cache per la definizione corrente del server
+func (s *MCPService) InspectServer(ctx context.Context, server domain.MCPServer) ConnectionReport {
+	report := s.connect(ctx, server, func(ctx context.Context, session *infrastructure.MCPSession, report *ConnectionReport) {
+		inventory := session.Inventory(ctx)
+		report.Initialize = &inventory.Initialize
+		report.Inventory = &inventory
+	})
+
+	if report.Inventory != nil && s.inventories != nil {
+		// La cache è solo un'ottimizzazione: un errore di scrittura non invalida l'inventario
+		_ = s.inventories.Put(server, *report.Inventory)
+	}
+	return report
+}
+
+// CachedInventory restituisce l'ultimo inventario letto per la definizione del server
+func (s *MCPService) CachedInventory(server domain.MCPServer) (*infrastructure.Inventory, bool) {
+	if s.inventories == nil {
+		return nil, false
+	}
+	inventory, ok := s.inventories.Get(server)
+	if !ok {
+		return nil, false
+	}
+	return &inventory, true
+}
+
+// ToolCount restituisce il numero di strumenti in cache per il server, senza connettersi
+func (s *MCPService) ToolCount(server domain.MCPServer) (int, bool) {
+	inventory, ok := s.CachedInventory(server)
+	if !ok {
+		return 0, false
+	}
+	return len(inventory.Tools), true
+}
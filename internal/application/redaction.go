@@ -0,0 +1,65 @@
+package application
+
+import "github.com/strawberry-code/mcp-curator/internal/domain"
+
+// IsSecret verifica se il valore di una chiave env/header va nascosto: forma o nome da segreto
+// oppure valore che contiene un segreto del vault sbloccato
+func (s *MCPService) IsSecret(key, value string) bool {
+	if domain.IsSecretValue(key, value) {
+		return true
+	}
+	for _, binding := range s.ValueBindings(value) {
+		if binding.Mode == BindInline {
+			return true
+		}
+	}
+	return false
+}
+
+// RedactText rimuove i segreti da un testo destinato agli appunti o a un export
+func (s *MCPService) RedactText(text string) string {
+	return s.redact(text, domain.RedactedValue)
+}
+
+// MaskText nasconde i segreti di un testo mostrato nell'interfaccia
+func (s *MCPService) MaskText(text string) string {
+	return s.redact(text, domain.MaskedValue)
+}
+
+// RedactServer restituisce una copia del server senza segreti, pronta per un export
+func (s *MCPService) RedactServer(server domain.MCPServer) domain.MCPServer {
+	redacted := server.Redacted(domain.RedactedValue)
+	values := s.vaultValues()
+	if len(values) == 0 {
+		return redacted
+	}
+	for i, arg := range redacted.Args {
+		redacted.Args[i] = domain.RedactValues(arg, values, domain.RedactedValue)
+	}
+	redacted.URL = domain.RedactValues(redacted.URL, values, domain.RedactedValue)
+	for _, field := range []string{domain.FieldEnv, domain.FieldHeaders} {
+		for key, value := range redacted.KeyValues(field) {
+			redacted.SetKeyValue(field, key, domain.RedactValues(value, values, domain.RedactedValue))
+		}
+	}
+	return redacted
+}
+
+// redact sostituisce i valori del vault sbloccato e quanto riconosciuto dal rilevatore
+func (s *MCPService) redact(text, replacement string) string {
+	return domain.RedactText(domain.RedactValues(text, s.vaultValues(), replacement), replacement)
+}
+
+// vaultValues restituisce i valori dei segreti del vault sbloccato
+func (s *MCPService) vaultValues() []string {
+	if !s.VaultUnlocked() {
+		return nil
+	}
+	var values []string
+	for _, name := range s.vault.Names() {
+		if value, ok, err := s.vault.Get(name); err == nil && ok && len(value) >= minInlineSecretLength {
+			values = append(values, value)
+		}
+	}
+	return values
+}
@@ -1,17 +1,39 @@
 package application
 
 import (
+	"encoding/json"
+	"errors"
 	"fmt"
 
 	"github.com/strawberry-code/mcp-curator/internal/domain"
 	"github.com/strawberry-code/mcp-curator/internal/infrastructure"
 )
 
+// ServerExistsError indica che nella destinazione esiste già un server con lo stesso nome
+type ServerExistsError struct {
+	Name     string
+	Location domain.Location
+}
+
+func (e *ServerExistsError) Error() string {
+	return fmt.Sprintf("server '%s' già esistente in %s", e.Name, e.Location)
+}
+
 // MCPService gestisce i casi d'uso per la configurazione MCP
 type MCPService struct {
-	claudeRepo  *infrastructure.ClaudeConfigRepository
-	projectRepo *infrastructure.ProjectConfigRepository
-	config      *domain.Configuration
+	claudeRepo   *infrastructure.ClaudeConfigRepository
+	projectRepo  *infrastructure.ProjectConfigRepository
+	settingsRepo *infrastructure.SettingsRepository
+	settings     infrastructure.Settings
+	inventories  *infrastructure.InventoryCache
+	vault        *infrastructure.VaultRepository
+	config       *domain.Configuration
+	discovered   []*domain.Project
+	history      History
+
+	// pendingRefs sono i server di un salvataggio fermo su un conflitto di ~/.claude.json:
+	// nessun file è stato scritto e ResolveConflicts li salva tutti
+	pendingRefs []domain.ServerRef
 }
 
 // NewMCPService crea un nuovo servizio MCP
@@ -22,21 +44,82 @@ func NewMCPService() (*MCPService, error) {
 	}
 
 	return &MCPService{
-		claudeRepo:  claudeRepo,
-		projectRepo: infrastructure.NewProjectConfigRepository(),
+		claudeRepo:   claudeRepo,
+		projectRepo:  infrastructure.NewProjectConfigRepository(),
+		settingsRepo: newSettingsRepository(),
+		inventories:  newInventoryCache(),
+		vault:        newVaultRepository(),
 	}, nil
 }
 
-// Load carica la configurazione
+// NewMCPServiceWithConfigPath crea un servizio MCP che usa un file di configurazione personalizzato
+func NewMCPServiceWithConfigPath(configPath string) *MCPService {
+	return &MCPService{
+		claudeRepo:   infrastructure.NewClaudeConfigRepositoryWithPath(configPath),
+		projectRepo:  infrastructure.NewProjectConfigRepository(),
+		settingsRepo: newSettingsRepository(),
+		inventories:  newInventoryCache(),
+		vault:        newVaultRepository(),
+	}
+}
+
+// newSettingsRepository crea il repository delle preferenze (nil se la directory
+// di configurazione utente non è determinabile: si usano i valori predefiniti)
+func newSettingsRepository() *infrastructure.SettingsRepository {
+	repo, err := infrastructure.NewSettingsRepository()
+	if err != nil {
+		return nil
+	}
+	return repo
+}
+
+// Load carica preferenze e configurazione
 func (s *MCPService) Load() error {
+	if err := s.loadSettings(); err != nil {
+		return err
+	}
+
 	config, err := s.claudeRepo.Load()
 	if err != nil {
 		return err
 	}
+
+	// Carica i server di .mcp.json e .mcp.local.json di ogni progetto.
+	// Un file illeggibile non blocca il caricamento: verrà segnalato al salvataggio.
+	for _, project := range config.Projects {
+		_ = s.projectRepo.LoadProject(project)
+	}
+
 	s.config = config
 	return nil
 }
 
+// Reload ricarica la configurazione da disco e restituisce i server cambiati rispetto a quella in memoria
+func (s *MCPService) Reload() ([]domain.ServerChange, error) {
+	previous := s.config
+	if err := s.Load(); err != nil {
+		return nil, err
+	}
+	return domain.DiffConfigurations(previous, s.config), nil
+}
+
+// WatchedFiles restituisce i file di configurazione da osservare:
+// ~/.claude.json e i .mcp.json/.mcp.local.json di ogni progetto conosciuto
+func (s *MCPService) WatchedFiles() []string {
+	files := []string{s.claudeRepo.GetConfigPath()}
+	if s.config == nil {
+		return files
+	}
+	for _, path := range s.config.ProjectPaths() {
+		for _, scope := range domain.ProjectScopes {
+			if scope.FileName() != "" {
+				files = append(files, s.projectRepo.FilePath(path, scope))
+			}
+		}
+	}
+	return files
+}
+
 // GetConfiguration restituisce la configurazione corrente
 func (s *MCPService) GetConfiguration() *domain.Configuration {
 	return s.config
@@ -47,141 +130,215 @@ func (s *MCPService) GetConfigPath() string {
 	return s.claudeRepo.GetConfigPath()
 }
 
-// AddGlobalServer aggiunge un server globale
-func (s *MCPService) AddGlobalServer(name string, server domain.MCPServer) error {
-	if s.config == nil {
-		return fmt.Errorf("configurazione non caricata")
+// GetLocationPath restituisce il path del file in cui è salvata una posizione
+func (s *MCPService) GetLocationPath(loc domain.Location) string {
+	if loc.Scope.FileName() != "" {
+		return s.projectRepo.FilePath(loc.ProjectPath, loc.Scope)
 	}
+	return s.claudeRepo.GetConfigPath()
+}
 
-	if _, exists := s.config.GlobalServers[name]; exists {
-		return fmt.Errorf("server '%s' già esistente", name)
+// AddServer aggiunge un server in una posizione
+func (s *MCPService) AddServer(loc domain.Location, name string, server domain.MCPServer) error {
+	if err := s.checkLocation(loc); err != nil {
+		return err
+	}
+
+	if _, exists := s.config.GetServer(loc, name); exists {
+		return &ServerExistsError{Name: name, Location: loc}
 	}
 
-	s.config.AddGlobalServer(name, server)
-	return s.claudeRepo.Save(s.config)
+	server.Name = name
+	if issues := server.Validate(); issues.HasErrors() {
+		return &domain.ValidationError{Name: name, Issues: issues}
+	}
+
+	ref := domain.ServerRef{Location: loc, Name: name}
+	changes := s.snapshot(ref)
+	s.ensureProject(loc)
+	s.config.SetServer(loc, name, server)
+	return s.record(OpAdd, name, changes, s.persist(ref))
 }
 
-// AddProjectServer aggiunge un server a un progetto
-func (s *MCPService) AddProjectServer(projectPath, name string, server domain.MCPServer) error {
-	if s.config == nil {
-		return fmt.Errorf("configurazione non caricata")
+// UpdateServer aggiorna un server esistente in una posizione
+func (s *MCPService) UpdateServer(loc domain.Location, name string, server domain.MCPServer) error {
+	if err := s.checkLocation(loc); err != nil {
+		return err
 	}
 
-	project := s.config.GetOrCreateProject(projectPath)
+	if _, exists := s.config.GetServer(loc, name); !exists {
+		return fmt.Errorf("server '%s' non trovato in %s", name, loc)
+	}
 
-	if _, exists := project.MCPServers[name]; exists {
-		return fmt.Errorf("server '%s' già esistente nel progetto", name)
+	// Il nome non cambia: un nome già presente nel file non blocca la modifica degli altri campi
+	if issues := server.Validate().Without(domain.FieldName); issues.HasErrors() {
+		return &domain.ValidationError{Name: name, Issues: issues}
 	}
 
-	project.AddServer(name, server)
-	return s.claudeRepo.Save(s.config)
+	ref := domain.ServerRef{Location: loc, Name: name}
+	changes := s.snapshot(ref)
+	s.config.SetServer(loc, name, server)
+	return s.record(OpUpdate, name, changes, s.persist(ref))
 }
 
-// RemoveGlobalServer rimuove un server globale
-func (s *MCPService) RemoveGlobalServer(name string) error {
-	if s.config == nil {
-		return fmt.Errorf("configurazione non caricata")
+// RemoveServer rimuove un server da una posizione
+func (s *MCPService) RemoveServer(loc domain.Location, name string) error {
+	if err := s.checkLocation(loc); err != nil {
+		return err
 	}
 
-	if !s.config.RemoveGlobalServer(name) {
-		return fmt.Errorf("server '%s' non trovato", name)
+	ref := domain.ServerRef{Location: loc, Name: name}
+	changes := s.snapshot(ref)
+	if !s.config.RemoveServer(loc, name) {
+		return fmt.Errorf("server '%s' non trovato in %s", name, loc)
 	}
 
-	return s.claudeRepo.Save(s.config)
+	return s.record(OpRemove, name, changes, s.persist(ref))
 }
 
-// RemoveProjectServer rimuove un server da un progetto
-func (s *MCPService) RemoveProjectServer(projectPath, name string) error {
-	if s.config == nil {
-		return fmt.Errorf("configurazione non caricata")
+// MoveServer sposta un server tra due posizioni.
+// Se overwrite è false e la destinazione contiene già un server con lo stesso nome
+// restituisce un *ServerExistsError senza modificare nulla.
+func (s *MCPService) MoveServer(name string, from, to domain.Location, overwrite bool) error {
+	if err := s.checkLocation(from); err != nil {
+		return err
+	}
+	if err := to.Validate(); err != nil {
+		return err
+	}
+	if from == to {
+		return fmt.Errorf("origine e destinazione coincidono")
 	}
 
-	project, exists := s.config.GetProject(projectPath)
+	server, exists := s.config.GetServer(from, name)
 	if !exists {
-		return fmt.Errorf("progetto '%s' non trovato", projectPath)
+		return fmt.Errorf("server '%s' non trovato in %s", name, from)
 	}
 
-	if !project.RemoveServer(name) {
-		return fmt.Errorf("server '%s' non trovato nel progetto", name)
+	if _, exists := s.config.GetServer(to, name); exists && !overwrite {
+		return &ServerExistsError{Name: name, Location: to}
 	}
 
-	return s.claudeRepo.Save(s.config)
+	// Aggiungi alla destinazione e rimuovi dall'origine
+	refs := []domain.ServerRef{{Location: from, Name: name}, {Location: to, Name: name}}
+	changes := s.snapshot(refs...)
+	s.ensureProject(to)
+	s.config.SetServer(to, name, server)
+	s.config.RemoveServer(from, name)
+
+	return s.record(OpMove, name, changes, s.persist(refs...))
 }
 
-// UpdateGlobalServer aggiorna un server globale
-func (s *MCPService) UpdateGlobalServer(name string, server domain.MCPServer) error {
-	if s.config == nil {
-		return fmt.Errorf("configurazione non caricata")
+// CloneServer copia un server su più destinazioni e restituisce quante copie sono riuscite.
+// Le destinazioni che contengono già un server con lo stesso nome vengono saltate.
+func (s *MCPService) CloneServer(name string, from domain.Location, targets []domain.Location) (int, error) {
+	if err := s.checkLocation(from); err != nil {
+		return 0, err
 	}
 
-	if _, exists := s.config.GlobalServers[name]; !exists {
-		return fmt.Errorf("server '%s' non trovato", name)
+	server, exists := s.config.GetServer(from, name)
+	if !exists {
+		return 0, fmt.Errorf("server '%s' non trovato in %s", name, from)
 	}
 
-	s.config.GlobalServers[name] = server
-	return s.claudeRepo.Save(s.config)
-}
-
-// UpdateProjectServer aggiorna un server di progetto
-func (s *MCPService) UpdateProjectServer(projectPath, name string, server domain.MCPServer) error {
-	if s.config == nil {
-		return fmt.Errorf("configurazione non caricata")
+	var errs []error
+	var cloned []domain.ServerRef
+	var changes []Change
+	for _, to := range targets {
+		if err := to.Validate(); err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		if _, exists := s.config.GetServer(to, name); exists {
+			errs = append(errs, &ServerExistsError{Name: name, Location: to})
+			continue
+		}
+		ref := domain.ServerRef{Location: to, Name: name}
+		changes = append(changes, s.snapshot(ref)...)
+		s.ensureProject(to)
+		s.config.SetServer(to, name, server.Clone())
+		cloned = append(cloned, ref)
 	}
 
-	project, exists := s.config.GetProject(projectPath)
-	if !exists {
-		return fmt.Errorf("progetto '%s' non trovato", projectPath)
+	if len(cloned) > 0 {
+		if err := s.record(OpClone, name, changes, s.persist(cloned...)); err != nil {
+			return 0, err
+		}
 	}
 
-	if _, serverExists := project.MCPServers[name]; !serverExists {
-		return fmt.Errorf("server '%s' non trovato nel progetto", name)
-	}
+	return len(cloned), errors.Join(errs...)
+}
 
-	project.MCPServers[name] = server
-	return s.claudeRepo.Save(s.config)
+// AddGlobalServer aggiunge un server globale
+func (s *MCPService) AddGlobalServer(name string, server domain.MCPServer) error {
+	return s.AddServer(domain.GlobalLocation(), name, server)
 }
 
-// MoveServerToGlobal sposta un server da un progetto a globale
-func (s *MCPService) MoveServerToGlobal(projectPath, name string) error {
-	if s.config == nil {
-		return fmt.Errorf("configurazione non caricata")
-	}
+// AddProjectServer aggiunge un server a un progetto
+func (s *MCPService) AddProjectServer(projectPath, name string, server domain.MCPServer) error {
+	return s.AddServer(domain.ProjectLocation(projectPath, domain.ScopeProject), name, server)
+}
 
-	project, exists := s.config.GetProject(projectPath)
-	if !exists {
-		return fmt.Errorf("progetto '%s' non trovato", projectPath)
-	}
+// RemoveGlobalServer rimuove un server globale
+func (s *MCPService) RemoveGlobalServer(name string) error {
+	return s.RemoveServer(domain.GlobalLocation(), name)
+}
 
-	server, exists := project.GetServer(name)
-	if !exists {
-		return fmt.Errorf("server '%s' non trovato nel progetto", name)
-	}
+// RemoveProjectServer rimuove un server da un progetto
+func (s *MCPService) RemoveProjectServer(projectPath, name string) error {
+	return s.RemoveServer(domain.ProjectLocation(projectPath, domain.ScopeProject), name)
+}
 
-	// Aggiungi a globali e rimuovi dal progetto
-	s.config.AddGlobalServer(name, server)
-	project.RemoveServer(name)
+// UpdateGlobalServer aggiorna un server globale
+func (s *MCPService) UpdateGlobalServer(name string, server domain.MCPServer) error {
+	return s.UpdateServer(domain.GlobalLocation(), name, server)
+}
 
-	return s.claudeRepo.Save(s.config)
+// UpdateProjectServer aggiorna un server di progetto
+func (s *MCPService) UpdateProjectServer(projectPath, name string, server domain.MCPServer) error {
+	return s.UpdateServer(domain.ProjectLocation(projectPath, domain.ScopeProject), name, server)
+}
+
+// MoveServerToGlobal sposta un server da un progetto a globale
+func (s *MCPService) MoveServerToGlobal(projectPath, name string) error {
+	return s.MoveServer(name, domain.ProjectLocation(projectPath, domain.ScopeProject), domain.GlobalLocation(), false)
 }
 
 // MoveServerToProject sposta un server globale a un progetto
 func (s *MCPService) MoveServerToProject(name, projectPath string) error {
+	return s.MoveServer(name, domain.GlobalLocation(), domain.ProjectLocation(projectPath, domain.ScopeProject), false)
+}
+
+// ResolveConflicts risolve i conflitti di un salvataggio e salva di nuovo, insieme ai file
+// di progetto dello stesso salvataggio: per ogni server tiene la versione del curator se
+// keepOurs lo indica, altrimenti quella su disco
+func (s *MCPService) ResolveConflicts(conflicts []domain.ServerConflict, keepOurs map[domain.ServerRef]bool) error {
 	if s.config == nil {
 		return fmt.Errorf("configurazione non caricata")
 	}
 
-	server, exists := s.config.GetGlobalServer(name)
-	if !exists {
-		return fmt.Errorf("server globale '%s' non trovato", name)
+	for _, conflict := range conflicts {
+		s.claudeRepo.AcceptExternal(conflict)
+		if keepOurs[conflict.Ref] {
+			continue
+		}
+		if conflict.Theirs == nil {
+			s.config.RemoveServer(conflict.Ref.Location, conflict.Ref.Name)
+		} else {
+			s.config.SetServer(conflict.Ref.Location, conflict.Ref.Name, conflict.Theirs.Clone())
+		}
 	}
 
-	project := s.config.GetOrCreateProject(projectPath)
-
-	// Aggiungi al progetto e rimuovi dai globali
-	project.AddServer(name, server)
-	s.config.RemoveGlobalServer(name)
-
-	return s.claudeRepo.Save(s.config)
+	// Il salvataggio in attesa comprende anche i file di progetto, non ancora scritti
+	refs := append([]domain.ServerRef(nil), s.pendingRefs...)
+	for _, conflict := range conflicts {
+		refs = append(refs, conflict.Ref)
+	}
+	if err := s.persist(refs...); err != nil {
+		return err
+	}
+	s.history.rollback = nil
+	return nil
 }
 
 // GetEffectiveServers restituisce i server effettivi per un progetto
@@ -190,7 +347,17 @@ func (s *MCPService) GetEffectiveServers(projectPath string) (map[string]domain.
 		return nil, fmt.Errorf("configurazione non caricata")
 	}
 
-	return s.projectRepo.GetEffectiveServers(s.config, projectPath)
+	return s.config.GetEffectiveServers(projectPath), nil
+}
+
+// GetEffectiveConfig restituisce i server effettivi per un progetto con la loro
+// provenienza e le definizioni che sovrascrivono
+func (s *MCPService) GetEffectiveConfig(projectPath string) (domain.EffectiveConfig, error) {
+	if s.config == nil {
+		return nil, fmt.Errorf("configurazione non caricata")
+	}
+
+	return s.config.GetEffectiveConfig(projectPath), nil
 }
 
 // ParseServerFromJSON converte un JSON raw in nome e MCPServer
@@ -206,6 +373,109 @@ func (s *MCPService) ParseServerFromJSON(jsonData map[string]interface{}) (strin
 	if err != nil {
 		return "", domain.MCPServer{}, err
 	}
+	// Il nome è la chiave in mcpServers, non un campo del server
+	delete(server.Raw, "name")
 
 	return name, server, nil
 }
+
+// ServerJSON restituisce la voce di mcpServers che verrà scritta per il server,
+// inclusi i campi non gestiti dal curator conservati da Raw
+func (s *MCPService) ServerJSON(name string, server domain.MCPServer) (string, error) {
+	data, err := json.MarshalIndent(map[string]interface{}{name: infrastructure.ServerToMap(server)}, "", "  ")
+	if err != nil {
+		return "", fmt.Errorf("impossibile serializzare il server: %w", err)
+	}
+	return string(data), nil
+}
+
+// checkLocation verifica che la configurazione sia caricata e la posizione valida
+func (s *MCPService) checkLocation(loc domain.Location) error {
+	if s.config == nil {
+		return fmt.Errorf("configurazione non caricata")
+	}
+	return loc.Validate()
+}
+
+// ensureProject aggiunge in memoria il progetto di una posizione, caricando i suoi file
+// (senza caricarli un salvataggio riscriverebbe .mcp.json ignorando i server esistenti)
+func (s *MCPService) ensureProject(loc domain.Location) {
+	if loc.IsGlobal() {
+		return
+	}
+	if _, ok := s.config.GetProject(loc.ProjectPath); ok {
+		return
+	}
+	project := s.config.GetOrCreateProject(loc.ProjectPath)
+	_ = s.projectRepo.LoadProject(project)
+}
+
+// persist salva su disco i server indicati: ~/.claude.json viene scritto una sola volta,
+// nei file di progetto vengono aggiornate solo le voci toccate.
+// Il merge di ~/.claude.json viene verificato prima di scrivere: con un conflitto nessun
+// file cambia. Se una scrittura fallisce, i file di progetto già scritti tornano come prima,
+// così uno spostamento tra file non perde né duplica il server.
+func (s *MCPService) persist(refs ...domain.ServerRef) error {
+	saveClaude := false
+	fileChanges := make(map[domain.Location]map[string]*domain.MCPServer)
+
+	for _, ref := range refs {
+		if ref.Scope.FileName() == "" {
+			saveClaude = true
+			continue
+		}
+		if fileChanges[ref.Location] == nil {
+			fileChanges[ref.Location] = make(map[string]*domain.MCPServer)
+		}
+		var current *domain.MCPServer
+		if server, ok := s.config.GetServer(ref.Location, ref.Name); ok {
+			current = &server
+		}
+		fileChanges[ref.Location][ref.Name] = current
+	}
+
+	if saveClaude {
+		if err := s.claudeRepo.Check(s.config); err != nil {
+			return s.pendingOnConflict(refs, err)
+		}
+	}
+
+	written := make([]infrastructure.ProjectFileSnapshot, 0, len(fileChanges))
+	for loc, changes := range fileChanges {
+		snapshot, err := s.projectRepo.Snapshot(loc.ProjectPath, loc.Scope)
+		if err == nil {
+			err = s.projectRepo.UpdateServers(loc.ProjectPath, loc.Scope, changes)
+		}
+		if err != nil {
+			return s.restoreProjectFiles(written, err)
+		}
+		written = append(written, snapshot)
+	}
+
+	if saveClaude {
+		if err := s.claudeRepo.Save(s.config); err != nil {
+			return s.pendingOnConflict(refs, s.restoreProjectFiles(written, err))
+		}
+	}
+	s.pendingRefs = nil
+	return nil
+}
+
+// pendingOnConflict ricorda i server da salvare se err è un conflitto da risolvere
+func (s *MCPService) pendingOnConflict(refs []domain.ServerRef, err error) error {
+	if isConflict(err) {
+		s.pendingRefs = append([]domain.ServerRef(nil), refs...)
+	}
+	return err
+}
+
+// restoreProjectFiles riporta i file di progetto già scritti al contenuto precedente
+// dopo l'errore err, che viene restituito (con gli eventuali ripristini falliti)
+func (s *MCPService) restoreProjectFiles(written []infrastructure.ProjectFileSnapshot, err error) error {
+	for _, snapshot := range written {
+		if restoreErr := s.projectRepo.Restore(snapshot); restoreErr != nil {
+			err = fmt.Errorf("%w (ripristino di %s fallito: %v)", err, snapshot.Path, restoreErr)
+		}
+	}
+	return err
+}
@@ -0,0 +1,205 @@
+package application
+
+import (
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/strawberry-code/mcp-curator/internal/domain"
+)
+
+// newTestService crea un servizio su un ~/.claude.json temporaneo con un progetto il cui
+// .mcp.json contiene il server "demo"
+func newTestService(t *testing.T) (service *MCPService, configPath, projectPath string) {
+	t.Helper()
+	dir := t.TempDir()
+	// Preferenze, vault e cache non devono toccare la directory dell'utente
+	t.Setenv("HOME", dir)
+	t.Setenv("XDG_CONFIG_HOME", filepath.Join(dir, "config"))
+
+	projectPath = filepath.Join(dir, "app")
+	if err := os.MkdirAll(projectPath, 0700); err != nil {
+		t.Fatal(err)
+	}
+	writeTestJSON(t, filepath.Join(projectPath, ".mcp.json"), map[string]interface{}{
+		"mcpServers": map[string]interface{}{"demo": map[string]interface{}{"command": "npx"}},
+	})
+	configPath = filepath.Join(dir, ".claude.json")
+	writeTestJSON(t, configPath, map[string]interface{}{
+		"mcpServers": map[string]interface{}{},
+		"projects":   map[string]interface{}{projectPath: map[string]interface{}{"mcpServers": map[string]interface{}{}}},
+	})
+
+	service = NewMCPServiceWithConfigPath(configPath)
+	if err := service.Load(); err != nil {
+		t.Fatal(err)
+	}
+	return service, configPath, projectPath
+}
+
+// addExternalGlobalServer simula un altro programma che aggiunge un server globale
+func addExternalGlobalServer(t *testing.T, configPath, name, command string) {
+	t.Helper()
+	var raw map[string]interface{}
+	readTestJSON(t, configPath, &raw)
+	raw["mcpServers"].(map[string]interface{})[name] = map[string]interface{}{"command": command}
+	writeTestJSON(t, configPath, raw)
+}
+
+// projectFileServers restituisce i nomi dei server scritti in .mcp.json
+func projectFileServers(t *testing.T, projectPath string) map[string]interface{} {
+	t.Helper()
+	var raw struct {
+		MCPServers map[string]interface{} `json:"mcpServers"`
+	}
+	readTestJSON(t, filepath.Join(projectPath, ".mcp.json"), &raw)
+	return raw.MCPServers
+}
+
+func TestMoveConflictWritesNothingUntilResolved(t *testing.T) {
+	service, configPath, projectPath := newTestService(t)
+	from := domain.ProjectLocation(projectPath, domain.ScopeProjectFile)
+	addExternalGlobalServer(t, configPath, "demo", "uvx")
+
+	err := service.MoveServer("demo", from, domain.GlobalLocation(), true)
+	var conflictErr *domain.ConflictError
+	if !errors.As(err, &conflictErr) {
+		t.Fatalf("atteso un conflitto, ottenuto %v", err)
+	}
+	if _, ok := projectFileServers(t, projectPath)["demo"]; !ok {
+		t.Fatal("il server è stato tolto da .mcp.json prima della risoluzione del conflitto")
+	}
+
+	keepOurs := map[domain.ServerRef]bool{conflictErr.Conflicts[0].Ref: true}
+	if err := service.ResolveConflicts(conflictErr.Conflicts, keepOurs); err != nil {
+		t.Fatalf("ResolveConflicts: %v", err)
+	}
+	if _, ok := projectFileServers(t, projectPath)["demo"]; ok {
+		t.Error("dopo la risoluzione il server è ancora in .mcp.json")
+	}
+	var raw map[string]interface{}
+	readTestJSON(t, configPath, &raw)
+	demo, _ := raw["mcpServers"].(map[string]interface{})["demo"].(map[string]interface{})
+	if demo["command"] != "npx" {
+		t.Errorf("server globale %v, atteso quello spostato", demo)
+	}
+}
+
+func writeTestJSON(t *testing.T, path string, value interface{}) {
+	t.Helper()
+	data, err := json.MarshalIndent(value, "", "  ")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(path, data, 0600); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func readTestJSON(t *testing.T, path string, value interface{}) {
+	t.Helper()
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := json.Unmarshal(data, value); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestDiscardConflictedMoveKeepsServer(t *testing.T) {
+	service, configPath, projectPath := newTestService(t)
+	from := domain.ProjectLocation(projectPath, domain.ScopeProjectFile)
+	addExternalGlobalServer(t, configPath, "demo", "uvx")
+
+	if err := service.MoveServer("demo", from, domain.GlobalLocation(), true); err == nil {
+		t.Fatal("atteso un conflitto")
+	}
+	if err := service.DiscardPendingChanges(); err != nil {
+		t.Fatalf("DiscardPendingChanges: %v", err)
+	}
+
+	if _, ok := service.GetConfiguration().GetServer(from, "demo"); !ok {
+		t.Error("il server non è più nel progetto dopo aver abbandonato lo spostamento")
+	}
+	if _, ok := projectFileServers(t, projectPath)["demo"]; !ok {
+		t.Error("il server non è più in .mcp.json dopo aver abbandonato lo spostamento")
+	}
+	if service.CanUndo() {
+		t.Error("lo spostamento abbandonato è rimasto nella cronologia")
+	}
+}
+
+func TestFailedSaveRestoresMemory(t *testing.T) {
+	service, _, projectPath := newTestService(t)
+	loc := domain.ProjectLocation(projectPath, domain.ScopeProjectFile)
+	mcpPath := filepath.Join(projectPath, ".mcp.json")
+	valid, err := os.ReadFile(mcpPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	commandOf := func() string {
+		server, _ := service.GetConfiguration().GetServer(loc, "demo")
+		return server.Command
+	}
+
+	// Un .mcp.json non leggibile fa fallire il salvataggio senza conflitto
+	if err := os.WriteFile(mcpPath, []byte("{"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	if err := service.UpdateServer(loc, "demo", domain.MCPServer{Command: "node"}); err == nil {
+		t.Fatal("atteso un errore di salvataggio")
+	}
+	if command := commandOf(); command != "npx" {
+		t.Errorf("dopo il salvataggio fallito il server in memoria ha il comando %s, atteso npx", command)
+	}
+	if service.CanUndo() {
+		t.Error("la modifica non salvata è finita nella cronologia")
+	}
+
+	// Lo stesso per l'annullamento: l'operazione resta annullabile
+	if err := os.WriteFile(mcpPath, valid, 0600); err != nil {
+		t.Fatal(err)
+	}
+	if err := service.UpdateServer(loc, "demo", domain.MCPServer{Command: "node"}); err != nil {
+		t.Fatalf("UpdateServer: %v", err)
+	}
+	if err := os.WriteFile(mcpPath, []byte("{"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := service.Undo(); err == nil {
+		t.Fatal("atteso un errore di salvataggio")
+	}
+	if command := commandOf(); command != "node" {
+		t.Errorf("dopo l'annullamento fallito il server in memoria ha il comando %s, atteso node", command)
+	}
+	if !service.CanUndo() {
+		t.Error("la modifica non è più annullabile dopo l'annullamento fallito")
+	}
+}
+
+func TestProjectMissingVariablesIgnoresShadowedServers(t *testing.T) {
+	service, _, projectPath := newTestService(t)
+	t.Setenv("MCP_CURATOR_TEST_UNSET", "")
+	os.Unsetenv("MCP_CURATOR_TEST_UNSET")
+
+	// La definizione globale usa una variabile mancante ma è sovrascritta da .mcp.json
+	global := domain.MCPServer{Command: "npx", Env: map[string]string{"TOKEN": "${MCP_CURATOR_TEST_UNSET}"}}
+	if err := service.AddServer(domain.GlobalLocation(), "demo", global); err != nil {
+		t.Fatalf("AddServer: %v", err)
+	}
+	if missing := service.ProjectMissingVariables(projectPath); len(missing) > 0 {
+		t.Errorf("variabili segnalate per una definizione sovrascritta: %v", missing)
+	}
+
+	// Il server globale senza sovrascritture resta controllato
+	if err := service.AddServer(domain.GlobalLocation(), "other", global); err != nil {
+		t.Fatalf("AddServer: %v", err)
+	}
+	refs := service.ProjectMissingVariables(projectPath)["MCP_CURATOR_TEST_UNSET"]
+	if len(refs) != 1 || refs[0].Name != "other" {
+		t.Errorf("server con la variabile mancante = %v, atteso solo other", refs)
+	}
+}
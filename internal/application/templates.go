@@ -0,0 +1,99 @@
+package application
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+
+	"github.com/strawberry-code/mcp-curator/internal/domain"
+	"github.com/strawberry-code/mcp-curator/internal/infrastructure"
+)
+
+// TemplateCatalogsEnv elenca cataloghi di template aggiuntivi separati come PATH
+// (utile per distribuire il catalogo del team senza toccare le preferenze)
+const TemplateCatalogsEnv = "MCP_CURATOR_TEMPLATES"
+
+// TemplateCatalogs restituisce i cataloghi aggiuntivi: quelli delle preferenze,
+// poi quelli di MCP_CURATOR_TEMPLATES
+func (s *MCPService) TemplateCatalogs() []string {
+	catalogs := append([]string(nil), s.settings.Templates.Catalogs...)
+	for _, path := range filepath.SplitList(os.Getenv(TemplateCatalogsEnv)) {
+		if path != "" && !slices.Contains(catalogs, path) {
+			catalogs = append(catalogs, path)
+		}
+	}
+	return catalogs
+}
+
+// Templates restituisce i template inclusi nel programma e quelli dei cataloghi aggiuntivi,
+// ordinati per categoria e nome. Un template di un catalogo sostituisce quello con lo stesso ID
+// dei cataloghi precedenti. I cataloghi illeggibili vengono saltati e riportati nell'errore.
+func (s *MCPService) Templates() ([]domain.ServerTemplate, error) {
+	templates, err := infrastructure.BuiltinTemplates()
+	if err != nil {
+		return nil, fmt.Errorf("catalogo incluso non valido: %w", err)
+	}
+
+	var errs []error
+	for _, path := range s.TemplateCatalogs() {
+		loaded, err := infrastructure.LoadTemplateCatalog(path)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		for _, t := range loaded {
+			index := slices.IndexFunc(templates, func(existing domain.ServerTemplate) bool { return existing.ID == t.ID })
+			if index >= 0 {
+				templates[index] = t
+			} else {
+				templates = append(templates, t)
+			}
+		}
+	}
+	domain.SortTemplates(templates)
+	return templates, errors.Join(errs...)
+}
+
+// FindTemplate cerca un template per ID
+func (s *MCPService) FindTemplate(id string) (domain.ServerTemplate, error) {
+	templates, err := s.Templates()
+	for _, t := range templates {
+		if t.ID == id {
+			return t, nil
+		}
+	}
+	if err != nil {
+		return domain.ServerTemplate{}, fmt.Errorf("template '%s' non trovato (%w)", id, err)
+	}
+	return domain.ServerTemplate{}, fmt.Errorf("template '%s' non trovato", id)
+}
+
+// AddTemplateCatalog aggiunge alle preferenze un catalogo (file o directory) dopo averlo letto
+func (s *MCPService) AddTemplateCatalog(path string) error {
+	abs, err := filepath.Abs(path)
+	if err != nil {
+		return err
+	}
+	if _, err := infrastructure.LoadTemplateCatalog(abs); err != nil {
+		return err
+	}
+	settings := s.settings
+	if slices.Contains(settings.Templates.Catalogs, abs) {
+		return nil
+	}
+	settings.Templates.Catalogs = append(slices.Clone(settings.Templates.Catalogs), abs)
+	return s.SaveSettings(settings)
+}
+
+// RemoveTemplateCatalog toglie un catalogo dalle preferenze
+func (s *MCPService) RemoveTemplateCatalog(path string) error {
+	settings := s.settings
+	index := slices.Index(settings.Templates.Catalogs, path)
+	if index < 0 {
+		return fmt.Errorf("catalogo non presente nelle preferenze: %s", path)
+	}
+	settings.Templates.Catalogs = slices.Delete(slices.Clone(settings.Templates.Catalogs), index, index+1)
+	return s.SaveSettings(settings)
+}
@@ -0,0 +1,33 @@
+package application
+
+import (
+	"github.com/strawberry-code/mcp-curator/internal/domain"
+)
+
+// ExpandServer espande ${VAR} e ${VAR:-default} con l'ambiente dell'utente,
+// come farebbe Claude Code all'avvio del server. Le variabili assenti dall'ambiente
+// vengono cercate tra i segreti del vault, se sbloccato.
+func (s *MCPService) ExpandServer(server domain.MCPServer) (domain.MCPServer, []domain.MissingVariable) {
+	return server.Expand(s.lookupVariable)
+}
+
+// ServerDiagnostics restituisce i problemi di configurazione del server
+// più le variabili d'ambiente mancanti nell'ambiente corrente e nel vault
+func (s *MCPService) ServerDiagnostics(server domain.MCPServer) domain.ValidationIssues {
+	return append(server.Validate(), server.CheckVariables(s.definedVariable)...)
+}
+
+// ProjectMissingVariables restituisce, per ogni variabile non definita e senza default,
+// i server attivi nel progetto che la usano. Conta solo la definizione effettiva di ogni
+// server: quelle sovrascritte da un livello più specifico non vengono avviate.
+func (s *MCPService) ProjectMissingVariables(path string) map[string][]domain.ServerRef {
+	result := make(map[string][]domain.ServerRef)
+	for name, effective := range s.config.GetEffectiveConfig(path) {
+		_, missing := effective.Server.Expand(s.definedVariable)
+		for _, variable := range domain.MissingVariableNames(missing) {
+			ref := domain.ServerRef{Location: effective.Location, Name: name}
+			result[variable] = append(result[variable], ref)
+		}
+	}
+	return result
+}
@@ -0,0 +1,498 @@
+package application
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/strawberry-code/mcp-curator/internal/domain"
+	"github.com/strawberry-code/mcp-curator/internal/infrastructure"
+)
+
+// launcherFileName è il nome dello script che avvia Claude Code con i segreti del vault
+const launcherFileName = "claude-vault.sh"
+
+// minInlineSecretLength evita di riconoscere come segreto un valore breve e comune
+// (es. "1" o "true") che compare per caso in altri server
+const minInlineSecretLength = 8
+
+// BindingMode indica come un valore env/header usa un segreto del vault
+type BindingMode string
+
+const (
+	// BindReference: il file contiene ${NOME}, il valore arriva dall'ambiente (launcher)
+	BindReference BindingMode = "reference"
+	// BindInline: il file contiene il valore risolto, aggiornato alla rotazione del segreto
+	BindInline BindingMode = "inline"
+)
+
+// SecretBinding è l'uso di un segreto del vault in un valore env o header di un server
+type SecretBinding struct {
+	Ref    domain.ServerRef
+	Field  string
+	Key    string
+	Secret string
+	Mode   BindingMode
+}
+
+// SecretInUseError indica che un segreto è ancora referenziato come ${NOME} da alcuni server
+type SecretInUseError struct {
+	Name     string
+	Bindings []SecretBinding
+}
+
+func (e *SecretInUseError) Error() string {
+	return fmt.Sprintf("il segreto '%s' è usato da %d valori come ${%s}", e.Name, len(e.Bindings), e.Name)
+}
+
+// newVaultRepository crea il repository del vault (nil se la directory
+// di configurazione utente non è determinabile: il vault non è disponibile)
+func newVaultRepository() *infrastructure.VaultRepository {
+	repo, err := infrastructure.NewVaultRepository()
+	if err != nil {
+		return nil
+	}
+	return repo
+}
+
+// checkVault verifica che il vault sia disponibile ed esista
+func (s *MCPService) checkVault() error {
+	if s.vault == nil {
+		return fmt.Errorf("directory di configurazione utente non disponibile")
+	}
+	if !s.vault.Exists() {
+		return infrastructure.ErrVaultNotFound
+	}
+	return nil
+}
+
+// GetVaultPath restituisce il path del file del vault ("" se non disponibile)
+func (s *MCPService) GetVaultPath() string {
+	if s.vault == nil {
+		return ""
+	}
+	return s.vault.GetPath()
+}
+
+// VaultExists verifica se il vault è stato creato
+func (s *MCPService) VaultExists() bool {
+	return s.vault != nil && s.vault.Exists()
+}
+
+// VaultUnlocked indica se i segreti sono disponibili
+func (s *MCPService) VaultUnlocked() bool {
+	return s.vault != nil && s.vault.Unlocked()
+}
+
+// CreateVault crea il vault protetto dalla passphrase e lo lascia sbloccato
+func (s *MCPService) CreateVault(passphrase string) error {
+	if s.vault == nil {
+		return fmt.Errorf("directory di configurazione utente non disponibile")
+	}
+	return s.vault.Create(passphrase)
+}
+
+// UnlockVault sblocca il vault con la passphrase
+func (s *MCPService) UnlockVault(passphrase string) error {
+	if err := s.checkVault(); err != nil {
+		return err
+	}
+	return s.vault.Unlock(passphrase)
+}
+
+// LockVault blocca il vault dimenticando i segreti
+func (s *MCPService) LockVault() {
+	if s.vault != nil {
+		s.vault.Lock()
+	}
+}
+
+// ChangeVaultPassphrase cifra il vault con una nuova passphrase
+func (s *MCPService) ChangeVaultPassphrase(passphrase string) error {
+	if err := s.checkVault(); err != nil {
+		return err
+	}
+	return s.vault.ChangePassphrase(passphrase)
+}
+
+// SecretNames restituisce i nomi dei segreti del vault (disponibili anche a vault bloccato)
+func (s *MCPService) SecretNames() []string {
+	if !s.VaultExists() {
+		return nil
+	}
+	return s.vault.Names()
+}
+
+// HasSecret verifica se il vault contiene un segreto con il nome indicato
+func (s *MCPService) HasSecret(name string) bool {
+	return s.VaultExists() && s.vault.Has(name)
+}
+
+// SecretValue restituisce il valore di un segreto (richiede il vault sbloccato)
+func (s *MCPService) SecretValue(name string) (string, error) {
+	if err := s.checkVault(); err != nil {
+		return "", err
+	}
+	value, ok, err := s.vault.Get(name)
+	if err != nil {
+		return "", err
+	}
+	if !ok {
+		return "", fmt.Errorf("segreto '%s' non presente nel vault", name)
+	}
+	return value, nil
+}
+
+// SetSecret crea un nuovo segreto nel vault. Per cambiare il valore di un segreto
+// esistente usare RotateSecret, che aggiorna anche i server che lo contengono.
+func (s *MCPService) SetSecret(name, value string) error {
+	if err := s.checkVault(); err != nil {
+		return err
+	}
+	if !domain.ValidateSecretName(name) {
+		return fmt.Errorf("nome di segreto non valido: %s (usare lettere, numeri e '_')", name)
+	}
+	if value == "" {
+		return fmt.Errorf("il valore del segreto non può essere vuoto")
+	}
+	if s.vault.Has(name) {
+		return fmt.Errorf("il segreto '%s' esiste già: usare la rotazione per cambiarne il valore", name)
+	}
+	return s.vault.Set(name, value)
+}
+
+// DeleteSecret elimina un segreto dal vault. Un segreto ancora referenziato come ${NOME}
+// non viene eliminato (*SecretInUseError): i server resterebbero senza valore.
+func (s *MCPService) DeleteSecret(name string) error {
+	if err := s.checkVault(); err != nil {
+		return err
+	}
+	var references []SecretBinding
+	for _, binding := range s.SecretUsages(name) {
+		if binding.Mode == BindReference {
+			references = append(references, binding)
+		}
+	}
+	if len(references) > 0 {
+		return &SecretInUseError{Name: name, Bindings: references}
+	}
+	return s.vault.Delete(name)
+}
+
+// SecretUsages restituisce i valori env e header che usano il segreto, come riferimento
+// ${NOME} o come valore risolto (questi ultimi solo a vault sbloccato)
+func (s *MCPService) SecretUsages(name string) []SecretBinding {
+	var usages []SecretBinding
+	for _, binding := range s.SecretBindings() {
+		if binding.Secret == name {
+			usages = append(usages, binding)
+		}
+	}
+	return usages
+}
+
+// SecretBindings restituisce tutti gli usi dei segreti del vault nei server configurati
+func (s *MCPService) SecretBindings() []SecretBinding {
+	if s.config == nil || !s.VaultExists() {
+		return nil
+	}
+	servers := s.config.AllServers()
+	refs := make([]domain.ServerRef, 0, len(servers))
+	for ref := range servers {
+		refs = append(refs, ref)
+	}
+
+	var bindings []SecretBinding
+	for _, ref := range domain.SortedServerRefs(refs) {
+		server := servers[ref]
+		for _, field := range []string{domain.FieldEnv, domain.FieldHeaders} {
+			values := server.KeyValues(field)
+			for _, key := range domain.SortedKeys(values) {
+				for _, binding := range s.ValueBindings(values[key]) {
+					binding.Ref, binding.Field, binding.Key = ref, field, key
+					bindings = append(bindings, binding)
+				}
+			}
+		}
+	}
+	return bindings
+}
+
+// ValueBindings riconosce i segreti del vault usati in un valore: i riferimenti ${NOME}
+// a segreti esistenti e, a vault sbloccato, i valori dei segreti contenuti nel testo.
+// Ref, Field e Key delle voci restituite non sono valorizzati.
+func (s *MCPService) ValueBindings(value string) []SecretBinding {
+	if value == "" || !s.VaultExists() {
+		return nil
+	}
+	var bindings []SecretBinding
+	for _, name := range s.vault.Names() {
+		if strings.Contains(value, domain.SecretReferenceValue(name)) {
+			bindings = append(bindings, SecretBinding{Secret: name, Mode: BindReference})
+			continue
+		}
+		secret, ok, err := s.vault.Get(name)
+		if err == nil && ok && len(secret) >= minInlineSecretLength && strings.Contains(value, secret) {
+			bindings = append(bindings, SecretBinding{Secret: name, Mode: BindInline})
+		}
+	}
+	return bindings
+}
+
+// BindSecret collega un valore env o header di un server a un segreto del vault.
+// Se il segreto non esiste viene creato con il valore attuale. Con BindReference il segreto
+// nel valore viene sostituito da ${NOME}; con BindInline il file contiene il valore risolto.
+func (s *MCPService) BindSecret(ref domain.ServerRef, field, key, name string, mode BindingMode) error {
+	if err := s.checkLocation(ref.Location); err != nil {
+		return err
+	}
+	if err := s.checkVault(); err != nil {
+		return err
+	}
+	if !s.vault.Unlocked() {
+		return infrastructure.ErrVaultLocked
+	}
+	if !domain.ValidateSecretName(name) {
+		return fmt.Errorf("nome di segreto non valido: %s (usare lettere, numeri e '_')", name)
+	}
+
+	server, exists := s.config.GetServer(ref.Location, ref.Name)
+	if !exists {
+		return fmt.Errorf("server '%s' non trovato in %s", ref.Name, ref.Location)
+	}
+	current, ok := server.KeyValues(field)[key]
+	if !ok {
+		return fmt.Errorf("chiave '%s' non presente in %s del server '%s'", key, field, ref.Name)
+	}
+
+	reference := domain.SecretReferenceValue(name)
+	secret, exists, err := s.vault.Get(name)
+	if err != nil {
+		return err
+	}
+	if !exists {
+		if current == "" || strings.Contains(current, "${") {
+			return fmt.Errorf("il valore di '%s' non può diventare un segreto: è vuoto o contiene variabili", key)
+		}
+		if err := s.vault.Set(name, current); err != nil {
+			return err
+		}
+		secret = current
+	}
+
+	value := current
+	switch {
+	case strings.Contains(value, reference):
+	case strings.Contains(value, secret):
+		value = strings.ReplaceAll(value, secret, reference)
+	default:
+		value = reference
+	}
+	if mode == BindInline {
+		value = strings.ReplaceAll(value, reference, secret)
+	}
+	if value == current {
+		return nil
+	}
+
+	updated := server.Clone()
+	updated.SetKeyValue(field, key, value)
+	changes := s.snapshot(ref)
+	s.config.SetServer(ref.Location, ref.Name, updated)
+	return s.record(OpBind, name, changes, s.persist(ref))
+}
+
+// RotateSecret cambia il valore di un segreto e aggiorna in un'unica operazione tutti i
+// server che contengono il valore precedente. I server che usano ${NOME} non cambiano:
+// riceveranno il nuovo valore al prossimo avvio dal launcher.
+// Se il salvataggio dei server fallisce il vault mantiene il valore precedente; annullare
+// l'operazione ripristina sia i server sia il valore nel vault.
+func (s *MCPService) RotateSecret(name, value string) ([]domain.ServerRef, error) {
+	if s.config == nil {
+		return nil, fmt.Errorf("configurazione non caricata")
+	}
+	if err := s.checkVault(); err != nil {
+		return nil, err
+	}
+	old, exists, err := s.vault.Get(name)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, fmt.Errorf("segreto '%s' non presente nel vault", name)
+	}
+	if value == "" {
+		return nil, fmt.Errorf("il valore del segreto non può essere vuoto")
+	}
+	if value == old {
+		return nil, nil
+	}
+
+	inline := make(map[domain.ServerRef]bool)
+	for _, binding := range s.SecretUsages(name) {
+		if binding.Mode == BindInline {
+			inline[binding.Ref] = true
+		}
+	}
+	if len(inline) == 0 {
+		return nil, s.vault.Set(name, value)
+	}
+
+	refs := make([]domain.ServerRef, 0, len(inline))
+	for ref := range inline {
+		refs = append(refs, ref)
+	}
+	refs = domain.SortedServerRefs(refs)
+
+	changes := s.snapshot(refs...)
+	for _, ref := range refs {
+		server, _ := s.config.GetServer(ref.Location, ref.Name)
+		updated := server.Clone()
+		for _, field := range []string{domain.FieldEnv, domain.FieldHeaders} {
+			for key, current := range updated.KeyValues(field) {
+				updated.SetKeyValue(field, key, strings.ReplaceAll(current, old, value))
+			}
+		}
+		s.config.SetServer(ref.Location, ref.Name, updated)
+	}
+	err = s.record(OpRotate, name, changes, s.persistSecret(name, value, old, refs...))
+	if err != nil && !isConflict(err) {
+		return nil, err
+	}
+	s.history.undo[len(s.history.undo)-1].Secret = &SecretChange{Name: name, Before: old, After: value}
+	if err != nil {
+		s.restoreSecretOnDiscard(name, old)
+	}
+	return refs, err
+}
+
+// persistSecret scrive nel vault il valore di un segreto e salva i server che lo
+// contengono inline. Se il salvataggio dei server fallisce il vault torna al valore
+// precedente, così segreto e server restano allineati; con un conflitto il valore resta
+// in attesa della risoluzione, come i server.
+func (s *MCPService) persistSecret(name, value, previous string, refs ...domain.ServerRef) error {
+	if err := s.vault.Set(name, value); err != nil {
+		return err
+	}
+	err := s.persist(refs...)
+	if err != nil && !isConflict(err) {
+		if restoreErr := s.vault.Set(name, previous); restoreErr != nil {
+			return fmt.Errorf("%w (ripristino del segreto '%s' nel vault fallito: %v)", err, name, restoreErr)
+		}
+	}
+	return err
+}
+
+// restoreSecretOnDiscard estende il rollback di un salvataggio in conflitto: se le
+// modifiche vengono abbandonate il segreto torna al valore precedente
+func (s *MCPService) restoreSecretOnDiscard(name, previous string) {
+	rollback := s.history.rollback
+	if rollback == nil {
+		return
+	}
+	s.history.rollback = func() {
+		rollback()
+		// Il rollback non può fallire: con il vault bloccato il valore resta quello nuovo
+		_ = s.vault.Set(name, previous)
+	}
+}
+
+// checkSecretState verifica che il segreto di un'operazione abbia ancora il valore
+// lasciato dall'operazione (undo) o quello precedente (redo)
+func (s *MCPService) checkSecretState(secret SecretChange, forward bool) error {
+	if err := s.checkVault(); err != nil {
+		return err
+	}
+	expected := secret.After
+	if forward {
+		expected = secret.Before
+	}
+	current, exists, err := s.vault.Get(secret.Name)
+	if err != nil {
+		return err
+	}
+	if !exists || current != expected {
+		return fmt.Errorf("il segreto '%s' è stato modificato dopo l'operazione", secret.Name)
+	}
+	return nil
+}
+
+// VaultExports restituisce i segreti del vault referenziati come ${NOME} da almeno
+// un server: sono le variabili che il launcher esporta prima di avviare Claude Code
+func (s *MCPService) VaultExports() (map[string]string, error) {
+	if s.config == nil {
+		return nil, fmt.Errorf("configurazione non caricata")
+	}
+	if err := s.checkVault(); err != nil {
+		return nil, err
+	}
+	if !s.vault.Unlocked() {
+		return nil, infrastructure.ErrVaultLocked
+	}
+
+	exports := make(map[string]string)
+	for _, server := range s.config.AllServers() {
+		server.Expand(func(name string) (string, bool) {
+			if value, ok, _ := s.vault.Get(name); ok {
+				exports[name] = value
+			}
+			return "", false
+		})
+	}
+	return exports, nil
+}
+
+// GetLauncherPath restituisce il path dello script di avvio con i segreti ("" se non disponibile)
+func (s *MCPService) GetLauncherPath() string {
+	if s.vault == nil {
+		return ""
+	}
+	return filepath.Join(filepath.Dir(s.vault.GetPath()), launcherFileName)
+}
+
+// WriteLauncher genera lo script che chiede la passphrase, esporta i segreti referenziati
+// come ${NOME} e avvia Claude Code. executable è il path del curator (vuoto = eseguibile corrente).
+func (s *MCPService) WriteLauncher(executable string) (string, error) {
+	if err := s.checkVault(); err != nil {
+		return "", err
+	}
+	if executable == "" {
+		var err error
+		if executable, err = os.Executable(); err != nil {
+			return "", fmt.Errorf("impossibile determinare il path del curator: %w", err)
+		}
+	}
+
+	path := s.GetLauncherPath()
+	command := []string{executable, "--config", s.GetConfigPath()}
+	if err := infrastructure.WriteVaultLauncher(path, command); err != nil {
+		return "", err
+	}
+	return path, nil
+}
+
+// lookupVariable cerca una variabile nell'ambiente e poi tra i segreti del vault sbloccato
+func (s *MCPService) lookupVariable(name string) (string, bool) {
+	if value, ok := os.LookupEnv(name); ok {
+		return value, true
+	}
+	if s.vault != nil {
+		if value, ok, _ := s.vault.Get(name); ok {
+			return value, true
+		}
+	}
+	return "", false
+}
+
+// definedVariable come lookupVariable, ma considera definiti anche i segreti del vault
+// bloccato: il launcher li esporterà all'avvio di Claude Code
+func (s *MCPService) definedVariable(name string) (string, bool) {
+	if value, ok := s.lookupVariable(name); ok {
+		return value, true
+	}
+	if s.HasSecret(name) {
+		return domain.SecretReferenceValue(name), true
+	}
+	return "", false
+}
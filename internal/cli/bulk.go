@@ -0,0 +1,111 @@
+package cli
+
+import (
+	"fmt"
+
+	"github.com/strawberry-code/mcp-curator/internal/application"
+	"github.com/strawberry-code/mcp-curator/internal/domain"
+	"github.com/strawberry-code/mcp-curator/internal/i18n"
+)
+
+// runBulk rimuove, sposta o copia in un'unica operazione tutti i server selezionati con
+// gli stessi criteri di `search`, eventualmente limitati a un progetto
+func runBulk(c *CLI, args []string) error {
+	if len(args) == 0 {
+		return errUsage
+	}
+	action := application.BulkAction(args[0])
+	if action != application.BulkRemove && action != application.BulkMove && action != application.BulkClone {
+		return errUsage
+	}
+
+	fs := c.newFlagSet("bulk " + args[0])
+	var ff filterFlags
+	ff.register(fs)
+	project := fs.String("project", "", i18n.T("cli.flag.project_only"))
+	var targets stringList
+	fs.Var(&targets, "to", i18n.T("cli.flag.to_bulk"))
+	toScope := fs.String("to-scope", "", i18n.T("cli.flag.to_scope_many"))
+	force := fs.Bool("force", false, i18n.T("cli.flag.force_many"))
+	dryRun := fs.Bool("dry-run", false, i18n.T("cli.flag.dry_run"))
+	positional, err := parseArgs(fs, args[1:])
+	if err != nil {
+		return err
+	}
+	if len(positional) > 1 {
+		return errUsage
+	}
+	// Senza criteri la selezione sarebbe l'intera configurazione
+	query := firstArg(positional)
+	if query == "" && ff.serverType == "" && ff.source == "" && *project == "" {
+		return fmt.Errorf("indicare almeno un criterio di selezione (testo, --type, --source o --project)")
+	}
+	switch {
+	case action == application.BulkRemove && len(targets) > 0,
+		action == application.BulkMove && len(targets) != 1,
+		action == application.BulkClone && len(targets) == 0:
+		return errUsage
+	}
+
+	filter, err := ff.filter(query)
+	if err != nil {
+		return err
+	}
+	projectPath, err := resolveProjectPath(*project)
+	if err != nil {
+		return err
+	}
+	locations := make([]domain.Location, 0, len(targets))
+	for _, target := range targets {
+		scope := *toScope
+		if target == scopeGlobalKeyword {
+			scope = ""
+		}
+		loc, err := parseLocation(target, scope)
+		if err != nil {
+			return err
+		}
+		locations = append(locations, loc)
+	}
+
+	service, err := c.loadService()
+	if err != nil {
+		return err
+	}
+	refs := matchingServers(service.GetConfiguration(), filter, projectPath)
+	if len(refs) == 0 {
+		fmt.Fprintln(c.stdout, i18n.T("cli.bulk_none"))
+		return nil
+	}
+
+	op := application.BulkOperation{Action: action, Servers: refs, Targets: locations, Overwrite: *force}
+	if *dryRun {
+		plan, err := service.PlanBulk(op)
+		if err != nil {
+			return err
+		}
+		c.printBulkPlan(plan)
+		fmt.Fprintf(c.stdout, i18n.T("cli.bulk_plan")+"\n", len(refs), changeCounts(plan.Changes))
+		return nil
+	}
+
+	plan, err := service.ApplyBulk(op)
+	c.printBulkPlan(plan)
+	if len(plan.Changes) > 0 {
+		fmt.Fprintf(c.stdout, i18n.T("cli.bulk_done")+"\n", len(refs), changeCounts(plan.Changes))
+	}
+	if err != nil && len(plan.Changes) > 0 && len(plan.Skipped) > 0 {
+		return fmt.Errorf("alcuni server sono stati saltati (usa --force per sovrascrivere)")
+	}
+	return err
+}
+
+// printBulkPlan stampa le modifiche di un'operazione di gruppo e i server saltati
+func (c *CLI) printBulkPlan(plan application.BulkPlan) {
+	for _, change := range plan.Changes {
+		fmt.Fprintf(c.stdout, "%s %s (%s)\n", changeSymbol(change.Kind), change.Ref.Name, locationLabel(change.Ref.Location))
+	}
+	for _, err := range plan.Skipped {
+		fmt.Fprintf(c.stdout, "! %s\n", errorText(err))
+	}
+}
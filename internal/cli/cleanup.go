@@ -0,0 +1,103 @@
+package cli
+
+import (
+	"fmt"
+	"text/tabwriter"
+
+	"github.com/strawberry-code/mcp-curator/internal/domain"
+	"github.com/strawberry-code/mcp-curator/internal/i18n"
+)
+
+// runCleanup elenca i progetti di ~/.claude.json la cui cartella non esiste più e ne
+// rimuove le voci, dopo averle eventualmente salvate in un archivio
+func runCleanup(c *CLI, args []string) error {
+	fs := c.newFlagSet("cleanup")
+	archive := fs.String("archive", "", i18n.T("cli.flag.archive"))
+	fs.BoolVar(&c.reveal, "reveal", false, i18n.T("cli.flag.reveal_archive"))
+	dryRun := fs.Bool("dry-run", false, i18n.T("cli.flag.dry_run_cleanup"))
+	asJSON := fs.Bool("json", false, i18n.T("cli.flag.json_cleanup"))
+	positional, err := parseArgs(fs, args)
+	if err != nil {
+		return err
+	}
+
+	service, err := c.loadService()
+	if err != nil {
+		return err
+	}
+	stale := service.StaleProjects()
+
+	// Con dei path si rimuovono solo quelli, che devono essere tra i progetti non trovati
+	if len(positional) > 0 {
+		byPath := make(map[string]*domain.Project, len(stale))
+		for _, p := range stale {
+			byPath[p.Path] = p
+		}
+		selected := make([]*domain.Project, 0, len(positional))
+		for _, arg := range positional {
+			path, err := resolveProjectPath(arg)
+			if err != nil {
+				return err
+			}
+			p, ok := byPath[path]
+			if !ok {
+				return fmt.Errorf("'%s' non è un progetto con la cartella mancante", path)
+			}
+			selected = append(selected, p)
+		}
+		stale = selected
+	}
+
+	if *asJSON {
+		result := make([]interface{}, 0, len(stale))
+		for _, p := range stale {
+			result = append(result, map[string]interface{}{
+				"path":    p.Path,
+				"servers": p.AllServerCount(),
+				"bytes":   p.DataSize,
+			})
+		}
+		return c.printJSON(result)
+	}
+	if len(stale) == 0 {
+		fmt.Fprintln(c.stdout, i18n.T("cli.cleanup_none"))
+		return nil
+	}
+
+	var total int64
+	paths := make([]string, len(stale))
+	w := tabwriter.NewWriter(c.stdout, 0, 4, 2, ' ', 0)
+	for i, p := range stale {
+		paths[i] = p.Path
+		total += p.DataSize
+		fmt.Fprintf(w, "%s\t%s\t%s\n", p.Path, fmt.Sprintf(i18n.T("cli.server_count"), p.AllServerCount()), formatBytes(p.DataSize))
+	}
+	w.Flush()
+	if *dryRun {
+		fmt.Fprintf(c.stdout, i18n.T("cli.cleanup_plan")+"\n", len(stale), formatBytes(total))
+		return nil
+	}
+
+	if *archive != "" {
+		if err := service.ArchiveProjects(paths, *archive, c.reveal); err != nil {
+			return err
+		}
+		fmt.Fprintf(c.stdout, i18n.T("cli.archive_saved")+"\n", *archive)
+	}
+	if err := service.RemoveProjects(paths); err != nil {
+		return err
+	}
+	fmt.Fprintf(c.stdout, i18n.T("cli.cleanup_done")+"\n", len(paths), service.GetConfigPath(), formatBytes(total))
+	return nil
+}
+
+// formatBytes formatta una dimensione in byte in forma leggibile
+func formatBytes(size int64) string {
+	switch {
+	case size < 1024:
+		return fmt.Sprintf("%d B", size)
+	case size < 1024*1024:
+		return fmt.Sprintf("%.1f KB", float64(size)/1024)
+	}
+	return fmt.Sprintf("%.1f MB", float64(size)/(1024*1024))
+}
@@ -0,0 +1,216 @@
+package cli
+
+import (
+	"bufio"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"slices"
+	"strings"
+
+	"github.com/strawberry-code/mcp-curator/internal/application"
+	"github.com/strawberry-code/mcp-curator/internal/domain"
+	"github.com/strawberry-code/mcp-curator/internal/i18n"
+	"github.com/strawberry-code/mcp-curator/internal/version"
+)
+
+// Codici di uscita
+const (
+	exitOK    = 0
+	exitError = 1
+	exitUsage = 2
+)
+
+// errUsage segnala un errore di sintassi già descritto all'utente
+var errUsage = errors.New("uso non valido")
+
+// command descrive un sottocomando della CLI
+type command struct {
+	name  string
+	usage string
+	run   func(c *CLI, args []string) error
+}
+
+// summary restituisce la descrizione del comando nella lingua corrente
+func (cmd command) summary() string {
+	return i18n.T("cli.cmd." + cmd.name)
+}
+
+// CLI esegue i sottocomandi headless senza avviare l'interfaccia grafica
+type CLI struct {
+	stdout     io.Writer
+	stderr     io.Writer
+	configPath string
+	service    *application.MCPService
+	// stdin legge i segreti riga per riga quando l'input non è un terminale
+	stdin *bufio.Reader
+	// reveal disattiva la rimozione dei segreti dall'output (flag --reveal)
+	reveal bool
+}
+
+var commands = []command{
+	{"list", "list [--project PATH] [--effective] [--json] [--reveal]", runList},
+	{"show", "show NOME [--project PATH [--scope S]] [--json] [--reveal]", runShow},
+	{"add", "add NOME [--project PATH [--scope S]] [--template ID [--param K=V]...] [--type T] [--command CMD] [--arg A]... [--url URL] [--env K=V]... [--header K=V]... [--timeout MS] [--json JSON]", runAdd},
+	{"update", "update NOME [--project PATH [--scope S]] [--type T] [--command CMD] [--arg A]... [--clear-args] [--url URL] [--env K=V]... [--unset-env K]... [--header K=V]... [--unset-header K]... [--timeout MS]", runUpdate},
+	{"remove", "remove NOME [--project PATH [--scope S]]", runRemove},
+	{"move", "move NOME [--from PATH [--from-scope S]] --to global|PATH [--to-scope S] [--force]", runMove},
+	{"clone", "clone NOME [--project PATH [--scope S]] --to global|PATH [--to ...] [--to-scope S]", runClone},
+	{"bulk", "bulk remove|move|clone [TESTO] [--type T] [--source S] [--project PATH] [--to global|PATH]... [--to-scope S] [--force] [--dry-run]", runBulk},
+	{"import", "import [PATH [--format F] [--project PATH [--scope S]] [--server NOME]... [--dry-run] [--force]] [--json]", runImport},
+	{"export", "export [NOME]... [--project PATH [--scope S]|--project PATH --effective] [--format F] [--output FILE] [--reveal]", runExport},
+	{"search", "search [TESTO] [--type T] [--source S] [--sort name|type|usage] [--json] [--reveal]", runSearch},
+	{"compare", "compare SINISTRA DESTRA [--all] [--json] [--reveal]", runCompare},
+	{"duplicates", "duplicates [--json] [--reveal]", runDuplicates},
+	{"promote", "promote NOME [--project PATH [--scope S]] [--as NOME] [--keep-copies] [--dry-run]", runPromote},
+	{"discover", "discover [CARTELLA]... [--depth N] [--save-roots] [--register] [--json]", runDiscover},
+	{"register", "register PATH...", runRegister},
+	{"cleanup", "cleanup [PATH]... [--archive FILE [--reveal]] [--dry-run] [--json]", runCleanup},
+	{"validate", "validate", runValidate},
+	{"test", "test NOME [--project PATH [--scope S]] [--json]", runTest},
+	{"inventory", "inventory NOME [--project PATH [--scope S]] [--cached] [--json]", runInventory},
+	{"templates", "templates [list] [--json]|show ID [--json]|add-catalog PATH|remove-catalog PATH", runTemplates},
+	{"vault", "vault init|list [--unlock]|set NOME|rotate NOME|delete NOME|bind NOME (--env K|--header K) [--secret S] [--inline] [--project PATH [--scope S]]|export|launcher|passwd", runVault},
+	{"backups", "backups [--diff]", runBackups},
+	{"restore", "restore N|PATH [--server NOME]...", runRestore},
+	{"version", "version", runVersion},
+}
+
+// IsCommand verifica se gli argomenti richiedono la modalità CLI invece della GUI
+func IsCommand(args []string) bool {
+	for _, arg := range args {
+		// macOS passa -psn_X_Y alle app avviate dal Finder
+		if !strings.HasPrefix(arg, "-psn_") {
+			return true
+		}
+	}
+	return false
+}
+
+// Run esegue il sottocomando indicato e restituisce il codice di uscita
+func Run(args []string, stdout, stderr io.Writer) int {
+	c := &CLI{stdout: stdout, stderr: stderr}
+
+	// Flag globali prima del sottocomando
+	global := flag.NewFlagSet(version.Name, flag.ContinueOnError)
+	global.SetOutput(stderr)
+	global.StringVar(&c.configPath, "config", "", i18n.T("cli.flag_config"))
+	lang := global.String("lang", "", i18n.T("cli.flag_lang"))
+	global.Usage = func() { c.printUsage(stderr) }
+	if err := global.Parse(args); err != nil {
+		if errors.Is(err, flag.ErrHelp) {
+			return exitOK
+		}
+		return exitUsage
+	}
+	args = global.Args()
+
+	if *lang != "" {
+		code := strings.ToUpper(*lang)
+		if !slices.Contains(i18n.SupportedLangs, code) {
+			fmt.Fprintf(stderr, i18n.T("cli.unknown_lang")+"\n", *lang, strings.Join(i18n.SupportedLangs, ", "))
+			return exitUsage
+		}
+		i18n.SetLang(code)
+	}
+
+	if len(args) == 0 || args[0] == "help" {
+		c.printUsage(stdout)
+		return exitOK
+	}
+
+	for _, cmd := range commands {
+		if cmd.name != args[0] {
+			continue
+		}
+		if err := cmd.run(c, args[1:]); err != nil {
+			if errors.Is(err, flag.ErrHelp) {
+				return exitOK
+			}
+			if errors.Is(err, errUsage) {
+				fmt.Fprintf(stderr, i18n.T("cli.usage_line")+"\n", cmd.usage)
+				return exitUsage
+			}
+			fmt.Fprintf(stderr, i18n.T("cli.error")+"\n", errorText(err))
+			return exitError
+		}
+		return exitOK
+	}
+
+	fmt.Fprintf(stderr, i18n.T("cli.unknown_command")+"\n\n", args[0])
+	c.printUsage(stderr)
+	return exitUsage
+}
+
+// errorText descrive un errore per il terminale, con i problemi di validazione tradotti;
+// gli errori multipli (errors.Join) vanno uno per riga
+func errorText(err error) string {
+	if joined, ok := err.(interface{ Unwrap() []error }); ok {
+		lines := make([]string, 0, len(joined.Unwrap()))
+		for _, e := range joined.Unwrap() {
+			lines = append(lines, errorText(e))
+		}
+		return strings.Join(lines, "\n")
+	}
+	var validationErr *domain.ValidationError
+	if errors.As(err, &validationErr) {
+		errs := validationErr.Issues.Errors()
+		messages := make([]string, len(errs))
+		for i, issue := range errs {
+			messages[i] = i18n.Issue(issue.Code, issue.Detail)
+		}
+		return fmt.Sprintf(i18n.T("validation.invalid_server"), validationErr.Name) + ": " + strings.Join(messages, "; ")
+	}
+	return err.Error()
+}
+
+// printUsage stampa l'elenco dei sottocomandi
+func (c *CLI) printUsage(w io.Writer) {
+	fmt.Fprintf(w, "%s v%s\n\n", version.Name, version.Version)
+	fmt.Fprintln(w, i18n.T("cli.help_gui"))
+	fmt.Fprintln(w, "\n"+i18n.T("cli.help_usage"))
+	fmt.Fprintln(w, "\n"+i18n.T("cli.help_commands"))
+	// La colonna delle descrizioni segue il nome di comando più lungo
+	width := 0
+	for _, cmd := range commands {
+		width = max(width, len(cmd.name))
+	}
+	indent := strings.Repeat(" ", width+3)
+	for _, cmd := range commands {
+		fmt.Fprintf(w, "  %-*s %s\n", width, cmd.name, cmd.summary())
+		fmt.Fprintf(w, "%smcp-curator %s\n", indent, cmd.usage)
+	}
+	fmt.Fprintln(w, "\n"+i18n.T("cli.help_scope"))
+}
+
+// newFlagSet crea un FlagSet per un sottocomando
+func (c *CLI) newFlagSet(name string) *flag.FlagSet {
+	fs := flag.NewFlagSet(name, flag.ContinueOnError)
+	fs.SetOutput(c.stderr)
+	return fs
+}
+
+// loadService crea e carica il servizio MCP
+func (c *CLI) loadService() (*application.MCPService, error) {
+	if c.service != nil {
+		return c.service, nil
+	}
+
+	var service *application.MCPService
+	if c.configPath != "" {
+		service = application.NewMCPServiceWithConfigPath(c.configPath)
+	} else {
+		var err error
+		service, err = application.NewMCPService()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if err := service.Load(); err != nil {
+		return nil, err
+	}
+	c.service = service
+	return service, nil
+}
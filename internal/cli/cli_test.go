@@ -0,0 +1,39 @@
+package cli
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+)
+
+func TestPrintUsageAlignsSummaries(t *testing.T) {
+	var stdout, stderr bytes.Buffer
+	if code := Run([]string{"help"}, &stdout, &stderr); code != exitOK {
+		t.Fatalf("exit code %d: %s", code, stderr.String())
+	}
+
+	// Ogni descrizione e ogni riga d'uso iniziano alla stessa colonna
+	column := -1
+	for _, cmd := range commands {
+		for _, line := range strings.Split(stdout.String(), "\n") {
+			var start int
+			switch {
+			case strings.HasPrefix(line, "  "+cmd.name+" "):
+				start = strings.Index(line, cmd.summary())
+			case strings.HasSuffix(line, "mcp-curator "+cmd.usage):
+				start = strings.Index(line, "mcp-curator")
+			default:
+				continue
+			}
+			if column == -1 {
+				column = start
+			}
+			if start != column {
+				t.Errorf("colonna %d invece di %d: %q", start, column, line)
+			}
+		}
+	}
+	if column == -1 {
+		t.Fatal("nessun comando nell'uso")
+	}
+}
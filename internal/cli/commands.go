@@ -0,0 +1,1086 @@
+package cli
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/strawberry-code/mcp-curator/internal/application"
+	"github.com/strawberry-code/mcp-curator/internal/domain"
+	"github.com/strawberry-code/mcp-curator/internal/i18n"
+	"github.com/strawberry-code/mcp-curator/internal/infrastructure"
+	"github.com/strawberry-code/mcp-curator/internal/version"
+)
+
+// scopeGlobalKeyword identifica lo scope globale negli argomenti --to
+const scopeGlobalKeyword = "global"
+
+// locationFlags raccoglie i flag che identificano la posizione di un server
+type locationFlags struct {
+	project string
+	scope   string
+}
+
+// register registra i flag di posizione su un FlagSet
+func (lf *locationFlags) register(fs *flag.FlagSet, projectUsage string) {
+	fs.StringVar(&lf.project, "project", "", projectUsage)
+	fs.StringVar(&lf.scope, "scope", "", i18n.T("cli.flag.scope"))
+}
+
+// location converte i flag nella posizione corrispondente (globale se manca --project)
+func (lf *locationFlags) location() (domain.Location, error) {
+	return parseLocation(lf.project, lf.scope)
+}
+
+// parseLocation costruisce una posizione da path di progetto e scope di progetto
+func parseLocation(project, scope string) (domain.Location, error) {
+	if project == "" || project == scopeGlobalKeyword {
+		if scope != "" {
+			return domain.Location{}, fmt.Errorf("--scope richiede un progetto")
+		}
+		return domain.GlobalLocation(), nil
+	}
+
+	projectScope := domain.ScopeProject
+	if scope != "" {
+		projectScope = domain.Scope(scope)
+		if !projectScope.IsProjectScope() {
+			return domain.Location{}, fmt.Errorf("scope '%s' non valido (project, project-file, project-local)", scope)
+		}
+	}
+
+	projectPath, err := resolveProjectPath(project)
+	if err != nil {
+		return domain.Location{}, err
+	}
+	return domain.ProjectLocation(projectPath, projectScope), nil
+}
+
+// serverFlags raccoglie i flag che descrivono i campi di un server
+type serverFlags struct {
+	serverType string
+	command    string
+	args       stringList
+	url        string
+	env        keyValues
+	headers    keyValues
+	timeout    int
+}
+
+// register registra i flag dei campi server su un FlagSet
+func (sf *serverFlags) register(fs *flag.FlagSet) {
+	sf.env = make(keyValues)
+	sf.headers = make(keyValues)
+	fs.StringVar(&sf.serverType, "type", "", i18n.T("cli.flag.type"))
+	fs.StringVar(&sf.command, "command", "", i18n.T("cli.flag.command"))
+	fs.Var(&sf.args, "arg", i18n.T("cli.flag.arg"))
+	fs.StringVar(&sf.url, "url", "", i18n.T("cli.flag.url"))
+	fs.Var(sf.env, "env", i18n.T("cli.flag.env"))
+	fs.Var(sf.headers, "header", i18n.T("cli.flag.header"))
+	fs.IntVar(&sf.timeout, "timeout", 0, i18n.T("cli.flag.timeout"))
+}
+
+// apply applica al server i flag impostati esplicitamente
+func (sf *serverFlags) apply(server *domain.MCPServer, set map[string]bool) {
+	if set["type"] {
+		server.Type = domain.ServerType(sf.serverType)
+	}
+	if set["command"] {
+		server.Command = sf.command
+	}
+	if set["arg"] {
+		server.Args = append([]string(nil), sf.args...)
+	}
+	if set["url"] {
+		server.URL = sf.url
+	}
+	if set["env"] {
+		if server.Env == nil {
+			server.Env = make(map[string]string)
+		}
+		for k, v := range sf.env {
+			server.Env[k] = v
+		}
+	}
+	if set["header"] {
+		if server.Headers == nil {
+			server.Headers = make(map[string]string)
+		}
+		for k, v := range sf.headers {
+			server.Headers[k] = v
+		}
+	}
+	if set["timeout"] {
+		server.Timeout = sf.timeout
+	}
+}
+
+// resolveProjectPath converte il path di progetto in assoluto (come in ~/.claude.json)
+func resolveProjectPath(path string) (string, error) {
+	if path == "" {
+		return "", nil
+	}
+	abs, err := filepath.Abs(path)
+	if err != nil {
+		return "", fmt.Errorf("path di progetto non valido '%s': %w", path, err)
+	}
+	return abs, nil
+}
+
+// expectName verifica che sia presente esattamente un argomento posizionale (il nome)
+func expectName(positional []string) (string, error) {
+	if len(positional) != 1 || positional[0] == "" {
+		return "", errUsage
+	}
+	return positional[0], nil
+}
+
+// runList elenca i server configurati
+func runList(c *CLI, args []string) error {
+	fs := c.newFlagSet("list")
+	project := fs.String("project", "", i18n.T("cli.flag.project_list"))
+	effective := fs.Bool("effective", false, i18n.T("cli.flag.effective"))
+	asJSON := fs.Bool("json", false, i18n.T("cli.flag.json"))
+	fs.BoolVar(&c.reveal, "reveal", false, i18n.T("cli.flag.reveal"))
+	positional, err := parseArgs(fs, args)
+	if err != nil {
+		return err
+	}
+	if len(positional) > 0 || (*effective && *project == "") {
+		return errUsage
+	}
+
+	service, err := c.loadService()
+	if err != nil {
+		return err
+	}
+	config := service.GetConfiguration()
+
+	projectPath, err := resolveProjectPath(*project)
+	if err != nil {
+		return err
+	}
+
+	if projectPath != "" {
+		if *effective {
+			effectiveConfig, err := service.GetEffectiveConfig(projectPath)
+			if err != nil {
+				return err
+			}
+			if *asJSON {
+				return c.printJSON(map[string]interface{}{
+					"mcpServers": c.serversToMap(effectiveConfig.Servers()),
+					"sources":    effectiveSourcesToMap(service, effectiveConfig),
+				})
+			}
+			c.printEffectiveTable(effectiveConfig)
+			return nil
+		}
+		p, ok := config.GetProject(projectPath)
+		if !ok {
+			return fmt.Errorf("progetto '%s' non trovato", projectPath)
+		}
+		if *asJSON {
+			return c.printJSON(c.projectToMap(p))
+		}
+		c.printProjectServers(p, "")
+		return nil
+	}
+
+	if *asJSON {
+		projects := make(map[string]interface{}, len(config.Projects))
+		for path, p := range config.Projects {
+			projects[path] = c.projectToMap(p)
+		}
+		return c.printJSON(map[string]interface{}{
+			"mcpServers": c.serversToMap(config.GlobalServers),
+			"projects":   projects,
+		})
+	}
+
+	fmt.Fprintf(c.stdout, i18n.T("cli.list_global")+"\n", service.GetConfigPath())
+	c.printServerTable(config.GlobalServers, "  ")
+
+	paths := config.ProjectPaths()
+	sort.Strings(paths)
+	fmt.Fprintln(c.stdout, "\n"+i18n.T("cli.list_projects"))
+	for _, path := range paths {
+		p := config.Projects[path]
+		if p.Missing {
+			fmt.Fprintf(c.stdout, "  %s  (%s)\n", path, i18n.T("cli.list_project_missing"))
+		} else {
+			fmt.Fprintf(c.stdout, "  %s\n", path)
+		}
+		c.printProjectServers(p, "    ")
+	}
+	return nil
+}
+
+// runShow mostra la configurazione di un singolo server
+func runShow(c *CLI, args []string) error {
+	fs := c.newFlagSet("show")
+	var lf locationFlags
+	lf.register(fs, i18n.T("cli.flag.project_server"))
+	asJSON := fs.Bool("json", false, i18n.T("cli.flag.json"))
+	fs.BoolVar(&c.reveal, "reveal", false, i18n.T("cli.flag.reveal"))
+	positional, err := parseArgs(fs, args)
+	if err != nil {
+		return err
+	}
+	name, err := expectName(positional)
+	if err != nil {
+		return err
+	}
+
+	loc, err := lf.location()
+	if err != nil {
+		return err
+	}
+	service, err := c.loadService()
+	if err != nil {
+		return err
+	}
+
+	server, err := findServer(service.GetConfiguration(), loc, name)
+	if err != nil {
+		return err
+	}
+
+	if *asJSON {
+		return c.printJSON(infrastructure.ServerToMap(c.redacted(server)))
+	}
+
+	// I valori con ${VAR} sono seguiti dal valore espanso con l'ambiente corrente
+	expanded, _ := service.ExpandServer(server)
+	server, expanded = c.redacted(server), c.redacted(expanded)
+	withExpanded := func(raw, value string) string {
+		if raw == value {
+			return raw
+		}
+		return raw + "  → " + value
+	}
+
+	w := tabwriter.NewWriter(c.stdout, 0, 4, 2, ' ', 0)
+	fmt.Fprintf(w, "%s:\t%s\n", i18n.T("cli.field_name"), name)
+	fmt.Fprintf(w, "Scope:\t%s\n", locationLabel(loc))
+	fmt.Fprintf(w, "File:\t%s\n", service.GetLocationPath(loc))
+	fmt.Fprintf(w, "%s:\t%s\n", i18n.T("cli.field_type"), serverTypeLabel(server))
+	if server.Command != "" {
+		fmt.Fprintf(w, "%s:\t%s\n", i18n.T("cli.field_command"), withExpanded(server.Command, expanded.Command))
+	}
+	for i, arg := range server.Args {
+		fmt.Fprintf(w, "Arg[%d]:\t%s\n", i, withExpanded(arg, expanded.Args[i]))
+	}
+	if server.URL != "" {
+		fmt.Fprintf(w, "URL:\t%s\n", withExpanded(server.URL, expanded.URL))
+	}
+	for _, k := range domain.SortedKeys(server.Headers) {
+		fmt.Fprintf(w, "Header:\t%s: %s\n", k, withExpanded(server.Headers[k], expanded.Headers[k]))
+	}
+	for _, k := range domain.SortedKeys(server.Env) {
+		fmt.Fprintf(w, "Env:\t%s=%s\n", k, withExpanded(server.Env[k], expanded.Env[k]))
+	}
+	if server.Timeout > 0 {
+		fmt.Fprintf(w, "Timeout:\t%dms\n", server.Timeout)
+	}
+	return w.Flush()
+}
+
+// runAdd aggiunge un nuovo server
+func runAdd(c *CLI, args []string) error {
+	fs := c.newFlagSet("add")
+	var lf locationFlags
+	lf.register(fs, i18n.T("cli.flag.project_add"))
+	rawJSON := fs.String("json", "", i18n.T("cli.flag.json_server"))
+	templateID := fs.String("template", "", i18n.T("cli.flag.template"))
+	params := make(keyValues)
+	fs.Var(params, "param", i18n.T("cli.flag.param"))
+	var sf serverFlags
+	sf.register(fs)
+	positional, err := parseArgs(fs, args)
+	if err != nil {
+		return err
+	}
+	name, err := expectName(positional)
+	if err != nil {
+		return err
+	}
+	if *templateID != "" && *rawJSON != "" {
+		return fmt.Errorf("indicare solo uno tra --template e --json")
+	}
+
+	server := domain.MCPServer{}
+	if *templateID != "" {
+		service, err := c.loadService()
+		if err != nil {
+			return err
+		}
+		template, err := service.FindTemplate(*templateID)
+		if err != nil {
+			return err
+		}
+		if server, err = template.Instantiate(params); err != nil {
+			return err
+		}
+	} else if len(params) > 0 {
+		return fmt.Errorf("--param richiede --template")
+	}
+	if *rawJSON != "" {
+		var data map[string]interface{}
+		if err := json.Unmarshal([]byte(*rawJSON), &data); err != nil {
+			return fmt.Errorf("JSON non valido: %w", err)
+		}
+		server, err = infrastructure.ParseServer(data)
+		if err != nil {
+			return err
+		}
+	}
+	sf.apply(&server, flagsSet(fs))
+
+	if server.Type == "" {
+		switch {
+		case server.Command != "":
+			server.Type = domain.ServerTypeStdio
+		case server.URL != "":
+			server.Type = domain.ServerTypeHTTP
+		default:
+			return fmt.Errorf("specificare --command, --url, --json o --template")
+		}
+	}
+
+	loc, err := lf.location()
+	if err != nil {
+		return err
+	}
+	service, err := c.loadService()
+	if err != nil {
+		return err
+	}
+
+	if err := service.AddServer(loc, name, server); err != nil {
+		return err
+	}
+	fmt.Fprintf(c.stdout, i18n.T("cli.server_added")+"\n", name, locationLabel(loc))
+	server.Name = name
+	c.printWarnings(server.Validate())
+	return nil
+}
+
+// runUpdate modifica solo i campi indicati di un server esistente
+func runUpdate(c *CLI, args []string) error {
+	fs := c.newFlagSet("update")
+	var lf locationFlags
+	lf.register(fs, i18n.T("cli.flag.project_server"))
+	clearArgs := fs.Bool("clear-args", false, i18n.T("cli.flag.clear_args"))
+	var unsetEnv, unsetHeaders stringList
+	fs.Var(&unsetEnv, "unset-env", i18n.T("cli.flag.unset_env"))
+	fs.Var(&unsetHeaders, "unset-header", i18n.T("cli.flag.unset_header"))
+	var sf serverFlags
+	sf.register(fs)
+	positional, err := parseArgs(fs, args)
+	if err != nil {
+		return err
+	}
+	name, err := expectName(positional)
+	if err != nil {
+		return err
+	}
+
+	set := flagsSet(fs)
+	delete(set, "project")
+	delete(set, "scope")
+	if len(set) == 0 {
+		return fmt.Errorf("nessuna modifica indicata")
+	}
+
+	loc, err := lf.location()
+	if err != nil {
+		return err
+	}
+	service, err := c.loadService()
+	if err != nil {
+		return err
+	}
+
+	current, err := findServer(service.GetConfiguration(), loc, name)
+	if err != nil {
+		return err
+	}
+	server := current.Clone()
+
+	if *clearArgs {
+		server.Args = nil
+	}
+	sf.apply(&server, set)
+	for _, k := range unsetEnv {
+		delete(server.Env, k)
+	}
+	for _, k := range unsetHeaders {
+		delete(server.Headers, k)
+	}
+
+	if err := service.UpdateServer(loc, name, server); err != nil {
+		return err
+	}
+	fmt.Fprintf(c.stdout, i18n.T("cli.server_updated")+"\n", name)
+	c.printWarnings(server.Validate().Without(domain.FieldName))
+	return nil
+}
+
+// runRemove rimuove un server
+func runRemove(c *CLI, args []string) error {
+	fs := c.newFlagSet("remove")
+	var lf locationFlags
+	lf.register(fs, i18n.T("cli.flag.project_server"))
+	positional, err := parseArgs(fs, args)
+	if err != nil {
+		return err
+	}
+	name, err := expectName(positional)
+	if err != nil {
+		return err
+	}
+
+	loc, err := lf.location()
+	if err != nil {
+		return err
+	}
+	service, err := c.loadService()
+	if err != nil {
+		return err
+	}
+
+	if err := service.RemoveServer(loc, name); err != nil {
+		return err
+	}
+	fmt.Fprintf(c.stdout, i18n.T("cli.server_removed")+"\n", name, locationLabel(loc))
+	return nil
+}
+
+// runMove sposta un server tra due posizioni (scope globale o file di un progetto)
+func runMove(c *CLI, args []string) error {
+	fs := c.newFlagSet("move")
+	from := fs.String("from", "", i18n.T("cli.flag.project_source"))
+	fromScope := fs.String("from-scope", "", i18n.T("cli.flag.from_scope"))
+	to := fs.String("to", "", i18n.T("cli.flag.to"))
+	toGlobal := fs.Bool("to-global", false, i18n.T("cli.flag.to_global"))
+	toScope := fs.String("to-scope", "", i18n.T("cli.flag.to_scope"))
+	force := fs.Bool("force", false, i18n.T("cli.flag.force_one"))
+	positional, err := parseArgs(fs, args)
+	if err != nil {
+		return err
+	}
+	name, err := expectName(positional)
+	if err != nil {
+		return err
+	}
+
+	if *toGlobal {
+		if *to != "" {
+			return errUsage
+		}
+		*to = scopeGlobalKeyword
+	}
+	if *to == "" {
+		return errUsage
+	}
+
+	fromLoc, err := parseLocation(*from, *fromScope)
+	if err != nil {
+		return err
+	}
+	toLoc, err := parseLocation(*to, *toScope)
+	if err != nil {
+		return err
+	}
+
+	service, err := c.loadService()
+	if err != nil {
+		return err
+	}
+
+	if err := service.MoveServer(name, fromLoc, toLoc, *force); err != nil {
+		var exists *application.ServerExistsError
+		if errors.As(err, &exists) {
+			return fmt.Errorf("%w (usa --force per sovrascriverlo)", err)
+		}
+		return err
+	}
+	fmt.Fprintf(c.stdout, i18n.T("cli.server_moved")+"\n", name, locationLabel(toLoc))
+	return nil
+}
+
+// runClone copia un server su uno o più scope di destinazione
+func runClone(c *CLI, args []string) error {
+	fs := c.newFlagSet("clone")
+	var lf locationFlags
+	lf.register(fs, i18n.T("cli.flag.project_source"))
+	var targets stringList
+	fs.Var(&targets, "to", i18n.T("cli.flag.to_repeat"))
+	toScope := fs.String("to-scope", "", i18n.T("cli.flag.to_scope_many"))
+	positional, err := parseArgs(fs, args)
+	if err != nil {
+		return err
+	}
+	name, err := expectName(positional)
+	if err != nil {
+		return err
+	}
+	if len(targets) == 0 {
+		return errUsage
+	}
+
+	from, err := lf.location()
+	if err != nil {
+		return err
+	}
+	locations := make([]domain.Location, 0, len(targets))
+	for _, target := range targets {
+		scope := *toScope
+		if target == scopeGlobalKeyword {
+			scope = ""
+		}
+		loc, err := parseLocation(target, scope)
+		if err != nil {
+			return err
+		}
+		locations = append(locations, loc)
+	}
+
+	service, err := c.loadService()
+	if err != nil {
+		return err
+	}
+
+	cloned, err := service.CloneServer(name, from, locations)
+	if cloned > 0 {
+		fmt.Fprintf(c.stdout, i18n.T("cli.server_cloned")+"\n", name, cloned)
+	}
+	return err
+}
+
+// runVersion stampa la versione
+func runVersion(c *CLI, args []string) error {
+	fmt.Fprintf(c.stdout, "%s %s\n", version.Name, version.Version)
+	return nil
+}
+
+// findServer cerca un server in una posizione
+func findServer(config *domain.Configuration, loc domain.Location, name string) (domain.MCPServer, error) {
+	if !loc.IsGlobal() {
+		if _, ok := config.GetProject(loc.ProjectPath); !ok {
+			return domain.MCPServer{}, fmt.Errorf("progetto '%s' non trovato", loc.ProjectPath)
+		}
+	}
+	server, ok := config.GetServer(loc, name)
+	if !ok {
+		return domain.MCPServer{}, fmt.Errorf("server '%s' non trovato in %s", name, loc)
+	}
+	return server, nil
+}
+
+// locationLabel descrive una posizione per l'output
+func locationLabel(loc domain.Location) string {
+	if loc.IsGlobal() {
+		return i18n.T("cli.location_global")
+	}
+	if file := loc.Scope.FileName(); file != "" {
+		return fmt.Sprintf("%s (%s)", loc.ProjectPath, file)
+	}
+	return fmt.Sprintf(i18n.T("cli.location_project"), loc.ProjectPath)
+}
+
+// printProjectServers stampa i server di un progetto, raggruppati per file
+func (c *CLI) printProjectServers(p *domain.Project, indent string) {
+	c.printServerTable(p.MCPServers, indent)
+	for _, scope := range domain.ProjectScopes[1:] {
+		servers := p.Servers(scope)
+		if len(servers) == 0 {
+			continue
+		}
+		fmt.Fprintf(c.stdout, "%s%s\n", indent, scope.FileName())
+		c.printServerTable(servers, indent+"  ")
+	}
+}
+
+// projectToMap converte i server di un progetto in JSON, con una sezione per ogni file
+func (c *CLI) projectToMap(p *domain.Project) map[string]interface{} {
+	result := map[string]interface{}{"mcpServers": c.serversToMap(p.MCPServers)}
+	for _, scope := range domain.ProjectScopes[1:] {
+		if servers := p.Servers(scope); len(servers) > 0 {
+			result[scope.FileName()] = map[string]interface{}{"mcpServers": c.serversToMap(servers)}
+		}
+	}
+	return result
+}
+
+// printServerTable stampa una tabella nome/tipo/destinazione dei server
+func (c *CLI) printServerTable(servers map[string]domain.MCPServer, indent string) {
+	if len(servers) == 0 {
+		fmt.Fprintf(c.stdout, "%s%s\n", indent, i18n.T("cli.no_servers"))
+		return
+	}
+
+	w := tabwriter.NewWriter(c.stdout, 0, 4, 2, ' ', 0)
+	for _, name := range domain.SortedKeys(servers) {
+		server := c.redacted(servers[name])
+		fmt.Fprintf(w, "%s%s\t%s\t%s\n", indent, name, serverTypeLabel(server), serverTarget(server))
+	}
+	w.Flush()
+}
+
+// printEffectiveTable stampa i server effettivi di un progetto con il livello da cui
+// provengono e i livelli che sovrascrivono
+func (c *CLI) printEffectiveTable(effective domain.EffectiveConfig) {
+	if len(effective) == 0 {
+		fmt.Fprintln(c.stdout, i18n.T("cli.no_servers"))
+		return
+	}
+
+	w := tabwriter.NewWriter(c.stdout, 0, 4, 2, ' ', 0)
+	for _, name := range effective.Names() {
+		entry := effective[name]
+		server := c.redacted(entry.Server)
+		line := fmt.Sprintf("%s\t%s\t%s\t%s", name, serverTypeLabel(server), serverTarget(server), sourceLabel(entry.Location))
+		if entry.IsShadowing() {
+			shadowed := make([]string, len(entry.Shadows))
+			for i, layer := range entry.Shadows {
+				shadowed[i] = sourceLabel(layer.Location)
+			}
+			line += "\t" + fmt.Sprintf(i18n.T("cli.shadows"), strings.Join(shadowed, ", "))
+		}
+		fmt.Fprintln(w, line)
+	}
+	w.Flush()
+}
+
+// sourceLabel descrive brevemente il livello di provenienza di un server effettivo
+func sourceLabel(loc domain.Location) string {
+	switch loc.Scope {
+	case domain.ScopeGlobal:
+		return i18n.T("cli.source_global")
+	case domain.ScopeProject:
+		return i18n.T("cli.source_project")
+	}
+	return loc.Scope.FileName()
+}
+
+// effectiveSourcesToMap descrive per l'output JSON la provenienza di ogni server effettivo
+func effectiveSourcesToMap(service *application.MCPService, effective domain.EffectiveConfig) map[string]interface{} {
+	layer := func(l domain.ServerLayer) map[string]interface{} {
+		return map[string]interface{}{"scope": string(l.Location.Scope), "file": service.GetLocationPath(l.Location)}
+	}
+	result := make(map[string]interface{}, len(effective))
+	for name, entry := range effective {
+		source := layer(entry.ServerLayer)
+		if entry.IsShadowing() {
+			shadows := make([]interface{}, len(entry.Shadows))
+			for i, shadowed := range entry.Shadows {
+				shadows[i] = layer(shadowed)
+			}
+			source["shadows"] = shadows
+		}
+		result[name] = source
+	}
+	return result
+}
+
+// printJSON stampa un valore come JSON indentato
+func (c *CLI) printJSON(v interface{}) error {
+	data, err := json.MarshalIndent(v, "", "  ")
+	if err != nil {
+		return err
+	}
+	fmt.Fprintln(c.stdout, string(data))
+	return nil
+}
+
+// serversToMap converte una mappa di server nel formato JSON di ~/.claude.json
+func (c *CLI) serversToMap(servers map[string]domain.MCPServer) map[string]interface{} {
+	result := make(map[string]interface{}, len(servers))
+	for name, server := range servers {
+		result[name] = infrastructure.ServerToMap(c.redacted(server))
+	}
+	return result
+}
+
+// redacted rimuove i segreti dal server da stampare, salvo con --reveal
+func (c *CLI) redacted(server domain.MCPServer) domain.MCPServer {
+	if c.reveal || c.service == nil {
+		return server
+	}
+	return c.service.RedactServer(server)
+}
+
+// serverTypeLabel restituisce il tipo del server, dedotto dai campi se non esplicito
+func serverTypeLabel(server domain.MCPServer) string {
+	switch {
+	case server.Type != "":
+		return string(server.Type)
+	case server.Command != "":
+		return string(domain.ServerTypeStdio)
+	case server.URL != "":
+		return "http/sse"
+	}
+	return "?"
+}
+
+// serverTarget restituisce il comando completo o l'URL del server
+func serverTarget(server domain.MCPServer) string {
+	if server.URL != "" {
+		return server.URL
+	}
+	return strings.TrimSpace(server.Command + " " + strings.Join(server.Args, " "))
+}
+
+// runTest esegue l'handshake MCP con un server e ne riporta l'esito
+func runTest(c *CLI, args []string) error {
+	fs := c.newFlagSet("test")
+	var lf locationFlags
+	lf.register(fs, i18n.T("cli.flag.project_server"))
+	asJSON := fs.Bool("json", false, i18n.T("cli.flag.json"))
+	positional, err := parseArgs(fs, args)
+	if err != nil {
+		return err
+	}
+	name, err := expectName(positional)
+	if err != nil {
+		return err
+	}
+
+	loc, err := lf.location()
+	if err != nil {
+		return err
+	}
+	service, err := c.loadService()
+	if err != nil {
+		return err
+	}
+	server, err := findServer(service.GetConfiguration(), loc, name)
+	if err != nil {
+		return err
+	}
+
+	report := service.TestServer(context.Background(), server)
+
+	if *asJSON {
+		out := map[string]interface{}{
+			"ok":         report.OK(),
+			"durationMs": report.Duration.Milliseconds(),
+		}
+		if report.Initialize != nil {
+			out["protocolVersion"] = report.Initialize.ProtocolVersion
+			out["serverInfo"] = report.Initialize.ServerInfo
+			out["capabilities"] = report.Initialize.Capabilities
+		}
+		if report.Err != nil {
+			out["reason"] = report.FailureReason()
+			out["error"] = report.Err.Error()
+		}
+		if report.Stderr != "" {
+			out["stderr"] = report.Stderr
+		}
+		if err := c.printJSON(out); err != nil {
+			return err
+		}
+	} else {
+		w := tabwriter.NewWriter(c.stdout, 0, 4, 2, ' ', 0)
+		if report.OK() {
+			fmt.Fprintf(w, "%s:\t"+i18n.T("cli.test_ok")+"\n", i18n.T("cli.field_result"), report.Duration.Milliseconds())
+			fmt.Fprintf(w, "%s:\t%s\n", i18n.T("cli.field_protocol"), report.Initialize.ProtocolVersion)
+			fmt.Fprintf(w, "Server:\t%s %s\n", report.Initialize.ServerInfo.Name, report.Initialize.ServerInfo.Version)
+			fmt.Fprintf(w, "Capability:\t%s\n", strings.Join(domain.SortedKeys(report.Initialize.Capabilities), ", "))
+		} else {
+			fmt.Fprintf(w, "%s:\t"+i18n.T("cli.test_failed")+"\n", i18n.T("cli.field_result"), report.FailureReason())
+			fmt.Fprintf(w, "%s:\t%s\n", i18n.T("cli.field_error"), report.Err)
+		}
+		if err := w.Flush(); err != nil {
+			return err
+		}
+		if stderr := strings.TrimSpace(report.Stderr); stderr != "" {
+			fmt.Fprintf(c.stdout, "\nStderr:\n%s\n", stderr)
+		}
+	}
+
+	if !report.OK() {
+		return fmt.Errorf("handshake con %s non riuscito", name)
+	}
+	return nil
+}
+
+// runInventory elenca strumenti, risorse e prompt di un server (dalla cache con --cached)
+func runInventory(c *CLI, args []string) error {
+	fs := c.newFlagSet("inventory")
+	var lf locationFlags
+	lf.register(fs, i18n.T("cli.flag.project_server"))
+	cached := fs.Bool("cached", false, i18n.T("cli.flag.cached"))
+	asJSON := fs.Bool("json", false, i18n.T("cli.flag.json"))
+	positional, err := parseArgs(fs, args)
+	if err != nil {
+		return err
+	}
+	name, err := expectName(positional)
+	if err != nil {
+		return err
+	}
+
+	loc, err := lf.location()
+	if err != nil {
+		return err
+	}
+	service, err := c.loadService()
+	if err != nil {
+		return err
+	}
+	server, err := findServer(service.GetConfiguration(), loc, name)
+	if err != nil {
+		return err
+	}
+
+	var inventory *infrastructure.Inventory
+	if *cached {
+		var ok bool
+		if inventory, ok = service.CachedInventory(server); !ok {
+			return fmt.Errorf("nessun inventario in cache per %s: eseguire il comando senza --cached", name)
+		}
+	} else {
+		report := service.InspectServer(context.Background(), server)
+		if !report.OK() {
+			if stderr := strings.TrimSpace(report.Stderr); stderr != "" {
+				fmt.Fprintf(c.stderr, "%s\n", stderr)
+			}
+			return fmt.Errorf("connessione a %s non riuscita (%s): %v", name, report.FailureReason(), report.Err)
+		}
+		inventory = report.Inventory
+	}
+
+	if *asJSON {
+		return c.printJSON(inventory)
+	}
+
+	fmt.Fprintf(c.stdout, i18n.T("cli.inventory_header")+"\n",
+		inventory.Initialize.ServerInfo.Name, inventory.Initialize.ServerInfo.Version,
+		inventory.Initialize.ProtocolVersion, inventory.FetchedAt.Format("2006-01-02 15:04:05"))
+
+	fmt.Fprintf(c.stdout, "\n"+i18n.T("cli.inventory_tools")+"\n", len(inventory.Tools))
+	for _, tool := range inventory.Tools {
+		fmt.Fprintf(c.stdout, "  %s\t%s\n", tool.Name, firstLine(tool.Description))
+	}
+	fmt.Fprintf(c.stdout, "\n"+i18n.T("cli.inventory_resources")+"\n", len(inventory.Resources))
+	for _, resource := range inventory.Resources {
+		fmt.Fprintf(c.stdout, "  %s\t%s\n", resource.URI, resource.Name)
+	}
+	fmt.Fprintf(c.stdout, "\n"+i18n.T("cli.inventory_prompts")+"\n", len(inventory.Prompts))
+	for _, prompt := range inventory.Prompts {
+		fmt.Fprintf(c.stdout, "  %s\t%s\n", prompt.Name, firstLine(prompt.Description))
+	}
+	for _, method := range domain.SortedKeys(inventory.Errors) {
+		fmt.Fprintf(c.stdout, "\n"+i18n.T("cli.inventory_failed")+"\n", method, inventory.Errors[method])
+	}
+	return nil
+}
+
+// firstLine restituisce la prima riga di un testo
+func firstLine(text string) string {
+	line, _, _ := strings.Cut(strings.TrimSpace(text), "\n")
+	return line
+}
+
+// runValidate controlla tutti i server configurati; fallisce se almeno uno ha errori
+func runValidate(c *CLI, args []string) error {
+	fs := c.newFlagSet("validate")
+	positional, err := parseArgs(fs, args)
+	if err != nil {
+		return err
+	}
+	if len(positional) > 0 {
+		return errUsage
+	}
+
+	service, err := c.loadService()
+	if err != nil {
+		return err
+	}
+	config := service.GetConfiguration()
+
+	var refs []domain.ServerRef
+	for _, name := range domain.SortedKeys(config.GlobalServers) {
+		refs = append(refs, domain.ServerRef{Location: domain.GlobalLocation(), Name: name})
+	}
+	for _, path := range domain.SortedKeys(config.Projects) {
+		for _, scope := range domain.ProjectScopes {
+			for _, name := range domain.SortedKeys(config.Projects[path].Servers(scope)) {
+				refs = append(refs, domain.ServerRef{Location: domain.ProjectLocation(path, scope), Name: name})
+			}
+		}
+	}
+
+	invalid := 0
+	for _, ref := range refs {
+		server, _ := config.GetServer(ref.Location, ref.Name)
+		issues := service.ServerDiagnostics(server)
+		if len(issues) == 0 {
+			continue
+		}
+		if issues.HasErrors() {
+			invalid++
+		}
+		fmt.Fprintf(c.stdout, "%s (%s):\n", ref.Name, locationLabel(ref.Location))
+		for _, issue := range issues {
+			fmt.Fprintf(c.stdout, "  %s: %s\n", severityLabel(issue.Severity), i18n.Issue(issue.Code, issue.Detail))
+		}
+	}
+
+	if invalid > 0 {
+		return fmt.Errorf("%d server non validi su %d", invalid, len(refs))
+	}
+	fmt.Fprintf(c.stdout, i18n.T("cli.valid_servers")+"\n", len(refs))
+	return nil
+}
+
+// printWarnings segnala su stderr gli avvisi di validazione di un server salvato
+func (c *CLI) printWarnings(issues domain.ValidationIssues) {
+	for _, issue := range issues.Warnings() {
+		fmt.Fprintf(c.stderr, "%s: %s\n", i18n.T("cli.severity_warning"), i18n.Issue(issue.Code, issue.Detail))
+	}
+}
+
+// severityLabel restituisce l'etichetta della gravità di un problema
+func severityLabel(severity domain.Severity) string {
+	if severity == domain.SeverityError {
+		return i18n.T("cli.severity_error")
+	}
+	return i18n.T("cli.severity_warning")
+}
+
+// runBackups elenca i backup di ~/.claude.json con il riepilogo delle differenze
+func runBackups(c *CLI, args []string) error {
+	fs := c.newFlagSet("backups")
+	showDiff := fs.Bool("diff", false, i18n.T("cli.flag.diff"))
+	positional, err := parseArgs(fs, args)
+	if err != nil {
+		return err
+	}
+	if len(positional) > 0 {
+		return errUsage
+	}
+
+	service, err := c.loadService()
+	if err != nil {
+		return err
+	}
+	backups, err := service.ListBackups()
+	if err != nil {
+		return err
+	}
+	if len(backups) == 0 {
+		fmt.Fprintln(c.stdout, i18n.T("cli.no_backups"))
+		return nil
+	}
+
+	for i, backup := range backups {
+		changes, err := service.DiffBackup(backup.Path)
+		if err != nil {
+			fmt.Fprintf(c.stdout, "%2d  %s  %s  (%v)\n", i+1, backup.Time.Format("2006-01-02 15:04:05"), backup.Path, err)
+			continue
+		}
+		fmt.Fprintf(c.stdout, "%2d  %s  %s  %s\n", i+1, backup.Time.Format("2006-01-02 15:04:05"), backup.Path, changeCounts(changes))
+		if *showDiff {
+			for _, change := range changes {
+				fmt.Fprintf(c.stdout, "      %s %s (%s)\n", changeSymbol(change.Kind), change.Ref.Name, locationLabel(change.Ref.Location))
+			}
+		}
+	}
+	return nil
+}
+
+// runRestore ripristina i server MCP da un backup
+func runRestore(c *CLI, args []string) error {
+	fs := c.newFlagSet("restore")
+	var names stringList
+	fs.Var(&names, "server", i18n.T("cli.flag.restore_server"))
+	positional, err := parseArgs(fs, args)
+	if err != nil {
+		return err
+	}
+	if len(positional) != 1 {
+		return errUsage
+	}
+
+	service, err := c.loadService()
+	if err != nil {
+		return err
+	}
+	path, err := resolveBackup(service.ListBackups, positional[0])
+	if err != nil {
+		return err
+	}
+
+	changes, err := service.DiffBackup(path)
+	if err != nil {
+		return err
+	}
+	var refs []domain.ServerRef
+	wanted := make(map[string]bool, len(names))
+	for _, name := range names {
+		wanted[name] = true
+	}
+	for _, change := range changes {
+		if len(wanted) == 0 || wanted[change.Ref.Name] {
+			refs = append(refs, change.Ref)
+		}
+	}
+	if len(refs) == 0 {
+		fmt.Fprintln(c.stdout, i18n.T("cli.nothing_to_restore"))
+		return nil
+	}
+
+	if err := service.RestoreBackup(path, refs); err != nil {
+		return err
+	}
+	fmt.Fprintf(c.stdout, i18n.T("cli.restored")+"\n", len(refs), path)
+	return nil
+}
+
+// resolveBackup interpreta l'argomento di restore: numero dell'elenco di `backups` o path
+func resolveBackup(list func() ([]infrastructure.Backup, error), arg string) (string, error) {
+	index, err := strconv.Atoi(arg)
+	if err != nil {
+		return arg, nil
+	}
+	backups, err := list()
+	if err != nil {
+		return "", err
+	}
+	if index < 1 || index > len(backups) {
+		return "", fmt.Errorf("backup %d inesistente (disponibili: %d)", index, len(backups))
+	}
+	return backups[index-1].Path, nil
+}
+
+// changeCounts riassume le differenze nel formato "+1 ~2 -0"
+func changeCounts(changes []domain.ServerChange) string {
+	counts := make(map[domain.ChangeKind]int)
+	for _, change := range changes {
+		counts[change.Kind]++
+	}
+	return fmt.Sprintf("+%d ~%d -%d", counts[domain.ChangeAdded], counts[domain.ChangeModified], counts[domain.ChangeRemoved])
+}
+
+// changeSymbol restituisce il simbolo di un tipo di differenza
+func changeSymbol(kind domain.ChangeKind) string {
+	switch kind {
+	case domain.ChangeAdded:
+		return "+"
+	case domain.ChangeRemoved:
+		return "-"
+	}
+	return "~"
+}
@@ -0,0 +1,146 @@
+package cli
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/strawberry-code/mcp-curator/internal/i18n"
+)
+
+// newTestConfig crea un ~/.claude.json temporaneo con un progetto registrato e vuoto
+func newTestConfig(t *testing.T) (configPath, projectPath string) {
+	t.Helper()
+	dir := t.TempDir()
+	// Preferenze, vault e backup non devono toccare la directory dell'utente
+	t.Setenv("HOME", dir)
+	t.Setenv("XDG_CONFIG_HOME", filepath.Join(dir, "config"))
+
+	projectPath = filepath.Join(dir, "app")
+	if err := os.MkdirAll(projectPath, 0700); err != nil {
+		t.Fatal(err)
+	}
+	configPath = filepath.Join(dir, ".claude.json")
+	data, err := json.Marshal(map[string]interface{}{
+		"mcpServers": map[string]interface{}{},
+		"projects":   map[string]interface{}{projectPath: map[string]interface{}{"mcpServers": map[string]interface{}{}}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(configPath, data, 0600); err != nil {
+		t.Fatal(err)
+	}
+	return configPath, projectPath
+}
+
+// runCLI esegue un comando sul file indicato e restituisce codice di uscita e output
+func runCLI(t *testing.T, configPath string, args ...string) (code int, stdout, stderr string) {
+	t.Helper()
+	var out, errOut bytes.Buffer
+	code = Run(append([]string{"--config", configPath}, args...), &out, &errOut)
+	return code, out.String(), errOut.String()
+}
+
+// readServers restituisce i server globali e quelli del progetto salvati nel file
+func readServers(t *testing.T, configPath, projectPath string) (global, project map[string]interface{}) {
+	t.Helper()
+	data, err := os.ReadFile(configPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var raw struct {
+		MCPServers map[string]interface{} `json:"mcpServers"`
+		Projects   map[string]struct {
+			MCPServers map[string]interface{} `json:"mcpServers"`
+		} `json:"projects"`
+	}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		t.Fatal(err)
+	}
+	return raw.MCPServers, raw.Projects[projectPath].MCPServers
+}
+
+func TestServerCommands(t *testing.T) {
+	configPath, projectPath := newTestConfig(t)
+
+	code, stdout, stderr := runCLI(t, configPath, "add", "demo", "--command", "npx", "--arg", "-y", "--arg", "demo-server")
+	if code != exitOK {
+		t.Fatalf("add: codice %d: %s", code, stderr)
+	}
+	if !strings.Contains(stdout, "Server 'demo' aggiunto in scope globale") {
+		t.Errorf("add: output inatteso %q", stdout)
+	}
+	global, _ := readServers(t, configPath, projectPath)
+	if server, ok := global["demo"].(map[string]interface{}); !ok || server["command"] != "npx" {
+		t.Fatalf("add: server globale non salvato: %v", global)
+	}
+
+	code, stdout, stderr = runCLI(t, configPath, "list")
+	if code != exitOK {
+		t.Fatalf("list: codice %d: %s", code, stderr)
+	}
+	for _, want := range []string{"demo", "npx -y demo-server", projectPath, "(nessun server)"} {
+		if !strings.Contains(stdout, want) {
+			t.Errorf("list: manca %q in %q", want, stdout)
+		}
+	}
+
+	if code, _, stderr = runCLI(t, configPath, "clone", "demo", "--to", projectPath); code != exitOK {
+		t.Fatalf("clone: codice %d: %s", code, stderr)
+	}
+	global, project := readServers(t, configPath, projectPath)
+	if global["demo"] == nil || project["demo"] == nil {
+		t.Fatalf("clone: atteso demo globale e nel progetto, ottenuti %v e %v", global, project)
+	}
+
+	if code, _, stderr = runCLI(t, configPath, "remove", "demo"); code != exitOK {
+		t.Fatalf("remove: codice %d: %s", code, stderr)
+	}
+	global, project = readServers(t, configPath, projectPath)
+	if global["demo"] != nil || project["demo"] == nil {
+		t.Fatalf("remove: atteso demo solo nel progetto, ottenuti %v e %v", global, project)
+	}
+
+	code, stdout, stderr = runCLI(t, configPath, "move", "demo", "--from", projectPath, "--to", "global")
+	if code != exitOK {
+		t.Fatalf("move: codice %d: %s", code, stderr)
+	}
+	if !strings.Contains(stdout, "spostato in scope globale") {
+		t.Errorf("move: output inatteso %q", stdout)
+	}
+	global, project = readServers(t, configPath, projectPath)
+	if global["demo"] == nil || project["demo"] != nil {
+		t.Fatalf("move: atteso demo solo globale, ottenuti %v e %v", global, project)
+	}
+
+	code, _, stderr = runCLI(t, configPath, "remove", "assente")
+	if code != exitError || !strings.HasPrefix(stderr, "errore: ") {
+		t.Errorf("remove di un server inesistente: codice %d, stderr %q", code, stderr)
+	}
+	if code, _, _ = runCLI(t, configPath, "remove"); code != exitUsage {
+		t.Errorf("remove senza nome: codice %d, atteso %d", code, exitUsage)
+	}
+}
+
+func TestRunLang(t *testing.T) {
+	configPath, _ := newTestConfig(t)
+	t.Cleanup(func() { i18n.SetLang(string(i18n.IT)) })
+
+	code, _, stderr := runCLI(t, configPath, "--lang", "en", "sconosciuto")
+	if code != exitUsage || !strings.HasPrefix(stderr, "unknown command: sconosciuto") {
+		t.Errorf("codice %d, stderr %q", code, stderr)
+	}
+	code, stdout, _ := runCLI(t, configPath, "--lang", "EN", "list")
+	if code != exitOK || !strings.Contains(stdout, "(no servers)") {
+		t.Errorf("list in inglese: codice %d, output %q", code, stdout)
+	}
+
+	code, _, stderr = runCLI(t, configPath, "--lang", "xx", "list")
+	if code != exitUsage || !strings.Contains(stderr, "xx") {
+		t.Errorf("lingua non supportata: codice %d, stderr %q", code, stderr)
+	}
+}
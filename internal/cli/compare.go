@@ -0,0 +1,147 @@
+package cli
+
+import (
+	"fmt"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/strawberry-code/mcp-curator/internal/application"
+	"github.com/strawberry-code/mcp-curator/internal/domain"
+	"github.com/strawberry-code/mcp-curator/internal/i18n"
+)
+
+// runCompare confronta due server, due progetti (configurazioni effettive) o un progetto
+// e lo scope globale, campo per campo
+func runCompare(c *CLI, args []string) error {
+	fs := c.newFlagSet("compare")
+	all := fs.Bool("all", false, i18n.T("cli.flag.all"))
+	asJSON := fs.Bool("json", false, i18n.T("cli.flag.json"))
+	fs.BoolVar(&c.reveal, "reveal", false, i18n.T("cli.flag.reveal"))
+	positional, err := parseArgs(fs, args)
+	if err != nil {
+		return err
+	}
+	if len(positional) != 2 {
+		return errUsage
+	}
+
+	service, err := c.loadService()
+	if err != nil {
+		return err
+	}
+	left, err := parseCompareSide(service.GetConfiguration(), positional[0])
+	if err != nil {
+		return err
+	}
+	right, err := parseCompareSide(service.GetConfiguration(), positional[1])
+	if err != nil {
+		return err
+	}
+	comparisons, err := service.Compare(left, right)
+	if err != nil {
+		return err
+	}
+
+	if *asJSON {
+		result := make([]interface{}, 0, len(comparisons))
+		for _, comparison := range comparisons {
+			result = append(result, c.comparisonToMap(comparison))
+		}
+		return c.printJSON(result)
+	}
+
+	identical := 0
+	w := tabwriter.NewWriter(c.stdout, 0, 4, 2, ' ', 0)
+	for _, comparison := range comparisons {
+		switch {
+		case comparison.OnlyLeft():
+			fmt.Fprintf(w, "< %s\t"+i18n.T("cli.compare_left_only")+"\n", comparison.Name, locationLabel(comparison.Left.Location))
+		case comparison.OnlyRight():
+			fmt.Fprintf(w, "> %s\t"+i18n.T("cli.compare_right_only")+"\n", comparison.Name, locationLabel(comparison.Right.Location))
+		case comparison.Identical():
+			identical++
+			if *all {
+				fmt.Fprintf(w, "= %s\t%s ↔ %s\n", comparison.Name, locationLabel(comparison.Left.Location), locationLabel(comparison.Right.Location))
+			}
+		default:
+			fmt.Fprintf(w, "≠ %s\t%s ↔ %s\n", comparison.Name, locationLabel(comparison.Left.Location), locationLabel(comparison.Right.Location))
+			for _, diff := range c.comparisonDiffs(comparison) {
+				fmt.Fprintf(w, "      %s\t%s → %s\n", diff.Field, diffValue(diff.Old), diffValue(diff.New))
+			}
+		}
+	}
+	if err := w.Flush(); err != nil {
+		return err
+	}
+	if identical > 0 && !*all {
+		fmt.Fprintf(c.stdout, i18n.T("cli.compare_identical")+"\n", identical)
+	}
+	return nil
+}
+
+// parseCompareSide interpreta un lato del confronto: "global", il path di un progetto
+// (configurazione effettiva), NOME@global o NOME@PATH (il server effettivo del progetto)
+func parseCompareSide(config *domain.Configuration, spec string) (application.CompareSide, error) {
+	name, where, isServer := strings.Cut(spec, "@")
+	if !isServer {
+		where = spec
+	}
+	if where == scopeGlobalKeyword {
+		if !isServer {
+			return application.CompareSide{Kind: application.CompareGlobal}, nil
+		}
+		ref := domain.ServerRef{Location: domain.GlobalLocation(), Name: name}
+		return application.CompareSide{Kind: application.CompareServer, Ref: ref}, nil
+	}
+
+	path, err := resolveProjectPath(where)
+	if err != nil {
+		return application.CompareSide{}, err
+	}
+	if !isServer {
+		return application.CompareSide{Kind: application.CompareProject, ProjectPath: path}, nil
+	}
+	// Il server di un progetto è quello effettivo, dal file che ha la precedenza
+	effective, ok := config.GetEffectiveConfig(path)[name]
+	if !ok {
+		return application.CompareSide{}, fmt.Errorf("server '%s' non presente nel progetto %s", name, path)
+	}
+	ref := domain.ServerRef{Location: effective.Location, Name: name}
+	return application.CompareSide{Kind: application.CompareServer, Ref: ref}, nil
+}
+
+// comparisonDiffs restituisce le differenze di un confronto, con i segreti oscurati se richiesto
+func (c *CLI) comparisonDiffs(comparison domain.ServerComparison) []domain.FieldDiff {
+	return domain.DiffServerFields(c.redacted(comparison.Left.Server), c.redacted(comparison.Right.Server))
+}
+
+// comparisonToMap converte un confronto per l'output JSON
+func (c *CLI) comparisonToMap(comparison domain.ServerComparison) map[string]interface{} {
+	side := func(layer *domain.ServerLayer) interface{} {
+		if layer == nil {
+			return nil
+		}
+		entry := map[string]interface{}{
+			"name":  layer.Server.Name,
+			"scope": string(layer.Location.Scope),
+		}
+		if layer.Location.ProjectPath != "" {
+			entry["project"] = layer.Location.ProjectPath
+		}
+		return entry
+	}
+	result := map[string]interface{}{
+		"name":  comparison.Name,
+		"left":  side(comparison.Left),
+		"right": side(comparison.Right),
+	}
+	if comparison.Left != nil && comparison.Right != nil {
+		diffs := c.comparisonDiffs(comparison)
+		list := make([]interface{}, len(diffs))
+		for i, diff := range diffs {
+			list[i] = map[string]interface{}{"field": diff.Field, "left": diff.Old, "right": diff.New}
+		}
+		result["diffs"] = list
+	}
+	return result
+}
@@ -0,0 +1,153 @@
+package cli
+
+import (
+	"fmt"
+	"sort"
+	"text/tabwriter"
+
+	"github.com/strawberry-code/mcp-curator/internal/domain"
+	"github.com/strawberry-code/mcp-curator/internal/i18n"
+)
+
+// runDiscover cerca le cartelle con .mcp.json o .mcp.local.json non ancora registrate
+// come progetti in ~/.claude.json
+func runDiscover(c *CLI, args []string) error {
+	fs := c.newFlagSet("discover")
+	depth := fs.Int("depth", 0, i18n.T("cli.flag.depth"))
+	saveRoots := fs.Bool("save-roots", false, i18n.T("cli.flag.save_roots"))
+	register := fs.Bool("register", false, i18n.T("cli.flag.register"))
+	asJSON := fs.Bool("json", false, i18n.T("cli.flag.json"))
+	roots, err := parseArgs(fs, args)
+	if err != nil {
+		return err
+	}
+	if *depth < 0 || (*saveRoots && len(roots) == 0) {
+		return errUsage
+	}
+
+	service, err := c.loadService()
+	if err != nil {
+		return err
+	}
+	if *saveRoots {
+		for _, root := range roots {
+			if err := service.AddDiscoveryRoot(root); err != nil {
+				return err
+			}
+		}
+	}
+
+	discovered, scanErr := service.DiscoverProjects(roots, *depth)
+	if scanErr != nil {
+		if discovered == nil && len(roots) == 0 && len(service.DiscoveryRoots()) == 0 {
+			return fmt.Errorf("%w: indicale come argomenti o salvale con --save-roots", scanErr)
+		}
+		fmt.Fprintf(c.stderr, i18n.T("cli.warning")+"\n", scanErr)
+	}
+
+	if *asJSON && !*register {
+		result := make([]interface{}, 0, len(discovered))
+		for _, p := range discovered {
+			result = append(result, discoveredToMap(p))
+		}
+		return c.printJSON(result)
+	}
+	if len(discovered) == 0 {
+		fmt.Fprintln(c.stdout, i18n.T("cli.discover_none"))
+		return nil
+	}
+
+	w := tabwriter.NewWriter(c.stdout, 0, 4, 2, ' ', 0)
+	for _, p := range discovered {
+		fmt.Fprintf(w, "%s\t%s\n", p.Path, projectFilesSummary(p))
+	}
+	w.Flush()
+	if !*register {
+		fmt.Fprintln(c.stdout, "\n"+i18n.T("cli.discover_hint"))
+		return nil
+	}
+
+	paths := make([]string, len(discovered))
+	for i, p := range discovered {
+		paths[i] = p.Path
+	}
+	if err := service.RegisterProjects(paths); err != nil {
+		return err
+	}
+	fmt.Fprintf(c.stdout, i18n.T("cli.registered_count")+"\n", len(paths), service.GetConfigPath())
+	return nil
+}
+
+// runRegister registra delle cartelle come progetti in ~/.claude.json
+func runRegister(c *CLI, args []string) error {
+	positional, err := parseArgs(c.newFlagSet("register"), args)
+	if err != nil {
+		return err
+	}
+	if len(positional) == 0 {
+		return errUsage
+	}
+
+	service, err := c.loadService()
+	if err != nil {
+		return err
+	}
+	paths := make([]string, 0, len(positional))
+	for _, arg := range positional {
+		path, err := resolveProjectPath(arg)
+		if err != nil {
+			return err
+		}
+		if _, ok := service.GetConfiguration().GetProject(path); ok {
+			fmt.Fprintf(c.stdout, i18n.T("cli.already_registered")+"\n", path)
+			continue
+		}
+		paths = append(paths, path)
+	}
+	if len(paths) == 0 {
+		return nil
+	}
+	if err := service.RegisterProjects(paths); err != nil {
+		return err
+	}
+	for _, path := range paths {
+		project, _ := service.GetConfiguration().GetProject(path)
+		fmt.Fprintf(c.stdout, i18n.T("cli.registered")+"\n", path, projectFilesSummary(project))
+	}
+	return nil
+}
+
+// projectFilesSummary riassume i server dei file di un progetto, es. ".mcp.json: 2 server"
+func projectFilesSummary(p *domain.Project) string {
+	summary := ""
+	for _, scope := range domain.ProjectScopes {
+		if scope.FileName() == "" || !p.HasFile(scope) {
+			continue
+		}
+		if summary != "" {
+			summary += ", "
+		}
+		summary += fmt.Sprintf(i18n.T("cli.file_servers"), scope.FileName(), len(p.Servers(scope)))
+	}
+	if summary == "" {
+		return i18n.T("cli.no_mcp_files")
+	}
+	return summary
+}
+
+// discoveredToMap converte un progetto trovato per l'output JSON
+func discoveredToMap(p *domain.Project) map[string]interface{} {
+	files := make(map[string]interface{})
+	for _, scope := range domain.ProjectScopes {
+		if scope.FileName() == "" || !p.HasFile(scope) {
+			continue
+		}
+		names := make([]string, 0, len(p.Servers(scope)))
+		for name := range p.Servers(scope) {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		files[scope.FileName()] = names
+	}
+	return map[string]interface{}{"path": p.Path, "files": files}
+}
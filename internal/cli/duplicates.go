@@ -0,0 +1,214 @@
+package cli
+
+import (
+	"fmt"
+	"text/tabwriter"
+
+	"github.com/strawberry-code/mcp-curator/internal/domain"
+	"github.com/strawberry-code/mcp-curator/internal/i18n"
+)
+
+// runDuplicates elenca i server presenti in più copie, con le differenze tra le copie
+func runDuplicates(c *CLI, args []string) error {
+	fs := c.newFlagSet("duplicates")
+	asJSON := fs.Bool("json", false, i18n.T("cli.flag.json"))
+	fs.BoolVar(&c.reveal, "reveal", false, i18n.T("cli.flag.reveal"))
+	positional, err := parseArgs(fs, args)
+	if err != nil {
+		return err
+	}
+	if len(positional) > 0 {
+		return errUsage
+	}
+
+	service, err := c.loadService()
+	if err != nil {
+		return err
+	}
+	groups := service.FindDuplicates()
+
+	if *asJSON {
+		result := make([]interface{}, 0, len(groups))
+		for _, group := range groups {
+			result = append(result, c.duplicateGroupToMap(group))
+		}
+		return c.printJSON(result)
+	}
+
+	if len(groups) == 0 {
+		fmt.Fprintln(c.stdout, i18n.T("cli.duplicates_none"))
+		return nil
+	}
+	for i, group := range groups {
+		if i > 0 {
+			fmt.Fprintln(c.stdout)
+		}
+		c.printDuplicateGroup(group)
+	}
+	return nil
+}
+
+// printDuplicateGroup stampa un gruppo di duplicati: una riga per copia (= identica alla
+// definizione di riferimento, ≠ diversa) seguita dalle differenze e dal comando suggerito
+func (c *CLI) printDuplicateGroup(group domain.DuplicateGroup) {
+	different := 0
+	for i := range group.Copies {
+		if !group.Copies[i].Identical() {
+			different++
+		}
+	}
+	key := group.Key
+	if !c.reveal {
+		key = c.service.RedactText(key)
+	}
+	fmt.Fprintf(c.stdout, "%s  ("+i18n.T("cli.duplicates_group")+")\n", key, len(group.Copies), different)
+
+	w := tabwriter.NewWriter(c.stdout, 0, 4, 2, ' ', 0)
+	for _, dup := range group.Copies {
+		mark := "="
+		if !dup.Identical() {
+			mark = "≠"
+		}
+		fmt.Fprintf(w, "  %s %s\t%s\n", mark, dup.Ref.Name, locationLabel(dup.Ref.Location))
+		for _, diff := range domain.DiffServerFields(c.redacted(group.Reference), c.redacted(dup.Server)) {
+			fmt.Fprintf(w, "      %s\t%s → %s\n", diff.Field, diffValue(diff.Old), diffValue(diff.New))
+		}
+	}
+	w.Flush()
+
+	// Il comando suggerito parte dalla prima copia identica alla definizione di riferimento
+	var source domain.DuplicateCopy
+	for _, dup := range group.Copies {
+		if dup.Identical() {
+			source = dup
+			break
+		}
+	}
+	switch {
+	case source.Ref.IsGlobal() && len(group.Redundant(source.Server)) > 0:
+		fmt.Fprintf(c.stdout, "  → mcp-curator promote %s  (%s)\n", source.Ref.Name, i18n.T("cli.promote_hint"))
+	case !source.Ref.IsGlobal():
+		fmt.Fprintf(c.stdout, "  → mcp-curator promote %s %s\n", source.Ref.Name, projectFlags(source.Ref.Location))
+	}
+}
+
+// projectFlags restituisce i flag --project/--scope che individuano una posizione di progetto
+func projectFlags(loc domain.Location) string {
+	flags := "--project " + loc.ProjectPath
+	if loc.Scope != domain.ScopeProject {
+		flags += " --scope " + string(loc.Scope)
+	}
+	return flags
+}
+
+// diffValue rappresenta un valore del diff, indicando esplicitamente quelli assenti
+func diffValue(value string) string {
+	if value == "" {
+		return i18n.T("cli.absent")
+	}
+	return value
+}
+
+// duplicateGroupToMap converte un gruppo di duplicati per l'output JSON
+func (c *CLI) duplicateGroupToMap(group domain.DuplicateGroup) map[string]interface{} {
+	key := group.Key
+	if !c.reveal {
+		key = c.service.RedactText(key)
+	}
+	copies := make([]interface{}, 0, len(group.Copies))
+	for _, dup := range group.Copies {
+		entry := map[string]interface{}{
+			"name":      dup.Ref.Name,
+			"scope":     string(dup.Ref.Scope),
+			"identical": dup.Identical(),
+		}
+		if dup.Ref.ProjectPath != "" {
+			entry["project"] = dup.Ref.ProjectPath
+		}
+		if diffs := domain.DiffServerFields(c.redacted(group.Reference), c.redacted(dup.Server)); len(diffs) > 0 {
+			list := make([]interface{}, len(diffs))
+			for i, diff := range diffs {
+				list[i] = map[string]interface{}{"field": diff.Field, "reference": diff.Old, "value": diff.New}
+			}
+			entry["diffs"] = list
+		}
+		copies = append(copies, entry)
+	}
+	return map[string]interface{}{
+		"key":       key,
+		"name":      group.Name,
+		"identical": group.Identical(),
+		"copies":    copies,
+	}
+}
+
+// runPromote rende globale un server di progetto e rimuove le copie di progetto identiche
+func runPromote(c *CLI, args []string) error {
+	fs := c.newFlagSet("promote")
+	var lf locationFlags
+	lf.register(fs, i18n.T("cli.flag.project_promote"))
+	as := fs.String("as", "", i18n.T("cli.flag.as"))
+	keep := fs.Bool("keep-copies", false, i18n.T("cli.flag.keep_copies"))
+	dryRun := fs.Bool("dry-run", false, i18n.T("cli.flag.dry_run_promote"))
+	positional, err := parseArgs(fs, args)
+	if err != nil {
+		return err
+	}
+	name, err := expectName(positional)
+	if err != nil {
+		return err
+	}
+
+	loc, err := lf.location()
+	if err != nil {
+		return err
+	}
+	service, err := c.loadService()
+	if err != nil {
+		return err
+	}
+	server, ok := service.GetConfiguration().GetServer(loc, name)
+	if !ok {
+		return fmt.Errorf("server '%s' non trovato in %s", name, locationLabel(loc))
+	}
+	globalName := name
+	if *as != "" && loc.IsGlobal() {
+		return errUsage
+	}
+	if *as != "" {
+		globalName = *as
+	}
+
+	// Le copie superflue sono quelle di progetto identiche alla copia promossa,
+	// che è sempre tra queste
+	source := domain.ServerRef{Location: loc, Name: name}
+	var remove []domain.ServerRef
+	if !*keep && !loc.IsGlobal() {
+		remove = []domain.ServerRef{source}
+	}
+	if !*keep {
+		for _, group := range service.FindDuplicates() {
+			for _, dup := range group.Copies {
+				if dup.Ref == source {
+					remove = group.Redundant(server)
+				}
+			}
+		}
+	}
+	if loc.IsGlobal() && len(remove) == 0 {
+		return fmt.Errorf("nessuna copia di progetto identica a '%s'", name)
+	}
+
+	for _, ref := range remove {
+		fmt.Fprintf(c.stdout, "- %s (%s)\n", ref.Name, locationLabel(ref.Location))
+	}
+	if *dryRun {
+		fmt.Fprintf(c.stdout, i18n.T("cli.promote_plan")+"\n", globalName, len(remove))
+		return nil
+	}
+	if err := service.PromoteToGlobal(globalName, server, remove); err != nil {
+		return err
+	}
+	fmt.Fprintf(c.stdout, i18n.T("cli.promote_done")+"\n", globalName, len(remove))
+	return nil
+}
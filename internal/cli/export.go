@@ -0,0 +1,107 @@
+package cli
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/strawberry-code/mcp-curator/internal/domain"
+	"github.com/strawberry-code/mcp-curator/internal/i18n"
+)
+
+// runExport esporta i server nel formato di un altro client, su stdout o su file.
+// Senza nomi esporta tutti i server della posizione (o quelli effettivi con --effective).
+func runExport(c *CLI, args []string) error {
+	fs := c.newFlagSet("export")
+	var lf locationFlags
+	lf.register(fs, i18n.T("cli.flag.project_export"))
+	effective := fs.Bool("effective", false, i18n.T("cli.flag.effective_export"))
+	format := fs.String("format", string(domain.ExportMCPServers), i18n.T("cli.flag.export_format"))
+	output := fs.String("output", "", i18n.T("cli.flag.output"))
+	fs.BoolVar(&c.reveal, "reveal", false, i18n.T("cli.flag.reveal_export"))
+	names, err := parseArgs(fs, args)
+	if err != nil {
+		return err
+	}
+	if *effective && (lf.project == "" || lf.scope != "") {
+		return errUsage
+	}
+	if !validExportFormat(domain.ExportFormat(*format)) {
+		return fmt.Errorf("formato '%s' non valido (%s)", *format, exportFormatList())
+	}
+
+	loc, err := lf.location()
+	if err != nil {
+		return err
+	}
+	service, err := c.loadService()
+	if err != nil {
+		return err
+	}
+
+	var servers map[string]domain.MCPServer
+	if *effective {
+		servers, err = service.GetEffectiveServers(loc.ProjectPath)
+	} else {
+		if !loc.IsGlobal() {
+			if _, ok := service.GetConfiguration().GetProject(loc.ProjectPath); !ok {
+				return fmt.Errorf("progetto '%s' non trovato", loc.ProjectPath)
+			}
+		}
+		servers, _ = service.GetConfiguration().Servers(loc)
+	}
+	if err != nil {
+		return err
+	}
+	if len(names) > 0 {
+		selected := make(map[string]domain.MCPServer, len(names))
+		for _, name := range names {
+			server, ok := servers[name]
+			if !ok {
+				return fmt.Errorf("server '%s' non trovato in %s", name, locationLabel(loc))
+			}
+			selected[name] = server
+		}
+		servers = selected
+	}
+
+	result, err := service.ExportServers(domain.ExportFormat(*format), servers, c.reveal)
+	if err != nil {
+		return err
+	}
+	for _, name := range result.IssueNames() {
+		for _, issue := range result.Issues[name] {
+			fmt.Fprintf(c.stderr, i18n.T("cli.warning")+"\n", name+": "+i18n.Issue(issue.Code, issue.Detail))
+		}
+	}
+
+	if *output == "" {
+		_, err := c.stdout.Write(result.Data)
+		return err
+	}
+	if err := service.WriteExport(*output, result); err != nil {
+		return err
+	}
+	fmt.Fprintf(c.stdout, i18n.T("cli.exported")+"\n", len(servers)-len(result.Skipped), *output)
+	return nil
+}
+
+// validExportFormat verifica che il formato di esportazione sia supportato
+func validExportFormat(format domain.ExportFormat) bool {
+	for _, f := range domain.ExportFormats() {
+		if f == format {
+			return true
+		}
+	}
+	return false
+}
+
+// exportFormatList elenca i formati di esportazione per i messaggi di errore
+func exportFormatList() string {
+	formats := make([]string, 0, len(domain.ExportFormats()))
+	for _, f := range domain.ExportFormats() {
+		formats = append(formats, string(f))
+	}
+	sort.Strings(formats)
+	return strings.Join(formats, ", ")
+}
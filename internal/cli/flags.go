@@ -0,0 +1,81 @@
+package cli
+
+import (
+	"flag"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// stringList è un flag ripetibile che accumula valori in ordine
+type stringList []string
+
+func (l *stringList) String() string {
+	return strings.Join(*l, " ")
+}
+
+func (l *stringList) Set(value string) error {
+	*l = append(*l, value)
+	return nil
+}
+
+// keyValues è un flag ripetibile nel formato KEY=value
+type keyValues map[string]string
+
+func (kv keyValues) String() string {
+	keys := make([]string, 0, len(kv))
+	for k := range kv {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	pairs := make([]string, 0, len(keys))
+	for _, k := range keys {
+		pairs = append(pairs, k+"="+kv[k])
+	}
+	return strings.Join(pairs, ",")
+}
+
+func (kv keyValues) Set(value string) error {
+	idx := strings.Index(value, "=")
+	if idx <= 0 {
+		return fmt.Errorf("formato non valido '%s', atteso KEY=value", value)
+	}
+	kv[value[:idx]] = value[idx+1:]
+	return nil
+}
+
+// parseArgs interpreta i flag permettendo argomenti posizionali in qualsiasi posizione
+// (il package flag si ferma al primo argomento non-flag)
+func parseArgs(fs *flag.FlagSet, args []string) ([]string, error) {
+	// Tutto ciò che segue "--" è sempre posizionale
+	var trailing []string
+	for i, arg := range args {
+		if arg == "--" {
+			trailing = args[i+1:]
+			args = args[:i]
+			break
+		}
+	}
+
+	var positional []string
+	for {
+		if err := fs.Parse(args); err != nil {
+			return nil, err
+		}
+		rest := fs.Args()
+		if len(rest) == 0 {
+			return append(positional, trailing...), nil
+		}
+		positional = append(positional, rest[0])
+		args = rest[1:]
+	}
+}
+
+// flagsSet restituisce l'insieme dei flag impostati esplicitamente
+func flagsSet(fs *flag.FlagSet) map[string]bool {
+	set := make(map[string]bool)
+	fs.Visit(func(f *flag.Flag) {
+		set[f.Name] = true
+	})
+	return set
+}
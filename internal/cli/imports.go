@@ -0,0 +1,157 @@
+package cli
+
+import (
+	"fmt"
+	"text/tabwriter"
+
+	"github.com/strawberry-code/mcp-curator/internal/domain"
+	"github.com/strawberry-code/mcp-curator/internal/i18n"
+	"github.com/strawberry-code/mcp-curator/internal/infrastructure"
+)
+
+// runImport importa i server dal file di configurazione di un altro client MCP.
+// Senza PATH elenca i file degli altri client trovati su questa macchina.
+func runImport(c *CLI, args []string) error {
+	fs := c.newFlagSet("import")
+	var lf locationFlags
+	lf.register(fs, i18n.T("cli.flag.project_import"))
+	format := fs.String("format", "", i18n.T("cli.flag.import_format"))
+	var names stringList
+	fs.Var(&names, "server", i18n.T("cli.flag.import_server"))
+	dryRun := fs.Bool("dry-run", false, i18n.T("cli.flag.dry_run_import"))
+	force := fs.Bool("force", false, i18n.T("cli.flag.force_import"))
+	asJSON := fs.Bool("json", false, i18n.T("cli.flag.json_import"))
+	positional, err := parseArgs(fs, args)
+	if err != nil {
+		return err
+	}
+	if len(positional) > 1 {
+		return errUsage
+	}
+
+	service, err := c.loadService()
+	if err != nil {
+		return err
+	}
+
+	if len(positional) == 0 {
+		return c.printImportSources(service.ImportSources(), *asJSON)
+	}
+
+	loc, err := lf.location()
+	if err != nil {
+		return err
+	}
+	path := positional[0]
+	servers, err := service.ReadImport(domain.ImportFormat(*format), path)
+	if err != nil {
+		return err
+	}
+	if len(names) > 0 {
+		servers, err = selectImported(servers, names)
+		if err != nil {
+			return err
+		}
+	}
+	if len(servers) == 0 {
+		fmt.Fprintf(c.stdout, i18n.T("cli.import_empty")+"\n", path)
+		return nil
+	}
+
+	candidates, err := service.PlanImport(loc, servers)
+	if err != nil {
+		return err
+	}
+	if *dryRun {
+		if *asJSON {
+			return c.printJSON(c.importPlanToList(candidates))
+		}
+		fmt.Fprintf(c.stdout, "%s: %s\n", i18n.T("cli.field_destination"), locationLabel(loc))
+		c.printImportPlan(candidates)
+		return nil
+	}
+
+	for _, candidate := range candidates {
+		for _, issue := range candidate.Issues {
+			fmt.Fprintf(c.stderr, i18n.T("cli.warning")+"\n", candidate.Name+": "+i18n.Issue(issue.Code, issue.Detail))
+		}
+	}
+	imported, err := service.ImportServers(loc, servers, *force, path)
+	if imported > 0 {
+		fmt.Fprintf(c.stdout, i18n.T("cli.imported")+"\n", imported, locationLabel(loc))
+	} else if err == nil {
+		fmt.Fprintln(c.stdout, i18n.T("cli.import_nothing"))
+	}
+	return err
+}
+
+// selectImported filtra i server importati per nome
+func selectImported(servers []domain.ImportedServer, names []string) ([]domain.ImportedServer, error) {
+	byName := make(map[string]domain.ImportedServer, len(servers))
+	for _, s := range servers {
+		byName[s.Name] = s
+	}
+	selected := make([]domain.ImportedServer, 0, len(names))
+	for _, name := range names {
+		s, ok := byName[name]
+		if !ok {
+			return nil, fmt.Errorf("server '%s' non presente nel file", name)
+		}
+		selected = append(selected, s)
+	}
+	return selected, nil
+}
+
+// printImportSources elenca i file degli altri client trovati
+func (c *CLI) printImportSources(sources []domain.ImportSource, asJSON bool) error {
+	if asJSON {
+		list := make([]map[string]interface{}, 0, len(sources))
+		for _, s := range sources {
+			list = append(list, map[string]interface{}{"format": s.Format, "path": s.Path, "project": s.ProjectPath})
+		}
+		return c.printJSON(list)
+	}
+	if len(sources) == 0 {
+		fmt.Fprintln(c.stdout, i18n.T("cli.import_no_sources"))
+		return nil
+	}
+	w := tabwriter.NewWriter(c.stdout, 0, 4, 2, ' ', 0)
+	for _, s := range sources {
+		fmt.Fprintf(w, "%s\t%s\n", s.Format, s.Path)
+	}
+	return w.Flush()
+}
+
+// printImportPlan stampa i server da importare con stato e avvisi di conversione
+func (c *CLI) printImportPlan(candidates []domain.ImportCandidate) {
+	w := tabwriter.NewWriter(c.stdout, 0, 4, 2, ' ', 0)
+	for _, candidate := range candidates {
+		server := c.redacted(candidate.Server)
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\n", candidate.Name, i18n.T("import.status_"+string(candidate.Status)), serverTypeLabel(server), serverTarget(server))
+	}
+	w.Flush()
+
+	for _, candidate := range candidates {
+		for _, issue := range candidate.Issues {
+			fmt.Fprintf(c.stdout, "  ! %s: %s\n", candidate.Name, i18n.Issue(issue.Code, issue.Detail))
+		}
+	}
+}
+
+// importPlanToList converte i server da importare per l'output JSON
+func (c *CLI) importPlanToList(candidates []domain.ImportCandidate) []map[string]interface{} {
+	list := make([]map[string]interface{}, 0, len(candidates))
+	for _, candidate := range candidates {
+		issues := make([]string, 0, len(candidate.Issues))
+		for _, issue := range candidate.Issues {
+			issues = append(issues, i18n.Issue(issue.Code, issue.Detail))
+		}
+		list = append(list, map[string]interface{}{
+			"name":   candidate.Name,
+			"status": candidate.Status,
+			"server": infrastructure.ServerToMap(c.redacted(candidate.Server)),
+			"issues": issues,
+		})
+	}
+	return list
+}
@@ -0,0 +1,122 @@
+package cli
+
+import (
+	"flag"
+	"fmt"
+	"slices"
+	"text/tabwriter"
+
+	"github.com/strawberry-code/mcp-curator/internal/domain"
+	"github.com/strawberry-code/mcp-curator/internal/i18n"
+	"github.com/strawberry-code/mcp-curator/internal/infrastructure"
+)
+
+// filterFlags raccoglie i flag che selezionano i server per tipo e file di origine
+type filterFlags struct {
+	serverType string
+	source     string
+}
+
+// register registra i flag di filtro su un FlagSet
+func (ff *filterFlags) register(fs *flag.FlagSet) {
+	fs.StringVar(&ff.serverType, "type", "", i18n.T("cli.flag.filter_type"))
+	fs.StringVar(&ff.source, "source", "", i18n.T("cli.flag.filter_source"))
+}
+
+// filter costruisce il filtro dei server a partire dai flag e dal testo da cercare
+func (ff *filterFlags) filter(query string) (domain.ServerFilter, error) {
+	filter := domain.ServerFilter{Query: query, Type: domain.ServerType(ff.serverType), Scope: domain.Scope(ff.source)}
+	if filter.Type != "" && !slices.Contains([]domain.ServerType{domain.ServerTypeStdio, domain.ServerTypeHTTP, domain.ServerTypeSSE}, filter.Type) {
+		return filter, fmt.Errorf("tipo '%s' non valido (stdio, http, sse)", ff.serverType)
+	}
+	if filter.Scope != "" && filter.Scope != domain.ScopeGlobal && !filter.Scope.IsProjectScope() {
+		return filter, fmt.Errorf("file di origine '%s' non valido (global, project, project-file, project-local)", ff.source)
+	}
+	return filter, nil
+}
+
+// matchingServers restituisce i server che rispettano il filtro, limitati a un progetto
+// se projectPath non è vuoto
+func matchingServers(config *domain.Configuration, filter domain.ServerFilter, projectPath string) []domain.ServerRef {
+	var refs []domain.ServerRef
+	for ref, server := range config.AllServers() {
+		if projectPath != "" && ref.ProjectPath != projectPath {
+			continue
+		}
+		if filter.Matches(ref, server) {
+			refs = append(refs, ref)
+		}
+	}
+	return domain.SortedServerRefs(refs)
+}
+
+// runSearch cerca i server in tutti gli scope per testo, tipo e file di origine
+func runSearch(c *CLI, args []string) error {
+	fs := c.newFlagSet("search")
+	var ff filterFlags
+	ff.register(fs)
+	order := fs.String("sort", string(domain.SortByName), i18n.T("cli.flag.sort"))
+	asJSON := fs.Bool("json", false, i18n.T("cli.flag.json"))
+	fs.BoolVar(&c.reveal, "reveal", false, i18n.T("cli.flag.reveal"))
+	positional, err := parseArgs(fs, args)
+	if err != nil {
+		return err
+	}
+	if len(positional) > 1 {
+		return errUsage
+	}
+
+	filter, err := ff.filter(firstArg(positional))
+	if err != nil {
+		return err
+	}
+	if !slices.Contains(domain.ServerSorts, domain.ServerSort(*order)) {
+		return fmt.Errorf("ordinamento '%s' non valido (name, type, usage)", *order)
+	}
+
+	service, err := c.loadService()
+	if err != nil {
+		return err
+	}
+	config := service.GetConfiguration()
+	usage := config.ProjectUsage()
+	refs := config.SortServers(matchingServers(config, filter, ""), domain.ServerSort(*order), usage)
+
+	if *asJSON {
+		result := make([]interface{}, 0, len(refs))
+		for _, ref := range refs {
+			server, _ := config.GetServer(ref.Location, ref.Name)
+			entry := map[string]interface{}{
+				"name":     ref.Name,
+				"scope":    string(ref.Scope),
+				"projects": usage[ref.Name],
+				"server":   infrastructure.ServerToMap(c.redacted(server)),
+			}
+			if ref.ProjectPath != "" {
+				entry["project"] = ref.ProjectPath
+			}
+			result = append(result, entry)
+		}
+		return c.printJSON(result)
+	}
+
+	if len(refs) == 0 {
+		fmt.Fprintln(c.stdout, i18n.T("cli.search_none"))
+		return nil
+	}
+	w := tabwriter.NewWriter(c.stdout, 0, 4, 2, ' ', 0)
+	for _, ref := range refs {
+		server, _ := config.GetServer(ref.Location, ref.Name)
+		server = c.redacted(server)
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t"+i18n.T("cli.search_usage")+"\n", ref.Name, serverTypeLabel(server), serverTarget(server), locationLabel(ref.Location), usage[ref.Name])
+	}
+	return w.Flush()
+}
+
+// firstArg restituisce il primo argomento posizionale ("" se non ce ne sono)
+func firstArg(positional []string) string {
+	if len(positional) == 0 {
+		return ""
+	}
+	return positional[0]
+}
@@ -0,0 +1,191 @@
+package cli
+
+import (
+	"fmt"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/strawberry-code/mcp-curator/internal/domain"
+	"github.com/strawberry-code/mcp-curator/internal/i18n"
+	"github.com/strawberry-code/mcp-curator/internal/infrastructure"
+)
+
+// templateCommands sono i sottocomandi di `templates`
+var templateCommands = map[string]func(c *CLI, args []string) error{
+	"list":           runTemplatesList,
+	"show":           runTemplatesShow,
+	"add-catalog":    runTemplatesAddCatalog,
+	"remove-catalog": runTemplatesRemoveCatalog,
+}
+
+// runTemplates gestisce il catalogo dei template (senza sottocomando: list)
+func runTemplates(c *CLI, args []string) error {
+	if len(args) == 0 || strings.HasPrefix(args[0], "-") {
+		return runTemplatesList(c, args)
+	}
+	run, ok := templateCommands[args[0]]
+	if !ok {
+		return errUsage
+	}
+	return run(c, args[1:])
+}
+
+// runTemplatesList elenca i template disponibili e i cataloghi aggiuntivi
+func runTemplatesList(c *CLI, args []string) error {
+	fs := c.newFlagSet("templates list")
+	asJSON := fs.Bool("json", false, i18n.T("cli.flag.json"))
+	if _, err := parseArgs(fs, args); err != nil {
+		return err
+	}
+	service, err := c.loadService()
+	if err != nil {
+		return err
+	}
+
+	templates, loadErr := service.Templates()
+	if loadErr != nil {
+		fmt.Fprintf(c.stderr, i18n.T("cli.warning")+"\n", loadErr)
+	}
+	if *asJSON {
+		list := make([]map[string]interface{}, 0, len(templates))
+		for _, t := range templates {
+			list = append(list, templateToMap(t))
+		}
+		return c.printJSON(map[string]interface{}{"catalogs": service.TemplateCatalogs(), "templates": list})
+	}
+
+	w := tabwriter.NewWriter(c.stdout, 0, 4, 2, ' ', 0)
+	for _, t := range templates {
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\n", t.ID, t.Category, t.DisplayName(), t.Description)
+	}
+	if err := w.Flush(); err != nil {
+		return err
+	}
+	if catalogs := service.TemplateCatalogs(); len(catalogs) > 0 {
+		fmt.Fprintln(c.stdout, "\n"+i18n.T("cli.catalogs"))
+		for _, path := range catalogs {
+			fmt.Fprintf(c.stdout, "  %s\n", path)
+		}
+	}
+	return nil
+}
+
+// runTemplatesShow mostra parametri e server di un template
+func runTemplatesShow(c *CLI, args []string) error {
+	fs := c.newFlagSet("templates show")
+	asJSON := fs.Bool("json", false, i18n.T("cli.flag.json"))
+	positional, err := parseArgs(fs, args)
+	if err != nil {
+		return err
+	}
+	id, err := expectName(positional)
+	if err != nil {
+		return err
+	}
+	service, err := c.loadService()
+	if err != nil {
+		return err
+	}
+	t, err := service.FindTemplate(id)
+	if err != nil {
+		return err
+	}
+	if *asJSON {
+		return c.printJSON(templateToMap(t))
+	}
+
+	w := tabwriter.NewWriter(c.stdout, 0, 4, 2, ' ', 0)
+	fmt.Fprintf(w, "ID:\t%s\n", t.ID)
+	fmt.Fprintf(w, "%s:\t%s\n", i18n.T("cli.field_name"), t.DisplayName())
+	if t.Category != "" {
+		fmt.Fprintf(w, "%s:\t%s\n", i18n.T("cli.field_category"), t.Category)
+	}
+	if t.Description != "" {
+		fmt.Fprintf(w, "%s:\t%s\n", i18n.T("cli.field_description"), t.Description)
+	}
+	if t.Homepage != "" {
+		fmt.Fprintf(w, "Homepage:\t%s\n", t.Homepage)
+	}
+	if t.Source != "" {
+		fmt.Fprintf(w, "%s:\t%s\n", i18n.T("cli.field_catalog"), t.Source)
+	}
+	for _, p := range t.Parameters {
+		required := ""
+		if p.Required {
+			required = ", " + i18n.T("cli.field_required")
+		}
+		fmt.Fprintf(w, "%s:\t--param %s=... (%s%s)", i18n.T("cli.field_parameter"), p.Name, p.Kind, required)
+		if p.Default != "" {
+			fmt.Fprintf(w, " "+i18n.T("cli.template_default"), p.Default)
+		}
+		if p.Description != "" {
+			fmt.Fprintf(w, " — %s", p.Description)
+		}
+		fmt.Fprintln(w)
+	}
+	if err := w.Flush(); err != nil {
+		return err
+	}
+	fmt.Fprintln(c.stdout)
+	return c.printJSON(infrastructure.ServerToMap(t.Server))
+}
+
+// runTemplatesAddCatalog aggiunge alle preferenze un catalogo (file JSON o directory)
+func runTemplatesAddCatalog(c *CLI, args []string) error {
+	positional, err := parseArgs(c.newFlagSet("templates add-catalog"), args)
+	if err != nil {
+		return err
+	}
+	path, err := expectName(positional)
+	if err != nil {
+		return err
+	}
+	service, err := c.loadService()
+	if err != nil {
+		return err
+	}
+	if err := service.AddTemplateCatalog(path); err != nil {
+		return err
+	}
+	fmt.Fprintf(c.stdout, i18n.T("cli.catalog_added")+"\n", path)
+	return nil
+}
+
+// runTemplatesRemoveCatalog toglie un catalogo dalle preferenze
+func runTemplatesRemoveCatalog(c *CLI, args []string) error {
+	positional, err := parseArgs(c.newFlagSet("templates remove-catalog"), args)
+	if err != nil {
+		return err
+	}
+	path, err := expectName(positional)
+	if err != nil {
+		return err
+	}
+	service, err := c.loadService()
+	if err != nil {
+		return err
+	}
+	if err := service.RemoveTemplateCatalog(path); err != nil {
+		return err
+	}
+	fmt.Fprintf(c.stdout, i18n.T("cli.catalog_removed")+"\n", path)
+	return nil
+}
+
+// templateToMap converte un template nel formato dei file di catalogo
+func templateToMap(t domain.ServerTemplate) map[string]interface{} {
+	result := map[string]interface{}{
+		"id":     t.ID,
+		"name":   t.DisplayName(),
+		"server": infrastructure.ServerToMap(t.Server),
+	}
+	for key, value := range map[string]string{"description": t.Description, "category": t.Category, "homepage": t.Homepage, "source": t.Source} {
+		if value != "" {
+			result[key] = value
+		}
+	}
+	if len(t.Parameters) > 0 {
+		result["parameters"] = t.Parameters
+	}
+	return result
+}
@@ -0,0 +1,375 @@
+package cli
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+	"text/tabwriter"
+
+	"golang.org/x/term"
+
+	"github.com/strawberry-code/mcp-curator/internal/application"
+	"github.com/strawberry-code/mcp-curator/internal/domain"
+	"github.com/strawberry-code/mcp-curator/internal/i18n"
+	"github.com/strawberry-code/mcp-curator/internal/infrastructure"
+)
+
+// vaultCommands sono i sottocomandi di `vault`
+var vaultCommands = map[string]func(c *CLI, args []string) error{
+	"init":     runVaultInit,
+	"list":     runVaultList,
+	"set":      runVaultSet,
+	"rotate":   runVaultRotate,
+	"delete":   runVaultDelete,
+	"bind":     runVaultBind,
+	"export":   runVaultExport,
+	"launcher": runVaultLauncher,
+	"passwd":   runVaultPasswd,
+}
+
+// runVault gestisce il vault dei segreti
+func runVault(c *CLI, args []string) error {
+	if len(args) == 0 {
+		return errUsage
+	}
+	run, ok := vaultCommands[args[0]]
+	if !ok {
+		return errUsage
+	}
+	return run(c, args[1:])
+}
+
+// runVaultInit crea il vault chiedendo due volte la passphrase
+func runVaultInit(c *CLI, args []string) error {
+	if _, err := parseArgs(c.newFlagSet("vault init"), args); err != nil {
+		return err
+	}
+	service, err := c.loadService()
+	if err != nil {
+		return err
+	}
+	if service.VaultExists() {
+		return fmt.Errorf("il vault esiste già: %s", service.GetVaultPath())
+	}
+
+	passphrase, err := c.readNewPassphrase()
+	if err != nil {
+		return err
+	}
+	if err := service.CreateVault(passphrase); err != nil {
+		return err
+	}
+	fmt.Fprintf(c.stdout, i18n.T("cli.vault_created")+"\n", service.GetVaultPath())
+	return nil
+}
+
+// runVaultList elenca i segreti e i server che li usano (i valori non vengono mai mostrati)
+func runVaultList(c *CLI, args []string) error {
+	fs := c.newFlagSet("vault list")
+	unlock := fs.Bool("unlock", false, i18n.T("cli.flag.unlock"))
+	if _, err := parseArgs(fs, args); err != nil {
+		return err
+	}
+	service, err := c.loadService()
+	if err != nil {
+		return err
+	}
+	if !service.VaultExists() {
+		return infrastructure.ErrVaultNotFound
+	}
+	if *unlock {
+		if err := c.unlockVault(service); err != nil {
+			return err
+		}
+	}
+
+	names := service.SecretNames()
+	if len(names) == 0 {
+		fmt.Fprintln(c.stdout, i18n.T("cli.no_secrets"))
+		return nil
+	}
+	w := tabwriter.NewWriter(c.stdout, 0, 4, 2, ' ', 0)
+	for _, name := range names {
+		fmt.Fprintf(w, "%s\t"+i18n.T("cli.secret_usages")+"\n", name, len(service.SecretUsages(name)))
+		for _, binding := range service.SecretUsages(name) {
+			fmt.Fprintf(w, "  %s (%s)\t%s %s [%s]\n", binding.Ref.Name, locationLabel(binding.Ref.Location), binding.Field, binding.Key, binding.Mode)
+		}
+	}
+	return w.Flush()
+}
+
+// runVaultSet aggiunge un segreto leggendo il valore da terminale o da stdin
+func runVaultSet(c *CLI, args []string) error {
+	positional, err := parseArgs(c.newFlagSet("vault set"), args)
+	if err != nil {
+		return err
+	}
+	name, err := expectName(positional)
+	if err != nil {
+		return err
+	}
+	service, err := c.loadService()
+	if err != nil {
+		return err
+	}
+	if err := c.unlockVault(service); err != nil {
+		return err
+	}
+	value, err := c.readSecret(fmt.Sprintf(i18n.T("cli.prompt_secret_value"), name))
+	if err != nil {
+		return err
+	}
+	if err := service.SetSecret(name, value); err != nil {
+		return err
+	}
+	fmt.Fprintf(c.stdout, i18n.T("cli.secret_added")+"\n", name)
+	return nil
+}
+
+// runVaultRotate cambia il valore di un segreto e aggiorna i server che lo contengono
+func runVaultRotate(c *CLI, args []string) error {
+	positional, err := parseArgs(c.newFlagSet("vault rotate"), args)
+	if err != nil {
+		return err
+	}
+	name, err := expectName(positional)
+	if err != nil {
+		return err
+	}
+	service, err := c.loadService()
+	if err != nil {
+		return err
+	}
+	if err := c.unlockVault(service); err != nil {
+		return err
+	}
+	value, err := c.readSecret(fmt.Sprintf(i18n.T("cli.prompt_new_secret_value"), name))
+	if err != nil {
+		return err
+	}
+
+	refs, err := service.RotateSecret(name, value)
+	if err != nil {
+		return err
+	}
+	fmt.Fprintf(c.stdout, i18n.T("cli.secret_updated")+"\n", name)
+	for _, ref := range refs {
+		fmt.Fprintf(c.stdout, "  "+i18n.T("cli.secret_server_updated")+"\n", ref.Name, locationLabel(ref.Location))
+	}
+	return nil
+}
+
+// runVaultDelete elimina un segreto non più referenziato
+func runVaultDelete(c *CLI, args []string) error {
+	positional, err := parseArgs(c.newFlagSet("vault delete"), args)
+	if err != nil {
+		return err
+	}
+	name, err := expectName(positional)
+	if err != nil {
+		return err
+	}
+	service, err := c.loadService()
+	if err != nil {
+		return err
+	}
+	if err := c.unlockVault(service); err != nil {
+		return err
+	}
+
+	err = service.DeleteSecret(name)
+	var inUse *application.SecretInUseError
+	if errors.As(err, &inUse) {
+		for _, binding := range inUse.Bindings {
+			fmt.Fprintf(c.stderr, "  %s (%s): %s %s\n", binding.Ref.Name, locationLabel(binding.Ref.Location), binding.Field, binding.Key)
+		}
+	}
+	if err != nil {
+		return err
+	}
+	fmt.Fprintf(c.stdout, i18n.T("cli.secret_deleted")+"\n", name)
+	return nil
+}
+
+// runVaultBind collega un valore env o header di un server a un segreto del vault
+func runVaultBind(c *CLI, args []string) error {
+	fs := c.newFlagSet("vault bind")
+	var lf locationFlags
+	lf.register(fs, i18n.T("cli.flag.project_server"))
+	envKey := fs.String("env", "", i18n.T("cli.flag.bind_env"))
+	headerKey := fs.String("header", "", i18n.T("cli.flag.bind_header"))
+	secret := fs.String("secret", "", i18n.T("cli.flag.secret"))
+	inline := fs.Bool("inline", false, i18n.T("cli.flag.inline"))
+	positional, err := parseArgs(fs, args)
+	if err != nil {
+		return err
+	}
+	name, err := expectName(positional)
+	if err != nil {
+		return err
+	}
+
+	field, key := domain.FieldEnv, *envKey
+	if *headerKey != "" {
+		field, key = domain.FieldHeaders, *headerKey
+	}
+	if (*envKey == "") == (*headerKey == "") {
+		return fmt.Errorf("indicare una sola tra --env e --header")
+	}
+	secretName := *secret
+	if secretName == "" {
+		secretName = key
+	}
+	mode := application.BindReference
+	if *inline {
+		mode = application.BindInline
+	}
+
+	loc, err := lf.location()
+	if err != nil {
+		return err
+	}
+	service, err := c.loadService()
+	if err != nil {
+		return err
+	}
+	if err := c.unlockVault(service); err != nil {
+		return err
+	}
+
+	ref := domain.ServerRef{Location: loc, Name: name}
+	if err := service.BindSecret(ref, field, key, secretName, mode); err != nil {
+		return err
+	}
+	fmt.Fprintf(c.stdout, i18n.T("cli.secret_bound")+"\n", field, key, name, secretName, mode)
+	if mode == application.BindReference {
+		fmt.Fprintf(c.stdout, i18n.T("cli.launcher_hint")+"\n", secretName)
+	}
+	return nil
+}
+
+// runVaultExport stampa i segreti referenziati come ${NOME} in formato `export` per la shell
+func runVaultExport(c *CLI, args []string) error {
+	if _, err := parseArgs(c.newFlagSet("vault export"), args); err != nil {
+		return err
+	}
+	service, err := c.loadService()
+	if err != nil {
+		return err
+	}
+	if err := c.unlockVault(service); err != nil {
+		return err
+	}
+
+	exports, err := service.VaultExports()
+	if err != nil {
+		return err
+	}
+	for _, name := range domain.SortedKeys(exports) {
+		fmt.Fprintf(c.stdout, "export %s=%s\n", name, infrastructure.ShellQuote(exports[name]))
+	}
+	return nil
+}
+
+// runVaultLauncher genera lo script che avvia Claude Code con i segreti del vault
+func runVaultLauncher(c *CLI, args []string) error {
+	if _, err := parseArgs(c.newFlagSet("vault launcher"), args); err != nil {
+		return err
+	}
+	service, err := c.loadService()
+	if err != nil {
+		return err
+	}
+	path, err := service.WriteLauncher("")
+	if err != nil {
+		return err
+	}
+	fmt.Fprintf(c.stdout, i18n.T("cli.launcher_created")+"\n", path)
+	fmt.Fprintln(c.stdout, i18n.T("cli.launcher_usage"))
+	return nil
+}
+
+// runVaultPasswd cambia la passphrase del vault
+func runVaultPasswd(c *CLI, args []string) error {
+	if _, err := parseArgs(c.newFlagSet("vault passwd"), args); err != nil {
+		return err
+	}
+	service, err := c.loadService()
+	if err != nil {
+		return err
+	}
+	if err := c.unlockVault(service); err != nil {
+		return err
+	}
+	passphrase, err := c.readNewPassphrase()
+	if err != nil {
+		return err
+	}
+	if err := service.ChangeVaultPassphrase(passphrase); err != nil {
+		return err
+	}
+	fmt.Fprintln(c.stdout, i18n.T("cli.passphrase_changed"))
+	return nil
+}
+
+// unlockVault sblocca il vault con la passphrase di MCP_CURATOR_PASSPHRASE o chiesta all'utente
+func (c *CLI) unlockVault(service *application.MCPService) error {
+	if !service.VaultExists() {
+		return fmt.Errorf("%w: crearlo con `mcp-curator vault init`", infrastructure.ErrVaultNotFound)
+	}
+	passphrase, ok := os.LookupEnv(infrastructure.VaultPassphraseEnv)
+	if !ok {
+		var err error
+		if passphrase, err = c.readSecret(i18n.T("cli.prompt_passphrase")); err != nil {
+			return err
+		}
+	}
+	return service.UnlockVault(passphrase)
+}
+
+// readNewPassphrase chiede una nuova passphrase con conferma (o la legge da MCP_CURATOR_PASSPHRASE)
+func (c *CLI) readNewPassphrase() (string, error) {
+	if passphrase, ok := os.LookupEnv(infrastructure.VaultPassphraseEnv); ok {
+		return passphrase, nil
+	}
+	passphrase, err := c.readSecret(i18n.T("cli.prompt_new_passphrase"))
+	if err != nil {
+		return "", err
+	}
+	if !term.IsTerminal(int(os.Stdin.Fd())) {
+		return passphrase, nil
+	}
+	confirm, err := c.readSecret(i18n.T("cli.prompt_repeat_passphrase"))
+	if err != nil {
+		return "", err
+	}
+	if confirm != passphrase {
+		return "", fmt.Errorf("le passphrase non coincidono")
+	}
+	return passphrase, nil
+}
+
+// readSecret legge un valore senza eco dal terminale, oppure una riga da stdin se rediretto
+func (c *CLI) readSecret(prompt string) (string, error) {
+	fd := int(os.Stdin.Fd())
+	if term.IsTerminal(fd) {
+		fmt.Fprint(c.stderr, prompt)
+		data, err := term.ReadPassword(fd)
+		fmt.Fprintln(c.stderr)
+		if err != nil {
+			return "", fmt.Errorf("impossibile leggere da terminale: %w", err)
+		}
+		return string(data), nil
+	}
+
+	if c.stdin == nil {
+		c.stdin = bufio.NewReader(os.Stdin)
+	}
+	line, err := c.stdin.ReadString('\n')
+	if err != nil && line == "" {
+		return "", fmt.Errorf("valore atteso su stdin: %w", err)
+	}
+	return strings.TrimRight(line, "\r\n"), nil
+}
@@ -0,0 +1,99 @@
+package domain
+
+import (
+	"errors"
+	"strings"
+)
+
+// ErrUnterminatedQuote è restituito quando una riga di comando ha un apice non chiuso
+var ErrUnterminatedQuote = errors.New("apice non chiuso nella riga di comando")
+
+// SplitCommandLine divide una riga di comando come la shell POSIX: rispetta apici singoli
+// e doppi e il backslash, ma non espande variabili né caratteri jolly (${VAR} resta letterale,
+// come si aspetta Claude Code). Una riga vuota restituisce nil.
+func SplitCommandLine(line string) ([]string, error) {
+	var words []string
+	var word strings.Builder
+	inWord := false
+	var quote rune
+
+	runes := []rune(line)
+	for i := 0; i < len(runes); i++ {
+		r := runes[i]
+		switch {
+		case quote == '\'':
+			if r == '\'' {
+				quote = 0
+			} else {
+				word.WriteRune(r)
+			}
+		case quote == '"':
+			switch {
+			case r == '"':
+				quote = 0
+			case r == '\\' && i+1 < len(runes) && strings.ContainsRune("\"\\$`\n", runes[i+1]):
+				i++
+				if runes[i] != '\n' {
+					word.WriteRune(runes[i])
+				}
+			default:
+				word.WriteRune(r)
+			}
+		case r == '\'' || r == '"':
+			quote = r
+			inWord = true
+		case r == '\\':
+			if i+1 < len(runes) {
+				i++
+				// backslash-a-capo continua la riga
+				if runes[i] != '\n' {
+					word.WriteRune(runes[i])
+					inWord = true
+				}
+			}
+		case r == ' ' || r == '\t' || r == '\n' || r == '\r':
+			if inWord {
+				words = append(words, word.String())
+				word.Reset()
+				inWord = false
+			}
+		default:
+			word.WriteRune(r)
+			inWord = true
+		}
+	}
+	if quote != 0 {
+		return nil, ErrUnterminatedQuote
+	}
+	if inWord {
+		words = append(words, word.String())
+	}
+	return words, nil
+}
+
+// JoinCommandLine compone una riga di comando che SplitCommandLine (e la shell) riporta
+// agli stessi argomenti, aggiungendo gli apici solo dove servono. Le variabili restano
+// letterali anche nella shell: '${TOKEN}' viene passato così com'è, come fa Claude Code.
+func JoinCommandLine(words []string) string {
+	quoted := make([]string, len(words))
+	for i, word := range words {
+		quoted[i] = quoteWord(word)
+	}
+	return strings.Join(quoted, " ")
+}
+
+// quoteWord racchiude una parola tra apici singoli se contiene caratteri speciali per la
+// shell, compresi $ (espansione delle variabili) e le graffe (espansione {a,b})
+func quoteWord(word string) string {
+	if word == "" {
+		return "''"
+	}
+	safe := strings.IndexFunc(word, func(r rune) bool {
+		return !(r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z' || r >= '0' && r <= '9' ||
+			strings.ContainsRune("_-./:=@%+,", r))
+	}) < 0
+	if safe {
+		return word
+	}
+	return "'" + strings.ReplaceAll(word, "'", `'\''`) + "'"
+}
@@ -0,0 +1,33 @@
+package domain
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestJoinCommandLine(t *testing.T) {
+	tests := []struct {
+		words []string
+		want  string
+	}{
+		{[]string{"npx", "-y", "@scope/pkg@1.2.0"}, "npx -y @scope/pkg@1.2.0"},
+		{[]string{"--token", "${TOKEN}"}, "--token '${TOKEN}'"},
+		{[]string{"$HOME/data"}, "'$HOME/data'"},
+		{[]string{"file{a,b}"}, "'file{a,b}'"},
+		{[]string{"two words", ""}, "'two words' ''"},
+		{[]string{"it's"}, `'it'\''s'`},
+	}
+	for _, tt := range tests {
+		got := JoinCommandLine(tt.words)
+		if got != tt.want {
+			t.Errorf("JoinCommandLine(%q) = %q, atteso %q", tt.words, got, tt.want)
+		}
+		back, err := SplitCommandLine(got)
+		if err != nil {
+			t.Fatalf("SplitCommandLine(%q): %v", got, err)
+		}
+		if !reflect.DeepEqual(back, tt.words) {
+			t.Errorf("SplitCommandLine(%q) = %q, atteso %q", got, back, tt.words)
+		}
+	}
+}
@@ -0,0 +1,128 @@
+package domain
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// ServerComparison confronta le definizioni di un server sui due lati di un confronto.
+// Left o Right è nil se il server è presente su un solo lato.
+type ServerComparison struct {
+	Name  string
+	Left  *ServerLayer
+	Right *ServerLayer
+	// Diffs sono le differenze campo per campo da Left a Right
+	Diffs []FieldDiff
+}
+
+// OnlyLeft verifica se il server è presente solo a sinistra
+func (c *ServerComparison) OnlyLeft() bool {
+	return c.Right == nil
+}
+
+// OnlyRight verifica se il server è presente solo a destra
+func (c *ServerComparison) OnlyRight() bool {
+	return c.Left == nil
+}
+
+// Identical verifica se il server è presente su entrambi i lati con la stessa definizione
+func (c *ServerComparison) Identical() bool {
+	return c.Left != nil && c.Right != nil && len(c.Diffs) == 0
+}
+
+// CompareServers confronta due definizioni, anche di server con nomi diversi;
+// il nome del confronto è quello del server di sinistra
+func CompareServers(left, right ServerLayer) ServerComparison {
+	return ServerComparison{
+		Name:  left.Server.Name,
+		Left:  &left,
+		Right: &right,
+		Diffs: DiffServerFields(left.Server, right.Server),
+	}
+}
+
+// CompareServerLayers confronta per nome due insiemi di server (es. le configurazioni
+// effettive di due progetti), in ordine alfabetico
+func CompareServerLayers(left, right map[string]ServerLayer) []ServerComparison {
+	names := make(map[string]bool, len(left)+len(right))
+	for name := range left {
+		names[name] = true
+	}
+	for name := range right {
+		names[name] = true
+	}
+	sorted := make([]string, 0, len(names))
+	for name := range names {
+		sorted = append(sorted, name)
+	}
+	sort.Strings(sorted)
+
+	comparisons := make([]ServerComparison, 0, len(sorted))
+	for _, name := range sorted {
+		l, inLeft := left[name]
+		r, inRight := right[name]
+		switch {
+		case inLeft && inRight:
+			comparison := CompareServers(l, r)
+			comparison.Name = name
+			comparisons = append(comparisons, comparison)
+		case inLeft:
+			comparisons = append(comparisons, ServerComparison{Name: name, Left: &l})
+		default:
+			comparisons = append(comparisons, ServerComparison{Name: name, Right: &r})
+		}
+	}
+	return comparisons
+}
+
+// CopyServerField copia in to un campo di from, indicato come in FieldDiff.Field
+// (es. "command", "env.API_KEY"); un valore assente in from viene rimosso da to.
+// Il tipo copiato è quello effettivo, come mostrato dal diff.
+func CopyServerField(from, to MCPServer, field string) (MCPServer, error) {
+	result := to.Clone()
+	name, key, _ := strings.Cut(field, ".")
+	switch {
+	case field == FieldType:
+		result.Type = from.EffectiveType()
+	case field == FieldCommand:
+		result.Command = from.Command
+	case field == FieldArgs:
+		result.Args = append([]string(nil), from.Args...)
+	case field == FieldURL:
+		result.URL = from.URL
+	case field == FieldTimeout:
+		result.Timeout = from.Timeout
+	case (name == FieldEnv || name == FieldHeaders) && key != "":
+		if value, ok := from.KeyValues(name)[key]; ok {
+			result.SetKeyValue(name, key, value)
+		} else {
+			delete(result.KeyValues(name), key)
+		}
+	default:
+		return to, fmt.Errorf("campo '%s' non valido", field)
+	}
+	return result, nil
+}
+
+// FieldValue restituisce il valore di un campo indicato come in FieldDiff.Field, nella
+// stessa forma del diff ("" se assente)
+func FieldValue(server MCPServer, field string) string {
+	name, key, _ := strings.Cut(field, ".")
+	switch {
+	case field == FieldType:
+		return string(server.EffectiveType())
+	case field == FieldCommand:
+		return server.Command
+	case field == FieldArgs:
+		return JoinCommandLine(server.Args)
+	case field == FieldURL:
+		return server.URL
+	case field == FieldTimeout && server.Timeout != 0:
+		return strconv.Itoa(server.Timeout)
+	case name == FieldEnv || name == FieldHeaders:
+		return server.KeyValues(name)[key]
+	}
+	return ""
+}
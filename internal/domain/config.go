@@ -1,13 +1,97 @@
 package domain
 
+import (
+	"fmt"
+	"path/filepath"
+	"sort"
+)
+
 // Scope rappresenta dove è definito un server MCP
 type Scope string
 
 const (
-	ScopeGlobal  Scope = "global"
-	ScopeProject Scope = "project"
+	ScopeGlobal       Scope = "global"        // ~/.claude.json → mcpServers
+	ScopeProject      Scope = "project"       // ~/.claude.json → projects.[path].mcpServers
+	ScopeProjectFile  Scope = "project-file"  // [project]/.mcp.json
+	ScopeProjectLocal Scope = "project-local" // [project]/.mcp.local.json
+)
+
+// Nomi dei file di configurazione di progetto
+const (
+	MCPJsonFile  = ".mcp.json"
+	MCPLocalFile = ".mcp.local.json"
 )
 
+// ProjectScopes elenca gli scope di progetto in ordine di precedenza crescente
+var ProjectScopes = []Scope{ScopeProject, ScopeProjectFile, ScopeProjectLocal}
+
+// IsProjectScope verifica se lo scope appartiene a un progetto
+func (s Scope) IsProjectScope() bool {
+	return s == ScopeProject || s == ScopeProjectFile || s == ScopeProjectLocal
+}
+
+// FileName restituisce il nome del file di progetto dello scope ("" se vive in ~/.claude.json)
+func (s Scope) FileName() string {
+	switch s {
+	case ScopeProjectFile:
+		return MCPJsonFile
+	case ScopeProjectLocal:
+		return MCPLocalFile
+	}
+	return ""
+}
+
+// Location identifica dove è (o sarà) definito un server: scope ed eventuale progetto
+type Location struct {
+	Scope       Scope
+	ProjectPath string
+}
+
+// ServerRef identifica un server per posizione e nome
+type ServerRef struct {
+	Location
+	Name string
+}
+
+// GlobalLocation restituisce la posizione dello scope globale
+func GlobalLocation() Location {
+	return Location{Scope: ScopeGlobal}
+}
+
+// ProjectLocation restituisce la posizione di uno scope di progetto
+func ProjectLocation(projectPath string, scope Scope) Location {
+	return Location{Scope: scope, ProjectPath: projectPath}
+}
+
+// IsGlobal verifica se la posizione è lo scope globale
+func (l Location) IsGlobal() bool {
+	return l.Scope == ScopeGlobal
+}
+
+// Validate verifica che la posizione sia coerente
+func (l Location) Validate() error {
+	switch {
+	case l.Scope == ScopeGlobal:
+		return nil
+	case l.Scope.IsProjectScope() && l.ProjectPath != "":
+		return nil
+	case l.Scope.IsProjectScope():
+		return fmt.Errorf("path di progetto mancante")
+	}
+	return fmt.Errorf("scope '%s' non valido", l.Scope)
+}
+
+// String restituisce una descrizione leggibile della posizione
+func (l Location) String() string {
+	switch l.Scope {
+	case ScopeGlobal:
+		return "scope globale"
+	case ScopeProject:
+		return "progetto " + l.ProjectPath
+	}
+	return filepath.Join(l.ProjectPath, l.Scope.FileName())
+}
+
 // Configuration è l'aggregate root che gestisce tutte le configurazioni MCP
 type Configuration struct {
 	GlobalServers  map[string]MCPServer
@@ -75,28 +159,90 @@ func (c *Configuration) GetOrCreateProject(path string) *Project {
 	return project
 }
 
-// GetEffectiveServers restituisce i server effettivi per un progetto (merge)
-// Ordine di precedenza: globali < project settings < .mcp.json < .mcp.local.json
-func (c *Configuration) GetEffectiveServers(projectPath string) map[string]MCPServer {
-	result := make(map[string]MCPServer)
+// Servers restituisce la mappa dei server definiti in una posizione
+func (c *Configuration) Servers(loc Location) (map[string]MCPServer, bool) {
+	if loc.IsGlobal() {
+		return c.GlobalServers, true
+	}
+	project, ok := c.Projects[loc.ProjectPath]
+	if !ok {
+		return nil, false
+	}
+	return project.Servers(loc.Scope), true
+}
 
-	// 1. Copia server globali
-	for name, server := range c.GlobalServers {
-		s := server.Clone()
-		s.Name = name
-		result[name] = s
+// GetServer restituisce un server definito in una posizione
+func (c *Configuration) GetServer(loc Location, name string) (MCPServer, bool) {
+	if loc.IsGlobal() {
+		return c.GetGlobalServer(name)
 	}
+	project, ok := c.Projects[loc.ProjectPath]
+	if !ok {
+		return MCPServer{}, false
+	}
+	return project.GetServerIn(loc.Scope, name)
+}
+
+// AllServers restituisce tutti i server della configurazione indicizzati per posizione
+func (c *Configuration) AllServers() map[ServerRef]MCPServer {
+	return allServers(c)
+}
 
-	// 2. Sovrascrivi con server del progetto (se esiste)
-	if project, ok := c.Projects[projectPath]; ok {
-		for name, server := range project.MCPServers {
-			s := server.Clone()
-			s.Name = name
-			result[name] = s
+// SortedServerRefs restituisce i riferimenti in ordine stabile: prima lo scope globale,
+// poi i progetti per path e scope, e per nome all'interno di ogni posizione
+func SortedServerRefs(refs []ServerRef) []ServerRef {
+	sorted := append([]ServerRef(nil), refs...)
+	sort.Slice(sorted, func(i, j int) bool {
+		a, b := sorted[i], sorted[j]
+		if a.IsGlobal() != b.IsGlobal() {
+			return a.IsGlobal()
+		}
+		if a.ProjectPath != b.ProjectPath {
+			return a.ProjectPath < b.ProjectPath
 		}
+		if a.Scope != b.Scope {
+			return scopeOrder(a.Scope) < scopeOrder(b.Scope)
+		}
+		return a.Name < b.Name
+	})
+	return sorted
+}
+
+// scopeOrder restituisce la posizione di uno scope di progetto in ProjectScopes
+func scopeOrder(scope Scope) int {
+	for i, s := range ProjectScopes {
+		if s == scope {
+			return i
+		}
+	}
+	return len(ProjectScopes)
+}
+
+// SetServer aggiunge o sostituisce un server in una posizione, creando il progetto se serve
+func (c *Configuration) SetServer(loc Location, name string, server MCPServer) {
+	if loc.IsGlobal() {
+		c.AddGlobalServer(name, server)
+		return
 	}
+	c.GetOrCreateProject(loc.ProjectPath).AddServerIn(loc.Scope, name, server)
+}
+
+// RemoveServer rimuove un server da una posizione
+func (c *Configuration) RemoveServer(loc Location, name string) bool {
+	if loc.IsGlobal() {
+		return c.RemoveGlobalServer(name)
+	}
+	project, ok := c.Projects[loc.ProjectPath]
+	if !ok {
+		return false
+	}
+	return project.RemoveServerIn(loc.Scope, name)
+}
 
-	return result
+// GetEffectiveServers restituisce i server effettivi per un progetto (merge)
+// Ordine di precedenza: globali < project settings < .mcp.json < .mcp.local.json
+func (c *Configuration) GetEffectiveServers(projectPath string) map[string]MCPServer {
+	return c.GetEffectiveConfig(projectPath).Servers()
 }
 
 // GlobalServerNames restituisce i nomi dei server globali in ordine
@@ -108,6 +254,18 @@ func (c *Configuration) GlobalServerNames() []string {
 	return names
 }
 
+// StaleProjects restituisce i progetti la cui cartella non esiste più, ordinati per path
+func (c *Configuration) StaleProjects() []*Project {
+	var stale []*Project
+	for _, project := range c.Projects {
+		if project.Missing {
+			stale = append(stale, project)
+		}
+	}
+	sort.Slice(stale, func(i, j int) bool { return stale[i].Path < stale[j].Path })
+	return stale
+}
+
 // ProjectPaths restituisce i path dei progetti in ordine
 func (c *Configuration) ProjectPaths() []string {
 	paths := make([]string, 0, len(c.Projects))
@@ -0,0 +1,28 @@
+package domain
+
+import (
+	"fmt"
+	"strings"
+)
+
+// ServerConflict descrive un server modificato sia dal curator sia da un altro programma
+// dopo l'ultimo caricamento della configurazione
+type ServerConflict struct {
+	Ref    ServerRef
+	Ours   *MCPServer // versione del curator (nil se rimosso)
+	Theirs *MCPServer // versione presente su disco (nil se rimosso esternamente)
+}
+
+// ConflictError indica che il salvataggio è stato annullato per modifiche concorrenti
+type ConflictError struct {
+	Path      string
+	Conflicts []ServerConflict
+}
+
+func (e *ConflictError) Error() string {
+	names := make([]string, 0, len(e.Conflicts))
+	for _, c := range e.Conflicts {
+		names = append(names, fmt.Sprintf("'%s' (%s)", c.Ref.Name, c.Ref.Location))
+	}
+	return fmt.Sprintf("%s è stato modificato da un altro programma: conflitto su %s", e.Path, strings.Join(names, ", "))
+}
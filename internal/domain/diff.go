@@ -0,0 +1,83 @@
+package domain
+
+import (
+	"reflect"
+	"sort"
+)
+
+// ChangeKind indica il tipo di differenza su un server
+type ChangeKind string
+
+const (
+	ChangeAdded    ChangeKind = "added"
+	ChangeRemoved  ChangeKind = "removed"
+	ChangeModified ChangeKind = "modified"
+)
+
+// ServerChange descrive una differenza su un server tra due configurazioni
+type ServerChange struct {
+	Ref  ServerRef
+	Kind ChangeKind
+}
+
+// DiffConfigurations confronta due configurazioni e restituisce i server aggiunti,
+// rimossi o modificati in new rispetto a old, ordinati per posizione e nome
+func DiffConfigurations(old, new *Configuration) []ServerChange {
+	oldServers := allServers(old)
+	newServers := allServers(new)
+
+	var changes []ServerChange
+	for ref, server := range newServers {
+		previous, ok := oldServers[ref]
+		switch {
+		case !ok:
+			changes = append(changes, ServerChange{Ref: ref, Kind: ChangeAdded})
+		case !sameServer(previous, server):
+			changes = append(changes, ServerChange{Ref: ref, Kind: ChangeModified})
+		}
+	}
+	for ref := range oldServers {
+		if _, ok := newServers[ref]; !ok {
+			changes = append(changes, ServerChange{Ref: ref, Kind: ChangeRemoved})
+		}
+	}
+
+	sort.Slice(changes, func(i, j int) bool {
+		a, b := changes[i].Ref, changes[j].Ref
+		if a.ProjectPath != b.ProjectPath {
+			return a.ProjectPath < b.ProjectPath
+		}
+		if a.Scope != b.Scope {
+			return a.Scope < b.Scope
+		}
+		return a.Name < b.Name
+	})
+	return changes
+}
+
+// allServers raccoglie tutti i server di una configurazione indicizzati per posizione
+func allServers(config *Configuration) map[ServerRef]MCPServer {
+	result := make(map[ServerRef]MCPServer)
+	if config == nil {
+		return result
+	}
+
+	for name, server := range config.GlobalServers {
+		result[ServerRef{Location: GlobalLocation(), Name: name}] = server
+	}
+	for path, project := range config.Projects {
+		for _, scope := range ProjectScopes {
+			loc := ProjectLocation(path, scope)
+			for name, server := range project.Servers(scope) {
+				result[ServerRef{Location: loc, Name: name}] = server
+			}
+		}
+	}
+	return result
+}
+
+// sameServer confronta due server ignorando il nome
+func sameServer(a, b MCPServer) bool {
+	a.Name, b.Name = "", ""
+	return reflect.DeepEqual(a, b)
+}
@@ -0,0 +1,139 @@
+package domain
+
+import "sort"
+
+// DuplicateCopy è una copia di un server in un gruppo di duplicati
+type DuplicateCopy struct {
+	Ref    ServerRef
+	Server MCPServer
+	// Diffs sono le differenze rispetto alla definizione di riferimento del gruppo
+	Diffs []FieldDiff
+}
+
+// Identical verifica se la copia coincide con la definizione di riferimento
+func (d *DuplicateCopy) Identical() bool {
+	return len(d.Diffs) == 0
+}
+
+// DuplicateGroup raggruppa le copie di uno stesso server: stesso comando con gli stessi
+// argomenti (o stesso URL), anche con nome, env, headers o timeout diversi
+type DuplicateGroup struct {
+	// Key è il comando con gli argomenti o l'URL che accomuna le copie
+	Key string
+	// Name è il nome suggerito per il server globale: quello della definizione di riferimento
+	Name string
+	// Reference è la definizione globale se esiste, altrimenti la più diffusa
+	Reference MCPServer
+	// Copies sono le copie, la globale per prima e poi per progetto, scope e nome
+	Copies []DuplicateCopy
+}
+
+// Identical verifica se tutte le copie sono identiche alla definizione di riferimento
+func (g *DuplicateGroup) Identical() bool {
+	for i := range g.Copies {
+		if !g.Copies[i].Identical() {
+			return false
+		}
+	}
+	return true
+}
+
+// Redundant restituisce le copie di progetto identiche a server: quelle che diventano
+// superflue se server è definito come globale
+func (g *DuplicateGroup) Redundant(server MCPServer) []ServerRef {
+	var refs []ServerRef
+	for _, c := range g.Copies {
+		if !c.Ref.IsGlobal() && SameDefinition(c.Server, server) {
+			refs = append(refs, c.Ref)
+		}
+	}
+	return refs
+}
+
+// duplicateKey restituisce ciò che identifica un server ai fini dei duplicati
+// ("" se il server non ha né comando né URL)
+func duplicateKey(server MCPServer) string {
+	if server.Command != "" {
+		return JoinCommandLine(append([]string{server.Command}, server.Args...))
+	}
+	return server.URL
+}
+
+// FindDuplicates cerca in tutta la configurazione i server presenti in più copie, con
+// almeno una copia in un progetto. I gruppi sono ordinati per numero di copie decrescente.
+func FindDuplicates(c *Configuration) []DuplicateGroup {
+	byKey := make(map[string][]DuplicateCopy)
+	for ref, server := range c.AllServers() {
+		if key := duplicateKey(server); key != "" {
+			s := server.Clone()
+			s.Name = ref.Name
+			byKey[key] = append(byKey[key], DuplicateCopy{Ref: ref, Server: s})
+		}
+	}
+
+	var groups []DuplicateGroup
+	for key, copies := range byKey {
+		if len(copies) < 2 {
+			continue
+		}
+		sortCopies(copies)
+		if copies[len(copies)-1].Ref.IsGlobal() {
+			// Solo copie globali: non c'è niente da promuovere
+			continue
+		}
+
+		reference := referenceCopy(copies)
+		for i := range copies {
+			copies[i].Diffs = DiffServerFields(reference.Server, copies[i].Server)
+		}
+		groups = append(groups, DuplicateGroup{
+			Key:       key,
+			Name:      reference.Ref.Name,
+			Reference: reference.Server,
+			Copies:    copies,
+		})
+	}
+
+	sort.Slice(groups, func(i, j int) bool {
+		if len(groups[i].Copies) != len(groups[j].Copies) {
+			return len(groups[i].Copies) > len(groups[j].Copies)
+		}
+		return groups[i].Key < groups[j].Key
+	})
+	return groups
+}
+
+// sortCopies ordina le copie come SortedServerRefs: la globale per prima, poi per
+// progetto, scope e nome
+func sortCopies(copies []DuplicateCopy) {
+	index := make(map[ServerRef]int, len(copies))
+	refs := make([]ServerRef, len(copies))
+	for i, c := range copies {
+		refs[i] = c.Ref
+	}
+	for i, ref := range SortedServerRefs(refs) {
+		index[ref] = i
+	}
+	sort.Slice(copies, func(i, j int) bool { return index[copies[i].Ref] < index[copies[j].Ref] })
+}
+
+// referenceCopy sceglie la definizione di riferimento: la copia globale se esiste,
+// altrimenti quella con più copie identiche (a parità, la prima in ordine)
+func referenceCopy(copies []DuplicateCopy) DuplicateCopy {
+	if copies[0].Ref.IsGlobal() {
+		return copies[0]
+	}
+	best, bestCount := 0, 0
+	for i := range copies {
+		count := 0
+		for j := range copies {
+			if SameDefinition(copies[i].Server, copies[j].Server) {
+				count++
+			}
+		}
+		if count > bestCount {
+			best, bestCount = i, count
+		}
+	}
+	return copies[best]
+}
@@ -0,0 +1,143 @@
+package domain
+
+import (
+	"sort"
+	"strconv"
+)
+
+// ServerLayer è la definizione di un server in uno dei livelli del merge
+type ServerLayer struct {
+	Location Location
+	Server   MCPServer
+}
+
+// EffectiveServer è un server della configurazione effettiva di un progetto,
+// con il livello da cui proviene e le definizioni omonime che sovrascrive
+type EffectiveServer struct {
+	Name string
+	ServerLayer
+	// Shadows sono le definizioni sovrascritte, dalla precedenza più alta alla più bassa
+	Shadows []ServerLayer
+}
+
+// IsShadowing verifica se il server sovrascrive almeno un'altra definizione
+func (e *EffectiveServer) IsShadowing() bool {
+	return len(e.Shadows) > 0
+}
+
+// EffectiveConfig è il risultato del merge di tutti i livelli di un progetto
+type EffectiveConfig map[string]EffectiveServer
+
+// Servers restituisce i soli server effettivi, come GetEffectiveServers
+func (e EffectiveConfig) Servers() map[string]MCPServer {
+	servers := make(map[string]MCPServer, len(e))
+	for name, effective := range e {
+		servers[name] = effective.Server
+	}
+	return servers
+}
+
+// Names restituisce i nomi dei server effettivi in ordine alfabetico
+func (e EffectiveConfig) Names() []string {
+	names := make([]string, 0, len(e))
+	for name := range e {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// Shadowing restituisce i nomi dei server che sovrascrivono altre definizioni, in ordine
+func (e EffectiveConfig) Shadowing() []string {
+	var names []string
+	for _, name := range e.Names() {
+		if effective := e[name]; effective.IsShadowing() {
+			names = append(names, name)
+		}
+	}
+	return names
+}
+
+// GetEffectiveConfig calcola la configurazione effettiva di un progetto indicando per
+// ogni server il livello da cui proviene e le definizioni che sovrascrive.
+// Ordine di precedenza: globali < project settings < .mcp.json < .mcp.local.json
+func (c *Configuration) GetEffectiveConfig(projectPath string) EffectiveConfig {
+	result := make(EffectiveConfig)
+	add := func(loc Location, servers map[string]MCPServer) {
+		for name, server := range servers {
+			s := server.Clone()
+			s.Name = name
+			layer := ServerLayer{Location: loc, Server: s}
+			effective := EffectiveServer{Name: name, ServerLayer: layer}
+			if previous, ok := result[name]; ok {
+				effective.Shadows = append([]ServerLayer{previous.ServerLayer}, previous.Shadows...)
+			}
+			result[name] = effective
+		}
+	}
+
+	add(GlobalLocation(), c.GlobalServers)
+	if project, ok := c.Projects[projectPath]; ok {
+		for _, scope := range ProjectScopes {
+			add(ProjectLocation(projectPath, scope), project.Servers(scope))
+		}
+	}
+	return result
+}
+
+// FieldDiff è la differenza di un singolo campo tra due definizioni di un server
+type FieldDiff struct {
+	Field string
+	Old   string
+	New   string
+}
+
+// DiffServerFields confronta due definizioni di un server campo per campo; env e
+// headers sono confrontati per chiave (es. "env.API_KEY"). Un valore assente è "".
+func DiffServerFields(old, new MCPServer) []FieldDiff {
+	var diffs []FieldDiff
+	add := func(field, a, b string) {
+		if a != b {
+			diffs = append(diffs, FieldDiff{Field: field, Old: a, New: b})
+		}
+	}
+	timeout := func(ms int) string {
+		if ms == 0 {
+			return ""
+		}
+		return strconv.Itoa(ms)
+	}
+
+	add(FieldType, string(old.EffectiveType()), string(new.EffectiveType()))
+	add(FieldCommand, old.Command, new.Command)
+	add(FieldArgs, JoinCommandLine(old.Args), JoinCommandLine(new.Args))
+	add(FieldURL, old.URL, new.URL)
+	diffs = append(diffs, diffMap(FieldEnv, old.Env, new.Env)...)
+	diffs = append(diffs, diffMap(FieldHeaders, old.Headers, new.Headers)...)
+	add(FieldTimeout, timeout(old.Timeout), timeout(new.Timeout))
+	return diffs
+}
+
+// diffMap confronta due mappe chiave per chiave, in ordine alfabetico
+func diffMap(field string, old, new map[string]string) []FieldDiff {
+	keys := make(map[string]bool, len(old)+len(new))
+	for k := range old {
+		keys[k] = true
+	}
+	for k := range new {
+		keys[k] = true
+	}
+	sorted := make([]string, 0, len(keys))
+	for k := range keys {
+		sorted = append(sorted, k)
+	}
+	sort.Strings(sorted)
+
+	var diffs []FieldDiff
+	for _, k := range sorted {
+		if old[k] != new[k] {
+			diffs = append(diffs, FieldDiff{Field: field + "." + k, Old: old[k], New: new[k]})
+		}
+	}
+	return diffs
+}
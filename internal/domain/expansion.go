@@ -0,0 +1,175 @@
+package domain
+
+import (
+	"sort"
+	"strings"
+)
+
+// LookupFunc restituisce il valore di una variabile d'ambiente e se è definita (es. os.LookupEnv)
+type LookupFunc func(name string) (string, bool)
+
+// MissingVariable è una variabile ${VAR} senza valore né default usata in un campo del server
+type MissingVariable struct {
+	Field string
+	Name  string
+}
+
+// VariableRef è un riferimento ${VAR} o ${VAR:-default} trovato in un valore
+type VariableRef struct {
+	Name       string
+	Default    string
+	HasDefault bool
+	// Text è il riferimento così come scritto (es. "${PORT:-8080}")
+	Text string
+}
+
+// ExpandVariables espande ${VAR} e ${VAR:-default} come fa Claude Code.
+// Restituisce la stringa espansa e le variabili non definite e senza default
+// (che restano invariate nel risultato). Le sequenze non valide restano letterali.
+func ExpandVariables(s string, lookup LookupFunc) (string, []string) {
+	var missing []string
+	result := ReplaceVariables(s, func(ref VariableRef) string {
+		if value, ok := lookup(ref.Name); ok {
+			return value
+		}
+		if ref.HasDefault {
+			return ref.Default
+		}
+		missing = append(missing, ref.Name)
+		return ref.Text
+	})
+	return result, missing
+}
+
+// ReplaceVariables sostituisce ogni riferimento ${VAR} o ${VAR:-default} con il valore
+// restituito da replace. Le sequenze non valide restano letterali.
+func ReplaceVariables(s string, replace func(ref VariableRef) string) string {
+	if !strings.Contains(s, "${") {
+		return s
+	}
+
+	var out strings.Builder
+	for {
+		start := strings.Index(s, "${")
+		if start < 0 {
+			out.WriteString(s)
+			break
+		}
+		end := strings.IndexByte(s[start:], '}')
+		if end < 0 {
+			out.WriteString(s)
+			break
+		}
+		end += start
+
+		expr := s[start+2 : end]
+		name, def, hasDefault := strings.Cut(expr, ":-")
+		if !isVariableName(name) {
+			// Non è un riferimento a variabile: copia "${" e prosegui
+			out.WriteString(s[:start+2])
+			s = s[start+2:]
+			continue
+		}
+
+		out.WriteString(s[:start])
+		out.WriteString(replace(VariableRef{Name: name, Default: def, HasDefault: hasDefault, Text: s[start : end+1]}))
+		s = s[end+1:]
+	}
+	return out.String()
+}
+
+// isVariableName verifica che il nome sia valido per una variabile d'ambiente
+func isVariableName(name string) bool {
+	return name != "" && envNamePattern.MatchString(name)
+}
+
+// HasVariables indica se almeno un campo del server contiene riferimenti ${...}
+func (s *MCPServer) HasVariables() bool {
+	clone := s.Clone()
+	found := false
+	clone.eachExpandable(func(_ string, value string) string {
+		found = found || strings.Contains(value, "${")
+		return value
+	})
+	return found
+}
+
+// VariableRefs restituisce i riferimenti ${...} distinti usati nei campi del server, in ordine
+func (s *MCPServer) VariableRefs() []VariableRef {
+	clone := s.Clone()
+	seen := make(map[string]bool)
+	var refs []VariableRef
+	clone.eachExpandable(func(_ string, value string) string {
+		ReplaceVariables(value, func(ref VariableRef) string {
+			if !seen[ref.Text] {
+				seen[ref.Text] = true
+				refs = append(refs, ref)
+			}
+			return ref.Text
+		})
+		return value
+	})
+	sort.Slice(refs, func(i, j int) bool { return refs[i].Text < refs[j].Text })
+	return refs
+}
+
+// Expand restituisce una copia del server con le variabili espanse in command, args,
+// env, url e headers, insieme alle variabili mancanti per campo
+func (s *MCPServer) Expand(lookup LookupFunc) (MCPServer, []MissingVariable) {
+	expanded := s.Clone()
+	var missing []MissingVariable
+	expanded.eachExpandable(func(field, value string) string {
+		result, names := ExpandVariables(value, lookup)
+		for _, name := range names {
+			missing = append(missing, MissingVariable{Field: field, Name: name})
+		}
+		return result
+	})
+	return expanded, missing
+}
+
+// CheckVariables segnala come errori le variabili non definite e senza default.
+// A differenza di Validate dipende dall'ambiente: non blocca il salvataggio.
+func (s *MCPServer) CheckVariables(lookup LookupFunc) ValidationIssues {
+	_, missing := s.Expand(lookup)
+	var issues ValidationIssues
+	seen := make(map[MissingVariable]bool)
+	for _, m := range missing {
+		if seen[m] {
+			continue
+		}
+		seen[m] = true
+		issues = append(issues, ValidationIssue{Field: m.Field, Severity: SeverityError, Code: "variable_missing", Detail: m.Name})
+	}
+	return issues
+}
+
+// MissingVariableNames restituisce i nomi distinti delle variabili mancanti, in ordine
+func MissingVariableNames(missing []MissingVariable) []string {
+	set := make(map[string]bool, len(missing))
+	for _, m := range missing {
+		set[m.Name] = true
+	}
+	names := make([]string, 0, len(set))
+	for name := range set {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// eachExpandable applica fn ai campi in cui Claude Code espande le variabili,
+// sostituendo ogni valore con quello restituito (da usare su una copia)
+func (s *MCPServer) eachExpandable(fn func(field, value string) string) {
+	s.Command = fn(FieldCommand, s.Command)
+	for i, arg := range s.Args {
+		s.Args[i] = fn(FieldArgs, arg)
+	}
+	for _, k := range SortedKeys(s.Env) {
+		s.Env[k] = fn(FieldEnv, s.Env[k])
+	}
+	s.URL = fn(FieldURL, s.URL)
+	for _, k := range SortedKeys(s.Headers) {
+		s.Headers[k] = fn(FieldHeaders, s.Headers[k])
+	}
+}
@@ -0,0 +1,61 @@
+package domain
+
+import "sort"
+
+// ExportFormat identifica il formato in cui esportare i server per un altro client
+type ExportFormat string
+
+const (
+	// ExportMCPServers è lo snippet {"mcpServers": {...}} di Claude Code e .mcp.json
+	ExportMCPServers ExportFormat = "mcp-servers"
+	// ExportClaudeDesktop è claude_desktop_config.json (solo server stdio)
+	ExportClaudeDesktop ExportFormat = "claude-desktop"
+	// ExportCursor è .cursor/mcp.json
+	ExportCursor ExportFormat = "cursor"
+	// ExportVSCode è .vscode/mcp.json (chiave servers)
+	ExportVSCode ExportFormat = "vscode"
+	// ExportCodex è la sezione [mcp_servers.*] di ~/.codex/config.toml
+	ExportCodex ExportFormat = "codex"
+	// ExportGoose è la sezione extensions di ~/.config/goose/config.yaml
+	ExportGoose ExportFormat = "goose"
+)
+
+// ExportFormats restituisce i formati di esportazione supportati
+func ExportFormats() []ExportFormat {
+	return []ExportFormat{ExportMCPServers, ExportClaudeDesktop, ExportCursor, ExportVSCode, ExportCodex, ExportGoose}
+}
+
+// ExportResult è il risultato di un'esportazione
+type ExportResult struct {
+	Format ExportFormat
+	Data   []byte
+	// Issues segnala, per server, cosa il formato di destinazione non può rappresentare
+	Issues map[string]ValidationIssues
+	// Skipped sono i server non esportati perché il formato non supporta il loro tipo
+	Skipped []string
+}
+
+// IssueNames restituisce i nomi dei server con problemi, in ordine alfabetico
+func (r *ExportResult) IssueNames() []string {
+	names := make([]string, 0, len(r.Issues))
+	for name := range r.Issues {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// FileName restituisce il nome di file suggerito per il formato
+func (f ExportFormat) FileName() string {
+	switch f {
+	case ExportClaudeDesktop:
+		return "claude_desktop_config.json"
+	case ExportCodex:
+		return "config.toml"
+	case ExportGoose:
+		return "config.yaml"
+	case ExportMCPServers:
+		return ".mcp.json"
+	}
+	return "mcp.json"
+}
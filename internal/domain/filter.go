@@ -0,0 +1,103 @@
+package domain
+
+import (
+	"sort"
+	"strings"
+)
+
+// ServerFilter seleziona i server per testo, tipo e file di origine
+type ServerFilter struct {
+	// Query è cercato, senza distinguere maiuscole, in nome, comando, argomenti, URL,
+	// chiavi di env e path del progetto
+	Query string
+	// Type limita ai server di un tipo ("" per tutti)
+	Type ServerType
+	// Scope limita ai server di un file di origine ("" per tutti)
+	Scope Scope
+}
+
+// IsEmpty verifica se il filtro lascia passare tutti i server
+func (f ServerFilter) IsEmpty() bool {
+	return strings.TrimSpace(f.Query) == "" && f.Type == "" && f.Scope == ""
+}
+
+// Matches verifica se un server rispetta il filtro
+func (f ServerFilter) Matches(ref ServerRef, server MCPServer) bool {
+	if f.Type != "" && server.EffectiveType() != f.Type {
+		return false
+	}
+	if f.Scope != "" && ref.Scope != f.Scope {
+		return false
+	}
+	return f.matchesText(ref, server)
+}
+
+// matchesText verifica se la ricerca testuale trova il server
+func (f ServerFilter) matchesText(ref ServerRef, server MCPServer) bool {
+	query := strings.ToLower(strings.TrimSpace(f.Query))
+	if query == "" {
+		return true
+	}
+	fields := []string{ref.Name, server.Command, server.URL, ref.ProjectPath}
+	fields = append(fields, server.Args...)
+	for key := range server.Env {
+		fields = append(fields, key)
+	}
+	for _, field := range fields {
+		if strings.Contains(strings.ToLower(field), query) {
+			return true
+		}
+	}
+	return false
+}
+
+// MatchesProject verifica se la ricerca testuale trova il path di un progetto; con un
+// filtro per tipo o file di origine conta solo chi ha server che lo rispettano
+func (f ServerFilter) MatchesProject(path string) bool {
+	query := strings.ToLower(strings.TrimSpace(f.Query))
+	return query != "" && f.Type == "" && f.Scope == "" && strings.Contains(strings.ToLower(path), query)
+}
+
+// ServerSort è il criterio di ordinamento dei server
+type ServerSort string
+
+const (
+	SortByName  ServerSort = "name"
+	SortByType  ServerSort = "type"
+	SortByUsage ServerSort = "usage"
+)
+
+// ServerSorts elenca i criteri di ordinamento disponibili
+var ServerSorts = []ServerSort{SortByName, SortByType, SortByUsage}
+
+// ProjectUsage conta per ogni nome di server i progetti che lo usano, cioè quelli nella
+// cui configurazione effettiva compare (i server globali contano in tutti i progetti)
+func (c *Configuration) ProjectUsage() map[string]int {
+	usage := make(map[string]int)
+	for path := range c.Projects {
+		for name := range c.GetEffectiveConfig(path) {
+			usage[name]++
+		}
+	}
+	return usage
+}
+
+// SortServers ordina i riferimenti ai server di c secondo il criterio indicato; a parità
+// vale l'ordine di SortedServerRefs. usage è il risultato di ProjectUsage (serve solo
+// per SortByUsage, dal più usato al meno usato).
+func (c *Configuration) SortServers(refs []ServerRef, order ServerSort, usage map[string]int) []ServerRef {
+	sorted := SortedServerRefs(refs)
+	serverType := func(ref ServerRef) string {
+		server, _ := c.GetServer(ref.Location, ref.Name)
+		return string(server.EffectiveType())
+	}
+	switch order {
+	case SortByName:
+		sort.SliceStable(sorted, func(i, j int) bool { return sorted[i].Name < sorted[j].Name })
+	case SortByType:
+		sort.SliceStable(sorted, func(i, j int) bool { return serverType(sorted[i]) < serverType(sorted[j]) })
+	case SortByUsage:
+		sort.SliceStable(sorted, func(i, j int) bool { return usage[sorted[i].Name] > usage[sorted[j].Name] })
+	}
+	return sorted
+}
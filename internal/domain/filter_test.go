@@ -0,0 +1,26 @@
+package domain
+
+import "testing"
+
+func TestServerFilterMatchesImplicitType(t *testing.T) {
+	ref := ServerRef{Location: GlobalLocation(), Name: "demo"}
+	tests := []struct {
+		name   string
+		server MCPServer
+		filter ServerType
+		want   bool
+	}{
+		{"comando senza type come stdio", MCPServer{Command: "npx"}, ServerTypeStdio, true},
+		{"solo URL come http", MCPServer{URL: "https://example.com/mcp"}, ServerTypeHTTP, true},
+		{"solo URL non è stdio", MCPServer{URL: "https://example.com/mcp"}, ServerTypeStdio, false},
+		{"type esplicito sse", MCPServer{Type: ServerTypeSSE, URL: "https://example.com/sse"}, ServerTypeHTTP, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			filter := ServerFilter{Type: tt.filter}
+			if got := filter.Matches(ref, tt.server); got != tt.want {
+				t.Errorf("Matches = %v, atteso %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,112 @@
+package domain
+
+import (
+	"reflect"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+// ImportFormat identifica il formato di configurazione di un altro client MCP
+type ImportFormat string
+
+const (
+	// ImportClaudeDesktop è claude_desktop_config.json (chiave mcpServers)
+	ImportClaudeDesktop ImportFormat = "claude-desktop"
+	// ImportCursor è .cursor/mcp.json (chiave mcpServers)
+	ImportCursor ImportFormat = "cursor"
+	// ImportVSCode è .vscode/mcp.json (chiave servers) o settings.json (chiave mcp.servers)
+	ImportVSCode ImportFormat = "vscode"
+	// ImportCodex è ~/.codex/config.toml (tabelle [mcp_servers.NOME])
+	ImportCodex ImportFormat = "codex"
+	// ImportGoose è ~/.config/goose/config.yaml (chiave extensions)
+	ImportGoose ImportFormat = "goose"
+)
+
+// ImportFormats restituisce i formati supportati
+func ImportFormats() []ImportFormat {
+	return []ImportFormat{ImportClaudeDesktop, ImportCursor, ImportVSCode, ImportCodex, ImportGoose}
+}
+
+// ImportSource è un file di configurazione di un altro client da cui importare server
+type ImportSource struct {
+	Format ImportFormat
+	Path   string
+	// ProjectPath è il progetto a cui appartiene il file ("" per i file utente)
+	ProjectPath string
+}
+
+// ImportedServer è un server letto da un altro client e convertito nel formato di Claude Code
+type ImportedServer struct {
+	Name   string
+	Server MCPServer
+	// Issues segnala cosa non è stato convertito (campi ignorati, variabili non supportate, ...);
+	// con un errore la voce non è stata letta e il server è vuoto
+	Issues ValidationIssues
+}
+
+// ImportStatus indica come un server importato si rapporta alla destinazione
+type ImportStatus string
+
+const (
+	// ImportNew: nessun server con lo stesso nome nella destinazione
+	ImportNew ImportStatus = "new"
+	// ImportIdentical: la destinazione contiene già lo stesso server
+	ImportIdentical ImportStatus = "identical"
+	// ImportConflict: la destinazione contiene un server diverso con lo stesso nome
+	ImportConflict ImportStatus = "conflict"
+)
+
+// ImportCandidate è un server importato con il suo stato rispetto alla destinazione
+type ImportCandidate struct {
+	ImportedServer
+	Status ImportStatus
+}
+
+// invalidNameChars riconosce i caratteri non ammessi nei nomi dei server
+var invalidNameChars = regexp.MustCompile(`[^A-Za-z0-9_-]+`)
+
+// SanitizeServerName rende un nome utilizzabile come prefisso dei tool sostituendo
+// spazi e caratteri non ammessi con '-' (es. "GitHub Copilot" -> "GitHub-Copilot")
+func SanitizeServerName(name string) string {
+	return strings.Trim(invalidNameChars.ReplaceAllString(strings.TrimSpace(name), "-"), "-")
+}
+
+// PlanImport confronta i server importati con quelli già presenti nella destinazione
+func PlanImport(existing map[string]MCPServer, servers []ImportedServer) []ImportCandidate {
+	candidates := make([]ImportCandidate, 0, len(servers))
+	for _, s := range servers {
+		status := ImportNew
+		if current, ok := existing[s.Name]; ok {
+			status = ImportConflict
+			if SameDefinition(current, s.Server) {
+				status = ImportIdentical
+			}
+		}
+		candidates = append(candidates, ImportCandidate{ImportedServer: s, Status: status})
+	}
+	sort.SliceStable(candidates, func(i, j int) bool { return candidates[i].Name < candidates[j].Name })
+	return candidates
+}
+
+// SameDefinition confronta i campi gestiti di due server ignorando nome, campi sconosciuti
+// e il tipo stdio implicito (un server con command e senza type è stdio)
+func SameDefinition(a, b MCPServer) bool {
+	normalize := func(s MCPServer) MCPServer {
+		s.Name, s.Raw = "", nil
+		if s.Type == "" && s.Command != "" {
+			s.Type = ServerTypeStdio
+		}
+		if len(s.Args) == 0 {
+			s.Args = nil
+		}
+		if len(s.Env) == 0 {
+			s.Env = nil
+		}
+		if len(s.Headers) == 0 {
+			s.Headers = nil
+		}
+		return s
+	}
+	return reflect.DeepEqual(normalize(a), normalize(b))
+}
@@ -4,25 +4,58 @@ import "path/filepath"
 
 // Project rappresenta un progetto con configurazione MCP
 type Project struct {
-	Path        string               `json:"-"`
-	Name        string               `json:"-"`
-	MCPServers  map[string]MCPServer `json:"mcpServers,omitempty"`
-	HasMCPJson  bool                 `json:"-"`
-	HasMCPLocal bool                 `json:"-"`
+	Path         string               `json:"-"`
+	Name         string               `json:"-"`
+	MCPServers   map[string]MCPServer `json:"mcpServers,omitempty"`
+	FileServers  map[string]MCPServer `json:"-"` // da .mcp.json
+	LocalServers map[string]MCPServer `json:"-"` // da .mcp.local.json
+	HasMCPJson   bool                 `json:"-"`
+	HasMCPLocal  bool                 `json:"-"`
+	Missing      bool                 `json:"-"` // la cartella del progetto non esiste più
+	DataSize     int64                `json:"-"` // byte della voce del progetto in ~/.claude.json
 }
 
 // NewProject crea un nuovo progetto dal path
 func NewProject(path string) *Project {
 	return &Project{
-		Path:       path,
-		Name:       filepath.Base(path),
-		MCPServers: make(map[string]MCPServer),
+		Path:         path,
+		Name:         filepath.Base(path),
+		MCPServers:   make(map[string]MCPServer),
+		FileServers:  make(map[string]MCPServer),
+		LocalServers: make(map[string]MCPServer),
 	}
 }
 
+// Servers restituisce la mappa dei server di uno scope di progetto
+func (p *Project) Servers(scope Scope) map[string]MCPServer {
+	switch scope {
+	case ScopeProject:
+		if p.MCPServers == nil {
+			p.MCPServers = make(map[string]MCPServer)
+		}
+		return p.MCPServers
+	case ScopeProjectFile:
+		if p.FileServers == nil {
+			p.FileServers = make(map[string]MCPServer)
+		}
+		return p.FileServers
+	case ScopeProjectLocal:
+		if p.LocalServers == nil {
+			p.LocalServers = make(map[string]MCPServer)
+		}
+		return p.LocalServers
+	}
+	return nil
+}
+
 // GetServer restituisce un server per nome
 func (p *Project) GetServer(name string) (MCPServer, bool) {
-	server, ok := p.MCPServers[name]
+	return p.GetServerIn(ScopeProject, name)
+}
+
+// GetServerIn restituisce un server per nome da uno scope di progetto
+func (p *Project) GetServerIn(scope Scope, name string) (MCPServer, bool) {
+	server, ok := p.Servers(scope)[name]
 	if ok {
 		server.Name = name
 	}
@@ -31,17 +64,37 @@ func (p *Project) GetServer(name string) (MCPServer, bool) {
 
 // AddServer aggiunge un server al progetto
 func (p *Project) AddServer(name string, server MCPServer) {
-	if p.MCPServers == nil {
-		p.MCPServers = make(map[string]MCPServer)
+	p.AddServerIn(ScopeProject, name, server)
+}
+
+// AddServerIn aggiunge un server a uno scope di progetto
+func (p *Project) AddServerIn(scope Scope, name string, server MCPServer) {
+	servers := p.Servers(scope)
+	if servers == nil {
+		return
 	}
 	server.Name = name
-	p.MCPServers[name] = server
+	servers[name] = server
+
+	// Il file esiste dopo il primo salvataggio di un server
+	switch scope {
+	case ScopeProjectFile:
+		p.HasMCPJson = true
+	case ScopeProjectLocal:
+		p.HasMCPLocal = true
+	}
 }
 
 // RemoveServer rimuove un server dal progetto
 func (p *Project) RemoveServer(name string) bool {
-	if _, ok := p.MCPServers[name]; ok {
-		delete(p.MCPServers, name)
+	return p.RemoveServerIn(ScopeProject, name)
+}
+
+// RemoveServerIn rimuove un server da uno scope di progetto
+func (p *Project) RemoveServerIn(scope Scope, name string) bool {
+	servers := p.Servers(scope)
+	if _, ok := servers[name]; ok {
+		delete(servers, name)
 		return true
 	}
 	return false
@@ -51,3 +104,19 @@ func (p *Project) RemoveServer(name string) bool {
 func (p *Project) ServerCount() int {
 	return len(p.MCPServers)
 }
+
+// AllServerCount restituisce il numero di server definiti in tutti gli scope del progetto
+func (p *Project) AllServerCount() int {
+	return len(p.MCPServers) + len(p.FileServers) + len(p.LocalServers)
+}
+
+// HasFile verifica se esiste il file di configurazione di uno scope di progetto
+func (p *Project) HasFile(scope Scope) bool {
+	switch scope {
+	case ScopeProjectFile:
+		return p.HasMCPJson
+	case ScopeProjectLocal:
+		return p.HasMCPLocal
+	}
+	return false
+}
@@ -0,0 +1,343 @@
+package domain
+
+import (
+	"math"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+const (
+	// MaskedValue sostituisce i segreti mostrati nell'interfaccia
+	MaskedValue = "••••••••"
+	// RedactedValue sostituisce i segreti nei testi copiati negli appunti e negli export
+	RedactedValue = "[REDACTED]"
+)
+
+// Soglie per riconoscere un valore casuale (token, chiavi API) dalla sola forma
+const (
+	minSecretLength  = 20
+	minSecretEntropy = 3.5
+)
+
+// secretKeyWords sono le parti di nome (env, header, parametro) che indicano un segreto
+var secretKeyWords = []string{"TOKEN", "SECRET", "PASSWORD", "PASSWD", "PASSPHRASE", "CREDENTIAL", "AUTH", "COOKIE", "PRIVATE", "SIGNATURE"}
+
+// secretPrefixes sono i prefissi dei token più diffusi (GitHub, OpenAI/Anthropic, Slack, AWS, GitLab, ...)
+var secretPrefixes = []string{"ghp_", "gho_", "ghu_", "ghs_", "ghr_", "github_pat_", "sk-", "xoxb-", "xoxp-", "xoxa-", "xapp-", "AKIA", "ASIA", "glpat-", "hf_", "npm_", "pypi-", "AIza", "ya29."}
+
+// authSchemes sono gli schemi di Authorization seguiti dalla credenziale
+var authSchemes = []string{"bearer ", "basic ", "token ", "bot "}
+
+var (
+	urlPattern      = regexp.MustCompile(`[A-Za-z][A-Za-z0-9+.-]*://[^\s"'<>]+`)
+	jsonPairPattern = regexp.MustCompile(`"([^"\\]+)"(\s*:\s*)"((?:[^"\\]|\\.)*)"`)
+	assignPattern   = regexp.MustCompile(`(?m)(^|[\s,;'"])([A-Za-z_][A-Za-z0-9_.-]*)(\s*[=:]\s*)([^\s,;'"]+(?: [^\s,;'"]+)?)`)
+	tokenPattern    = regexp.MustCompile(`[A-Za-z0-9_\-+/=.]{20,}`)
+	variablePattern = regexp.MustCompile(`\$\{[^}]*\}`)
+)
+
+// IsSecretKey verifica se il nome di una variabile, di un header o di un parametro
+// indica un segreto (es. GITHUB_TOKEN, Authorization, x-api-key, client_secret)
+func IsSecretKey(key string) bool {
+	upper := strings.ToUpper(key)
+	for _, word := range secretKeyWords {
+		if strings.Contains(upper, word) {
+			return true
+		}
+	}
+	// KEY solo come parola intera o suffisso (API_KEY, APIKEY, x-api-key), non in MONKEY_PATCH
+	for _, part := range strings.FieldsFunc(upper, func(r rune) bool {
+		return !(r >= 'A' && r <= 'Z' || r >= '0' && r <= '9')
+	}) {
+		if part == "KEY" || part == "PAT" || strings.HasSuffix(part, "APIKEY") || strings.HasSuffix(part, "ACCESSKEY") || part == "SIG" {
+			return true
+		}
+	}
+	return false
+}
+
+// LooksLikeSecret verifica se un valore ha la forma di una credenziale: prefisso di un token noto,
+// schema di Authorization seguito da una credenziale, oppure stringa lunga ad alta entropia
+func LooksLikeSecret(value string) bool {
+	v := strings.TrimSpace(value)
+	if v == "" || isOnlyReferences(v) {
+		return false
+	}
+	lower := strings.ToLower(v)
+	for _, scheme := range authSchemes {
+		if strings.HasPrefix(lower, scheme) {
+			rest := strings.TrimSpace(v[len(scheme):])
+			return len(rest) >= 8 && !isOnlyReferences(rest)
+		}
+	}
+	for _, prefix := range secretPrefixes {
+		if strings.HasPrefix(v, prefix) && len(v) >= len(prefix)+12 {
+			return true
+		}
+	}
+	return isHighEntropy(v)
+}
+
+// IsSecretValue verifica se il valore di una chiave env/header va nascosto:
+// chiave con nome da segreto (salvo riferimenti ${VAR}), valore dalla forma di un segreto
+// o URL con credenziali
+func IsSecretValue(key, value string) bool {
+	if value == "" || isOnlyReferences(value) {
+		return false
+	}
+	if IsSecretKey(key) && hasLiteralSecret(value) {
+		return true
+	}
+	return LooksLikeSecret(value) || URLHasCredentials(value)
+}
+
+// isOnlyReferences verifica se il valore contiene solo riferimenti ${VAR} (e spazi)
+func isOnlyReferences(value string) bool {
+	return strings.Contains(value, "${") && strings.TrimSpace(variablePattern.ReplaceAllString(value, "")) == ""
+}
+
+// hasLiteralSecret verifica che, tolti i riferimenti ${VAR} e lo schema di autenticazione,
+// nel valore resti testo: "Bearer ${TOKEN}" non contiene segreti
+func hasLiteralSecret(value string) bool {
+	literal := strings.TrimSpace(variablePattern.ReplaceAllString(value, ""))
+	lower := strings.ToLower(literal + " ")
+	for _, scheme := range authSchemes {
+		if lower == scheme {
+			return false
+		}
+	}
+	return literal != ""
+}
+
+// isHighEntropy riconosce stringhe lunghe, senza spazi, con caratteri misti e distribuiti
+// in modo casuale, escludendo percorsi, URL e nomi di pacchetto
+func isHighEntropy(v string) bool {
+	if len(v) < minSecretLength || strings.ContainsAny(v, " \t\n") {
+		return false
+	}
+	if strings.Contains(v, "://") || strings.HasPrefix(v, "@") || strings.HasPrefix(v, "~") ||
+		strings.HasPrefix(v, "/") || strings.HasPrefix(v, "./") || strings.HasPrefix(v, "../") {
+		return false
+	}
+
+	var lower, upper, digit bool
+	counts := make(map[rune]int)
+	for _, r := range v {
+		switch {
+		case r >= 'a' && r <= 'z':
+			lower = true
+		case r >= 'A' && r <= 'Z':
+			upper = true
+		case r >= '0' && r <= '9':
+			digit = true
+		}
+		counts[r]++
+	}
+	classes := 0
+	for _, present := range []bool{lower, upper, digit} {
+		if present {
+			classes++
+		}
+	}
+	if classes < 2 || !digit && classes < 3 {
+		return false
+	}
+
+	entropy := 0.0
+	length := float64(len([]rune(v)))
+	for _, n := range counts {
+		p := float64(n) / length
+		entropy -= p * math.Log2(p)
+	}
+	return entropy >= minSecretEntropy
+}
+
+// URLHasCredentials verifica se un URL contiene credenziali (userinfo o parametri da segreto)
+func URLHasCredentials(raw string) bool {
+	return RedactURL(raw, RedactedValue) != raw
+}
+
+// RedactURL sostituisce le credenziali di un URL: password (o token come utente) e
+// valori dei parametri di query con nome da segreto. Gli URL non validi restano invariati.
+func RedactURL(raw, replacement string) string {
+	if !strings.Contains(raw, "://") {
+		return raw
+	}
+	u, err := url.Parse(raw)
+	if err != nil || u.Host == "" {
+		return raw
+	}
+
+	result := raw
+	if u.User != nil {
+		userinfo := u.User.String()
+		masked := replacement
+		if _, hasPassword := u.User.Password(); hasPassword {
+			masked = u.User.Username() + ":" + replacement
+		}
+		result = strings.Replace(result, userinfo+"@", masked+"@", 1)
+	}
+
+	if u.RawQuery != "" {
+		query := u.RawQuery
+		var parts []string
+		for _, pair := range strings.Split(query, "&") {
+			key, value, found := strings.Cut(pair, "=")
+			name, _ := url.QueryUnescape(key)
+			if found && value != "" && (IsSecretKey(name) || LooksLikeSecret(value)) && !isOnlyReferences(value) {
+				pair = key + "=" + replacement
+			}
+			parts = append(parts, pair)
+		}
+		result = strings.Replace(result, "?"+query, "?"+strings.Join(parts, "&"), 1)
+	}
+	return result
+}
+
+// SecretArgs indica quali argomenti contengono segreti: valori di flag con nome da segreto
+// (--token X, --api-key=X), credenziali negli URL e valori dalla forma di un segreto
+func SecretArgs(args []string) []bool {
+	secret := make([]bool, len(args))
+	for i, arg := range args {
+		if strings.HasPrefix(arg, "-") {
+			name, value, hasValue := strings.Cut(strings.TrimLeft(arg, "-"), "=")
+			if IsSecretKey(name) {
+				if hasValue {
+					secret[i] = hasLiteralSecret(value)
+				} else if i+1 < len(args) && !strings.HasPrefix(args[i+1], "-") && hasLiteralSecret(args[i+1]) {
+					secret[i+1] = true
+				}
+				continue
+			}
+			if hasValue && URLHasCredentials(value) {
+				secret[i] = true
+				continue
+			}
+		}
+		if !secret[i] {
+			secret[i] = LooksLikeSecret(arg) || URLHasCredentials(arg)
+		}
+	}
+	return secret
+}
+
+// RedactArg restituisce l'argomento senza il segreto: per --flag=valore resta il nome del flag
+// (e, se il valore è un URL, tutto tranne le credenziali)
+func RedactArg(arg, replacement string) string {
+	if strings.HasPrefix(arg, "-") {
+		if name, value, found := strings.Cut(arg, "="); found {
+			if redacted := RedactURL(value, replacement); redacted != value {
+				return name + "=" + redacted
+			}
+			return name + "=" + replacement
+		}
+	}
+	if redacted := RedactURL(arg, replacement); redacted != arg {
+		return redacted
+	}
+	return replacement
+}
+
+// Redacted restituisce una copia del server con i segreti sostituiti da replacement
+// (RedactedValue o MaskedValue) in args, URL, env, headers e nei campi non gestiti con nome da segreto
+func (s *MCPServer) Redacted(replacement string) MCPServer {
+	redacted := s.Clone()
+	for i, secret := range SecretArgs(redacted.Args) {
+		if secret {
+			redacted.Args[i] = RedactArg(redacted.Args[i], replacement)
+		}
+	}
+	redacted.URL = RedactURL(redacted.URL, replacement)
+	for _, field := range []string{FieldEnv, FieldHeaders} {
+		values := redacted.KeyValues(field)
+		for _, key := range SortedKeys(values) {
+			value := values[key]
+			switch {
+			case IsSecretValue(key, value) && URLHasCredentials(value) && !IsSecretKey(key):
+				values[key] = RedactURL(value, replacement)
+			case IsSecretValue(key, value):
+				values[key] = replacement
+			}
+		}
+	}
+	redacted.Raw = redactRaw(redacted.Raw, replacement)
+	return redacted
+}
+
+// redactRaw sostituisce i valori stringa con chiave da segreto nei campi JSON non gestiti.
+// env e headers sono gestiti dalle mappe del server e vengono lasciati a Redacted.
+func redactRaw(raw map[string]interface{}, replacement string) map[string]interface{} {
+	for key, value := range raw {
+		if key == FieldEnv || key == FieldHeaders || key == FieldArgs {
+			continue
+		}
+		raw[key] = redactJSONValue(key, value, replacement)
+	}
+	return raw
+}
+
+// redactJSONValue applica la redazione a un valore JSON annidato
+func redactJSONValue(key string, value interface{}, replacement string) interface{} {
+	switch v := value.(type) {
+	case string:
+		if IsSecretValue(key, v) {
+			if URLHasCredentials(v) && !IsSecretKey(key) {
+				return RedactURL(v, replacement)
+			}
+			return replacement
+		}
+	case map[string]interface{}:
+		for k, item := range v {
+			v[k] = redactJSONValue(k, item, replacement)
+		}
+	case []interface{}:
+		for i, item := range v {
+			v[i] = redactJSONValue(key, item, replacement)
+		}
+	}
+	return value
+}
+
+// RedactText sostituisce i segreti riconoscibili in un testo libero (JSON, comandi, log):
+// credenziali negli URL, valori di chiavi da segreto ("token": "...", API_KEY=...,
+// Authorization: ...) e token dalla forma di un segreto
+func RedactText(text, replacement string) string {
+	text = urlPattern.ReplaceAllStringFunc(text, func(match string) string {
+		return RedactURL(match, replacement)
+	})
+	text = jsonPairPattern.ReplaceAllStringFunc(text, func(match string) string {
+		m := jsonPairPattern.FindStringSubmatch(match)
+		if IsSecretValue(m[1], m[3]) && m[3] != replacement {
+			return `"` + m[1] + `"` + m[2] + `"` + replacement + `"`
+		}
+		return match
+	})
+	text = assignPattern.ReplaceAllStringFunc(text, func(match string) string {
+		m := assignPattern.FindStringSubmatch(match)
+		if IsSecretKey(m[2]) && hasLiteralSecret(m[4]) && m[4] != replacement && !strings.HasPrefix(m[4], replacement) {
+			return m[1] + m[2] + m[3] + replacement
+		}
+		return match
+	})
+	return tokenPattern.ReplaceAllStringFunc(text, func(match string) string {
+		if LooksLikeSecret(match) {
+			return replacement
+		}
+		return match
+	})
+}
+
+// RedactValues sostituisce in un testo le occorrenze dei valori indicati (es. i segreti
+// del vault), dal più lungo al più corto per non lasciare frammenti
+func RedactValues(text string, values []string, replacement string) string {
+	sorted := append([]string(nil), values...)
+	sort.Slice(sorted, func(i, j int) bool { return len(sorted[i]) > len(sorted[j]) })
+	for _, value := range sorted {
+		if value != "" {
+			text = strings.ReplaceAll(text, value, replacement)
+		}
+	}
+	return text
+}
@@ -0,0 +1,38 @@
+package domain
+
+// SecretReferenceValue restituisce il riferimento ${NOME} a una variabile
+func SecretReferenceValue(name string) string {
+	return "${" + name + "}"
+}
+
+// ValidateSecretName verifica che il nome di un segreto sia utilizzabile come variabile d'ambiente
+func ValidateSecretName(name string) bool {
+	return isVariableName(name)
+}
+
+// KeyValues restituisce la mappa env o headers del server (nil per gli altri campi)
+func (s *MCPServer) KeyValues(field string) map[string]string {
+	switch field {
+	case FieldEnv:
+		return s.Env
+	case FieldHeaders:
+		return s.Headers
+	}
+	return nil
+}
+
+// SetKeyValue imposta una chiave di env o headers creando la mappa se necessario
+func (s *MCPServer) SetKeyValue(field, key, value string) {
+	switch field {
+	case FieldEnv:
+		if s.Env == nil {
+			s.Env = make(map[string]string)
+		}
+		s.Env[key] = value
+	case FieldHeaders:
+		if s.Headers == nil {
+			s.Headers = make(map[string]string)
+		}
+		s.Headers[key] = value
+	}
+}
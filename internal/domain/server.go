@@ -19,6 +19,11 @@ type MCPServer struct {
 	Headers map[string]string `json:"headers,omitempty"`
 	Env     map[string]string `json:"env,omitempty"`
 	Timeout int               `json:"timeout,omitempty"`
+
+	// Raw è il JSON originale del server così come letto dal file.
+	// Conserva i campi sconosciuti e i valori non gestiti (es. env non stringa)
+	// per riscriverli invariati al salvataggio.
+	Raw map[string]interface{} `json:"-"`
 }
 
 // IsStdio verifica se il server è di tipo stdio
@@ -26,6 +31,20 @@ func (s *MCPServer) IsStdio() bool {
 	return s.Type == ServerTypeStdio || s.Command != ""
 }
 
+// EffectiveType restituisce il tipo del server anche se è omesso: stdio con un comando,
+// http con il solo URL (come lo interpreta Claude Code)
+func (s *MCPServer) EffectiveType() ServerType {
+	switch {
+	case s.Type != "":
+		return s.Type
+	case s.Command != "":
+		return ServerTypeStdio
+	case s.URL != "":
+		return ServerTypeHTTP
+	}
+	return ""
+}
+
 // IsHTTP verifica se il server è di tipo HTTP o SSE
 func (s *MCPServer) IsHTTP() bool {
 	return s.Type == ServerTypeHTTP || s.Type == ServerTypeSSE || s.URL != ""
@@ -60,5 +79,34 @@ func (s *MCPServer) Clone() MCPServer {
 		}
 	}
 
+	clone.Raw = CloneRaw(s.Raw)
+
+	return clone
+}
+
+// CloneRaw crea una copia profonda di un oggetto JSON decodificato
+func CloneRaw(raw map[string]interface{}) map[string]interface{} {
+	if raw == nil {
+		return nil
+	}
+	clone := make(map[string]interface{}, len(raw))
+	for k, v := range raw {
+		clone[k] = cloneJSONValue(v)
+	}
 	return clone
 }
+
+// cloneJSONValue copia ricorsivamente oggetti e array JSON (gli altri valori sono immutabili)
+func cloneJSONValue(v interface{}) interface{} {
+	switch val := v.(type) {
+	case map[string]interface{}:
+		return CloneRaw(val)
+	case []interface{}:
+		clone := make([]interface{}, len(val))
+		for i, item := range val {
+			clone[i] = cloneJSONValue(item)
+		}
+		return clone
+	}
+	return v
+}
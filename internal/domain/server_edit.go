@@ -0,0 +1,74 @@
+package domain
+
+// ServerEdit sono i campi di un server modificabili dal form della GUI
+type ServerEdit struct {
+	Type    ServerType
+	Command string
+	Args    []string
+	URL     string
+	Headers map[string]string
+	Env     map[string]string
+	Timeout int
+}
+
+// NewServerEdit prepara i valori del form per un server esistente (nil per uno nuovo).
+// Senza type esplicito il tipo mostrato è quello dedotto: http per un server con solo
+// URL, altrimenti stdio.
+func NewServerEdit(server *MCPServer) ServerEdit {
+	if server == nil {
+		return ServerEdit{Type: ServerTypeStdio}
+	}
+	return ServerEdit{
+		Type:    inferredType(*server),
+		Command: server.Command,
+		Args:    server.Args,
+		URL:     server.URL,
+		Headers: server.Headers,
+		Env:     server.Env,
+		Timeout: server.Timeout,
+	}
+}
+
+// IsRemote indica se il tipo scelto è un trasporto remoto (http o sse)
+func (e ServerEdit) IsRemote() bool {
+	return e.Type == ServerTypeHTTP || e.Type == ServerTypeSSE
+}
+
+// Apply costruisce il server modificato partendo da original (nil per un server nuovo),
+// così i campi che il form non mostra restano invariati. Il type resta assente se original
+// non lo aveva e il tipo scelto è quello dedotto: il file non riceve chiavi mai scritte.
+func (e ServerEdit) Apply(original *MCPServer) MCPServer {
+	var server MCPServer
+	if original != nil {
+		server = original.Clone()
+	}
+	server.Type = e.Type
+	if original != nil && original.Type == "" && e.Type == inferredType(*original) {
+		server.Type = ""
+	}
+
+	// I campi dell'altro trasporto sono nascosti: comando e URL insieme renderebbero il server
+	// non valido, mentre gli headers di un server stdio (solo un avviso) restano invariati
+	if e.IsRemote() {
+		server.Command = ""
+		server.Args = nil
+		server.URL = e.URL
+		server.Headers = e.Headers
+	} else {
+		server.Command = e.Command
+		server.Args = e.Args
+		server.URL = ""
+	}
+	server.Env = e.Env
+	server.Timeout = e.Timeout
+	return server
+}
+
+// inferredType restituisce il tipo di un server come lo mostra il form: quello esplicito,
+// http per un server con solo URL, altrimenti stdio
+func inferredType(server MCPServer) ServerType {
+	if serverType := server.EffectiveType(); serverType != "" {
+		return serverType
+	}
+	return ServerTypeStdio
+}
@@ -0,0 +1,266 @@
+package domain
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// ParameterKind indica che tipo di valore chiede un parametro di template
+type ParameterKind string
+
+const (
+	// ParameterString è un testo libero
+	ParameterString ParameterKind = "string"
+	// ParameterPath è un percorso di file o directory
+	ParameterPath ParameterKind = "path"
+	// ParameterSecret è un token o una password (mascherato, di default un riferimento ${VAR})
+	ParameterSecret ParameterKind = "secret"
+	// ParameterPort è una porta TCP (1-65535)
+	ParameterPort ParameterKind = "port"
+	// ParameterURL è un URL
+	ParameterURL ParameterKind = "url"
+)
+
+// templatePlaceholder riconosce i segnaposto {{nome}} dei parametri
+var templatePlaceholder = regexp.MustCompile(`\{\{\s*([A-Za-z0-9_]+)\s*\}\}`)
+
+// TemplateParameter è un valore chiesto all'utente per istanziare un template
+type TemplateParameter struct {
+	Name        string        `json:"name"`
+	Label       string        `json:"label,omitempty"`
+	Description string        `json:"description,omitempty"`
+	Kind        ParameterKind `json:"kind,omitempty"`
+	Default     string        `json:"default,omitempty"`
+	Required    bool          `json:"required,omitempty"`
+}
+
+// DisplayLabel restituisce l'etichetta del parametro (il nome se non indicata)
+func (p TemplateParameter) DisplayLabel() string {
+	if p.Label != "" {
+		return p.Label
+	}
+	return p.Name
+}
+
+// ServerTemplate descrive un server pronto all'uso: i campi di Server possono contenere
+// segnaposto {{parametro}} sostituiti con i valori scelti dall'utente
+type ServerTemplate struct {
+	ID          string
+	Name        string
+	Description string
+	Category    string
+	// Homepage è la pagina del progetto del server
+	Homepage   string
+	Parameters []TemplateParameter
+	Server     MCPServer
+	// Source è il catalogo da cui proviene il template ("" per quelli inclusi nel programma)
+	Source string
+}
+
+// TemplateError segnala parametri mancanti o non validi
+type TemplateError struct {
+	Template string
+	Problems []string
+}
+
+func (e *TemplateError) Error() string {
+	return fmt.Sprintf("template '%s': %s", e.Template, strings.Join(e.Problems, "; "))
+}
+
+// DisplayName restituisce il nome del template (l'ID se non indicato)
+func (t *ServerTemplate) DisplayName() string {
+	if t.Name != "" {
+		return t.Name
+	}
+	return t.ID
+}
+
+// Defaults restituisce i valori predefiniti dei parametri
+func (t *ServerTemplate) Defaults() map[string]string {
+	values := make(map[string]string, len(t.Parameters))
+	for _, p := range t.Parameters {
+		values[p.Name] = p.Default
+	}
+	return values
+}
+
+// Check verifica la coerenza del template: ID presente, parametri con nome univoco
+// e segnaposto che si riferiscono a parametri dichiarati
+func (t *ServerTemplate) Check() error {
+	var problems []string
+	if strings.TrimSpace(t.ID) == "" {
+		problems = append(problems, "id mancante")
+	}
+	declared := make(map[string]bool)
+	for _, p := range t.Parameters {
+		switch {
+		case p.Name == "":
+			problems = append(problems, "parametro senza nome")
+		case declared[p.Name]:
+			problems = append(problems, fmt.Sprintf("parametro ripetuto: %s", p.Name))
+		}
+		declared[p.Name] = true
+	}
+	for _, name := range t.placeholders() {
+		if !declared[name] {
+			problems = append(problems, fmt.Sprintf("segnaposto {{%s}} senza parametro", name))
+		}
+	}
+	if len(problems) > 0 {
+		return &TemplateError{Template: t.ID, Problems: problems}
+	}
+	return nil
+}
+
+// Instantiate crea il server sostituendo i segnaposto con values (i parametri assenti
+// prendono il default). Gli argomenti e le voci env/headers che usano un parametro
+// facoltativo lasciato vuoto vengono omessi.
+func (t *ServerTemplate) Instantiate(values map[string]string) (MCPServer, error) {
+	resolved := t.Defaults()
+	var problems []string
+	for _, p := range t.Parameters {
+		value, ok := values[p.Name]
+		if ok {
+			value = strings.TrimSpace(value)
+			resolved[p.Name] = value
+		} else {
+			value = resolved[p.Name]
+		}
+		if problem := p.check(value); problem != "" {
+			problems = append(problems, problem)
+		}
+	}
+	if len(problems) > 0 {
+		return MCPServer{}, &TemplateError{Template: t.ID, Problems: problems}
+	}
+
+	replace := func(s string) string {
+		return templatePlaceholder.ReplaceAllStringFunc(s, func(match string) string {
+			return resolved[templatePlaceholder.FindStringSubmatch(match)[1]]
+		})
+	}
+	// Un argomento o un valore che usa un parametro facoltativo lasciato vuoto viene omesso
+	// (es. "--port={{port}}" senza porta)
+	omitted := func(s string) bool {
+		for _, m := range templatePlaceholder.FindAllStringSubmatch(s, -1) {
+			if resolved[m[1]] == "" {
+				return true
+			}
+		}
+		return false
+	}
+
+	server := t.Server.Clone()
+	server.Raw = replaceRaw(server.Raw, replace)
+	server.Command = replace(server.Command)
+	server.URL = replace(server.URL)
+	server.Args = nil
+	for _, arg := range t.Server.Args {
+		if !omitted(arg) {
+			server.Args = append(server.Args, replace(arg))
+		}
+	}
+	for _, field := range []string{FieldEnv, FieldHeaders} {
+		source := t.Server.KeyValues(field)
+		var target map[string]string
+		for key, value := range source {
+			if omitted(key) || omitted(value) {
+				continue
+			}
+			if target == nil {
+				target = make(map[string]string, len(source))
+			}
+			target[replace(key)] = replace(value)
+		}
+		switch field {
+		case FieldEnv:
+			server.Env = target
+		case FieldHeaders:
+			server.Headers = target
+		}
+	}
+	return server, nil
+}
+
+// replaceRaw sostituisce i segnaposto anche nei campi non gestiti del template (es. cwd)
+func replaceRaw(raw map[string]interface{}, replace func(string) string) map[string]interface{} {
+	for key, value := range raw {
+		raw[key] = replaceJSONValue(value, replace)
+	}
+	return raw
+}
+
+func replaceJSONValue(value interface{}, replace func(string) string) interface{} {
+	switch v := value.(type) {
+	case string:
+		return replace(v)
+	case map[string]interface{}:
+		return replaceRaw(v, replace)
+	case []interface{}:
+		for i, item := range v {
+			v[i] = replaceJSONValue(item, replace)
+		}
+	}
+	return value
+}
+
+// check restituisce il problema del valore di un parametro ("" se valido)
+func (p TemplateParameter) check(value string) string {
+	if value == "" {
+		if p.Required {
+			return fmt.Sprintf("%s obbligatorio", p.DisplayLabel())
+		}
+		return ""
+	}
+	switch p.Kind {
+	case ParameterPort:
+		if port, err := strconv.Atoi(value); err != nil || port < 1 || port > 65535 {
+			return fmt.Sprintf("%s: porta non valida (1-65535)", p.DisplayLabel())
+		}
+	case ParameterURL:
+		if issues := validateURL(value); issues.HasErrors() {
+			return fmt.Sprintf("%s: URL non valido", p.DisplayLabel())
+		}
+	}
+	return ""
+}
+
+// placeholders restituisce i nomi dei parametri usati nei campi del server
+func (t *ServerTemplate) placeholders() []string {
+	found := make(map[string]bool)
+	collect := func(s string) {
+		for _, m := range templatePlaceholder.FindAllStringSubmatch(s, -1) {
+			found[m[1]] = true
+		}
+	}
+	collect(t.Server.Command)
+	collect(t.Server.URL)
+	for _, arg := range t.Server.Args {
+		collect(arg)
+	}
+	for _, values := range []map[string]string{t.Server.Env, t.Server.Headers} {
+		for key, value := range values {
+			collect(key)
+			collect(value)
+		}
+	}
+	names := make([]string, 0, len(found))
+	for name := range found {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// SortTemplates ordina i template per categoria e nome
+func SortTemplates(templates []ServerTemplate) {
+	sort.SliceStable(templates, func(i, j int) bool {
+		if templates[i].Category != templates[j].Category {
+			return templates[i].Category < templates[j].Category
+		}
+		return strings.ToLower(templates[i].DisplayName()) < strings.ToLower(templates[j].DisplayName())
+	})
+}
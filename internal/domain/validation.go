@@ -0,0 +1,265 @@
+package domain
+
+import (
+	"fmt"
+	"net"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+// Severity indica la gravità di un problema di validazione
+type Severity string
+
+const (
+	// SeverityError blocca il salvataggio: Claude Code non userebbe il server
+	SeverityError Severity = "error"
+	// SeverityWarning segnala una configurazione sospetta ma utilizzabile
+	SeverityWarning Severity = "warning"
+)
+
+// Campi del server a cui si riferiscono i problemi di validazione
+const (
+	FieldName    = "name"
+	FieldType    = "type"
+	FieldCommand = "command"
+	FieldArgs    = "args"
+	FieldURL     = "url"
+	FieldEnv     = "env"
+	FieldHeaders = "headers"
+	FieldTimeout = "timeout"
+)
+
+// maxServerNameLength è la lunghezza oltre la quale i nomi dei tool (mcp__<nome>__<tool>)
+// rischiano di superare il limite di 64 caratteri
+const maxServerNameLength = 32
+
+var (
+	serverNamePattern = regexp.MustCompile(`^[A-Za-z0-9_-]+$`)
+	envNamePattern    = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+	headerNamePattern = regexp.MustCompile("^[!#$%&'*+.^_`|~0-9A-Za-z-]+$")
+)
+
+// ValidationIssue è un problema rilevato nella configurazione di un server
+type ValidationIssue struct {
+	Field    string
+	Severity Severity
+	// Code identifica il problema (es. "command_required"), usato per la traduzione
+	Code string
+	// Detail è il valore che ha causato il problema (chiave env, schema URL, ...)
+	Detail string
+}
+
+// String restituisce il codice del problema con l'eventuale dettaglio, in forma non
+// tradotta: i messaggi per l'utente sono nelle chiavi validation.<code> di i18n
+func (i ValidationIssue) String() string {
+	if i.Detail == "" {
+		return i.Code
+	}
+	return i.Code + " (" + i.Detail + ")"
+}
+
+// ValidationIssues è l'elenco dei problemi di un server
+type ValidationIssues []ValidationIssue
+
+// HasErrors indica se almeno un problema blocca il salvataggio
+func (v ValidationIssues) HasErrors() bool {
+	for _, issue := range v {
+		if issue.Severity == SeverityError {
+			return true
+		}
+	}
+	return false
+}
+
+// Errors restituisce solo i problemi bloccanti
+func (v ValidationIssues) Errors() ValidationIssues {
+	return v.filter(func(issue ValidationIssue) bool { return issue.Severity == SeverityError })
+}
+
+// Warnings restituisce solo gli avvisi
+func (v ValidationIssues) Warnings() ValidationIssues {
+	return v.filter(func(issue ValidationIssue) bool { return issue.Severity == SeverityWarning })
+}
+
+// ForField restituisce i problemi relativi a un campo
+func (v ValidationIssues) ForField(field string) ValidationIssues {
+	return v.filter(func(issue ValidationIssue) bool { return issue.Field == field })
+}
+
+// Without restituisce i problemi escludendo quelli di un campo
+func (v ValidationIssues) Without(field string) ValidationIssues {
+	return v.filter(func(issue ValidationIssue) bool { return issue.Field != field })
+}
+
+func (v ValidationIssues) filter(keep func(ValidationIssue) bool) ValidationIssues {
+	var out ValidationIssues
+	for _, issue := range v {
+		if keep(issue) {
+			out = append(out, issue)
+		}
+	}
+	return out
+}
+
+// ValidationError è restituito quando un server ha problemi bloccanti
+type ValidationError struct {
+	Name   string
+	Issues ValidationIssues
+}
+
+func (e *ValidationError) Error() string {
+	errs := e.Issues.Errors()
+	messages := make([]string, len(errs))
+	for i, issue := range errs {
+		messages[i] = issue.String()
+	}
+	return fmt.Sprintf("server '%s' non valido: %s", e.Name, strings.Join(messages, "; "))
+}
+
+// Validate controlla la configurazione del server e restituisce errori e avvisi.
+// Il nome viene controllato solo se impostato (s.Name).
+func (s *MCPServer) Validate() ValidationIssues {
+	var issues ValidationIssues
+	add := func(field string, severity Severity, code, detail string) {
+		issues = append(issues, ValidationIssue{Field: field, Severity: severity, Code: code, Detail: detail})
+	}
+
+	if s.Name != "" {
+		issues = append(issues, ValidateServerName(s.Name)...)
+	}
+
+	// Tipo e coerenza dei campi con il trasporto
+	command := strings.TrimSpace(s.Command)
+	rawURL := strings.TrimSpace(s.URL)
+	switch s.Type {
+	case "":
+		switch {
+		case command != "" && rawURL != "":
+			add(FieldURL, SeverityError, "command_with_url", "")
+		case command == "" && rawURL == "":
+			add(FieldType, SeverityError, "type_missing", "")
+		}
+	case ServerTypeStdio:
+		if command == "" {
+			add(FieldCommand, SeverityError, "command_required", "")
+		}
+		if rawURL != "" {
+			add(FieldURL, SeverityError, "url_for_stdio", "")
+		}
+	case ServerTypeHTTP, ServerTypeSSE:
+		if rawURL == "" {
+			add(FieldURL, SeverityError, "url_required", string(s.Type))
+		}
+		if command != "" {
+			add(FieldCommand, SeverityError, "command_for_remote", string(s.Type))
+		}
+		if s.Type == ServerTypeSSE {
+			add(FieldType, SeverityWarning, "sse_deprecated", "")
+		}
+	default:
+		add(FieldType, SeverityError, "type_invalid", string(s.Type))
+	}
+
+	remote := s.Type == ServerTypeHTTP || s.Type == ServerTypeSSE || (s.Type == "" && command == "" && rawURL != "")
+
+	// Comando e argomenti
+	if command != "" && strings.ContainsAny(command, " \t") && len(s.Args) == 0 {
+		add(FieldCommand, SeverityWarning, "command_has_spaces", "")
+	}
+	for i, arg := range s.Args {
+		if arg == "" {
+			add(FieldArgs, SeverityWarning, "args_empty", fmt.Sprint(i+1))
+		}
+	}
+	if remote && len(s.Args) > 0 {
+		add(FieldArgs, SeverityWarning, "args_for_remote", "")
+	}
+
+	// URL
+	if rawURL != "" {
+		issues = append(issues, validateURL(rawURL)...)
+	}
+
+	// Variabili d'ambiente
+	for _, key := range SortedKeys(s.Env) {
+		switch {
+		case strings.TrimSpace(key) == "":
+			add(FieldEnv, SeverityError, "env_name_empty", "")
+		case !envNamePattern.MatchString(key):
+			add(FieldEnv, SeverityWarning, "env_name_invalid", key)
+		}
+	}
+
+	// Headers
+	if len(s.Headers) > 0 && !remote {
+		add(FieldHeaders, SeverityWarning, "headers_for_stdio", "")
+	}
+	for _, key := range SortedKeys(s.Headers) {
+		if !headerNamePattern.MatchString(key) {
+			add(FieldHeaders, SeverityError, "header_name_invalid", key)
+		}
+	}
+
+	if s.Timeout < 0 {
+		add(FieldTimeout, SeverityError, "timeout_negative", "")
+	}
+
+	return issues
+}
+
+// ValidateServerName controlla che il nome sia utilizzabile come prefisso dei tool (mcp__<nome>__<tool>)
+func ValidateServerName(name string) ValidationIssues {
+	switch {
+	case strings.TrimSpace(name) == "":
+		return ValidationIssues{{Field: FieldName, Severity: SeverityError, Code: "name_required"}}
+	case !serverNamePattern.MatchString(name):
+		return ValidationIssues{{Field: FieldName, Severity: SeverityError, Code: "name_invalid", Detail: name}}
+	case len(name) > maxServerNameLength:
+		return ValidationIssues{{Field: FieldName, Severity: SeverityWarning, Code: "name_too_long", Detail: name}}
+	}
+	return nil
+}
+
+// validateURL controlla schema e host dell'URL di un server remoto
+func validateURL(rawURL string) ValidationIssues {
+	// Con variabili ${VAR} l'URL effettivo è noto solo all'avvio di Claude Code
+	if strings.Contains(rawURL, "${") {
+		return nil
+	}
+	u, err := url.Parse(rawURL)
+	if err != nil || u.Host == "" {
+		return ValidationIssues{{Field: FieldURL, Severity: SeverityError, Code: "url_invalid", Detail: rawURL}}
+	}
+	switch u.Scheme {
+	case "https":
+		return nil
+	case "http":
+		if !isLocalHost(u.Hostname()) {
+			return ValidationIssues{{Field: FieldURL, Severity: SeverityWarning, Code: "url_insecure", Detail: u.Hostname()}}
+		}
+		return nil
+	default:
+		return ValidationIssues{{Field: FieldURL, Severity: SeverityError, Code: "url_scheme", Detail: u.Scheme}}
+	}
+}
+
+// isLocalHost verifica se l'host è la macchina locale
+func isLocalHost(host string) bool {
+	if host == "localhost" || strings.HasSuffix(host, ".localhost") {
+		return true
+	}
+	ip := net.ParseIP(host)
+	return ip != nil && ip.IsLoopback()
+}
+
+// SortedKeys restituisce le chiavi di una mappa in ordine alfabetico
+func SortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -1,6 +1,10 @@
 package i18n
 
-import "sync"
+import (
+	"fmt"
+	"strings"
+	"sync"
+)
 
 // Lang rappresenta una lingua supportata
 type Lang string
@@ -67,6 +71,16 @@ func OnChange(fn func(Lang)) {
 	defaultTranslator.OnLanguageChange(fn)
 }
 
+// Issue traduce un problema di validazione dal suo codice (chiave validation.<code>),
+// inserendo il dettaglio se il messaggio lo prevede
+func Issue(code, detail string) string {
+	text := T("validation." + code)
+	if strings.Contains(text, "%s") {
+		text = fmt.Sprintf(text, detail)
+	}
+	return text
+}
+
 // SetLanguage imposta la lingua
 func (t *Translator) SetLanguage(lang Lang) {
 	t.mu.Lock()
@@ -161,8 +175,6 @@ func (t *Translator) loadStrings() {
 		"dialog.delete_server":         "Elimina Server",
 		"dialog.delete_confirm":        "Sei sicuro di voler eliminare il server '%s'?",
 		"dialog.move_server":           "Sposta Server",
-		"dialog.move_to_project":       "Seleziona il progetto di destinazione:",
-		"dialog.move_to_global":        "Vuoi spostare il server '%s' nello scope globale?",
 		"dialog.no_projects":           "Nessun Progetto",
 		"dialog.no_projects_msg":       "Non ci sono progetti configurati in cui spostare il server.",
 		"dialog.clone_server":          "Clona Server",
@@ -186,11 +198,573 @@ func (t *Translator) loadStrings() {
 		"form.command":     "Comando",
 		"form.command_hint": "Comando (es: uvx, npx)",
 		"form.args":        "Argomenti",
-		"form.args_hint":   "Argomenti separati da spazio (es: -y mcp-server)",
 		"form.url":         "URL",
 		"form.url_hint":    "URL (per http/sse)",
 		"form.env":         "Variabili Ambiente",
-		"form.env_hint":    "KEY=value, KEY2=value2 (separati da virgola)",
+
+		// Scope e file di progetto
+		"form.file":                "File",
+		"form.file_settings":       "~/.claude.json (impostazioni progetto)",
+		"form.file_mcp_json":       ".mcp.json (condiviso)",
+		"form.file_mcp_local":      ".mcp.local.json (locale)",
+		"form.project_required":    "Seleziona un progetto",
+		"dialog.move_to":           "Seleziona la destinazione:",
+		"dialog.clone_file":        "File di destinazione nei progetti:",
+		"dialog.overwrite_title":   "Server già esistente",
+		"dialog.overwrite_confirm": "Esiste già un server '%s' in %s. Vuoi sovrascriverlo?",
+
+		// Conflitti di salvataggio
+		"conflict.title":       "Configurazione modificata esternamente",
+		"conflict.message":     "%s è stato modificato da un altro programma (es. Claude Code) dopo il caricamento. Le altre modifiche verranno mantenute; scegli quale versione tenere per i server modificati da entrambe le parti.",
+		"conflict.mine":        "Mia versione",
+		"conflict.theirs":      "Versione su disco",
+		"conflict.keep_mine":   "Mantieni la mia",
+		"conflict.keep_theirs": "Usa quella su disco",
+		"conflict.removed":     "(rimosso)",
+		"conflict.apply":       "Applica",
+
+		// Modifiche esterne
+		"banner.changed_externally": "Configurazione modificata esternamente: %s",
+		"banner.added":              "%d aggiunti",
+		"banner.modified":           "%d modificati",
+		"banner.removed":            "%d rimossi",
+
+		// Backup
+		"toolbar.backups":         "Backup",
+		"backup.title":            "Backup di ~/.claude.json",
+		"backup.select":           "Seleziona un backup per vedere le differenze",
+		"backup.none":             "Nessun backup disponibile",
+		"backup.no_changes":       "I server MCP del backup coincidono con quelli attuali",
+		"backup.diff_hint":        "Effetto del ripristino sui server MCP (seleziona quelli da ripristinare):",
+		"backup.change_added":     "verrà aggiunto",
+		"backup.change_removed":   "verrà rimosso",
+		"backup.change_modified":  "verrà ripristinato",
+		"backup.restore_selected": "Ripristina selezionati",
+		"backup.restore_all":      "Ripristina tutto",
+		"backup.nothing_selected": "Nessun server selezionato",
+		"backup.restore_confirm":  "Ripristinare %d server dal backup del %s? Il resto di ~/.claude.json non verrà modificato.",
+		"backup.retention":        "Conservazione",
+		"backup.max_count":        "Numero massimo",
+		"backup.max_age":          "Età massima (giorni, 0 = illimitata)",
+		"backup.dir":              "Directory",
+		"backup.dir_hint":         "Accanto a ~/.claude.json",
+
+		// Cronologia
+		"toolbar.history":    "Cronologia",
+		"btn.close":          "Chiudi",
+		"history.title":      "Cronologia modifiche",
+		"history.empty":      "Nessuna modifica in questa sessione",
+		"history.undone":     "annullata",
+		"history.op_add":     "Aggiunto '%s'",
+		"history.op_update":  "Modificato '%s'",
+		"history.op_remove":  "Eliminato '%s'",
+		"history.op_move":    "Spostato '%s'",
+		"history.op_clone":   "Clonato '%s'",
+		"history.op_restore": "Ripristino da %s",
+
+		// Test connessione
+		"btn.test":                 "Testa",
+		"test.title":               "Test connessione: %s",
+		"test.running":             "Handshake MCP con %s in corso (timeout %s)...",
+		"test.success":             "Handshake riuscito in %s",
+		"test.failed":              "Handshake non riuscito",
+		"test.protocol":            "Versione protocollo",
+		"test.server_info":         "Server",
+		"test.capabilities":        "Capability",
+		"test.instructions":        "Istruzioni",
+		"test.none":                "nessuna",
+		"test.reason":              "Motivo",
+		"test.detail":              "Dettaglio",
+		"test.stderr":              "Output stderr",
+		"test.failure_not_found":   "Comando non trovato",
+		"test.failure_exited":      "Il processo è terminato con codice %d",
+		"test.failure_timeout":     "Nessuna risposta entro %s",
+		"test.failure_tls":         "Errore TLS",
+		"test.failure_http_status": "Il server ha risposto HTTP %d",
+		"test.failure_network":     "Errore di rete",
+		"test.failure_protocol":    "Risposta non conforme al protocollo MCP",
+		"test.failure_config":      "Configurazione del server incompleta",
+
+		// Inventario server
+		"inventory.title":          "Inventario",
+		"inventory.load":           "Leggi inventario",
+		"inventory.refresh":        "Aggiorna",
+		"inventory.not_loaded":     "Connettiti al server per vedere strumenti, risorse e prompt che espone",
+		"inventory.loading":        "Lettura di strumenti, risorse e prompt da %s (timeout %s)...",
+		"inventory.fetched_at":     "Letto il %s",
+		"inventory.tools":          "Strumenti (%d)",
+		"inventory.resources":      "Risorse (%d)",
+		"inventory.prompts":        "Prompt (%d)",
+		"inventory.schema":         "Schema di input",
+		"inventory.no_description": "Nessuna descrizione",
+		"inventory.list_error":     "%s non riuscito: %s",
+		"tree.tool_count":          "%d tool",
+
+		// Validazione server
+		"validation.name_required":       "il nome è obbligatorio",
+		"validation.name_invalid":        "il nome può contenere solo lettere, numeri, '-' e '_' (i tool diventano mcp__<nome>__<tool>)",
+		"validation.name_too_long":       "nome lungo: i nomi dei tool mcp__<nome>__<tool> potrebbero superare 64 caratteri",
+		"validation.type_invalid":        "tipo sconosciuto: %s (valori ammessi: stdio, http, sse)",
+		"validation.type_missing":        "specificare un comando (stdio) o un URL (http/sse)",
+		"validation.command_required":    "il comando è obbligatorio per i server stdio",
+		"validation.command_with_url":    "un server non può avere sia comando sia URL",
+		"validation.command_for_remote":  "il comando è ignorato dai server %s",
+		"validation.command_has_spaces":  "il comando contiene spazi: gli argomenti vanno indicati in args",
+		"validation.args_empty":          "argomento vuoto in posizione %s",
+		"validation.args_for_remote":     "gli args sono ignorati dai server remoti",
+		"validation.url_required":        "l'URL è obbligatorio per i server %s",
+		"validation.url_for_stdio":       "l'URL è ignorato dai server stdio",
+		"validation.url_invalid":         "URL non valido: %s",
+		"validation.url_scheme":          "schema URL non supportato: %s (usare http o https)",
+		"validation.url_insecure":        "connessione non cifrata (http) verso un host remoto: %s",
+		"validation.sse_deprecated":      "il trasporto SSE è deprecato: preferire http se il server lo supporta",
+		"validation.env_name_empty":      "nome di variabile d'ambiente vuoto",
+		"validation.env_name_invalid":    "nome di variabile d'ambiente insolito: %s",
+		"validation.headers_for_stdio":   "gli headers sono ignorati dai server stdio",
+		"validation.header_name_invalid": "nome di header non valido: %s",
+		"validation.timeout_negative":    "il timeout non può essere negativo",
+		"validation.title":               "Problemi di configurazione",
+		"validation.invalid_server":      "Il server %s non è valido",
+
+		// Espansione variabili
+		"validation.variable_missing":   "variabile ${%s} non definita e senza default",
+		"detail.headers":                "Headers",
+		"detail.expanded":               "espanso",
+		"detail.missing_variables":      "Variabili d'ambiente mancanti",
+		"detail.missing_variables_hint": "Non definite nell'ambiente e senza default: i server che le usano potrebbero non avviarsi.",
+
+		// Vault dei segreti
+		"toolbar.vault":             "Vault",
+		"history.op_bind":           "Collegato segreto '%s'",
+		"history.op_rotate":         "Rotazione segreto '%s'",
+		"vault.title":               "Vault dei segreti",
+		"vault.intro":               "I segreti sono cifrati con una passphrase in %s. Env e headers possono usarli come ${NOME}: avviando Claude Code con il launcher i valori vengono esportati senza comparire nei file di configurazione.",
+		"vault.create":              "Crea vault",
+		"vault.passphrase":          "Passphrase",
+		"vault.passphrase_confirm":  "Ripeti passphrase",
+		"vault.passphrase_mismatch": "Le passphrase non coincidono",
+		"vault.unlock":              "Sblocca",
+		"vault.lock":                "Blocca",
+		"vault.locked_hint":         "Il vault è bloccato: inserire la passphrase per gestire i segreti.",
+		"vault.no_secrets":          "Nessun segreto nel vault",
+		"vault.usages":              "%d usi",
+		"vault.add_secret":          "Aggiungi segreto",
+		"vault.secret_name":         "Nome",
+		"vault.secret_value":        "Valore",
+		"vault.rotate":              "Ruota",
+		"vault.rotate_title":        "Ruota %s",
+		"vault.new_value":           "Nuovo valore",
+		"vault.rotated":             "Segreto %s aggiornato: %d server modificati",
+		"vault.delete_confirm":      "Eliminare il segreto %s dal vault?",
+		"vault.in_use":              "Il segreto %s è ancora usato come ${%s} da:",
+		"vault.change_passphrase":   "Cambia passphrase",
+		"vault.launcher":            "Genera launcher",
+		"vault.launcher_created":    "Launcher creato in %s. Usarlo al posto di `claude` per avviare Claude Code con i segreti referenziati come ${NOME}.",
+		"vault.copy_path":           "Copia percorso",
+		"vault.mode":                "Modalità",
+		"vault.mode_reference":      "riferimento ${NOME}",
+		"vault.mode_inline":         "valore risolto nel file",
+		"vault.binding":             "vault: %s (%s)",
+		"vault.bind":                "Vault",
+		"vault.bind_title":          "Collega %s al vault",
+		"vault.bind_hint":           "Se il segreto non esiste viene creato con il valore attuale.",
+		"vault.needs_unlock":        "Sbloccare il vault per collegare i valori ai segreti.",
+
+		// Mascheramento dei segreti
+		"detail.reveal": "Mostra",
+		"detail.hide":   "Nascondi",
+		"btn.copy_json": "Copia JSON",
+
+		// Editor strutturati del form server
+		"form.headers":                  "Headers",
+		"form.timeout":                  "Timeout (ms)",
+		"form.timeout_hint":             "Vuoto: default di Claude Code",
+		"form.add_row":                  "Aggiungi",
+		"form.add_arg":                  "Aggiungi argomento",
+		"form.paste_command":            "Importa",
+		"form.paste_command_hint":       "Incolla una riga di comando (es: npx -y pkg --dir \"My Docs\")",
+		"form.env_name_hint":            "NOME",
+		"form.header_name_hint":         "Nome header",
+		"form.value_hint":               "Valore",
+		"form.preview":                  "JSON che verrà scritto",
+		"validation.command_line_quote": "riga di comando non valida: apice non chiuso",
+		"validation.key_duplicate":      "chiave ripetuta: %s (vale l'ultima riga)",
+		"validation.timeout_invalid":    "il timeout deve essere un numero intero di millisecondi",
+
+		// Catalogo di template
+		"dialog.add_via_template":        "Da template",
+		"template.title":                 "Template di server",
+		"template.select":                "Seleziona un template",
+		"template.filter":                "Cerca template...",
+		"template.source":                "Catalogo: %s",
+		"template.add":                   "Aggiungi server",
+		"template.catalogs":              "Cataloghi",
+		"template.catalogs_hint":         "Cataloghi aggiuntivi (file JSON o cartelle condivise dal team) letti oltre a quello incluso; un template con lo stesso ID sostituisce quello incluso.",
+		"template.no_catalogs":           "Nessun catalogo aggiuntivo",
+		"template.add_file":              "Aggiungi file...",
+		"template.add_folder":            "Aggiungi cartella...",
+		"validation.template_catalog":    "%s",
+		"validation.template_parameters": "%s",
+
+		// Importazione da altri client
+		"toolbar.import":                  "Importa",
+		"history.op_import":               "Importazione da %s",
+		"import.title":                    "Importa da altri client",
+		"import.source":                   "File di origine",
+		"import.select_source":            "Seleziona un file di configurazione",
+		"import.no_sources":               "Nessun file di altri client trovato",
+		"import.format":                   "Formato",
+		"import.browse":                   "Sfoglia...",
+		"import.target":                   "Destinazione",
+		"import.overwrite":                "Sostituisci i server diversi con lo stesso nome",
+		"import.run":                      "Importa selezionati",
+		"import.empty":                    "Nessun server MCP nel file",
+		"import.partial":                  "Importati %d server, alcuni sono stati saltati:",
+		"import.done":                     "Importati %d server in %s",
+		"import.status_new":               "Nuovo",
+		"import.status_identical":         "Già presente",
+		"import.status_conflict":          "Conflitto",
+		"validation.import_field_ignored": "campo '%s' non supportato da Claude Code: non importato",
+		"validation.import_variable":      "variabile %s del client di origine non supportata: sostituirla con un valore o ${VAR}",
+		"validation.import_disabled":      "server disabilitato nel client di origine",
+		"validation.import_renamed":       "nome originale '%s' non utilizzabile: rinominato",
+		"validation.import_conflict":      "esiste già un server diverso con questo nome nella destinazione",
+		"validation.import_invalid":       "voce non valida, non importabile: %s",
+		"import.format_claude-desktop":    "Claude Desktop",
+		"import.format_cursor":            "Cursor",
+		"import.format_vscode":            "VS Code",
+		"import.format_codex":             "Codex CLI",
+		"import.format_goose":             "Goose",
+		"validation.import_read":          "%s",
+
+		// Esportazione verso altri client
+		"toolbar.export":                      "Esporta",
+		"export.title":                        "Esporta verso altri client",
+		"export.source":                       "Server",
+		"export.format":                       "Formato",
+		"export.servers":                      "Server da esportare",
+		"export.preview":                      "Anteprima (segreti nascosti)",
+		"export.effective":                    "%s — server effettivi",
+		"export.empty":                        "Nessun server in questa posizione",
+		"export.include_secrets":              "Includi i segreti nel file salvato",
+		"export.copy":                         "Copia",
+		"export.save":                         "Salva su file…",
+		"export.done":                         "Esportati %d server in %s",
+		"export.format_mcp-servers":           "Snippet mcpServers (.mcp.json)",
+		"export.format_claude-desktop":        "Claude Desktop",
+		"export.format_cursor":                "Cursor",
+		"export.format_vscode":                "VS Code",
+		"export.format_codex":                 "Codex CLI (TOML)",
+		"export.format_goose":                 "Goose (YAML)",
+		"validation.export_field_unsupported": "campo '%s' non rappresentabile nel formato di destinazione: non esportato",
+		"validation.export_type_unsupported":  "server %s non supportati dal formato di destinazione: server non esportato",
+		"validation.export_variable":          "%s verrà scritto letteralmente: il client di destinazione non espande le variabili",
+		"validation.export_variable_default":  "il default di %s non è supportato dal client di destinazione",
+
+		// Configurazione effettiva e definizioni sovrascritte
+		"detail.effective_configs":    "Configurazione Effettiva",
+		"detail.no_effective_servers": "Nessun server attivo in questo progetto",
+		"detail.shadowing_count":      "%d sovrascritti",
+		"detail.shadows":              "      sovrascrive: %s",
+		"detail.show_diff":            "Differenze",
+		"detail.diff_title":           "Definizioni di %s",
+		"detail.diff_against":         "%s rispetto a %s",
+		"detail.diff_identical":       "Definizione identica",
+
+		// Server duplicati e promozione a globale
+		"toolbar.duplicates":       "Duplicati",
+		"history.op_promote":       "Promosso a globale '%s'",
+		"duplicates.title":         "Server duplicati",
+		"duplicates.hint":          "Server con lo stesso comando e gli stessi argomenti (o lo stesso URL) presenti in più progetti. Promuovendoli a globali le copie identiche diventano superflue e vengono rimosse in un'unica operazione annullabile.",
+		"duplicates.none":          "Nessun server duplicato",
+		"duplicates.summary":       "copie: %d, diverse: %d",
+		"duplicates.promote":       "Promuovi a globale",
+		"duplicates.definition":    "Definizione",
+		"duplicates.global_name":   "Nome globale",
+		"duplicates.remove_copies": "Copie da rimuovere:",
+
+		// Pulizia dei progetti non trovati
+		"toolbar.cleanup":         "Pulizia",
+		"tree.missing":            "cartella non trovata",
+		"detail.project_missing":  "La cartella del progetto non esiste più: la sua voce occupa %s in ~/.claude.json e può essere rimossa.",
+		"cleanup.title":           "Pulizia progetti",
+		"cleanup.hint":            "Progetti registrati in ~/.claude.json la cui cartella è stata cancellata o spostata. Rimuovendoli si eliminano i loro server e i dati di Claude Code (history, permessi, stato); prima della scrittura viene creato un backup del file.",
+		"cleanup.none":            "Nessun progetto con la cartella mancante",
+		"cleanup.project_details": "%d server · %s",
+		"cleanup.total":           "Selezionati: %d progetti (%s)",
+		"cleanup.archive_first":   "Salva un archivio delle voci prima di rimuoverle",
+		"cleanup.include_secrets": "Includi i segreti nell'archivio",
+		"cleanup.export_only":     "Esporta archivio…",
+		"cleanup.remove":          "Rimuovi selezionati",
+		"cleanup.confirm":         "Rimuovere %d progetti (%s) da ~/.claude.json?",
+		"cleanup.archived":        "Archiviati %d progetti in %s",
+		"cleanup.removed":         "Rimossi %d progetti",
+
+		// Ricerca dei progetti non registrati
+		"toolbar.discover":          "Scopri",
+		"tree.discovered":           "Progetti scoperti",
+		"detail.discovered_project": "Progetto scoperto",
+		"detail.discovered_hint":    "Questa cartella contiene una configurazione MCP ma non è registrata in ~/.claude.json. Registrandola compare tra i progetti con i server dei suoi file.",
+		"discovery.title":           "Scopri progetti",
+		"discovery.hint":            "Cerca nelle cartelle indicate (es. ~/src) i progetti con .mcp.json o .mcp.local.json che non sono ancora registrati in ~/.claude.json. Le cartelle nascoste, node_modules e vendor vengono saltate.",
+		"discovery.roots":           "Cartelle in cui cercare",
+		"discovery.no_roots":        "Nessuna cartella: aggiungine una",
+		"discovery.add_root":        "Aggiungi cartella…",
+		"discovery.scan":            "Cerca",
+		"discovery.none":            "Nessun progetto non registrato trovato",
+		"discovery.register":        "Registra selezionati",
+		"discovery.register_one":    "Registra progetto",
+		"discovery.registered":      "Registrati %d progetti",
+
+		// Ricerca, filtri e ordinamento del tree
+		"tree.search_placeholder":          "Cerca per nome, comando, argomenti, URL, env o path…",
+		"tree.filter_all_types":            "Tutti i tipi",
+		"tree.filter_all_sources":          "Tutti i file",
+		"tree.filter_source_global":        "Globale (~/.claude.json)",
+		"tree.filter_source_project":       "Progetto (~/.claude.json)",
+		"tree.filter_source_project_file":  ".mcp.json",
+		"tree.filter_source_project_local": ".mcp.local.json",
+		"tree.sort_name":                   "Ordina per nome",
+		"tree.sort_type":                   "Ordina per tipo",
+		"tree.sort_usage":                  "Ordina per progetti",
+
+		// Selezione multipla e operazioni di gruppo
+		"tree.multi_select":      "Selezione multipla",
+		"bulk.title":             "Operazione di gruppo",
+		"bulk.selected":          "%d server selezionati",
+		"bulk.delete":            "Elimina",
+		"bulk.move":              "Sposta",
+		"bulk.clone":             "Clona",
+		"bulk.export":            "Esporta",
+		"bulk.clear":             "Deseleziona",
+		"bulk.move_title":        "Sposta %d server",
+		"bulk.clone_title":       "Clona %d server",
+		"bulk.summary":           "Server selezionati: %d — modifiche: %s",
+		"bulk.skipped_exists":    "'%s' già presente in %s: saltato",
+		"bulk.overwrite":         "Sovrascrivi i server già presenti nelle destinazioni",
+		"bulk.apply":             "Applica",
+		"bulk.nothing":           "Nessuna modifica da applicare",
+		"export.selection":       "Selezione nel tree (%d server)",
+		"history.op_bulk_remove": "Eliminati %s server",
+		"history.op_bulk_move":   "Spostati %s server",
+		"history.op_bulk_clone":  "Clonati %s server",
+
+		// Confronto
+		"toolbar.compare":         "Confronta",
+		"compare.title":           "Confronta",
+		"compare.hint":            "Confronta due server, le configurazioni effettive di due progetti o un progetto e lo scope globale. Le frecce copiano un valore o l'intera definizione sull'altro lato, nel file in cui è scritto il server.",
+		"compare.left":            "Sinistra",
+		"compare.right":           "Destra",
+		"compare.effective":       "%s (configurazione effettiva)",
+		"compare.show_identical":  "Mostra i server identici",
+		"compare.pick_sides":      "Scegli i due lati da confrontare",
+		"compare.same_side":       "I due lati coincidono",
+		"compare.mismatch":        "Un server si confronta solo con un altro server",
+		"compare.summary":         "Identici: %d, diversi: %d, solo a sinistra: %d, solo a destra: %d",
+		"compare.empty":           "Nessun server su entrambi i lati",
+		"compare.only_left":       "Solo a sinistra, in %s",
+		"compare.only_right":      "Solo a destra, in %s",
+		"compare.clone_to":        "Copia in %s",
+		"compare.identical":       "Identici",
+		"compare.copy_definition": "Definizione",
+
+		// Toolbar: menu strumenti
+		"toolbar.tools": "Strumenti",
+
+		// Testi della riga di comando (mcp-curator <comando>)
+		"cli.usage_line":               "uso: mcp-curator %s",
+		"cli.error":                    "errore: %s",
+		"cli.unknown_command":          "comando sconosciuto: %s",
+		"cli.unknown_lang":             "lingua non supportata: %s (disponibili: %s)",
+		"cli.help_gui":                 "Senza argomenti avvia l'interfaccia grafica.",
+		"cli.help_usage":               "Uso: mcp-curator [--config PATH] [--lang LINGUA] <comando> [argomenti]",
+		"cli.help_commands":            "Comandi:",
+		"cli.help_scope":               "Senza --project i comandi operano sullo scope globale.\n--scope sceglie il file del progetto: project (~/.claude.json, default),\nproject-file (.mcp.json) o project-local (.mcp.local.json).",
+		"cli.flag_config":              "percorso alternativo di ~/.claude.json",
+		"cli.flag_lang":                "lingua dell'output: IT, EN, FR, DE, ES, PT, JA, KO, CN, UK",
+		"cli.cmd.list":                 "Elenca i server globali e di progetto (segreti nascosti salvo --reveal)",
+		"cli.cmd.show":                 "Mostra la configurazione di un server (segreti nascosti salvo --reveal)",
+		"cli.cmd.add":                  "Aggiunge un server, anche da un template del catalogo",
+		"cli.cmd.update":               "Modifica i campi indicati di un server",
+		"cli.cmd.remove":               "Rimuove un server",
+		"cli.cmd.move":                 "Sposta un server tra scope e file di progetto",
+		"cli.cmd.clone":                "Copia un server su altri scope",
+		"cli.cmd.bulk":                 "Rimuove, sposta o copia in un'unica operazione i server selezionati come con search",
+		"cli.cmd.import":               "Importa i server da Claude Desktop, Cursor, VS Code, Codex o Goose (senza PATH elenca i file trovati)",
+		"cli.cmd.export":               "Esporta i server per Claude Desktop, Cursor, VS Code, Codex, Goose o come snippet mcpServers",
+		"cli.cmd.search":               "Cerca i server in tutti gli scope per nome, comando, argomenti, URL, chiavi env o path del progetto",
+		"cli.cmd.compare":              "Confronta campo per campo due server (NOME@global, NOME@PATH), due progetti (PATH) o un progetto e global",
+		"cli.cmd.duplicates":           "Trova i server presenti in più copie tra scope globale e progetti, con le differenze",
+		"cli.cmd.promote":              "Rende globale un server e rimuove le copie di progetto identiche",
+		"cli.cmd.discover":             "Cerca le cartelle con .mcp.json o .mcp.local.json non ancora registrate come progetti",
+		"cli.cmd.register":             "Registra delle cartelle come progetti in ~/.claude.json",
+		"cli.cmd.cleanup":              "Rimuove da ~/.claude.json i progetti la cui cartella non esiste più, con archivio opzionale",
+		"cli.cmd.validate":             "Controlla la configurazione di tutti i server",
+		"cli.cmd.test":                 "Esegue l'handshake MCP initialize con un server",
+		"cli.cmd.inventory":            "Elenca strumenti, risorse e prompt esposti da un server",
+		"cli.cmd.templates":            "Elenca i template di server e gestisce i cataloghi aggiuntivi del team",
+		"cli.cmd.vault":                "Gestisce il vault cifrato dei segreti usati in env e headers",
+		"cli.cmd.backups":              "Elenca i backup di ~/.claude.json e le differenze con il file attuale",
+		"cli.cmd.restore":              "Ripristina i server MCP da un backup",
+		"cli.cmd.version":              "Mostra la versione",
+		"cli.location_global":          "scope globale",
+		"cli.location_project":         "progetto %s",
+		"cli.source_global":            "globale",
+		"cli.source_project":           "progetto",
+		"cli.severity_error":           "errore",
+		"cli.severity_warning":         "avviso",
+		"cli.warning":                  "Attenzione: %s",
+		"cli.absent":                   "(assente)",
+		"cli.no_servers":               "(nessun server)",
+		"cli.field_name":               "Nome",
+		"cli.field_type":               "Tipo",
+		"cli.field_command":            "Comando",
+		"cli.field_category":           "Categoria",
+		"cli.field_description":        "Descrizione",
+		"cli.field_catalog":            "Catalogo",
+		"cli.field_parameter":          "Parametro",
+		"cli.field_required":           "obbligatorio",
+		"cli.field_result":             "Esito",
+		"cli.field_protocol":           "Protocollo",
+		"cli.field_error":              "Errore",
+		"cli.field_destination":        "Destinazione",
+		"cli.list_global":              "Globale (%s)",
+		"cli.list_projects":            "Progetti",
+		"cli.list_project_missing":     "cartella non trovata: vedi cleanup",
+		"cli.server_added":             "Server '%s' aggiunto in %s",
+		"cli.server_updated":           "Server '%s' aggiornato",
+		"cli.server_removed":           "Server '%s' rimosso da %s",
+		"cli.server_moved":             "Server '%s' spostato in %s",
+		"cli.server_cloned":            "Server '%s' clonato su %d destinazioni",
+		"cli.test_ok":                  "OK (%dms)",
+		"cli.test_failed":              "FALLITO (%s)",
+		"cli.inventory_header":         "%s %s (protocollo %s, letto il %s)",
+		"cli.inventory_tools":          "Strumenti (%d):",
+		"cli.inventory_resources":      "Risorse (%d):",
+		"cli.inventory_prompts":        "Prompt (%d):",
+		"cli.inventory_failed":         "%s non riuscito: %s",
+		"cli.valid_servers":            "%d server validi",
+		"cli.no_backups":               "(nessun backup)",
+		"cli.nothing_to_restore":       "Nessuna differenza da ripristinare",
+		"cli.restored":                 "Ripristinati %d server da %s",
+		"cli.bulk_none":                "Nessun server selezionato",
+		"cli.bulk_plan":                "Server selezionati: %d, modifiche: %s",
+		"cli.bulk_done":                "Server selezionati: %d, modifiche salvate: %s",
+		"cli.cleanup_none":             "Nessun progetto con la cartella mancante",
+		"cli.cleanup_plan":             "%d progetti da rimuovere (%s)",
+		"cli.archive_saved":            "Archivio salvato in %s",
+		"cli.cleanup_done":             "Rimossi %d progetti da %s (%s)",
+		"cli.compare_left_only":        "solo a sinistra (%s)",
+		"cli.compare_right_only":       "solo a destra (%s)",
+		"cli.compare_identical":        "Server identici: %d (--all per mostrarli)",
+		"cli.discover_none":            "Nessun progetto non registrato trovato",
+		"cli.discover_hint":            "Per registrarli: mcp-curator discover --register, oppure mcp-curator register PATH",
+		"cli.registered_count":         "Registrati %d progetti in %s",
+		"cli.already_registered":       "%s: già registrato",
+		"cli.registered":               "Registrato %s (%s)",
+		"cli.no_mcp_files":             "nessun file MCP",
+		"cli.duplicates_none":          "Nessun server duplicato",
+		"cli.duplicates_group":         "copie: %d, diverse: %d",
+		"cli.promote_hint":             "rimuove le copie di progetto identiche",
+		"cli.promote_plan":             "'%s' diventerebbe globale, copie di progetto rimosse: %d",
+		"cli.promote_done":             "Server '%s' globale, copie di progetto rimosse: %d",
+		"cli.exported":                 "Esportati %d server in %s",
+		"cli.import_empty":             "Nessun server MCP in %s",
+		"cli.imported":                 "Importati %d server in %s",
+		"cli.import_nothing":           "Nessun server da importare: sono già tutti presenti",
+		"cli.import_no_sources":        "Nessun file di configurazione di altri client trovato",
+		"cli.search_none":              "Nessun server trovato",
+		"cli.search_usage":             "progetti: %d",
+		"cli.catalogs":                 "Cataloghi aggiuntivi",
+		"cli.catalog_added":            "Catalogo aggiunto: %s",
+		"cli.catalog_removed":          "Catalogo rimosso: %s",
+		"cli.vault_created":            "Vault creato: %s",
+		"cli.no_secrets":               "(nessun segreto)",
+		"cli.secret_usages":            "%d usi",
+		"cli.secret_added":             "Segreto %s aggiunto al vault",
+		"cli.secret_updated":           "Segreto %s aggiornato",
+		"cli.secret_server_updated":    "aggiornato %s (%s)",
+		"cli.secret_deleted":           "Segreto %s eliminato",
+		"cli.secret_bound":             "%s %s di %s collegato al segreto %s (%s)",
+		"cli.launcher_hint":            "Avviare Claude Code con il launcher (mcp-curator vault launcher) per risolvere ${%s}",
+		"cli.launcher_created":         "Launcher creato: %s",
+		"cli.launcher_usage":           "Usarlo al posto di `claude` per avviare Claude Code con i segreti referenziati come ${NOME}.",
+		"cli.passphrase_changed":       "Passphrase del vault cambiata",
+		"cli.prompt_passphrase":        "Passphrase del vault: ",
+		"cli.prompt_new_passphrase":    "Nuova passphrase del vault: ",
+		"cli.prompt_repeat_passphrase": "Ripetere la passphrase: ",
+		"cli.prompt_secret_value":      "Valore di %s: ",
+		"cli.prompt_new_secret_value":  "Nuovo valore di %s: ",
+		"cli.flag.project_server":      "progetto del server (default: globale)",
+		"cli.flag.project_add":         "aggiungi al progetto indicato (default: globale)",
+		"cli.flag.project_source":      "progetto di origine (default: globale)",
+		"cli.flag.project_promote":     "progetto della copia da promuovere (default: globale, per rimuovere solo le copie)",
+		"cli.flag.project_export":      "esporta dal progetto indicato (default: globale)",
+		"cli.flag.project_import":      "importa nel progetto indicato (default: globale)",
+		"cli.flag.project_only":        "solo i server di questo progetto (tutti i suoi file)",
+		"cli.flag.project_list":        "mostra solo i server del progetto indicato",
+		"cli.flag.scope":               "file del progetto: project (~/.claude.json), project-file (.mcp.json), project-local (.mcp.local.json)",
+		"cli.flag.type":                "tipo di server: stdio, http, sse",
+		"cli.flag.command":             "comando da eseguire (stdio)",
+		"cli.flag.arg":                 "argomento del comando (ripetibile)",
+		"cli.flag.url":                 "URL del server (http/sse)",
+		"cli.flag.env":                 "variabile d'ambiente KEY=value (ripetibile)",
+		"cli.flag.header":              "header HTTP KEY=value (ripetibile)",
+		"cli.flag.timeout":             "timeout in millisecondi",
+		"cli.flag.effective":           "mostra i server effettivi del progetto (merge di tutti gli scope)",
+		"cli.flag.effective_export":    "esporta i server effettivi del progetto (merge di tutti gli scope)",
+		"cli.flag.json":                "output in formato JSON",
+		"cli.flag.json_import":         "output in formato JSON (con --dry-run o senza PATH)",
+		"cli.flag.json_cleanup":        "elenca i progetti in formato JSON senza rimuoverli",
+		"cli.flag.json_server":         "configurazione JSON del server (i flag hanno la precedenza)",
+		"cli.flag.reveal":              "mostra i segreti invece di [REDACTED]",
+		"cli.flag.reveal_archive":      "includi i segreti nell'archivio invece di [REDACTED]",
+		"cli.flag.reveal_export":       "includi i segreti invece di [REDACTED]",
+		"cli.flag.template":            "parti da un template del catalogo (vedi `templates`)",
+		"cli.flag.param":               "parametro del template K=valore (ripetibile)",
+		"cli.flag.clear_args":          "rimuove tutti gli argomenti",
+		"cli.flag.unset_env":           "rimuove una variabile d'ambiente (ripetibile)",
+		"cli.flag.unset_header":        "rimuove un header HTTP (ripetibile)",
+		"cli.flag.from_scope":          "file del progetto di origine (project, project-file, project-local)",
+		"cli.flag.to":                  "destinazione: 'global' o path di progetto",
+		"cli.flag.to_repeat":           "destinazione: 'global' o path di progetto (ripetibile)",
+		"cli.flag.to_bulk":             "destinazione: 'global' o path di progetto (ripetibile per clone)",
+		"cli.flag.to_global":           "sposta nello scope globale (equivale a --to global)",
+		"cli.flag.to_scope":            "file del progetto di destinazione (project, project-file, project-local)",
+		"cli.flag.to_scope_many":       "file dei progetti di destinazione (project, project-file, project-local)",
+		"cli.flag.force_one":           "sovrascrive un server con lo stesso nome nella destinazione",
+		"cli.flag.force_many":          "sovrascrive i server con lo stesso nome nelle destinazioni",
+		"cli.flag.force_import":        "sostituisci i server diversi con lo stesso nome",
+		"cli.flag.dry_run":             "mostra cosa cambierebbe senza scrivere",
+		"cli.flag.dry_run_promote":     "mostra cosa verrebbe fatto senza scrivere",
+		"cli.flag.dry_run_import":      "mostra cosa verrebbe importato senza scrivere",
+		"cli.flag.dry_run_cleanup":     "elenca i progetti senza rimuoverli",
+		"cli.flag.archive":             "salva le voci dei progetti in questo file prima di rimuoverle",
+		"cli.flag.cached":              "usa l'ultimo inventario letto senza connettersi",
+		"cli.flag.diff":                "mostra i server che cambierebbero ripristinando ogni backup",
+		"cli.flag.restore_server":      "ripristina solo il server indicato (ripetibile)",
+		"cli.flag.import_server":       "importa solo il server indicato (ripetibile)",
+		"cli.flag.all":                 "mostra anche i server identici",
+		"cli.flag.depth":               "profondità massima di ricerca (default: preferenze, altrimenti 4)",
+		"cli.flag.save_roots":          "aggiungi le cartelle indicate alle preferenze",
+		"cli.flag.register":            "registra tutti i progetti trovati",
+		"cli.flag.as":                  "nome del server globale (default: il nome della copia)",
+		"cli.flag.keep_copies":         "non rimuovere le copie di progetto identiche",
+		"cli.flag.export_format":       "formato: mcp-servers, claude-desktop, cursor, vscode, codex, goose",
+		"cli.flag.output":              "scrivi su file invece che su stdout",
+		"cli.flag.import_format":       "formato del file: claude-desktop, cursor, vscode, codex, goose (default: dedotto dal percorso)",
+		"cli.flag.filter_type":         "solo i server di questo tipo: stdio, http, sse",
+		"cli.flag.filter_source":       "solo i server di questo file: global, project, project-file, project-local",
+		"cli.flag.sort":                "ordinamento: name, type, usage (progetti che usano il server)",
+		"cli.flag.unlock":              "sblocca il vault per riconoscere anche i valori risolti nei file",
+		"cli.flag.bind_env":            "variabile d'ambiente del server da collegare",
+		"cli.flag.bind_header":         "header HTTP del server da collegare",
+		"cli.flag.secret":              "nome del segreto (default: la chiave env o header); creato se assente",
+		"cli.flag.inline":              "scrivi nel file il valore risolto invece di ${NOME}",
+		"cli.shadows":                  "sovrascrive %s",
+		"cli.file_servers":             "%s: %d server",
+		"cli.server_count":             "%d server",
+		"cli.template_default":         "default %s",
 	}
 
 	// English
@@ -229,8 +803,6 @@ func (t *Translator) loadStrings() {
 		"dialog.delete_server":         "Delete Server",
 		"dialog.delete_confirm":        "Are you sure you want to delete the server '%s'?",
 		"dialog.move_server":           "Move Server",
-		"dialog.move_to_project":       "Select destination project:",
-		"dialog.move_to_global":        "Do you want to move the server '%s' to global scope?",
 		"dialog.no_projects":           "No Projects",
 		"dialog.no_projects_msg":       "There are no configured projects to move the server to.",
 		"dialog.clone_server":          "Clone Server",
@@ -252,11 +824,525 @@ func (t *Translator) loadStrings() {
 		"form.command":        "Command",
 		"form.command_hint":   "Command (e.g.: uvx, npx)",
 		"form.args":           "Arguments",
-		"form.args_hint":      "Space-separated arguments (e.g.: -y mcp-server)",
 		"form.url":            "URL",
 		"form.url_hint":       "URL (for http/sse)",
 		"form.env":            "Environment Variables",
-		"form.env_hint":       "KEY=value, KEY2=value2 (comma-separated)",
+		"form.file":                "File",
+		"form.file_settings":       "~/.claude.json (project settings)",
+		"form.file_mcp_json":       ".mcp.json (shared)",
+		"form.file_mcp_local":      ".mcp.local.json (local)",
+		"form.project_required":    "Select a project",
+		"dialog.move_to":           "Select the destination:",
+		"dialog.clone_file":        "Destination file in projects:",
+		"dialog.overwrite_title":   "Server already exists",
+		"dialog.overwrite_confirm": "A server '%s' already exists in %s. Do you want to overwrite it?",
+		"conflict.title":       "Configuration changed externally",
+		"conflict.message":     "%s was modified by another program (e.g. Claude Code) after it was loaded. Other changes will be kept; choose which version to keep for servers changed on both sides.",
+		"conflict.mine":        "My version",
+		"conflict.theirs":      "Version on disk",
+		"conflict.keep_mine":   "Keep mine",
+		"conflict.keep_theirs": "Use the one on disk",
+		"conflict.removed":     "(removed)",
+		"conflict.apply":       "Apply",
+		"banner.changed_externally": "Configuration changed externally: %s",
+		"banner.added":              "%d added",
+		"banner.modified":           "%d modified",
+		"banner.removed":            "%d removed",
+		"toolbar.backups":         "Backups",
+		"backup.title":            "~/.claude.json backups",
+		"backup.select":           "Select a backup to see the differences",
+		"backup.none":             "No backups available",
+		"backup.no_changes":       "The backup's MCP servers match the current ones",
+		"backup.diff_hint":        "Effect of restoring on MCP servers (select the ones to restore):",
+		"backup.change_added":     "will be added",
+		"backup.change_removed":   "will be removed",
+		"backup.change_modified":  "will be restored",
+		"backup.restore_selected": "Restore selected",
+		"backup.restore_all":      "Restore all",
+		"backup.nothing_selected": "No server selected",
+		"backup.restore_confirm":  "Restore %d servers from the backup of %s? The rest of ~/.claude.json will not be changed.",
+		"backup.retention":        "Retention",
+		"backup.max_count":        "Maximum count",
+		"backup.max_age":          "Maximum age (days, 0 = unlimited)",
+		"backup.dir":              "Directory",
+		"backup.dir_hint":         "Next to ~/.claude.json",
+		"toolbar.history":    "History",
+		"btn.close":          "Close",
+		"history.title":      "Change history",
+		"history.empty":      "No changes in this session",
+		"history.undone":     "undone",
+		"history.op_add":     "Added '%s'",
+		"history.op_update":  "Edited '%s'",
+		"history.op_remove":  "Deleted '%s'",
+		"history.op_move":    "Moved '%s'",
+		"history.op_clone":   "Cloned '%s'",
+		"history.op_restore": "Restore from %s",
+		"btn.test":                 "Test",
+		"test.title":               "Connection test: %s",
+		"test.running":             "MCP handshake with %s in progress (timeout %s)...",
+		"test.success":             "Handshake succeeded in %s",
+		"test.failed":              "Handshake failed",
+		"test.protocol":            "Protocol version",
+		"test.server_info":         "Server",
+		"test.capabilities":        "Capabilities",
+		"test.instructions":        "Instructions",
+		"test.none":                "none",
+		"test.reason":              "Reason",
+		"test.detail":              "Detail",
+		"test.stderr":              "Stderr output",
+		"test.failure_not_found":   "Command not found",
+		"test.failure_exited":      "The process exited with code %d",
+		"test.failure_timeout":     "No response within %s",
+		"test.failure_tls":         "TLS error",
+		"test.failure_http_status": "The server responded with HTTP %d",
+		"test.failure_network":     "Network error",
+		"test.failure_protocol":    "Response does not follow the MCP protocol",
+		"test.failure_config":      "Incomplete server configuration",
+		"inventory.title":          "Inventory",
+		"inventory.load":           "Read inventory",
+		"inventory.refresh":        "Refresh",
+		"inventory.not_loaded":     "Connect to the server to see the tools, resources and prompts it exposes",
+		"inventory.loading":        "Reading tools, resources and prompts from %s (timeout %s)...",
+		"inventory.fetched_at":     "Read on %s",
+		"inventory.tools":          "Tools (%d)",
+		"inventory.resources":      "Resources (%d)",
+		"inventory.prompts":        "Prompts (%d)",
+		"inventory.schema":         "Input schema",
+		"inventory.no_description": "No description",
+		"inventory.list_error":     "%s failed: %s",
+		"tree.tool_count":          "%d tools",
+		"validation.name_required":       "a name is required",
+		"validation.name_invalid":        "the name may only contain letters, digits, '-' and '_' (tools become mcp__<name>__<tool>)",
+		"validation.name_too_long":       "long name: tool names mcp__<name>__<tool> may exceed 64 characters",
+		"validation.type_invalid":        "unknown type: %s (allowed: stdio, http, sse)",
+		"validation.type_missing":        "set either a command (stdio) or a URL (http/sse)",
+		"validation.command_required":    "a command is required for stdio servers",
+		"validation.command_with_url":    "a server cannot have both a command and a URL",
+		"validation.command_for_remote":  "the command is ignored by %s servers",
+		"validation.command_has_spaces":  "the command contains spaces: arguments belong in args",
+		"validation.args_empty":          "empty argument at position %s",
+		"validation.args_for_remote":     "args are ignored by remote servers",
+		"validation.url_required":        "a URL is required for %s servers",
+		"validation.url_for_stdio":       "the URL is ignored by stdio servers",
+		"validation.url_invalid":         "invalid URL: %s",
+		"validation.url_scheme":          "unsupported URL scheme: %s (use http or https)",
+		"validation.url_insecure":        "unencrypted connection (http) to a remote host: %s",
+		"validation.sse_deprecated":      "the SSE transport is deprecated: prefer http if the server supports it",
+		"validation.env_name_empty":      "empty environment variable name",
+		"validation.env_name_invalid":    "unusual environment variable name: %s",
+		"validation.headers_for_stdio":   "headers are ignored by stdio servers",
+		"validation.header_name_invalid": "invalid header name: %s",
+		"validation.timeout_negative":    "the timeout cannot be negative",
+		"validation.title":               "Configuration problems",
+		"validation.invalid_server":      "Server %s is not valid",
+		"validation.variable_missing":   "variable ${%s} is not set and has no default",
+		"detail.headers":                "Headers",
+		"detail.expanded":               "expanded",
+		"detail.missing_variables":      "Missing environment variables",
+		"detail.missing_variables_hint": "Not set in the environment and without a default: servers that use them may fail to start.",
+		"toolbar.vault":             "Vault",
+		"history.op_bind":           "Bound secret '%s'",
+		"history.op_rotate":         "Rotated secret '%s'",
+		"vault.title":               "Secret vault",
+		"vault.intro":               "Secrets are encrypted with a passphrase in %s. Env and headers can use them as ${NAME}: when Claude Code is started through the launcher the values are exported without appearing in configuration files.",
+		"vault.create":              "Create vault",
+		"vault.passphrase":          "Passphrase",
+		"vault.passphrase_confirm":  "Repeat passphrase",
+		"vault.passphrase_mismatch": "Passphrases do not match",
+		"vault.unlock":              "Unlock",
+		"vault.lock":                "Lock",
+		"vault.locked_hint":         "The vault is locked: enter the passphrase to manage secrets.",
+		"vault.no_secrets":          "No secrets in the vault",
+		"vault.usages":              "%d uses",
+		"vault.add_secret":          "Add secret",
+		"vault.secret_name":         "Name",
+		"vault.secret_value":        "Value",
+		"vault.rotate":              "Rotate",
+		"vault.rotate_title":        "Rotate %s",
+		"vault.new_value":           "New value",
+		"vault.rotated":             "Secret %s updated: %d servers changed",
+		"vault.delete_confirm":      "Delete secret %s from the vault?",
+		"vault.in_use":              "Secret %s is still used as ${%s} by:",
+		"vault.change_passphrase":   "Change passphrase",
+		"vault.launcher":            "Generate launcher",
+		"vault.launcher_created":    "Launcher created at %s. Use it instead of `claude` to start Claude Code with the secrets referenced as ${NAME}.",
+		"vault.copy_path":           "Copy path",
+		"vault.mode":                "Mode",
+		"vault.mode_reference":      "${NAME} reference",
+		"vault.mode_inline":         "resolved value in file",
+		"vault.binding":             "vault: %s (%s)",
+		"vault.bind":                "Vault",
+		"vault.bind_title":          "Bind %s to the vault",
+		"vault.bind_hint":           "If the secret does not exist it is created with the current value.",
+		"vault.needs_unlock":        "Unlock the vault to bind values to secrets.",
+		"detail.reveal": "Reveal",
+		"detail.hide":   "Hide",
+		"btn.copy_json": "Copy JSON",
+		"form.headers":                  "Headers",
+		"form.timeout":                  "Timeout (ms)",
+		"form.timeout_hint":             "Empty: Claude Code default",
+		"form.add_row":                  "Add",
+		"form.add_arg":                  "Add argument",
+		"form.paste_command":            "Import",
+		"form.paste_command_hint":       "Paste a command line (e.g.: npx -y pkg --dir \"My Docs\")",
+		"form.env_name_hint":            "NAME",
+		"form.header_name_hint":         "Header name",
+		"form.value_hint":               "Value",
+		"form.preview":                  "JSON to be written",
+		"validation.command_line_quote": "invalid command line: unterminated quote",
+		"validation.key_duplicate":      "repeated key: %s (the last row wins)",
+		"validation.timeout_invalid":    "the timeout must be a whole number of milliseconds",
+		"dialog.add_via_template":        "From template",
+		"template.title":                 "Server templates",
+		"template.select":                "Select a template",
+		"template.filter":                "Search templates...",
+		"template.source":                "Catalog: %s",
+		"template.add":                   "Add server",
+		"template.catalogs":              "Catalogs",
+		"template.catalogs_hint":         "Additional catalogs (JSON files or folders shared by the team) read on top of the built-in one; a template with the same ID replaces the built-in one.",
+		"template.no_catalogs":           "No additional catalogs",
+		"template.add_file":              "Add file...",
+		"template.add_folder":            "Add folder...",
+		"validation.template_catalog":    "%s",
+		"validation.template_parameters": "%s",
+		"toolbar.import":                  "Import",
+		"history.op_import":               "Import from %s",
+		"import.title":                    "Import from other clients",
+		"import.source":                   "Source file",
+		"import.select_source":            "Select a configuration file",
+		"import.no_sources":               "No other client configuration found",
+		"import.format":                   "Format",
+		"import.browse":                   "Browse...",
+		"import.target":                   "Destination",
+		"import.overwrite":                "Replace different servers with the same name",
+		"import.run":                      "Import selected",
+		"import.empty":                    "No MCP servers in the file",
+		"import.partial":                  "Imported %d servers, some were skipped:",
+		"import.done":                     "Imported %d servers into %s",
+		"import.status_new":               "New",
+		"import.status_identical":         "Already present",
+		"import.status_conflict":          "Conflict",
+		"validation.import_field_ignored": "field '%s' is not supported by Claude Code: not imported",
+		"validation.import_variable":      "variable %s of the source client is not supported: replace it with a value or ${VAR}",
+		"validation.import_disabled":      "server disabled in the source client",
+		"validation.import_renamed":       "original name '%s' is not usable: renamed",
+		"validation.import_conflict":      "a different server with this name already exists in the destination",
+		"validation.import_invalid":       "invalid entry, cannot be imported: %s",
+		"import.format_claude-desktop":    "Claude Desktop",
+		"import.format_cursor":            "Cursor",
+		"import.format_vscode":            "VS Code",
+		"import.format_codex":             "Codex CLI",
+		"import.format_goose":             "Goose",
+		"validation.import_read":          "%s",
+		"toolbar.export":                      "Export",
+		"export.title":                        "Export to other clients",
+		"export.source":                       "Servers",
+		"export.format":                       "Format",
+		"export.servers":                      "Servers to export",
+		"export.preview":                      "Preview (secrets hidden)",
+		"export.effective":                    "%s — effective servers",
+		"export.empty":                        "No servers in this location",
+		"export.include_secrets":              "Include secrets in the saved file",
+		"export.copy":                         "Copy",
+		"export.save":                         "Save to file…",
+		"export.done":                         "Exported %d servers to %s",
+		"export.format_mcp-servers":           "mcpServers snippet (.mcp.json)",
+		"export.format_claude-desktop":        "Claude Desktop",
+		"export.format_cursor":                "Cursor",
+		"export.format_vscode":                "VS Code",
+		"export.format_codex":                 "Codex CLI (TOML)",
+		"export.format_goose":                 "Goose (YAML)",
+		"validation.export_field_unsupported": "field '%s' cannot be represented in the target format: not exported",
+		"validation.export_type_unsupported":  "%s servers are not supported by the target format: server not exported",
+		"validation.export_variable":          "%s will be written literally: the target client does not expand variables",
+		"validation.export_variable_default":  "the default of %s is not supported by the target client",
+		"detail.effective_configs":    "Effective Configuration",
+		"detail.no_effective_servers": "No active servers in this project",
+		"detail.shadowing_count":      "%d overridden",
+		"detail.shadows":              "      overrides: %s",
+		"detail.show_diff":            "Differences",
+		"detail.diff_title":           "Definitions of %s",
+		"detail.diff_against":         "%s compared to %s",
+		"detail.diff_identical":       "Identical definition",
+		"toolbar.duplicates":       "Duplicates",
+		"history.op_promote":       "Promoted '%s' to global",
+		"duplicates.title":         "Duplicate servers",
+		"duplicates.hint":          "Servers with the same command and arguments (or the same URL) found in several projects. Promoting them to global makes the identical copies redundant and removes them in a single undoable operation.",
+		"duplicates.none":          "No duplicate servers",
+		"duplicates.summary":       "copies: %d, different: %d",
+		"duplicates.promote":       "Promote to global",
+		"duplicates.definition":    "Definition",
+		"duplicates.global_name":   "Global name",
+		"duplicates.remove_copies": "Copies to remove:",
+		"toolbar.cleanup":         "Cleanup",
+		"tree.missing":            "folder not found",
+		"detail.project_missing":  "The project folder no longer exists: its entry takes %s in ~/.claude.json and can be removed.",
+		"cleanup.title":           "Project cleanup",
+		"cleanup.hint":            "Projects registered in ~/.claude.json whose folder was deleted or moved. Removing them deletes their servers and Claude Code data (history, permissions, state); a backup of the file is created before writing.",
+		"cleanup.none":            "No projects with a missing folder",
+		"cleanup.project_details": "%d servers · %s",
+		"cleanup.total":           "Selected: %d projects (%s)",
+		"cleanup.archive_first":   "Save an archive of the entries before removing them",
+		"cleanup.include_secrets": "Include secrets in the archive",
+		"cleanup.export_only":     "Export archive…",
+		"cleanup.remove":          "Remove selected",
+		"cleanup.confirm":         "Remove %d projects (%s) from ~/.claude.json?",
+		"cleanup.archived":        "Archived %d projects to %s",
+		"cleanup.removed":         "Removed %d projects",
+		"toolbar.discover":          "Discover",
+		"tree.discovered":           "Discovered projects",
+		"detail.discovered_project": "Discovered project",
+		"detail.discovered_hint":    "This folder contains an MCP configuration but is not registered in ~/.claude.json. Once registered it appears among the projects with the servers from its files.",
+		"discovery.title":           "Discover projects",
+		"discovery.hint":            "Searches the given folders (e.g. ~/src) for projects with .mcp.json or .mcp.local.json that are not yet registered in ~/.claude.json. Hidden folders, node_modules and vendor are skipped.",
+		"discovery.roots":           "Folders to search",
+		"discovery.no_roots":        "No folders: add one",
+		"discovery.add_root":        "Add folder…",
+		"discovery.scan":            "Search",
+		"discovery.none":            "No unregistered projects found",
+		"discovery.register":        "Register selected",
+		"discovery.register_one":    "Register project",
+		"discovery.registered":      "Registered %d projects",
+		"tree.search_placeholder":          "Search by name, command, args, URL, env or path…",
+		"tree.filter_all_types":            "All types",
+		"tree.filter_all_sources":          "All files",
+		"tree.filter_source_global":        "Global (~/.claude.json)",
+		"tree.filter_source_project":       "Project (~/.claude.json)",
+		"tree.filter_source_project_file":  ".mcp.json",
+		"tree.filter_source_project_local": ".mcp.local.json",
+		"tree.sort_name":                   "Sort by name",
+		"tree.sort_type":                   "Sort by type",
+		"tree.sort_usage":                  "Sort by projects",
+		"tree.multi_select":      "Multi-select",
+		"bulk.title":             "Bulk operation",
+		"bulk.selected":          "%d servers selected",
+		"bulk.delete":            "Delete",
+		"bulk.move":              "Move",
+		"bulk.clone":             "Clone",
+		"bulk.export":            "Export",
+		"bulk.clear":             "Clear",
+		"bulk.move_title":        "Move %d servers",
+		"bulk.clone_title":       "Clone %d servers",
+		"bulk.summary":           "Selected servers: %d — changes: %s",
+		"bulk.skipped_exists":    "'%s' already exists in %s: skipped",
+		"bulk.overwrite":         "Overwrite servers that already exist in the destinations",
+		"bulk.apply":             "Apply",
+		"bulk.nothing":           "No changes to apply",
+		"export.selection":       "Tree selection (%d servers)",
+		"history.op_bulk_remove": "Deleted %s servers",
+		"history.op_bulk_move":   "Moved %s servers",
+		"history.op_bulk_clone":  "Cloned %s servers",
+		"toolbar.compare":         "Compare",
+		"compare.title":           "Compare",
+		"compare.hint":            "Compare two servers, the effective configurations of two projects, or a project and the global scope. The arrows copy a value or the whole definition to the other side, in the file where that server is written.",
+		"compare.left":            "Left",
+		"compare.right":           "Right",
+		"compare.effective":       "%s (effective configuration)",
+		"compare.show_identical":  "Show identical servers",
+		"compare.pick_sides":      "Choose the two sides to compare",
+		"compare.same_side":       "Both sides are the same",
+		"compare.mismatch":        "A server can only be compared with another server",
+		"compare.summary":         "Identical: %d, different: %d, left only: %d, right only: %d",
+		"compare.empty":           "No servers on either side",
+		"compare.only_left":       "Left only, in %s",
+		"compare.only_right":      "Right only, in %s",
+		"compare.clone_to":        "Copy to %s",
+		"compare.identical":       "Identical",
+		"compare.copy_definition": "Definition",
+		"toolbar.tools": "Tools",
+		"cli.usage_line":               "usage: mcp-curator %s",
+		"cli.error":                    "error: %s",
+		"cli.unknown_command":          "unknown command: %s",
+		"cli.unknown_lang":             "unsupported language: %s (available: %s)",
+		"cli.help_gui":                 "Without arguments it starts the graphical interface.",
+		"cli.help_usage":               "Usage: mcp-curator [--config PATH] [--lang LANGUAGE] <command> [arguments]",
+		"cli.help_commands":            "Commands:",
+		"cli.help_scope":               "Without --project the commands work on the global scope.\n--scope selects the project file: project (~/.claude.json, default),\nproject-file (.mcp.json) or project-local (.mcp.local.json).",
+		"cli.flag_config":              "alternative path of ~/.claude.json",
+		"cli.flag_lang":                "output language: IT, EN, FR, DE, ES, PT, JA, KO, CN, UK",
+		"cli.cmd.list":                 "List global and project servers (secrets hidden unless --reveal)",
+		"cli.cmd.show":                 "Show a server's configuration (secrets hidden unless --reveal)",
+		"cli.cmd.add":                  "Add a server, optionally from a catalog template",
+		"cli.cmd.update":               "Change the given fields of a server",
+		"cli.cmd.remove":               "Remove a server",
+		"cli.cmd.move":                 "Move a server between scopes and project files",
+		"cli.cmd.clone":                "Copy a server to other scopes",
+		"cli.cmd.bulk":                 "Remove, move or copy the servers selected as with search in a single operation",
+		"cli.cmd.import":               "Import servers from Claude Desktop, Cursor, VS Code, Codex or Goose (without PATH lists the files found)",
+		"cli.cmd.export":               "Export servers for Claude Desktop, Cursor, VS Code, Codex, Goose or as an mcpServers snippet",
+		"cli.cmd.search":               "Search servers in all scopes by name, command, arguments, URL, env keys or project path",
+		"cli.cmd.compare":              "Compare field by field two servers (NAME@global, NAME@PATH), two projects (PATH) or a project and global",
+		"cli.cmd.duplicates":           "Find servers with several copies across the global scope and projects, with their differences",
+		"cli.cmd.promote":              "Make a server global and remove the identical project copies",
+		"cli.cmd.discover":             "Find folders with .mcp.json or .mcp.local.json not yet registered as projects",
+		"cli.cmd.register":             "Register folders as projects in ~/.claude.json",
+		"cli.cmd.cleanup":              "Remove from ~/.claude.json the projects whose folder no longer exists, with an optional archive",
+		"cli.cmd.validate":             "Check the configuration of all servers",
+		"cli.cmd.test":                 "Run the MCP initialize handshake with a server",
+		"cli.cmd.inventory":            "List the tools, resources and prompts exposed by a server",
+		"cli.cmd.templates":            "List server templates and manage the team's additional catalogs",
+		"cli.cmd.vault":                "Manage the encrypted vault of secrets used in env and headers",
+		"cli.cmd.backups":              "List the backups of ~/.claude.json and the differences with the current file",
+		"cli.cmd.restore":              "Restore MCP servers from a backup",
+		"cli.cmd.version":              "Show the version",
+		"cli.location_global":          "global scope",
+		"cli.location_project":         "project %s",
+		"cli.source_global":            "global",
+		"cli.source_project":           "project",
+		"cli.severity_error":           "error",
+		"cli.severity_warning":         "warning",
+		"cli.warning":                  "Warning: %s",
+		"cli.absent":                   "(absent)",
+		"cli.no_servers":               "(no servers)",
+		"cli.field_name":               "Name",
+		"cli.field_type":               "Type",
+		"cli.field_command":            "Command",
+		"cli.field_category":           "Category",
+		"cli.field_description":        "Description",
+		"cli.field_catalog":            "Catalog",
+		"cli.field_parameter":          "Parameter",
+		"cli.field_required":           "required",
+		"cli.field_result":             "Result",
+		"cli.field_protocol":           "Protocol",
+		"cli.field_error":              "Error",
+		"cli.field_destination":        "Destination",
+		"cli.list_global":              "Global (%s)",
+		"cli.list_projects":            "Projects",
+		"cli.list_project_missing":     "folder not found: see cleanup",
+		"cli.server_added":             "Server '%s' added to %s",
+		"cli.server_updated":           "Server '%s' updated",
+		"cli.server_removed":           "Server '%s' removed from %s",
+		"cli.server_moved":             "Server '%s' moved to %s",
+		"cli.server_cloned":            "Server '%s' cloned to %d destinations",
+		"cli.test_ok":                  "OK (%dms)",
+		"cli.test_failed":              "FAILED (%s)",
+		"cli.inventory_header":         "%s %s (protocol %s, read on %s)",
+		"cli.inventory_tools":          "Tools (%d):",
+		"cli.inventory_resources":      "Resources (%d):",
+		"cli.inventory_prompts":        "Prompts (%d):",
+		"cli.inventory_failed":         "%s failed: %s",
+		"cli.valid_servers":            "%d valid servers",
+		"cli.no_backups":               "(no backups)",
+		"cli.nothing_to_restore":       "No differences to restore",
+		"cli.restored":                 "Restored %d servers from %s",
+		"cli.bulk_none":                "No servers selected",
+		"cli.bulk_plan":                "Selected servers: %d, changes: %s",
+		"cli.bulk_done":                "Selected servers: %d, changes saved: %s",
+		"cli.cleanup_none":             "No projects with a missing folder",
+		"cli.cleanup_plan":             "%d projects to remove (%s)",
+		"cli.archive_saved":            "Archive saved to %s",
+		"cli.cleanup_done":             "Removed %d projects from %s (%s)",
+		"cli.compare_left_only":        "left only (%s)",
+		"cli.compare_right_only":       "right only (%s)",
+		"cli.compare_identical":        "Identical servers: %d (--all to show them)",
+		"cli.discover_none":            "No unregistered projects found",
+		"cli.discover_hint":            "To register them: mcp-curator discover --register, or mcp-curator register PATH",
+		"cli.registered_count":         "Registered %d projects in %s",
+		"cli.already_registered":       "%s: already registered",
+		"cli.registered":               "Registered %s (%s)",
+		"cli.no_mcp_files":             "no MCP files",
+		"cli.duplicates_none":          "No duplicate servers",
+		"cli.duplicates_group":         "copies: %d, different: %d",
+		"cli.promote_hint":             "removes the identical project copies",
+		"cli.promote_plan":             "'%s' would become global, project copies removed: %d",
+		"cli.promote_done":             "Server '%s' is global, project copies removed: %d",
+		"cli.exported":                 "Exported %d servers to %s",
+		"cli.import_empty":             "No MCP servers in %s",
+		"cli.imported":                 "Imported %d servers into %s",
+		"cli.import_nothing":           "No servers to import: they are all already present",
+		"cli.import_no_sources":        "No configuration files of other clients found",
+		"cli.search_none":              "No servers found",
+		"cli.search_usage":             "projects: %d",
+		"cli.catalogs":                 "Additional catalogs",
+		"cli.catalog_added":            "Catalog added: %s",
+		"cli.catalog_removed":          "Catalog removed: %s",
+		"cli.vault_created":            "Vault created: %s",
+		"cli.no_secrets":               "(no secrets)",
+		"cli.secret_usages":            "%d uses",
+		"cli.secret_added":             "Secret %s added to the vault",
+		"cli.secret_updated":           "Secret %s updated",
+		"cli.secret_server_updated":    "updated %s (%s)",
+		"cli.secret_deleted":           "Secret %s deleted",
+		"cli.secret_bound":             "%s %s of %s bound to secret %s (%s)",
+		"cli.launcher_hint":            "Start Claude Code with the launcher (mcp-curator vault launcher) to resolve ${%s}",
+		"cli.launcher_created":         "Launcher created: %s",
+		"cli.launcher_usage":           "Use it instead of `claude` to start Claude Code with the secrets referenced as ${NAME}.",
+		"cli.passphrase_changed":       "Vault passphrase changed",
+		"cli.prompt_passphrase":        "Vault passphrase: ",
+		"cli.prompt_new_passphrase":    "New vault passphrase: ",
+		"cli.prompt_repeat_passphrase": "Repeat the passphrase: ",
+		"cli.prompt_secret_value":      "Value of %s: ",
+		"cli.prompt_new_secret_value":  "New value of %s: ",
+		"cli.flag.project_server":      "server project (default: global)",
+		"cli.flag.project_add":         "add to the given project (default: global)",
+		"cli.flag.project_source":      "source project (default: global)",
+		"cli.flag.project_promote":     "project of the copy to promote (default: global, to only remove the copies)",
+		"cli.flag.project_export":      "export from the given project (default: global)",
+		"cli.flag.project_import":      "import into the given project (default: global)",
+		"cli.flag.project_only":        "only the servers of this project (all its files)",
+		"cli.flag.project_list":        "show only the servers of the given project",
+		"cli.flag.scope":               "project file: project (~/.claude.json), project-file (.mcp.json), project-local (.mcp.local.json)",
+		"cli.flag.type":                "server type: stdio, http, sse",
+		"cli.flag.command":             "command to run (stdio)",
+		"cli.flag.arg":                 "command argument (repeatable)",
+		"cli.flag.url":                 "server URL (http/sse)",
+		"cli.flag.env":                 "environment variable KEY=value (repeatable)",
+		"cli.flag.header":              "HTTP header KEY=value (repeatable)",
+		"cli.flag.timeout":             "timeout in milliseconds",
+		"cli.flag.effective":           "show the project's effective servers (merge of all scopes)",
+		"cli.flag.effective_export":    "export the project's effective servers (merge of all scopes)",
+		"cli.flag.json":                "output in JSON format",
+		"cli.flag.json_import":         "output in JSON format (with --dry-run or without PATH)",
+		"cli.flag.json_cleanup":        "list the projects in JSON format without removing them",
+		"cli.flag.json_server":         "server JSON configuration (flags take precedence)",
+		"cli.flag.reveal":              "show secrets instead of [REDACTED]",
+		"cli.flag.reveal_archive":      "include secrets in the archive instead of [REDACTED]",
+		"cli.flag.reveal_export":       "include secrets instead of [REDACTED]",
+		"cli.flag.template":            "start from a catalog template (see `templates`)",
+		"cli.flag.param":               "template parameter K=value (repeatable)",
+		"cli.flag.clear_args":          "remove all arguments",
+		"cli.flag.unset_env":           "remove an environment variable (repeatable)",
+		"cli.flag.unset_header":        "remove an HTTP header (repeatable)",
+		"cli.flag.from_scope":          "source project file (project, project-file, project-local)",
+		"cli.flag.to":                  "destination: 'global' or project path",
+		"cli.flag.to_repeat":           "destination: 'global' or project path (repeatable)",
+		"cli.flag.to_bulk":             "destination: 'global' or project path (repeatable for clone)",
+		"cli.flag.to_global":           "move to the global scope (same as --to global)",
+		"cli.flag.to_scope":            "destination project file (project, project-file, project-local)",
+		"cli.flag.to_scope_many":       "destination projects file (project, project-file, project-local)",
+		"cli.flag.force_one":           "overwrite a server with the same name in the destination",
+		"cli.flag.force_many":          "overwrite servers with the same name in the destinations",
+		"cli.flag.force_import":        "replace different servers with the same name",
+		"cli.flag.dry_run":             "show what would change without writing",
+		"cli.flag.dry_run_promote":     "show what would be done without writing",
+		"cli.flag.dry_run_import":      "show what would be imported without writing",
+		"cli.flag.dry_run_cleanup":     "list the projects without removing them",
+		"cli.flag.archive":             "save the project entries to this file before removing them",
+		"cli.flag.cached":              "use the last inventory read without connecting",
+		"cli.flag.diff":                "show the servers that restoring each backup would change",
+		"cli.flag.restore_server":      "restore only the given server (repeatable)",
+		"cli.flag.import_server":       "import only the given server (repeatable)",
+		"cli.flag.all":                 "also show identical servers",
+		"cli.flag.depth":               "maximum search depth (default: preferences, otherwise 4)",
+		"cli.flag.save_roots":          "add the given folders to the preferences",
+		"cli.flag.register":            "register all the projects found",
+		"cli.flag.as":                  "name of the global server (default: the copy's name)",
+		"cli.flag.keep_copies":         "do not remove the identical project copies",
+		"cli.flag.export_format":       "format: mcp-servers, claude-desktop, cursor, vscode, codex, goose",
+		"cli.flag.output":              "write to a file instead of stdout",
+		"cli.flag.import_format":       "file format: claude-desktop, cursor, vscode, codex, goose (default: inferred from the path)",
+		"cli.flag.filter_type":         "only servers of this type: stdio, http, sse",
+		"cli.flag.filter_source":       "only servers of this file: global, project, project-file, project-local",
+		"cli.flag.sort":                "sort order: name, type, usage (projects using the server)",
+		"cli.flag.unlock":              "unlock the vault to also recognize resolved values in the files",
+		"cli.flag.bind_env":            "server environment variable to bind",
+		"cli.flag.bind_header":         "server HTTP header to bind",
+		"cli.flag.secret":              "secret name (default: the env or header key); created if missing",
+		"cli.flag.inline":              "write the resolved value to the file instead of ${NAME}",
+		"cli.shadows":                  "overrides %s",
+		"cli.file_servers":             "%s: %d servers",
+		"cli.server_count":             "%d servers",
+		"cli.template_default":         "default %s",
 	}
 
 	// French
@@ -295,8 +1381,6 @@ func (t *Translator) loadStrings() {
 		"dialog.delete_server":         "Supprimer Serveur",
 		"dialog.delete_confirm":        "Êtes-vous sûr de vouloir supprimer le serveur '%s'?",
 		"dialog.move_server":           "Déplacer Serveur",
-		"dialog.move_to_project":       "Sélectionnez le projet de destination:",
-		"dialog.move_to_global":        "Voulez-vous déplacer le serveur '%s' vers la portée globale?",
 		"dialog.no_projects":           "Aucun Projet",
 		"dialog.no_projects_msg":       "Il n'y a pas de projets configurés pour déplacer le serveur.",
 		"dialog.clone_server":          "Cloner Serveur",
@@ -318,11 +1402,525 @@ func (t *Translator) loadStrings() {
 		"form.command":        "Commande",
 		"form.command_hint":   "Commande (ex: uvx, npx)",
 		"form.args":           "Arguments",
-		"form.args_hint":      "Arguments séparés par espace (ex: -y mcp-server)",
 		"form.url":            "URL",
 		"form.url_hint":       "URL (pour http/sse)",
 		"form.env":            "Variables d'Environnement",
-		"form.env_hint":       "CLÉ=valeur, CLÉ2=valeur2 (séparés par virgule)",
+		"form.file":                "Fichier",
+		"form.file_settings":       "~/.claude.json (paramètres du projet)",
+		"form.file_mcp_json":       ".mcp.json (partagé)",
+		"form.file_mcp_local":      ".mcp.local.json (local)",
+		"form.project_required":    "Sélectionnez un projet",
+		"dialog.move_to":           "Sélectionnez la destination :",
+		"dialog.clone_file":        "Fichier de destination dans les projets :",
+		"dialog.overwrite_title":   "Serveur déjà existant",
+		"dialog.overwrite_confirm": "Un serveur '%s' existe déjà dans %s. Voulez-vous le remplacer ?",
+		"conflict.title":       "Configuration modifiée à l'extérieur",
+		"conflict.message":     "%s a été modifié par un autre programme (ex. Claude Code) après son chargement. Les autres modifications seront conservées ; choisissez la version à garder pour les serveurs modifiés des deux côtés.",
+		"conflict.mine":        "Ma version",
+		"conflict.theirs":      "Version sur disque",
+		"conflict.keep_mine":   "Garder la mienne",
+		"conflict.keep_theirs": "Utiliser celle sur disque",
+		"conflict.removed":     "(supprimé)",
+		"conflict.apply":       "Appliquer",
+		"banner.changed_externally": "Configuration modifiée à l'extérieur : %s",
+		"banner.added":              "%d ajoutés",
+		"banner.modified":           "%d modifiés",
+		"banner.removed":            "%d supprimés",
+		"toolbar.backups":         "Sauvegardes",
+		"backup.title":            "Sauvegardes de ~/.claude.json",
+		"backup.select":           "Sélectionnez une sauvegarde pour voir les différences",
+		"backup.none":             "Aucune sauvegarde disponible",
+		"backup.no_changes":       "Les serveurs MCP de la sauvegarde correspondent aux actuels",
+		"backup.diff_hint":        "Effet de la restauration sur les serveurs MCP (sélectionnez ceux à restaurer) :",
+		"backup.change_added":     "sera ajouté",
+		"backup.change_removed":   "sera supprimé",
+		"backup.change_modified":  "sera restauré",
+		"backup.restore_selected": "Restaurer la sélection",
+		"backup.restore_all":      "Tout restaurer",
+		"backup.nothing_selected": "Aucun serveur sélectionné",
+		"backup.restore_confirm":  "Restaurer %d serveurs depuis la sauvegarde du %s ? Le reste de ~/.claude.json ne sera pas modifié.",
+		"backup.retention":        "Conservation",
+		"backup.max_count":        "Nombre maximum",
+		"backup.max_age":          "Âge maximum (jours, 0 = illimité)",
+		"backup.dir":              "Répertoire",
+		"backup.dir_hint":         "À côté de ~/.claude.json",
+		"toolbar.history":    "Historique",
+		"btn.close":          "Fermer",
+		"history.title":      "Historique des modifications",
+		"history.empty":      "Aucune modification dans cette session",
+		"history.undone":     "annulée",
+		"history.op_add":     "Ajouté '%s'",
+		"history.op_update":  "Modifié '%s'",
+		"history.op_remove":  "Supprimé '%s'",
+		"history.op_move":    "Déplacé '%s'",
+		"history.op_clone":   "Cloné '%s'",
+		"history.op_restore": "Restauration depuis %s",
+		"btn.test":                 "Tester",
+		"test.title":               "Test de connexion : %s",
+		"test.running":             "Handshake MCP avec %s en cours (délai %s)...",
+		"test.success":             "Handshake réussi en %s",
+		"test.failed":              "Échec du handshake",
+		"test.protocol":            "Version du protocole",
+		"test.server_info":         "Serveur",
+		"test.capabilities":        "Capacités",
+		"test.instructions":        "Instructions",
+		"test.none":                "aucune",
+		"test.reason":              "Motif",
+		"test.detail":              "Détail",
+		"test.stderr":              "Sortie stderr",
+		"test.failure_not_found":   "Commande introuvable",
+		"test.failure_exited":      "Le processus s'est terminé avec le code %d",
+		"test.failure_timeout":     "Aucune réponse en %s",
+		"test.failure_tls":         "Erreur TLS",
+		"test.failure_http_status": "Le serveur a répondu HTTP %d",
+		"test.failure_network":     "Erreur réseau",
+		"test.failure_protocol":    "Réponse non conforme au protocole MCP",
+		"test.failure_config":      "Configuration du serveur incomplète",
+		"inventory.title":          "Inventaire",
+		"inventory.load":           "Lire l'inventaire",
+		"inventory.refresh":        "Actualiser",
+		"inventory.not_loaded":     "Connectez-vous au serveur pour voir les outils, ressources et prompts qu'il expose",
+		"inventory.loading":        "Lecture des outils, ressources et prompts de %s (délai %s)...",
+		"inventory.fetched_at":     "Lu le %s",
+		"inventory.tools":          "Outils (%d)",
+		"inventory.resources":      "Ressources (%d)",
+		"inventory.prompts":        "Prompts (%d)",
+		"inventory.schema":         "Schéma d'entrée",
+		"inventory.no_description": "Aucune description",
+		"inventory.list_error":     "Échec de %s : %s",
+		"tree.tool_count":          "%d outils",
+		"validation.name_required":       "le nom est obligatoire",
+		"validation.name_invalid":        "le nom ne peut contenir que des lettres, chiffres, '-' et '_' (les outils deviennent mcp__<nom>__<outil>)",
+		"validation.name_too_long":       "nom long : les noms d'outils mcp__<nom>__<outil> peuvent dépasser 64 caractères",
+		"validation.type_invalid":        "type inconnu : %s (valeurs admises : stdio, http, sse)",
+		"validation.type_missing":        "indiquez une commande (stdio) ou une URL (http/sse)",
+		"validation.command_required":    "la commande est obligatoire pour les serveurs stdio",
+		"validation.command_with_url":    "un serveur ne peut pas avoir à la fois une commande et une URL",
+		"validation.command_for_remote":  "la commande est ignorée par les serveurs %s",
+		"validation.command_has_spaces":  "la commande contient des espaces : les arguments vont dans args",
+		"validation.args_empty":          "argument vide en position %s",
+		"validation.args_for_remote":     "les args sont ignorés par les serveurs distants",
+		"validation.url_required":        "l'URL est obligatoire pour les serveurs %s",
+		"validation.url_for_stdio":       "l'URL est ignorée par les serveurs stdio",
+		"validation.url_invalid":         "URL invalide : %s",
+		"validation.url_scheme":          "schéma d'URL non pris en charge : %s (utilisez http ou https)",
+		"validation.url_insecure":        "connexion non chiffrée (http) vers un hôte distant : %s",
+		"validation.sse_deprecated":      "le transport SSE est obsolète : préférez http si le serveur le prend en charge",
+		"validation.env_name_empty":      "nom de variable d'environnement vide",
+		"validation.env_name_invalid":    "nom de variable d'environnement inhabituel : %s",
+		"validation.headers_for_stdio":   "les en-têtes sont ignorés par les serveurs stdio",
+		"validation.header_name_invalid": "nom d'en-tête invalide : %s",
+		"validation.timeout_negative":    "le délai ne peut pas être négatif",
+		"validation.title":               "Problèmes de configuration",
+		"validation.invalid_server":      "Le serveur %s n'est pas valide",
+		"validation.variable_missing":   "la variable ${%s} n'est pas définie et n'a pas de valeur par défaut",
+		"detail.headers":                "En-têtes",
+		"detail.expanded":               "développé",
+		"detail.missing_variables":      "Variables d'environnement manquantes",
+		"detail.missing_variables_hint": "Non définies dans l'environnement et sans valeur par défaut : les serveurs qui les utilisent risquent de ne pas démarrer.",
+		"toolbar.vault":             "Coffre",
+		"history.op_bind":           "Secret '%s' lié",
+		"history.op_rotate":         "Rotation du secret '%s'",
+		"vault.title":               "Coffre à secrets",
+		"vault.intro":               "Les secrets sont chiffrés avec une phrase de passe dans %s. Env et headers peuvent les utiliser comme ${NOM} : en lançant Claude Code avec le lanceur, les valeurs sont exportées sans apparaître dans les fichiers de configuration.",
+		"vault.create":              "Créer le coffre",
+		"vault.passphrase":          "Phrase de passe",
+		"vault.passphrase_confirm":  "Répéter la phrase de passe",
+		"vault.passphrase_mismatch": "Les phrases de passe ne correspondent pas",
+		"vault.unlock":              "Déverrouiller",
+		"vault.lock":                "Verrouiller",
+		"vault.locked_hint":         "Le coffre est verrouillé : saisissez la phrase de passe pour gérer les secrets.",
+		"vault.no_secrets":          "Aucun secret dans le coffre",
+		"vault.usages":              "%d utilisations",
+		"vault.add_secret":          "Ajouter un secret",
+		"vault.secret_name":         "Nom",
+		"vault.secret_value":        "Valeur",
+		"vault.rotate":              "Renouveler",
+		"vault.rotate_title":        "Renouveler %s",
+		"vault.new_value":           "Nouvelle valeur",
+		"vault.rotated":             "Secret %s mis à jour : %d serveurs modifiés",
+		"vault.delete_confirm":      "Supprimer le secret %s du coffre ?",
+		"vault.in_use":              "Le secret %s est encore utilisé comme ${%s} par :",
+		"vault.change_passphrase":   "Changer la phrase de passe",
+		"vault.launcher":            "Générer le lanceur",
+		"vault.launcher_created":    "Lanceur créé dans %s. Utilisez-le à la place de `claude` pour lancer Claude Code avec les secrets référencés comme ${NOM}.",
+		"vault.copy_path":           "Copier le chemin",
+		"vault.mode":                "Mode",
+		"vault.mode_reference":      "référence ${NOM}",
+		"vault.mode_inline":         "valeur résolue dans le fichier",
+		"vault.binding":             "coffre : %s (%s)",
+		"vault.bind":                "Coffre",
+		"vault.bind_title":          "Lier %s au coffre",
+		"vault.bind_hint":           "Si le secret n'existe pas, il est créé avec la valeur actuelle.",
+		"vault.needs_unlock":        "Déverrouillez le coffre pour lier des valeurs aux secrets.",
+		"detail.reveal": "Afficher",
+		"detail.hide":   "Masquer",
+		"btn.copy_json": "Copier le JSON",
+		"form.headers":                  "En-têtes",
+		"form.timeout":                  "Délai (ms)",
+		"form.timeout_hint":             "Vide : valeur par défaut de Claude Code",
+		"form.add_row":                  "Ajouter",
+		"form.add_arg":                  "Ajouter un argument",
+		"form.paste_command":            "Importer",
+		"form.paste_command_hint":       "Collez une ligne de commande (ex. : npx -y pkg --dir \"My Docs\")",
+		"form.env_name_hint":            "NOM",
+		"form.header_name_hint":         "Nom de l'en-tête",
+		"form.value_hint":               "Valeur",
+		"form.preview":                  "JSON qui sera écrit",
+		"validation.command_line_quote": "ligne de commande invalide : guillemet non fermé",
+		"validation.key_duplicate":      "clé répétée : %s (la dernière ligne l'emporte)",
+		"validation.timeout_invalid":    "le délai doit être un nombre entier de millisecondes",
+		"dialog.add_via_template":        "Depuis un modèle",
+		"template.title":                 "Modèles de serveurs",
+		"template.select":                "Sélectionnez un modèle",
+		"template.filter":                "Rechercher des modèles...",
+		"template.source":                "Catalogue : %s",
+		"template.add":                   "Ajouter le serveur",
+		"template.catalogs":              "Catalogues",
+		"template.catalogs_hint":         "Catalogues supplémentaires (fichiers JSON ou dossiers partagés par l'équipe) lus en plus du catalogue intégré ; un modèle avec le même ID remplace celui intégré.",
+		"template.no_catalogs":           "Aucun catalogue supplémentaire",
+		"template.add_file":              "Ajouter un fichier...",
+		"template.add_folder":            "Ajouter un dossier...",
+		"validation.template_catalog":    "%s",
+		"validation.template_parameters": "%s",
+		"toolbar.import":                  "Importer",
+		"history.op_import":               "Import depuis %s",
+		"import.title":                    "Importer depuis d'autres clients",
+		"import.source":                   "Fichier source",
+		"import.select_source":            "Sélectionnez un fichier de configuration",
+		"import.no_sources":               "Aucune configuration d'autre client trouvée",
+		"import.format":                   "Format",
+		"import.browse":                   "Parcourir...",
+		"import.target":                   "Destination",
+		"import.overwrite":                "Remplacer les serveurs différents portant le même nom",
+		"import.run":                      "Importer la sélection",
+		"import.empty":                    "Aucun serveur MCP dans le fichier",
+		"import.partial":                  "%d serveurs importés, certains ont été ignorés :",
+		"import.done":                     "%d serveurs importés dans %s",
+		"import.status_new":               "Nouveau",
+		"import.status_identical":         "Déjà présent",
+		"import.status_conflict":          "Conflit",
+		"validation.import_field_ignored": "champ '%s' non pris en charge par Claude Code : non importé",
+		"validation.import_variable":      "variable %s du client source non prise en charge : remplacez-la par une valeur ou ${VAR}",
+		"validation.import_disabled":      "serveur désactivé dans le client source",
+		"validation.import_renamed":       "nom d'origine '%s' inutilisable : renommé",
+		"validation.import_conflict":      "un serveur différent portant ce nom existe déjà dans la destination",
+		"validation.import_invalid":       "entrée non valide, impossible à importer : %s",
+		"import.format_claude-desktop":    "Claude Desktop",
+		"import.format_cursor":            "Cursor",
+		"import.format_vscode":            "VS Code",
+		"import.format_codex":             "Codex CLI",
+		"import.format_goose":             "Goose",
+		"validation.import_read":          "%s",
+		"toolbar.export":                      "Exporter",
+		"export.title":                        "Exporter vers d'autres clients",
+		"export.source":                       "Serveurs",
+		"export.format":                       "Format",
+		"export.servers":                      "Serveurs à exporter",
+		"export.preview":                      "Aperçu (secrets masqués)",
+		"export.effective":                    "%s — serveurs effectifs",
+		"export.empty":                        "Aucun serveur à cet emplacement",
+		"export.include_secrets":              "Inclure les secrets dans le fichier enregistré",
+		"export.copy":                         "Copier",
+		"export.save":                         "Enregistrer dans un fichier…",
+		"export.done":                         "%d serveurs exportés vers %s",
+		"export.format_mcp-servers":           "Extrait mcpServers (.mcp.json)",
+		"export.format_claude-desktop":        "Claude Desktop",
+		"export.format_cursor":                "Cursor",
+		"export.format_vscode":                "VS Code",
+		"export.format_codex":                 "Codex CLI (TOML)",
+		"export.format_goose":                 "Goose (YAML)",
+		"validation.export_field_unsupported": "le champ '%s' ne peut pas être représenté dans le format cible : non exporté",
+		"validation.export_type_unsupported":  "serveurs %s non pris en charge par le format cible : serveur non exporté",
+		"validation.export_variable":          "%s sera écrit tel quel : le client cible n'étend pas les variables",
+		"validation.export_variable_default":  "la valeur par défaut de %s n'est pas prise en charge par le client cible",
+		"detail.effective_configs":    "Configuration Effective",
+		"detail.no_effective_servers": "Aucun serveur actif dans ce projet",
+		"detail.shadowing_count":      "%d remplacés",
+		"detail.shadows":              "      remplace : %s",
+		"detail.show_diff":            "Différences",
+		"detail.diff_title":           "Définitions de %s",
+		"detail.diff_against":         "%s par rapport à %s",
+		"detail.diff_identical":       "Définition identique",
+		"toolbar.duplicates":       "Doublons",
+		"history.op_promote":       "'%s' promu en global",
+		"duplicates.title":         "Serveurs en double",
+		"duplicates.hint":          "Serveurs ayant la même commande et les mêmes arguments (ou la même URL) présents dans plusieurs projets. Les promouvoir en global rend les copies identiques superflues et les supprime en une seule opération annulable.",
+		"duplicates.none":          "Aucun serveur en double",
+		"duplicates.summary":       "copies : %d, différentes : %d",
+		"duplicates.promote":       "Promouvoir en global",
+		"duplicates.definition":    "Définition",
+		"duplicates.global_name":   "Nom global",
+		"duplicates.remove_copies": "Copies à supprimer :",
+		"toolbar.cleanup":         "Nettoyage",
+		"tree.missing":            "dossier introuvable",
+		"detail.project_missing":  "Le dossier du projet n'existe plus : son entrée occupe %s dans ~/.claude.json et peut être supprimée.",
+		"cleanup.title":           "Nettoyage des projets",
+		"cleanup.hint":            "Projets enregistrés dans ~/.claude.json dont le dossier a été supprimé ou déplacé. Les supprimer efface leurs serveurs et les données de Claude Code (historique, permissions, état) ; une sauvegarde du fichier est créée avant l'écriture.",
+		"cleanup.none":            "Aucun projet avec un dossier manquant",
+		"cleanup.project_details": "%d serveurs · %s",
+		"cleanup.total":           "Sélectionnés : %d projets (%s)",
+		"cleanup.archive_first":   "Enregistrer une archive des entrées avant de les supprimer",
+		"cleanup.include_secrets": "Inclure les secrets dans l'archive",
+		"cleanup.export_only":     "Exporter l'archive…",
+		"cleanup.remove":          "Supprimer la sélection",
+		"cleanup.confirm":         "Supprimer %d projets (%s) de ~/.claude.json ?",
+		"cleanup.archived":        "%d projets archivés dans %s",
+		"cleanup.removed":         "%d projets supprimés",
+		"toolbar.discover":          "Découvrir",
+		"tree.discovered":           "Projets découverts",
+		"detail.discovered_project": "Projet découvert",
+		"detail.discovered_hint":    "Ce dossier contient une configuration MCP mais n'est pas enregistré dans ~/.claude.json. Une fois enregistré, il apparaît parmi les projets avec les serveurs de ses fichiers.",
+		"discovery.title":           "Découvrir des projets",
+		"discovery.hint":            "Recherche dans les dossiers indiqués (ex. ~/src) les projets avec .mcp.json ou .mcp.local.json pas encore enregistrés dans ~/.claude.json. Les dossiers cachés, node_modules et vendor sont ignorés.",
+		"discovery.roots":           "Dossiers à parcourir",
+		"discovery.no_roots":        "Aucun dossier : ajoutez-en un",
+		"discovery.add_root":        "Ajouter un dossier…",
+		"discovery.scan":            "Rechercher",
+		"discovery.none":            "Aucun projet non enregistré trouvé",
+		"discovery.register":        "Enregistrer la sélection",
+		"discovery.register_one":    "Enregistrer le projet",
+		"discovery.registered":      "%d projets enregistrés",
+		"tree.search_placeholder":          "Rechercher par nom, commande, arguments, URL, env ou chemin…",
+		"tree.filter_all_types":            "Tous les types",
+		"tree.filter_all_sources":          "Tous les fichiers",
+		"tree.filter_source_global":        "Global (~/.claude.json)",
+		"tree.filter_source_project":       "Projet (~/.claude.json)",
+		"tree.filter_source_project_file":  ".mcp.json",
+		"tree.filter_source_project_local": ".mcp.local.json",
+		"tree.sort_name":                   "Trier par nom",
+		"tree.sort_type":                   "Trier par type",
+		"tree.sort_usage":                  "Trier par projets",
+		"tree.multi_select":      "Sélection multiple",
+		"bulk.title":             "Opération groupée",
+		"bulk.selected":          "%d serveurs sélectionnés",
+		"bulk.delete":            "Supprimer",
+		"bulk.move":              "Déplacer",
+		"bulk.clone":             "Cloner",
+		"bulk.export":            "Exporter",
+		"bulk.clear":             "Désélectionner",
+		"bulk.move_title":        "Déplacer %d serveurs",
+		"bulk.clone_title":       "Cloner %d serveurs",
+		"bulk.summary":           "Serveurs sélectionnés : %d — modifications : %s",
+		"bulk.skipped_exists":    "'%s' existe déjà dans %s : ignoré",
+		"bulk.overwrite":         "Écraser les serveurs déjà présents dans les destinations",
+		"bulk.apply":             "Appliquer",
+		"bulk.nothing":           "Aucune modification à appliquer",
+		"export.selection":       "Sélection de l'arbre (%d serveurs)",
+		"history.op_bulk_remove": "%s serveurs supprimés",
+		"history.op_bulk_move":   "%s serveurs déplacés",
+		"history.op_bulk_clone":  "%s serveurs clonés",
+		"toolbar.compare":         "Comparer",
+		"compare.title":           "Comparer",
+		"compare.hint":            "Comparez deux serveurs, les configurations effectives de deux projets ou un projet et la portée globale. Les flèches copient une valeur ou la définition entière de l'autre côté, dans le fichier où le serveur est écrit.",
+		"compare.left":            "Gauche",
+		"compare.right":           "Droite",
+		"compare.effective":       "%s (configuration effective)",
+		"compare.show_identical":  "Afficher les serveurs identiques",
+		"compare.pick_sides":      "Choisissez les deux côtés à comparer",
+		"compare.same_side":       "Les deux côtés sont identiques",
+		"compare.mismatch":        "Un serveur ne se compare qu'à un autre serveur",
+		"compare.summary":         "Identiques : %d, différents : %d, seulement à gauche : %d, seulement à droite : %d",
+		"compare.empty":           "Aucun serveur de part et d'autre",
+		"compare.only_left":       "Seulement à gauche, dans %s",
+		"compare.only_right":      "Seulement à droite, dans %s",
+		"compare.clone_to":        "Copier dans %s",
+		"compare.identical":       "Identiques",
+		"compare.copy_definition": "Définition",
+		"toolbar.tools": "Outils",
+		"cli.usage_line":               "utilisation : mcp-curator %s",
+		"cli.error":                    "erreur : %s",
+		"cli.unknown_command":          "commande inconnue : %s",
+		"cli.unknown_lang":             "langue non prise en charge : %s (disponibles : %s)",
+		"cli.help_gui":                 "Sans arguments, lance l'interface graphique.",
+		"cli.help_usage":               "Utilisation : mcp-curator [--config PATH] [--lang LANGUE] <commande> [arguments]",
+		"cli.help_commands":            "Commandes :",
+		"cli.help_scope":               "Sans --project, les commandes agissent sur la portée globale.\n--scope choisit le fichier du projet : project (~/.claude.json, par défaut),\nproject-file (.mcp.json) ou project-local (.mcp.local.json).",
+		"cli.flag_config":              "chemin alternatif de ~/.claude.json",
+		"cli.flag_lang":                "langue de sortie : IT, EN, FR, DE, ES, PT, JA, KO, CN, UK",
+		"cli.cmd.list":                 "Liste les serveurs globaux et de projet (secrets masqués sauf --reveal)",
+		"cli.cmd.show":                 "Affiche la configuration d'un serveur (secrets masqués sauf --reveal)",
+		"cli.cmd.add":                  "Ajoute un serveur, éventuellement depuis un modèle du catalogue",
+		"cli.cmd.update":               "Modifie les champs indiqués d'un serveur",
+		"cli.cmd.remove":               "Supprime un serveur",
+		"cli.cmd.move":                 "Déplace un serveur entre portées et fichiers de projet",
+		"cli.cmd.clone":                "Copie un serveur vers d'autres portées",
+		"cli.cmd.bulk":                 "Supprime, déplace ou copie en une seule opération les serveurs sélectionnés comme avec search",
+		"cli.cmd.import":               "Importe les serveurs depuis Claude Desktop, Cursor, VS Code, Codex ou Goose (sans PATH, liste les fichiers trouvés)",
+		"cli.cmd.export":               "Exporte les serveurs pour Claude Desktop, Cursor, VS Code, Codex, Goose ou en extrait mcpServers",
+		"cli.cmd.search":               "Recherche les serveurs dans toutes les portées par nom, commande, arguments, URL, clés env ou chemin du projet",
+		"cli.cmd.compare":              "Compare champ par champ deux serveurs (NOM@global, NOM@PATH), deux projets (PATH) ou un projet et global",
+		"cli.cmd.duplicates":           "Trouve les serveurs présents en plusieurs copies entre portée globale et projets, avec les différences",
+		"cli.cmd.promote":              "Rend un serveur global et supprime les copies de projet identiques",
+		"cli.cmd.discover":             "Recherche les dossiers avec .mcp.json ou .mcp.local.json pas encore enregistrés comme projets",
+		"cli.cmd.register":             "Enregistre des dossiers comme projets dans ~/.claude.json",
+		"cli.cmd.cleanup":              "Supprime de ~/.claude.json les projets dont le dossier n'existe plus, avec archive facultative",
+		"cli.cmd.validate":             "Vérifie la configuration de tous les serveurs",
+		"cli.cmd.test":                 "Exécute la négociation MCP initialize avec un serveur",
+		"cli.cmd.inventory":            "Liste les outils, ressources et prompts exposés par un serveur",
+		"cli.cmd.templates":            "Liste les modèles de serveur et gère les catalogues supplémentaires de l'équipe",
+		"cli.cmd.vault":                "Gère le coffre chiffré des secrets utilisés dans env et headers",
+		"cli.cmd.backups":              "Liste les sauvegardes de ~/.claude.json et les différences avec le fichier actuel",
+		"cli.cmd.restore":              "Restaure les serveurs MCP depuis une sauvegarde",
+		"cli.cmd.version":              "Affiche la version",
+		"cli.location_global":          "portée globale",
+		"cli.location_project":         "projet %s",
+		"cli.source_global":            "global",
+		"cli.source_project":           "projet",
+		"cli.severity_error":           "erreur",
+		"cli.severity_warning":         "avertissement",
+		"cli.warning":                  "Attention : %s",
+		"cli.absent":                   "(absent)",
+		"cli.no_servers":               "(aucun serveur)",
+		"cli.field_name":               "Nom",
+		"cli.field_type":               "Type",
+		"cli.field_command":            "Commande",
+		"cli.field_category":           "Catégorie",
+		"cli.field_description":        "Description",
+		"cli.field_catalog":            "Catalogue",
+		"cli.field_parameter":          "Paramètre",
+		"cli.field_required":           "obligatoire",
+		"cli.field_result":             "Résultat",
+		"cli.field_protocol":           "Protocole",
+		"cli.field_error":              "Erreur",
+		"cli.field_destination":        "Destination",
+		"cli.list_global":              "Global (%s)",
+		"cli.list_projects":            "Projets",
+		"cli.list_project_missing":     "dossier introuvable : voir cleanup",
+		"cli.server_added":             "Serveur '%s' ajouté dans %s",
+		"cli.server_updated":           "Serveur '%s' mis à jour",
+		"cli.server_removed":           "Serveur '%s' supprimé de %s",
+		"cli.server_moved":             "Serveur '%s' déplacé vers %s",
+		"cli.server_cloned":            "Serveur '%s' cloné vers %d destinations",
+		"cli.test_ok":                  "OK (%dms)",
+		"cli.test_failed":              "ÉCHEC (%s)",
+		"cli.inventory_header":         "%s %s (protocole %s, lu le %s)",
+		"cli.inventory_tools":          "Outils (%d) :",
+		"cli.inventory_resources":      "Ressources (%d) :",
+		"cli.inventory_prompts":        "Prompts (%d) :",
+		"cli.inventory_failed":         "échec de %s : %s",
+		"cli.valid_servers":            "%d serveurs valides",
+		"cli.no_backups":               "(aucune sauvegarde)",
+		"cli.nothing_to_restore":       "Aucune différence à restaurer",
+		"cli.restored":                 "%d serveurs restaurés depuis %s",
+		"cli.bulk_none":                "Aucun serveur sélectionné",
+		"cli.bulk_plan":                "Serveurs sélectionnés : %d, modifications : %s",
+		"cli.bulk_done":                "Serveurs sélectionnés : %d, modifications enregistrées : %s",
+		"cli.cleanup_none":             "Aucun projet dont le dossier est manquant",
+		"cli.cleanup_plan":             "%d projets à supprimer (%s)",
+		"cli.archive_saved":            "Archive enregistrée dans %s",
+		"cli.cleanup_done":             "%d projets supprimés de %s (%s)",
+		"cli.compare_left_only":        "à gauche uniquement (%s)",
+		"cli.compare_right_only":       "à droite uniquement (%s)",
+		"cli.compare_identical":        "Serveurs identiques : %d (--all pour les afficher)",
+		"cli.discover_none":            "Aucun projet non enregistré trouvé",
+		"cli.discover_hint":            "Pour les enregistrer : mcp-curator discover --register, ou mcp-curator register PATH",
+		"cli.registered_count":         "%d projets enregistrés dans %s",
+		"cli.already_registered":       "%s : déjà enregistré",
+		"cli.registered":               "Enregistré %s (%s)",
+		"cli.no_mcp_files":             "aucun fichier MCP",
+		"cli.duplicates_none":          "Aucun serveur en double",
+		"cli.duplicates_group":         "copies : %d, différentes : %d",
+		"cli.promote_hint":             "supprime les copies de projet identiques",
+		"cli.promote_plan":             "'%s' deviendrait global, copies de projet supprimées : %d",
+		"cli.promote_done":             "Serveur '%s' global, copies de projet supprimées : %d",
+		"cli.exported":                 "%d serveurs exportés dans %s",
+		"cli.import_empty":             "Aucun serveur MCP dans %s",
+		"cli.imported":                 "%d serveurs importés dans %s",
+		"cli.import_nothing":           "Aucun serveur à importer : ils sont tous déjà présents",
+		"cli.import_no_sources":        "Aucun fichier de configuration d'autres clients trouvé",
+		"cli.search_none":              "Aucun serveur trouvé",
+		"cli.search_usage":             "projets : %d",
+		"cli.catalogs":                 "Catalogues supplémentaires",
+		"cli.catalog_added":            "Catalogue ajouté : %s",
+		"cli.catalog_removed":          "Catalogue supprimé : %s",
+		"cli.vault_created":            "Coffre créé : %s",
+		"cli.no_secrets":               "(aucun secret)",
+		"cli.secret_usages":            "%d utilisations",
+		"cli.secret_added":             "Secret %s ajouté au coffre",
+		"cli.secret_updated":           "Secret %s mis à jour",
+		"cli.secret_server_updated":    "mis à jour %s (%s)",
+		"cli.secret_deleted":           "Secret %s supprimé",
+		"cli.secret_bound":             "%s %s de %s lié au secret %s (%s)",
+		"cli.launcher_hint":            "Lancez Claude Code avec le lanceur (mcp-curator vault launcher) pour résoudre ${%s}",
+		"cli.launcher_created":         "Lanceur créé : %s",
+		"cli.launcher_usage":           "Utilisez-le à la place de `claude` pour lancer Claude Code avec les secrets référencés comme ${NOM}.",
+		"cli.passphrase_changed":       "Phrase secrète du coffre modifiée",
+		"cli.prompt_passphrase":        "Phrase secrète du coffre : ",
+		"cli.prompt_new_passphrase":    "Nouvelle phrase secrète du coffre : ",
+		"cli.prompt_repeat_passphrase": "Répétez la phrase secrète : ",
+		"cli.prompt_secret_value":      "Valeur de %s : ",
+		"cli.prompt_new_secret_value":  "Nouvelle valeur de %s : ",
+		"cli.flag.project_server":      "projet du serveur (par défaut : global)",
+		"cli.flag.project_add":         "ajouter au projet indiqué (par défaut : global)",
+		"cli.flag.project_source":      "projet d'origine (par défaut : global)",
+		"cli.flag.project_promote":     "projet de la copie à promouvoir (par défaut : global, pour supprimer seulement les copies)",
+		"cli.flag.project_export":      "exporter depuis le projet indiqué (par défaut : global)",
+		"cli.flag.project_import":      "importer dans le projet indiqué (par défaut : global)",
+		"cli.flag.project_only":        "uniquement les serveurs de ce projet (tous ses fichiers)",
+		"cli.flag.project_list":        "affiche uniquement les serveurs du projet indiqué",
+		"cli.flag.scope":               "fichier du projet : project (~/.claude.json), project-file (.mcp.json), project-local (.mcp.local.json)",
+		"cli.flag.type":                "type de serveur : stdio, http, sse",
+		"cli.flag.command":             "commande à exécuter (stdio)",
+		"cli.flag.arg":                 "argument de la commande (répétable)",
+		"cli.flag.url":                 "URL du serveur (http/sse)",
+		"cli.flag.env":                 "variable d'environnement KEY=value (répétable)",
+		"cli.flag.header":              "en-tête HTTP KEY=value (répétable)",
+		"cli.flag.timeout":             "délai en millisecondes",
+		"cli.flag.effective":           "affiche les serveurs effectifs du projet (fusion de toutes les portées)",
+		"cli.flag.effective_export":    "exporte les serveurs effectifs du projet (fusion de toutes les portées)",
+		"cli.flag.json":                "sortie au format JSON",
+		"cli.flag.json_import":         "sortie au format JSON (avec --dry-run ou sans PATH)",
+		"cli.flag.json_cleanup":        "liste les projets au format JSON sans les supprimer",
+		"cli.flag.json_server":         "configuration JSON du serveur (les flags sont prioritaires)",
+		"cli.flag.reveal":              "affiche les secrets au lieu de [REDACTED]",
+		"cli.flag.reveal_archive":      "inclut les secrets dans l'archive au lieu de [REDACTED]",
+		"cli.flag.reveal_export":       "inclut les secrets au lieu de [REDACTED]",
+		"cli.flag.template":            "partir d'un modèle du catalogue (voir `templates`)",
+		"cli.flag.param":               "paramètre du modèle K=valeur (répétable)",
+		"cli.flag.clear_args":          "supprime tous les arguments",
+		"cli.flag.unset_env":           "supprime une variable d'environnement (répétable)",
+		"cli.flag.unset_header":        "supprime un en-tête HTTP (répétable)",
+		"cli.flag.from_scope":          "fichier du projet d'origine (project, project-file, project-local)",
+		"cli.flag.to":                  "destination : 'global' ou chemin de projet",
+		"cli.flag.to_repeat":           "destination : 'global' ou chemin de projet (répétable)",
+		"cli.flag.to_bulk":             "destination : 'global' ou chemin de projet (répétable pour clone)",
+		"cli.flag.to_global":           "déplace vers la portée globale (équivaut à --to global)",
+		"cli.flag.to_scope":            "fichier du projet de destination (project, project-file, project-local)",
+		"cli.flag.to_scope_many":       "fichier des projets de destination (project, project-file, project-local)",
+		"cli.flag.force_one":           "écrase un serveur du même nom dans la destination",
+		"cli.flag.force_many":          "écrase les serveurs du même nom dans les destinations",
+		"cli.flag.force_import":        "remplace les serveurs différents portant le même nom",
+		"cli.flag.dry_run":             "affiche ce qui changerait sans écrire",
+		"cli.flag.dry_run_promote":     "affiche ce qui serait fait sans écrire",
+		"cli.flag.dry_run_import":      "affiche ce qui serait importé sans écrire",
+		"cli.flag.dry_run_cleanup":     "liste les projets sans les supprimer",
+		"cli.flag.archive":             "enregistre les entrées des projets dans ce fichier avant de les supprimer",
+		"cli.flag.cached":              "utilise le dernier inventaire lu sans se connecter",
+		"cli.flag.diff":                "affiche les serveurs que la restauration de chaque sauvegarde modifierait",
+		"cli.flag.restore_server":      "restaure uniquement le serveur indiqué (répétable)",
+		"cli.flag.import_server":       "importe uniquement le serveur indiqué (répétable)",
+		"cli.flag.all":                 "affiche aussi les serveurs identiques",
+		"cli.flag.depth":               "profondeur de recherche maximale (par défaut : préférences, sinon 4)",
+		"cli.flag.save_roots":          "ajoute les dossiers indiqués aux préférences",
+		"cli.flag.register":            "enregistre tous les projets trouvés",
+		"cli.flag.as":                  "nom du serveur global (par défaut : le nom de la copie)",
+		"cli.flag.keep_copies":         "ne pas supprimer les copies de projet identiques",
+		"cli.flag.export_format":       "format : mcp-servers, claude-desktop, cursor, vscode, codex, goose",
+		"cli.flag.output":              "écrire dans un fichier au lieu de stdout",
+		"cli.flag.import_format":       "format du fichier : claude-desktop, cursor, vscode, codex, goose (par défaut : déduit du chemin)",
+		"cli.flag.filter_type":         "uniquement les serveurs de ce type : stdio, http, sse",
+		"cli.flag.filter_source":       "uniquement les serveurs de ce fichier : global, project, project-file, project-local",
+		"cli.flag.sort":                "tri : name, type, usage (projets qui utilisent le serveur)",
+		"cli.flag.unlock":              "déverrouille le coffre pour reconnaître aussi les valeurs résolues dans les fichiers",
+		"cli.flag.bind_env":            "variable d'environnement du serveur à lier",
+		"cli.flag.bind_header":         "en-tête HTTP du serveur à lier",
+		"cli.flag.secret":              "nom du secret (par défaut : la clé env ou header) ; créé s'il est absent",
+		"cli.flag.inline":              "écrire la valeur résolue dans le fichier au lieu de ${NOM}",
+		"cli.shadows":                  "remplace %s",
+		"cli.file_servers":             "%s : %d serveurs",
+		"cli.server_count":             "%d serveurs",
+		"cli.template_default":         "par défaut %s",
 	}
 
 	// German
@@ -361,8 +1959,6 @@ func (t *Translator) loadStrings() {
 		"dialog.delete_server":         "Server löschen",
 		"dialog.delete_confirm":        "Sind Sie sicher, dass Sie den Server '%s' löschen möchten?",
 		"dialog.move_server":           "Server verschieben",
-		"dialog.move_to_project":       "Wählen Sie das Zielprojekt:",
-		"dialog.move_to_global":        "Möchten Sie den Server '%s' in den globalen Bereich verschieben?",
 		"dialog.no_projects":           "Keine Projekte",
 		"dialog.no_projects_msg":       "Es gibt keine konfigurierten Projekte, in die der Server verschoben werden kann.",
 		"dialog.clone_server":          "Server klonen",
@@ -384,11 +1980,525 @@ func (t *Translator) loadStrings() {
 		"form.command":        "Befehl",
 		"form.command_hint":   "Befehl (z.B.: uvx, npx)",
 		"form.args":           "Argumente",
-		"form.args_hint":      "Argumente durch Leerzeichen getrennt (z.B.: -y mcp-server)",
 		"form.url":            "URL",
 		"form.url_hint":       "URL (für http/sse)",
 		"form.env":            "Umgebungsvariablen",
-		"form.env_hint":       "SCHLÜSSEL=wert, SCHLÜSSEL2=wert2 (durch Komma getrennt)",
+		"form.file":                "Datei",
+		"form.file_settings":       "~/.claude.json (Projekteinstellungen)",
+		"form.file_mcp_json":       ".mcp.json (geteilt)",
+		"form.file_mcp_local":      ".mcp.local.json (lokal)",
+		"form.project_required":    "Wählen Sie ein Projekt",
+		"dialog.move_to":           "Ziel auswählen:",
+		"dialog.clone_file":        "Zieldatei in den Projekten:",
+		"dialog.overwrite_title":   "Server existiert bereits",
+		"dialog.overwrite_confirm": "Ein Server '%s' existiert bereits in %s. Möchten Sie ihn überschreiben?",
+		"conflict.title":       "Konfiguration extern geändert",
+		"conflict.message":     "%s wurde nach dem Laden von einem anderen Programm (z. B. Claude Code) geändert. Andere Änderungen bleiben erhalten; wählen Sie die Version für Server, die auf beiden Seiten geändert wurden.",
+		"conflict.mine":        "Meine Version",
+		"conflict.theirs":      "Version auf der Festplatte",
+		"conflict.keep_mine":   "Meine behalten",
+		"conflict.keep_theirs": "Version auf der Festplatte verwenden",
+		"conflict.removed":     "(entfernt)",
+		"conflict.apply":       "Anwenden",
+		"banner.changed_externally": "Konfiguration extern geändert: %s",
+		"banner.added":              "%d hinzugefügt",
+		"banner.modified":           "%d geändert",
+		"banner.removed":            "%d entfernt",
+		"toolbar.backups":         "Backups",
+		"backup.title":            "Backups von ~/.claude.json",
+		"backup.select":           "Wählen Sie ein Backup, um die Unterschiede zu sehen",
+		"backup.none":             "Keine Backups vorhanden",
+		"backup.no_changes":       "Die MCP-Server des Backups entsprechen den aktuellen",
+		"backup.diff_hint":        "Auswirkung der Wiederherstellung auf MCP-Server (wählen Sie die wiederherzustellenden aus):",
+		"backup.change_added":     "wird hinzugefügt",
+		"backup.change_removed":   "wird entfernt",
+		"backup.change_modified":  "wird wiederhergestellt",
+		"backup.restore_selected": "Auswahl wiederherstellen",
+		"backup.restore_all":      "Alles wiederherstellen",
+		"backup.nothing_selected": "Kein Server ausgewählt",
+		"backup.restore_confirm":  "%d Server aus dem Backup vom %s wiederherstellen? Der Rest von ~/.claude.json bleibt unverändert.",
+		"backup.retention":        "Aufbewahrung",
+		"backup.max_count":        "Maximale Anzahl",
+		"backup.max_age":          "Maximales Alter (Tage, 0 = unbegrenzt)",
+		"backup.dir":              "Verzeichnis",
+		"backup.dir_hint":         "Neben ~/.claude.json",
+		"toolbar.history":    "Verlauf",
+		"btn.close":          "Schließen",
+		"history.title":      "Änderungsverlauf",
+		"history.empty":      "Keine Änderungen in dieser Sitzung",
+		"history.undone":     "rückgängig gemacht",
+		"history.op_add":     "'%s' hinzugefügt",
+		"history.op_update":  "'%s' bearbeitet",
+		"history.op_remove":  "'%s' gelöscht",
+		"history.op_move":    "'%s' verschoben",
+		"history.op_clone":   "'%s' geklont",
+		"history.op_restore": "Wiederherstellung aus %s",
+		"btn.test":                 "Testen",
+		"test.title":               "Verbindungstest: %s",
+		"test.running":             "MCP-Handshake mit %s läuft (Timeout %s)...",
+		"test.success":             "Handshake in %s erfolgreich",
+		"test.failed":              "Handshake fehlgeschlagen",
+		"test.protocol":            "Protokollversion",
+		"test.server_info":         "Server",
+		"test.capabilities":        "Fähigkeiten",
+		"test.instructions":        "Anweisungen",
+		"test.none":                "keine",
+		"test.reason":              "Grund",
+		"test.detail":              "Details",
+		"test.stderr":              "Stderr-Ausgabe",
+		"test.failure_not_found":   "Befehl nicht gefunden",
+		"test.failure_exited":      "Der Prozess wurde mit Code %d beendet",
+		"test.failure_timeout":     "Keine Antwort innerhalb von %s",
+		"test.failure_tls":         "TLS-Fehler",
+		"test.failure_http_status": "Der Server antwortete mit HTTP %d",
+		"test.failure_network":     "Netzwerkfehler",
+		"test.failure_protocol":    "Antwort entspricht nicht dem MCP-Protokoll",
+		"test.failure_config":      "Unvollständige Serverkonfiguration",
+		"inventory.title":          "Inventar",
+		"inventory.load":           "Inventar lesen",
+		"inventory.refresh":        "Aktualisieren",
+		"inventory.not_loaded":     "Mit dem Server verbinden, um seine Tools, Ressourcen und Prompts zu sehen",
+		"inventory.loading":        "Lese Tools, Ressourcen und Prompts von %s (Timeout %s)...",
+		"inventory.fetched_at":     "Gelesen am %s",
+		"inventory.tools":          "Tools (%d)",
+		"inventory.resources":      "Ressourcen (%d)",
+		"inventory.prompts":        "Prompts (%d)",
+		"inventory.schema":         "Eingabeschema",
+		"inventory.no_description": "Keine Beschreibung",
+		"inventory.list_error":     "%s fehlgeschlagen: %s",
+		"tree.tool_count":          "%d Tools",
+		"validation.name_required":       "ein Name ist erforderlich",
+		"validation.name_invalid":        "der Name darf nur Buchstaben, Ziffern, '-' und '_' enthalten (Tools heißen mcp__<Name>__<Tool>)",
+		"validation.name_too_long":       "langer Name: Toolnamen mcp__<Name>__<Tool> können 64 Zeichen überschreiten",
+		"validation.type_invalid":        "unbekannter Typ: %s (erlaubt: stdio, http, sse)",
+		"validation.type_missing":        "einen Befehl (stdio) oder eine URL (http/sse) angeben",
+		"validation.command_required":    "für stdio-Server ist ein Befehl erforderlich",
+		"validation.command_with_url":    "ein Server kann nicht Befehl und URL zugleich haben",
+		"validation.command_for_remote":  "der Befehl wird von %s-Servern ignoriert",
+		"validation.command_has_spaces":  "der Befehl enthält Leerzeichen: Argumente gehören in args",
+		"validation.args_empty":          "leeres Argument an Position %s",
+		"validation.args_for_remote":     "args werden von entfernten Servern ignoriert",
+		"validation.url_required":        "für %s-Server ist eine URL erforderlich",
+		"validation.url_for_stdio":       "die URL wird von stdio-Servern ignoriert",
+		"validation.url_invalid":         "ungültige URL: %s",
+		"validation.url_scheme":          "nicht unterstütztes URL-Schema: %s (http oder https verwenden)",
+		"validation.url_insecure":        "unverschlüsselte Verbindung (http) zu einem entfernten Host: %s",
+		"validation.sse_deprecated":      "der SSE-Transport ist veraltet: http bevorzugen, wenn der Server es unterstützt",
+		"validation.env_name_empty":      "leerer Name einer Umgebungsvariable",
+		"validation.env_name_invalid":    "ungewöhnlicher Name einer Umgebungsvariable: %s",
+		"validation.headers_for_stdio":   "Header werden von stdio-Servern ignoriert",
+		"validation.header_name_invalid": "ungültiger Header-Name: %s",
+		"validation.timeout_negative":    "das Timeout darf nicht negativ sein",
+		"validation.title":               "Konfigurationsprobleme",
+		"validation.invalid_server":      "Server %s ist ungültig",
+		"validation.variable_missing":   "Variable ${%s} ist nicht gesetzt und hat keinen Standardwert",
+		"detail.headers":                "Header",
+		"detail.expanded":               "expandiert",
+		"detail.missing_variables":      "Fehlende Umgebungsvariablen",
+		"detail.missing_variables_hint": "Nicht in der Umgebung gesetzt und ohne Standardwert: Server, die sie verwenden, starten möglicherweise nicht.",
+		"toolbar.vault":             "Tresor",
+		"history.op_bind":           "Geheimnis '%s' verknüpft",
+		"history.op_rotate":         "Geheimnis '%s' rotiert",
+		"vault.title":               "Geheimnis-Tresor",
+		"vault.intro":               "Geheimnisse werden mit einer Passphrase in %s verschlüsselt. Env und Header können sie als ${NAME} verwenden: Wird Claude Code über den Launcher gestartet, werden die Werte exportiert, ohne in Konfigurationsdateien zu erscheinen.",
+		"vault.create":              "Tresor erstellen",
+		"vault.passphrase":          "Passphrase",
+		"vault.passphrase_confirm":  "Passphrase wiederholen",
+		"vault.passphrase_mismatch": "Die Passphrasen stimmen nicht überein",
+		"vault.unlock":              "Entsperren",
+		"vault.lock":                "Sperren",
+		"vault.locked_hint":         "Der Tresor ist gesperrt: Passphrase eingeben, um Geheimnisse zu verwalten.",
+		"vault.no_secrets":          "Keine Geheimnisse im Tresor",
+		"vault.usages":              "%d Verwendungen",
+		"vault.add_secret":          "Geheimnis hinzufügen",
+		"vault.secret_name":         "Name",
+		"vault.secret_value":        "Wert",
+		"vault.rotate":              "Rotieren",
+		"vault.rotate_title":        "%s rotieren",
+		"vault.new_value":           "Neuer Wert",
+		"vault.rotated":             "Geheimnis %s aktualisiert: %d Server geändert",
+		"vault.delete_confirm":      "Geheimnis %s aus dem Tresor löschen?",
+		"vault.in_use":              "Das Geheimnis %s wird noch als ${%s} verwendet von:",
+		"vault.change_passphrase":   "Passphrase ändern",
+		"vault.launcher":            "Launcher erzeugen",
+		"vault.launcher_created":    "Launcher erstellt unter %s. Verwenden Sie ihn statt `claude`, um Claude Code mit den als ${NAME} referenzierten Geheimnissen zu starten.",
+		"vault.copy_path":           "Pfad kopieren",
+		"vault.mode":                "Modus",
+		"vault.mode_reference":      "Referenz ${NAME}",
+		"vault.mode_inline":         "aufgelöster Wert in der Datei",
+		"vault.binding":             "Tresor: %s (%s)",
+		"vault.bind":                "Tresor",
+		"vault.bind_title":          "%s mit dem Tresor verknüpfen",
+		"vault.bind_hint":           "Existiert das Geheimnis nicht, wird es mit dem aktuellen Wert angelegt.",
+		"vault.needs_unlock":        "Entsperren Sie den Tresor, um Werte mit Geheimnissen zu verknüpfen.",
+		"detail.reveal": "Anzeigen",
+		"detail.hide":   "Verbergen",
+		"btn.copy_json": "JSON kopieren",
+		"form.headers":                  "Header",
+		"form.timeout":                  "Timeout (ms)",
+		"form.timeout_hint":             "Leer: Standard von Claude Code",
+		"form.add_row":                  "Hinzufügen",
+		"form.add_arg":                  "Argument hinzufügen",
+		"form.paste_command":            "Importieren",
+		"form.paste_command_hint":       "Befehlszeile einfügen (z. B.: npx -y pkg --dir \"My Docs\")",
+		"form.env_name_hint":            "NAME",
+		"form.header_name_hint":         "Header-Name",
+		"form.value_hint":               "Wert",
+		"form.preview":                  "Zu schreibendes JSON",
+		"validation.command_line_quote": "ungültige Befehlszeile: Anführungszeichen nicht geschlossen",
+		"validation.key_duplicate":      "wiederholter Schlüssel: %s (die letzte Zeile gilt)",
+		"validation.timeout_invalid":    "der Timeout muss eine ganze Zahl von Millisekunden sein",
+		"dialog.add_via_template":        "Aus Vorlage",
+		"template.title":                 "Server-Vorlagen",
+		"template.select":                "Vorlage auswählen",
+		"template.filter":                "Vorlagen suchen...",
+		"template.source":                "Katalog: %s",
+		"template.add":                   "Server hinzufügen",
+		"template.catalogs":              "Kataloge",
+		"template.catalogs_hint":         "Zusätzliche Kataloge (JSON-Dateien oder vom Team geteilte Ordner), die zusätzlich zum eingebauten gelesen werden; eine Vorlage mit derselben ID ersetzt die eingebaute.",
+		"template.no_catalogs":           "Keine zusätzlichen Kataloge",
+		"template.add_file":              "Datei hinzufügen...",
+		"template.add_folder":            "Ordner hinzufügen...",
+		"validation.template_catalog":    "%s",
+		"validation.template_parameters": "%s",
+		"toolbar.import":                  "Importieren",
+		"history.op_import":               "Import aus %s",
+		"import.title":                    "Aus anderen Clients importieren",
+		"import.source":                   "Quelldatei",
+		"import.select_source":            "Konfigurationsdatei auswählen",
+		"import.no_sources":               "Keine Konfiguration anderer Clients gefunden",
+		"import.format":                   "Format",
+		"import.browse":                   "Durchsuchen...",
+		"import.target":                   "Ziel",
+		"import.overwrite":                "Abweichende Server mit gleichem Namen ersetzen",
+		"import.run":                      "Auswahl importieren",
+		"import.empty":                    "Keine MCP-Server in der Datei",
+		"import.partial":                  "%d Server importiert, einige wurden übersprungen:",
+		"import.done":                     "%d Server in %s importiert",
+		"import.status_new":               "Neu",
+		"import.status_identical":         "Bereits vorhanden",
+		"import.status_conflict":          "Konflikt",
+		"validation.import_field_ignored": "feld '%s' wird von Claude Code nicht unterstützt: nicht importiert",
+		"validation.import_variable":      "variable %s des Quell-Clients wird nicht unterstützt: durch einen Wert oder ${VAR} ersetzen",
+		"validation.import_disabled":      "server im Quell-Client deaktiviert",
+		"validation.import_renamed":       "ursprünglicher Name '%s' nicht verwendbar: umbenannt",
+		"validation.import_conflict":      "im Ziel existiert bereits ein anderer Server mit diesem Namen",
+		"validation.import_invalid":       "ungültiger Eintrag, kann nicht importiert werden: %s",
+		"import.format_claude-desktop":    "Claude Desktop",
+		"import.format_cursor":            "Cursor",
+		"import.format_vscode":            "VS Code",
+		"import.format_codex":             "Codex CLI",
+		"import.format_goose":             "Goose",
+		"validation.import_read":          "%s",
+		"toolbar.export":                      "Exportieren",
+		"export.title":                        "Zu anderen Clients exportieren",
+		"export.source":                       "Server",
+		"export.format":                       "Format",
+		"export.servers":                      "Zu exportierende Server",
+		"export.preview":                      "Vorschau (Geheimnisse verborgen)",
+		"export.effective":                    "%s — effektive Server",
+		"export.empty":                        "Keine Server an diesem Ort",
+		"export.include_secrets":              "Geheimnisse in die gespeicherte Datei aufnehmen",
+		"export.copy":                         "Kopieren",
+		"export.save":                         "In Datei speichern…",
+		"export.done":                         "%d Server nach %s exportiert",
+		"export.format_mcp-servers":           "mcpServers-Snippet (.mcp.json)",
+		"export.format_claude-desktop":        "Claude Desktop",
+		"export.format_cursor":                "Cursor",
+		"export.format_vscode":                "VS Code",
+		"export.format_codex":                 "Codex CLI (TOML)",
+		"export.format_goose":                 "Goose (YAML)",
+		"validation.export_field_unsupported": "Feld '%s' ist im Zielformat nicht darstellbar: nicht exportiert",
+		"validation.export_type_unsupported":  "%s-Server werden vom Zielformat nicht unterstützt: Server nicht exportiert",
+		"validation.export_variable":          "%s wird wörtlich geschrieben: der Ziel-Client expandiert keine Variablen",
+		"validation.export_variable_default":  "der Standardwert von %s wird vom Ziel-Client nicht unterstützt",
+		"detail.effective_configs":    "Effektive Konfiguration",
+		"detail.no_effective_servers": "Keine aktiven Server in diesem Projekt",
+		"detail.shadowing_count":      "%d überschrieben",
+		"detail.shadows":              "      überschreibt: %s",
+		"detail.show_diff":            "Unterschiede",
+		"detail.diff_title":           "Definitionen von %s",
+		"detail.diff_against":         "%s verglichen mit %s",
+		"detail.diff_identical":       "Identische Definition",
+		"toolbar.duplicates":       "Duplikate",
+		"history.op_promote":       "'%s' global gemacht",
+		"duplicates.title":         "Doppelte Server",
+		"duplicates.hint":          "Server mit demselben Befehl und denselben Argumenten (oder derselben URL) in mehreren Projekten. Werden sie global gemacht, sind die identischen Kopien überflüssig und werden in einem einzigen rückgängig machbaren Vorgang entfernt.",
+		"duplicates.none":          "Keine doppelten Server",
+		"duplicates.summary":       "Kopien: %d, abweichend: %d",
+		"duplicates.promote":       "Global machen",
+		"duplicates.definition":    "Definition",
+		"duplicates.global_name":   "Globaler Name",
+		"duplicates.remove_copies": "Zu entfernende Kopien:",
+		"toolbar.cleanup":         "Bereinigen",
+		"tree.missing":            "Ordner nicht gefunden",
+		"detail.project_missing":  "Der Projektordner existiert nicht mehr: sein Eintrag belegt %s in ~/.claude.json und kann entfernt werden.",
+		"cleanup.title":           "Projekte bereinigen",
+		"cleanup.hint":            "In ~/.claude.json registrierte Projekte, deren Ordner gelöscht oder verschoben wurde. Beim Entfernen werden ihre Server und die Claude-Code-Daten (Verlauf, Berechtigungen, Status) gelöscht; vor dem Schreiben wird ein Backup der Datei erstellt.",
+		"cleanup.none":            "Keine Projekte mit fehlendem Ordner",
+		"cleanup.project_details": "%d Server · %s",
+		"cleanup.total":           "Ausgewählt: %d Projekte (%s)",
+		"cleanup.archive_first":   "Vor dem Entfernen ein Archiv der Einträge speichern",
+		"cleanup.include_secrets": "Geheimnisse in das Archiv aufnehmen",
+		"cleanup.export_only":     "Archiv exportieren…",
+		"cleanup.remove":          "Auswahl entfernen",
+		"cleanup.confirm":         "%d Projekte (%s) aus ~/.claude.json entfernen?",
+		"cleanup.archived":        "%d Projekte in %s archiviert",
+		"cleanup.removed":         "%d Projekte entfernt",
+		"toolbar.discover":          "Entdecken",
+		"tree.discovered":           "Entdeckte Projekte",
+		"detail.discovered_project": "Entdecktes Projekt",
+		"detail.discovered_hint":    "Dieser Ordner enthält eine MCP-Konfiguration, ist aber nicht in ~/.claude.json registriert. Nach der Registrierung erscheint er unter den Projekten mit den Servern seiner Dateien.",
+		"discovery.title":           "Projekte entdecken",
+		"discovery.hint":            "Durchsucht die angegebenen Ordner (z. B. ~/src) nach Projekten mit .mcp.json oder .mcp.local.json, die noch nicht in ~/.claude.json registriert sind. Versteckte Ordner, node_modules und vendor werden übersprungen.",
+		"discovery.roots":           "Zu durchsuchende Ordner",
+		"discovery.no_roots":        "Keine Ordner: füge einen hinzu",
+		"discovery.add_root":        "Ordner hinzufügen…",
+		"discovery.scan":            "Suchen",
+		"discovery.none":            "Keine nicht registrierten Projekte gefunden",
+		"discovery.register":        "Auswahl registrieren",
+		"discovery.register_one":    "Projekt registrieren",
+		"discovery.registered":      "%d Projekte registriert",
+		"tree.search_placeholder":          "Nach Name, Befehl, Argumenten, URL, Env oder Pfad suchen…",
+		"tree.filter_all_types":            "Alle Typen",
+		"tree.filter_all_sources":          "Alle Dateien",
+		"tree.filter_source_global":        "Global (~/.claude.json)",
+		"tree.filter_source_project":       "Projekt (~/.claude.json)",
+		"tree.filter_source_project_file":  ".mcp.json",
+		"tree.filter_source_project_local": ".mcp.local.json",
+		"tree.sort_name":                   "Nach Name sortieren",
+		"tree.sort_type":                   "Nach Typ sortieren",
+		"tree.sort_usage":                  "Nach Projekten sortieren",
+		"tree.multi_select":      "Mehrfachauswahl",
+		"bulk.title":             "Sammelaktion",
+		"bulk.selected":          "%d Server ausgewählt",
+		"bulk.delete":            "Löschen",
+		"bulk.move":              "Verschieben",
+		"bulk.clone":             "Klonen",
+		"bulk.export":            "Exportieren",
+		"bulk.clear":             "Auswahl aufheben",
+		"bulk.move_title":        "%d Server verschieben",
+		"bulk.clone_title":       "%d Server klonen",
+		"bulk.summary":           "Ausgewählte Server: %d — Änderungen: %s",
+		"bulk.skipped_exists":    "'%s' existiert bereits in %s: übersprungen",
+		"bulk.overwrite":         "Bereits vorhandene Server in den Zielen überschreiben",
+		"bulk.apply":             "Anwenden",
+		"bulk.nothing":           "Keine Änderungen anzuwenden",
+		"export.selection":       "Auswahl im Baum (%d Server)",
+		"history.op_bulk_remove": "%s Server gelöscht",
+		"history.op_bulk_move":   "%s Server verschoben",
+		"history.op_bulk_clone":  "%s Server geklont",
+		"toolbar.compare":         "Vergleichen",
+		"compare.title":           "Vergleichen",
+		"compare.hint":            "Vergleichen Sie zwei Server, die effektiven Konfigurationen zweier Projekte oder ein Projekt mit dem globalen Bereich. Die Pfeile kopieren einen Wert oder die ganze Definition auf die andere Seite, in die Datei, in der der Server steht.",
+		"compare.left":            "Links",
+		"compare.right":           "Rechts",
+		"compare.effective":       "%s (effektive Konfiguration)",
+		"compare.show_identical":  "Identische Server anzeigen",
+		"compare.pick_sides":      "Wählen Sie die beiden zu vergleichenden Seiten",
+		"compare.same_side":       "Beide Seiten sind gleich",
+		"compare.mismatch":        "Ein Server kann nur mit einem anderen Server verglichen werden",
+		"compare.summary":         "Identisch: %d, verschieden: %d, nur links: %d, nur rechts: %d",
+		"compare.empty":           "Auf keiner Seite Server",
+		"compare.only_left":       "Nur links, in %s",
+		"compare.only_right":      "Nur rechts, in %s",
+		"compare.clone_to":        "Nach %s kopieren",
+		"compare.identical":       "Identisch",
+		"compare.copy_definition": "Definition",
+		"toolbar.tools": "Werkzeuge",
+		"cli.usage_line":               "Verwendung: mcp-curator %s",
+		"cli.error":                    "Fehler: %s",
+		"cli.unknown_command":          "unbekannter Befehl: %s",
+		"cli.unknown_lang":             "nicht unterstützte Sprache: %s (verfügbar: %s)",
+		"cli.help_gui":                 "Ohne Argumente wird die grafische Oberfläche gestartet.",
+		"cli.help_usage":               "Verwendung: mcp-curator [--config PATH] [--lang SPRACHE] <Befehl> [Argumente]",
+		"cli.help_commands":            "Befehle:",
+		"cli.help_scope":               "Ohne --project arbeiten die Befehle im globalen Scope.\n--scope wählt die Projektdatei: project (~/.claude.json, Standard),\nproject-file (.mcp.json) oder project-local (.mcp.local.json).",
+		"cli.flag_config":              "alternativer Pfad von ~/.claude.json",
+		"cli.flag_lang":                "Ausgabesprache: IT, EN, FR, DE, ES, PT, JA, KO, CN, UK",
+		"cli.cmd.list":                 "Listet globale und Projekt-Server auf (Geheimnisse verborgen außer mit --reveal)",
+		"cli.cmd.show":                 "Zeigt die Konfiguration eines Servers (Geheimnisse verborgen außer mit --reveal)",
+		"cli.cmd.add":                  "Fügt einen Server hinzu, auch aus einer Katalogvorlage",
+		"cli.cmd.update":               "Ändert die angegebenen Felder eines Servers",
+		"cli.cmd.remove":               "Entfernt einen Server",
+		"cli.cmd.move":                 "Verschiebt einen Server zwischen Scopes und Projektdateien",
+		"cli.cmd.clone":                "Kopiert einen Server in andere Scopes",
+		"cli.cmd.bulk":                 "Entfernt, verschiebt oder kopiert die wie mit search ausgewählten Server in einem Vorgang",
+		"cli.cmd.import":               "Importiert Server aus Claude Desktop, Cursor, VS Code, Codex oder Goose (ohne PATH werden die gefundenen Dateien aufgelistet)",
+		"cli.cmd.export":               "Exportiert Server für Claude Desktop, Cursor, VS Code, Codex, Goose oder als mcpServers-Snippet",
+		"cli.cmd.search":               "Sucht Server in allen Scopes nach Name, Befehl, Argumenten, URL, env-Schlüsseln oder Projektpfad",
+		"cli.cmd.compare":              "Vergleicht Feld für Feld zwei Server (NAME@global, NAME@PATH), zwei Projekte (PATH) oder ein Projekt mit global",
+		"cli.cmd.duplicates":           "Findet Server mit mehreren Kopien im globalen Scope und in Projekten, mit den Unterschieden",
+		"cli.cmd.promote":              "Macht einen Server global und entfernt identische Projektkopien",
+		"cli.cmd.discover":             "Sucht Ordner mit .mcp.json oder .mcp.local.json, die noch nicht als Projekte registriert sind",
+		"cli.cmd.register":             "Registriert Ordner als Projekte in ~/.claude.json",
+		"cli.cmd.cleanup":              "Entfernt aus ~/.claude.json Projekte, deren Ordner nicht mehr existiert, mit optionalem Archiv",
+		"cli.cmd.validate":             "Prüft die Konfiguration aller Server",
+		"cli.cmd.test":                 "Führt den MCP-initialize-Handshake mit einem Server aus",
+		"cli.cmd.inventory":            "Listet die Tools, Ressourcen und Prompts eines Servers auf",
+		"cli.cmd.templates":            "Listet Servervorlagen auf und verwaltet zusätzliche Team-Kataloge",
+		"cli.cmd.vault":                "Verwaltet den verschlüsselten Tresor der in env und headers verwendeten Geheimnisse",
+		"cli.cmd.backups":              "Listet die Backups von ~/.claude.json und die Unterschiede zur aktuellen Datei auf",
+		"cli.cmd.restore":              "Stellt MCP-Server aus einem Backup wieder her",
+		"cli.cmd.version":              "Zeigt die Version",
+		"cli.location_global":          "globaler Scope",
+		"cli.location_project":         "Projekt %s",
+		"cli.source_global":            "global",
+		"cli.source_project":           "Projekt",
+		"cli.severity_error":           "Fehler",
+		"cli.severity_warning":         "Warnung",
+		"cli.warning":                  "Achtung: %s",
+		"cli.absent":                   "(fehlt)",
+		"cli.no_servers":               "(keine Server)",
+		"cli.field_name":               "Name",
+		"cli.field_type":               "Typ",
+		"cli.field_command":            "Befehl",
+		"cli.field_category":           "Kategorie",
+		"cli.field_description":        "Beschreibung",
+		"cli.field_catalog":            "Katalog",
+		"cli.field_parameter":          "Parameter",
+		"cli.field_required":           "erforderlich",
+		"cli.field_result":             "Ergebnis",
+		"cli.field_protocol":           "Protokoll",
+		"cli.field_error":              "Fehler",
+		"cli.field_destination":        "Ziel",
+		"cli.list_global":              "Global (%s)",
+		"cli.list_projects":            "Projekte",
+		"cli.list_project_missing":     "Ordner nicht gefunden: siehe cleanup",
+		"cli.server_added":             "Server '%s' zu %s hinzugefügt",
+		"cli.server_updated":           "Server '%s' aktualisiert",
+		"cli.server_removed":           "Server '%s' aus %s entfernt",
+		"cli.server_moved":             "Server '%s' nach %s verschoben",
+		"cli.server_cloned":            "Server '%s' in %d Ziele kopiert",
+		"cli.test_ok":                  "OK (%dms)",
+		"cli.test_failed":              "FEHLGESCHLAGEN (%s)",
+		"cli.inventory_header":         "%s %s (Protokoll %s, gelesen am %s)",
+		"cli.inventory_tools":          "Tools (%d):",
+		"cli.inventory_resources":      "Ressourcen (%d):",
+		"cli.inventory_prompts":        "Prompts (%d):",
+		"cli.inventory_failed":         "%s fehlgeschlagen: %s",
+		"cli.valid_servers":            "%d gültige Server",
+		"cli.no_backups":               "(keine Backups)",
+		"cli.nothing_to_restore":       "Keine Unterschiede zum Wiederherstellen",
+		"cli.restored":                 "%d Server aus %s wiederhergestellt",
+		"cli.bulk_none":                "Keine Server ausgewählt",
+		"cli.bulk_plan":                "Ausgewählte Server: %d, Änderungen: %s",
+		"cli.bulk_done":                "Ausgewählte Server: %d, gespeicherte Änderungen: %s",
+		"cli.cleanup_none":             "Keine Projekte mit fehlendem Ordner",
+		"cli.cleanup_plan":             "%d Projekte zu entfernen (%s)",
+		"cli.archive_saved":            "Archiv in %s gespeichert",
+		"cli.cleanup_done":             "%d Projekte aus %s entfernt (%s)",
+		"cli.compare_left_only":        "nur links (%s)",
+		"cli.compare_right_only":       "nur rechts (%s)",
+		"cli.compare_identical":        "Identische Server: %d (--all zum Anzeigen)",
+		"cli.discover_none":            "Keine nicht registrierten Projekte gefunden",
+		"cli.discover_hint":            "Zum Registrieren: mcp-curator discover --register oder mcp-curator register PATH",
+		"cli.registered_count":         "%d Projekte in %s registriert",
+		"cli.already_registered":       "%s: bereits registriert",
+		"cli.registered":               "Registriert: %s (%s)",
+		"cli.no_mcp_files":             "keine MCP-Dateien",
+		"cli.duplicates_none":          "Keine doppelten Server",
+		"cli.duplicates_group":         "Kopien: %d, abweichend: %d",
+		"cli.promote_hint":             "entfernt identische Projektkopien",
+		"cli.promote_plan":             "'%s' würde global, entfernte Projektkopien: %d",
+		"cli.promote_done":             "Server '%s' ist global, entfernte Projektkopien: %d",
+		"cli.exported":                 "%d Server nach %s exportiert",
+		"cli.import_empty":             "Keine MCP-Server in %s",
+		"cli.imported":                 "%d Server in %s importiert",
+		"cli.import_nothing":           "Keine Server zu importieren: alle sind bereits vorhanden",
+		"cli.import_no_sources":        "Keine Konfigurationsdateien anderer Clients gefunden",
+		"cli.search_none":              "Keine Server gefunden",
+		"cli.search_usage":             "Projekte: %d",
+		"cli.catalogs":                 "Zusätzliche Kataloge",
+		"cli.catalog_added":            "Katalog hinzugefügt: %s",
+		"cli.catalog_removed":          "Katalog entfernt: %s",
+		"cli.vault_created":            "Tresor erstellt: %s",
+		"cli.no_secrets":               "(keine Geheimnisse)",
+		"cli.secret_usages":            "%d Verwendungen",
+		"cli.secret_added":             "Geheimnis %s zum Tresor hinzugefügt",
+		"cli.secret_updated":           "Geheimnis %s aktualisiert",
+		"cli.secret_server_updated":    "aktualisiert: %s (%s)",
+		"cli.secret_deleted":           "Geheimnis %s gelöscht",
+		"cli.secret_bound":             "%s %s von %s an Geheimnis %s gebunden (%s)",
+		"cli.launcher_hint":            "Claude Code mit dem Launcher (mcp-curator vault launcher) starten, um ${%s} aufzulösen",
+		"cli.launcher_created":         "Launcher erstellt: %s",
+		"cli.launcher_usage":           "Anstelle von `claude` verwenden, um Claude Code mit den als ${NAME} referenzierten Geheimnissen zu starten.",
+		"cli.passphrase_changed":       "Tresor-Passphrase geändert",
+		"cli.prompt_passphrase":        "Tresor-Passphrase: ",
+		"cli.prompt_new_passphrase":    "Neue Tresor-Passphrase: ",
+		"cli.prompt_repeat_passphrase": "Passphrase wiederholen: ",
+		"cli.prompt_secret_value":      "Wert von %s: ",
+		"cli.prompt_new_secret_value":  "Neuer Wert von %s: ",
+		"cli.flag.project_server":      "Projekt des Servers (Standard: global)",
+		"cli.flag.project_add":         "zum angegebenen Projekt hinzufügen (Standard: global)",
+		"cli.flag.project_source":      "Quellprojekt (Standard: global)",
+		"cli.flag.project_promote":     "Projekt der zu befördernden Kopie (Standard: global, um nur die Kopien zu entfernen)",
+		"cli.flag.project_export":      "aus dem angegebenen Projekt exportieren (Standard: global)",
+		"cli.flag.project_import":      "in das angegebene Projekt importieren (Standard: global)",
+		"cli.flag.project_only":        "nur die Server dieses Projekts (alle seine Dateien)",
+		"cli.flag.project_list":        "nur die Server des angegebenen Projekts anzeigen",
+		"cli.flag.scope":               "Projektdatei: project (~/.claude.json), project-file (.mcp.json), project-local (.mcp.local.json)",
+		"cli.flag.type":                "Servertyp: stdio, http, sse",
+		"cli.flag.command":             "auszuführender Befehl (stdio)",
+		"cli.flag.arg":                 "Befehlsargument (wiederholbar)",
+		"cli.flag.url":                 "Server-URL (http/sse)",
+		"cli.flag.env":                 "Umgebungsvariable KEY=value (wiederholbar)",
+		"cli.flag.header":              "HTTP-Header KEY=value (wiederholbar)",
+		"cli.flag.timeout":             "Timeout in Millisekunden",
+		"cli.flag.effective":           "zeigt die effektiven Server des Projekts (Zusammenführung aller Scopes)",
+		"cli.flag.effective_export":    "exportiert die effektiven Server des Projekts (Zusammenführung aller Scopes)",
+		"cli.flag.json":                "Ausgabe im JSON-Format",
+		"cli.flag.json_import":         "Ausgabe im JSON-Format (mit --dry-run oder ohne PATH)",
+		"cli.flag.json_cleanup":        "listet die Projekte im JSON-Format auf, ohne sie zu entfernen",
+		"cli.flag.json_server":         "JSON-Konfiguration des Servers (Flags haben Vorrang)",
+		"cli.flag.reveal":              "zeigt Geheimnisse statt [REDACTED]",
+		"cli.flag.reveal_archive":      "Geheimnisse statt [REDACTED] ins Archiv aufnehmen",
+		"cli.flag.reveal_export":       "Geheimnisse statt [REDACTED] aufnehmen",
+		"cli.flag.template":            "von einer Katalogvorlage ausgehen (siehe `templates`)",
+		"cli.flag.param":               "Vorlagenparameter K=Wert (wiederholbar)",
+		"cli.flag.clear_args":          "entfernt alle Argumente",
+		"cli.flag.unset_env":           "entfernt eine Umgebungsvariable (wiederholbar)",
+		"cli.flag.unset_header":        "entfernt einen HTTP-Header (wiederholbar)",
+		"cli.flag.from_scope":          "Datei des Quellprojekts (project, project-file, project-local)",
+		"cli.flag.to":                  "Ziel: 'global' oder Projektpfad",
+		"cli.flag.to_repeat":           "Ziel: 'global' oder Projektpfad (wiederholbar)",
+		"cli.flag.to_bulk":             "Ziel: 'global' oder Projektpfad (für clone wiederholbar)",
+		"cli.flag.to_global":           "in den globalen Scope verschieben (entspricht --to global)",
+		"cli.flag.to_scope":            "Datei des Zielprojekts (project, project-file, project-local)",
+		"cli.flag.to_scope_many":       "Datei der Zielprojekte (project, project-file, project-local)",
+		"cli.flag.force_one":           "überschreibt einen gleichnamigen Server im Ziel",
+		"cli.flag.force_many":          "überschreibt gleichnamige Server in den Zielen",
+		"cli.flag.force_import":        "ersetzt abweichende Server mit gleichem Namen",
+		"cli.flag.dry_run":             "zeigt, was sich ändern würde, ohne zu schreiben",
+		"cli.flag.dry_run_promote":     "zeigt, was getan würde, ohne zu schreiben",
+		"cli.flag.dry_run_import":      "zeigt, was importiert würde, ohne zu schreiben",
+		"cli.flag.dry_run_cleanup":     "listet die Projekte auf, ohne sie zu entfernen",
+		"cli.flag.archive":             "speichert die Projekteinträge vor dem Entfernen in dieser Datei",
+		"cli.flag.cached":              "verwendet das zuletzt gelesene Inventar ohne Verbindung",
+		"cli.flag.diff":                "zeigt die Server, die sich beim Wiederherstellen jedes Backups ändern würden",
+		"cli.flag.restore_server":      "nur den angegebenen Server wiederherstellen (wiederholbar)",
+		"cli.flag.import_server":       "nur den angegebenen Server importieren (wiederholbar)",
+		"cli.flag.all":                 "zeigt auch identische Server",
+		"cli.flag.depth":               "maximale Suchtiefe (Standard: Einstellungen, sonst 4)",
+		"cli.flag.save_roots":          "fügt die angegebenen Ordner den Einstellungen hinzu",
+		"cli.flag.register":            "registriert alle gefundenen Projekte",
+		"cli.flag.as":                  "Name des globalen Servers (Standard: Name der Kopie)",
+		"cli.flag.keep_copies":         "identische Projektkopien nicht entfernen",
+		"cli.flag.export_format":       "Format: mcp-servers, claude-desktop, cursor, vscode, codex, goose",
+		"cli.flag.output":              "in eine Datei statt auf stdout schreiben",
+		"cli.flag.import_format":       "Dateiformat: claude-desktop, cursor, vscode, codex, goose (Standard: aus dem Pfad abgeleitet)",
+		"cli.flag.filter_type":         "nur Server dieses Typs: stdio, http, sse",
+		"cli.flag.filter_source":       "nur Server dieser Datei: global, project, project-file, project-local",
+		"cli.flag.sort":                "Sortierung: name, type, usage (Projekte, die den Server nutzen)",
+		"cli.flag.unlock":              "entsperrt den Tresor, um auch aufgelöste Werte in den Dateien zu erkennen",
+		"cli.flag.bind_env":            "zu bindende Umgebungsvariable des Servers",
+		"cli.flag.bind_header":         "zu bindender HTTP-Header des Servers",
+		"cli.flag.secret":              "Name des Geheimnisses (Standard: der env- oder header-Schlüssel); wird angelegt, falls nicht vorhanden",
+		"cli.flag.inline":              "den aufgelösten Wert statt ${NAME} in die Datei schreiben",
+		"cli.shadows":                  "überschreibt %s",
+		"cli.file_servers":             "%s: %d Server",
+		"cli.server_count":             "%d Server",
+		"cli.template_default":         "Standard %s",
 	}
 
 	// Spanish
@@ -427,8 +2537,6 @@ func (t *Translator) loadStrings() {
 		"dialog.delete_server":         "Eliminar Servidor",
 		"dialog.delete_confirm":        "¿Estás seguro de que deseas eliminar el servidor '%s'?",
 		"dialog.move_server":           "Mover Servidor",
-		"dialog.move_to_project":       "Selecciona el proyecto de destino:",
-		"dialog.move_to_global":        "¿Deseas mover el servidor '%s' al ámbito global?",
 		"dialog.no_projects":           "Sin Proyectos",
 		"dialog.no_projects_msg":       "No hay proyectos configurados a los que mover el servidor.",
 		"dialog.clone_server":          "Clonar Servidor",
@@ -450,11 +2558,525 @@ func (t *Translator) loadStrings() {
 		"form.command":        "Comando",
 		"form.command_hint":   "Comando (ej: uvx, npx)",
 		"form.args":           "Argumentos",
-		"form.args_hint":      "Argumentos separados por espacio (ej: -y mcp-server)",
 		"form.url":            "URL",
 		"form.url_hint":       "URL (para http/sse)",
 		"form.env":            "Variables de Entorno",
-		"form.env_hint":       "CLAVE=valor, CLAVE2=valor2 (separados por coma)",
+		"form.file":                "Archivo",
+		"form.file_settings":       "~/.claude.json (ajustes del proyecto)",
+		"form.file_mcp_json":       ".mcp.json (compartido)",
+		"form.file_mcp_local":      ".mcp.local.json (local)",
+		"form.project_required":    "Seleccione un proyecto",
+		"dialog.move_to":           "Seleccione el destino:",
+		"dialog.clone_file":        "Archivo de destino en los proyectos:",
+		"dialog.overwrite_title":   "El servidor ya existe",
+		"dialog.overwrite_confirm": "Ya existe un servidor '%s' en %s. ¿Desea sobrescribirlo?",
+		"conflict.title":       "Configuración modificada externamente",
+		"conflict.message":     "%s fue modificado por otro programa (p. ej. Claude Code) después de cargarlo. Los demás cambios se conservarán; elija qué versión mantener para los servidores modificados en ambos lados.",
+		"conflict.mine":        "Mi versión",
+		"conflict.theirs":      "Versión en disco",
+		"conflict.keep_mine":   "Mantener la mía",
+		"conflict.keep_theirs": "Usar la del disco",
+		"conflict.removed":     "(eliminado)",
+		"conflict.apply":       "Aplicar",
+		"banner.changed_externally": "Configuración modificada externamente: %s",
+		"banner.added":              "%d añadidos",
+		"banner.modified":           "%d modificados",
+		"banner.removed":            "%d eliminados",
+		"toolbar.backups":         "Copias",
+		"backup.title":            "Copias de seguridad de ~/.claude.json",
+		"backup.select":           "Seleccione una copia para ver las diferencias",
+		"backup.none":             "No hay copias disponibles",
+		"backup.no_changes":       "Los servidores MCP de la copia coinciden con los actuales",
+		"backup.diff_hint":        "Efecto de la restauración en los servidores MCP (seleccione los que desea restaurar):",
+		"backup.change_added":     "se añadirá",
+		"backup.change_removed":   "se eliminará",
+		"backup.change_modified":  "se restaurará",
+		"backup.restore_selected": "Restaurar seleccionados",
+		"backup.restore_all":      "Restaurar todo",
+		"backup.nothing_selected": "Ningún servidor seleccionado",
+		"backup.restore_confirm":  "¿Restaurar %d servidores de la copia del %s? El resto de ~/.claude.json no se modificará.",
+		"backup.retention":        "Retención",
+		"backup.max_count":        "Número máximo",
+		"backup.max_age":          "Antigüedad máxima (días, 0 = ilimitada)",
+		"backup.dir":              "Directorio",
+		"backup.dir_hint":         "Junto a ~/.claude.json",
+		"toolbar.history":    "Historial",
+		"btn.close":          "Cerrar",
+		"history.title":      "Historial de cambios",
+		"history.empty":      "No hay cambios en esta sesión",
+		"history.undone":     "deshecha",
+		"history.op_add":     "Añadido '%s'",
+		"history.op_update":  "Editado '%s'",
+		"history.op_remove":  "Eliminado '%s'",
+		"history.op_move":    "Movido '%s'",
+		"history.op_clone":   "Clonado '%s'",
+		"history.op_restore": "Restauración desde %s",
+		"btn.test":                 "Probar",
+		"test.title":               "Prueba de conexión: %s",
+		"test.running":             "Handshake MCP con %s en curso (tiempo límite %s)...",
+		"test.success":             "Handshake completado en %s",
+		"test.failed":              "Handshake fallido",
+		"test.protocol":            "Versión del protocolo",
+		"test.server_info":         "Servidor",
+		"test.capabilities":        "Capacidades",
+		"test.instructions":        "Instrucciones",
+		"test.none":                "ninguna",
+		"test.reason":              "Motivo",
+		"test.detail":              "Detalle",
+		"test.stderr":              "Salida stderr",
+		"test.failure_not_found":   "Comando no encontrado",
+		"test.failure_exited":      "El proceso terminó con código %d",
+		"test.failure_timeout":     "Sin respuesta en %s",
+		"test.failure_tls":         "Error TLS",
+		"test.failure_http_status": "El servidor respondió HTTP %d",
+		"test.failure_network":     "Error de red",
+		"test.failure_protocol":    "Respuesta no conforme al protocolo MCP",
+		"test.failure_config":      "Configuración del servidor incompleta",
+		"inventory.title":          "Inventario",
+		"inventory.load":           "Leer inventario",
+		"inventory.refresh":        "Actualizar",
+		"inventory.not_loaded":     "Conéctate al servidor para ver las herramientas, recursos y prompts que expone",
+		"inventory.loading":        "Leyendo herramientas, recursos y prompts de %s (tiempo límite %s)...",
+		"inventory.fetched_at":     "Leído el %s",
+		"inventory.tools":          "Herramientas (%d)",
+		"inventory.resources":      "Recursos (%d)",
+		"inventory.prompts":        "Prompts (%d)",
+		"inventory.schema":         "Esquema de entrada",
+		"inventory.no_description": "Sin descripción",
+		"inventory.list_error":     "%s falló: %s",
+		"tree.tool_count":          "%d herramientas",
+		"validation.name_required":       "el nombre es obligatorio",
+		"validation.name_invalid":        "el nombre solo puede contener letras, números, '-' y '_' (las herramientas pasan a ser mcp__<nombre>__<herramienta>)",
+		"validation.name_too_long":       "nombre largo: los nombres mcp__<nombre>__<herramienta> podrían superar 64 caracteres",
+		"validation.type_invalid":        "tipo desconocido: %s (valores permitidos: stdio, http, sse)",
+		"validation.type_missing":        "indica un comando (stdio) o una URL (http/sse)",
+		"validation.command_required":    "el comando es obligatorio para los servidores stdio",
+		"validation.command_with_url":    "un servidor no puede tener comando y URL a la vez",
+		"validation.command_for_remote":  "los servidores %s ignoran el comando",
+		"validation.command_has_spaces":  "el comando contiene espacios: los argumentos van en args",
+		"validation.args_empty":          "argumento vacío en la posición %s",
+		"validation.args_for_remote":     "los servidores remotos ignoran los args",
+		"validation.url_required":        "la URL es obligatoria para los servidores %s",
+		"validation.url_for_stdio":       "los servidores stdio ignoran la URL",
+		"validation.url_invalid":         "URL no válida: %s",
+		"validation.url_scheme":          "esquema de URL no admitido: %s (usa http o https)",
+		"validation.url_insecure":        "conexión sin cifrar (http) a un host remoto: %s",
+		"validation.sse_deprecated":      "el transporte SSE está obsoleto: usa http si el servidor lo admite",
+		"validation.env_name_empty":      "nombre de variable de entorno vacío",
+		"validation.env_name_invalid":    "nombre de variable de entorno inusual: %s",
+		"validation.headers_for_stdio":   "los servidores stdio ignoran los headers",
+		"validation.header_name_invalid": "nombre de header no válido: %s",
+		"validation.timeout_negative":    "el tiempo límite no puede ser negativo",
+		"validation.title":               "Problemas de configuración",
+		"validation.invalid_server":      "El servidor %s no es válido",
+		"validation.variable_missing":   "la variable ${%s} no está definida y no tiene valor por defecto",
+		"detail.headers":                "Headers",
+		"detail.expanded":               "expandido",
+		"detail.missing_variables":      "Variables de entorno que faltan",
+		"detail.missing_variables_hint": "No definidas en el entorno y sin valor por defecto: los servidores que las usan podrían no arrancar.",
+		"toolbar.vault":             "Bóveda",
+		"history.op_bind":           "Secreto '%s' vinculado",
+		"history.op_rotate":         "Rotación del secreto '%s'",
+		"vault.title":               "Bóveda de secretos",
+		"vault.intro":               "Los secretos se cifran con una frase de contraseña en %s. Env y headers pueden usarlos como ${NOMBRE}: al iniciar Claude Code con el lanzador, los valores se exportan sin aparecer en los archivos de configuración.",
+		"vault.create":              "Crear bóveda",
+		"vault.passphrase":          "Frase de contraseña",
+		"vault.passphrase_confirm":  "Repetir frase de contraseña",
+		"vault.passphrase_mismatch": "Las frases de contraseña no coinciden",
+		"vault.unlock":              "Desbloquear",
+		"vault.lock":                "Bloquear",
+		"vault.locked_hint":         "La bóveda está bloqueada: introduce la frase de contraseña para gestionar los secretos.",
+		"vault.no_secrets":          "No hay secretos en la bóveda",
+		"vault.usages":              "%d usos",
+		"vault.add_secret":          "Añadir secreto",
+		"vault.secret_name":         "Nombre",
+		"vault.secret_value":        "Valor",
+		"vault.rotate":              "Rotar",
+		"vault.rotate_title":        "Rotar %s",
+		"vault.new_value":           "Nuevo valor",
+		"vault.rotated":             "Secreto %s actualizado: %d servidores modificados",
+		"vault.delete_confirm":      "¿Eliminar el secreto %s de la bóveda?",
+		"vault.in_use":              "El secreto %s todavía se usa como ${%s} en:",
+		"vault.change_passphrase":   "Cambiar frase de contraseña",
+		"vault.launcher":            "Generar lanzador",
+		"vault.launcher_created":    "Lanzador creado en %s. Úsalo en lugar de `claude` para iniciar Claude Code con los secretos referenciados como ${NOMBRE}.",
+		"vault.copy_path":           "Copiar ruta",
+		"vault.mode":                "Modo",
+		"vault.mode_reference":      "referencia ${NOMBRE}",
+		"vault.mode_inline":         "valor resuelto en el archivo",
+		"vault.binding":             "bóveda: %s (%s)",
+		"vault.bind":                "Bóveda",
+		"vault.bind_title":          "Vincular %s a la bóveda",
+		"vault.bind_hint":           "Si el secreto no existe, se crea con el valor actual.",
+		"vault.needs_unlock":        "Desbloquea la bóveda para vincular valores a secretos.",
+		"detail.reveal": "Mostrar",
+		"detail.hide":   "Ocultar",
+		"btn.copy_json": "Copiar JSON",
+		"form.headers":                  "Encabezados",
+		"form.timeout":                  "Tiempo límite (ms)",
+		"form.timeout_hint":             "Vacío: valor predeterminado de Claude Code",
+		"form.add_row":                  "Añadir",
+		"form.add_arg":                  "Añadir argumento",
+		"form.paste_command":            "Importar",
+		"form.paste_command_hint":       "Pega una línea de comandos (ej.: npx -y pkg --dir \"My Docs\")",
+		"form.env_name_hint":            "NOMBRE",
+		"form.header_name_hint":         "Nombre del encabezado",
+		"form.value_hint":               "Valor",
+		"form.preview":                  "JSON que se escribirá",
+		"validation.command_line_quote": "línea de comandos no válida: comilla sin cerrar",
+		"validation.key_duplicate":      "clave repetida: %s (prevalece la última fila)",
+		"validation.timeout_invalid":    "el tiempo límite debe ser un número entero de milisegundos",
+		"dialog.add_via_template":        "Desde plantilla",
+		"template.title":                 "Plantillas de servidores",
+		"template.select":                "Selecciona una plantilla",
+		"template.filter":                "Buscar plantillas...",
+		"template.source":                "Catálogo: %s",
+		"template.add":                   "Añadir servidor",
+		"template.catalogs":              "Catálogos",
+		"template.catalogs_hint":         "Catálogos adicionales (archivos JSON o carpetas compartidas por el equipo) que se leen además del incluido; una plantilla con el mismo ID reemplaza a la incluida.",
+		"template.no_catalogs":           "Ningún catálogo adicional",
+		"template.add_file":              "Añadir archivo...",
+		"template.add_folder":            "Añadir carpeta...",
+		"validation.template_catalog":    "%s",
+		"validation.template_parameters": "%s",
+		"toolbar.import":                  "Importar",
+		"history.op_import":               "Importación desde %s",
+		"import.title":                    "Importar desde otros clientes",
+		"import.source":                   "Archivo de origen",
+		"import.select_source":            "Selecciona un archivo de configuración",
+		"import.no_sources":               "No se encontró configuración de otros clientes",
+		"import.format":                   "Formato",
+		"import.browse":                   "Examinar...",
+		"import.target":                   "Destino",
+		"import.overwrite":                "Reemplazar servidores distintos con el mismo nombre",
+		"import.run":                      "Importar seleccionados",
+		"import.empty":                    "No hay servidores MCP en el archivo",
+		"import.partial":                  "Se importaron %d servidores, algunos se omitieron:",
+		"import.done":                     "Se importaron %d servidores en %s",
+		"import.status_new":               "Nuevo",
+		"import.status_identical":         "Ya presente",
+		"import.status_conflict":          "Conflicto",
+		"validation.import_field_ignored": "campo '%s' no admitido por Claude Code: no importado",
+		"validation.import_variable":      "variable %s del cliente de origen no admitida: reemplázala por un valor o ${VAR}",
+		"validation.import_disabled":      "servidor desactivado en el cliente de origen",
+		"validation.import_renamed":       "nombre original '%s' no utilizable: renombrado",
+		"validation.import_conflict":      "ya existe un servidor distinto con este nombre en el destino",
+		"validation.import_invalid":       "entrada no válida, no se puede importar: %s",
+		"import.format_claude-desktop":    "Claude Desktop",
+		"import.format_cursor":            "Cursor",
+		"import.format_vscode":            "VS Code",
+		"import.format_codex":             "Codex CLI",
+		"import.format_goose":             "Goose",
+		"validation.import_read":          "%s",
+		"toolbar.export":                      "Exportar",
+		"export.title":                        "Exportar a otros clientes",
+		"export.source":                       "Servidores",
+		"export.format":                       "Formato",
+		"export.servers":                      "Servidores a exportar",
+		"export.preview":                      "Vista previa (secretos ocultos)",
+		"export.effective":                    "%s — servidores efectivos",
+		"export.empty":                        "No hay servidores en esta ubicación",
+		"export.include_secrets":              "Incluir los secretos en el archivo guardado",
+		"export.copy":                         "Copiar",
+		"export.save":                         "Guardar en archivo…",
+		"export.done":                         "%d servidores exportados a %s",
+		"export.format_mcp-servers":           "Fragmento mcpServers (.mcp.json)",
+		"export.format_claude-desktop":        "Claude Desktop",
+		"export.format_cursor":                "Cursor",
+		"export.format_vscode":                "VS Code",
+		"export.format_codex":                 "Codex CLI (TOML)",
+		"export.format_goose":                 "Goose (YAML)",
+		"validation.export_field_unsupported": "el campo '%s' no se puede representar en el formato de destino: no exportado",
+		"validation.export_type_unsupported":  "servidores %s no soportados por el formato de destino: servidor no exportado",
+		"validation.export_variable":          "%s se escribirá literalmente: el cliente de destino no expande variables",
+		"validation.export_variable_default":  "el valor por defecto de %s no es compatible con el cliente de destino",
+		"detail.effective_configs":    "Configuración Efectiva",
+		"detail.no_effective_servers": "No hay servidores activos en este proyecto",
+		"detail.shadowing_count":      "%d sobrescritos",
+		"detail.shadows":              "      sobrescribe: %s",
+		"detail.show_diff":            "Diferencias",
+		"detail.diff_title":           "Definiciones de %s",
+		"detail.diff_against":         "%s respecto a %s",
+		"detail.diff_identical":       "Definición idéntica",
+		"toolbar.duplicates":       "Duplicados",
+		"history.op_promote":       "'%s' promovido a global",
+		"duplicates.title":         "Servidores duplicados",
+		"duplicates.hint":          "Servidores con el mismo comando y argumentos (o la misma URL) presentes en varios proyectos. Al promoverlos a globales, las copias idénticas sobran y se eliminan en una sola operación que se puede deshacer.",
+		"duplicates.none":          "No hay servidores duplicados",
+		"duplicates.summary":       "copias: %d, distintas: %d",
+		"duplicates.promote":       "Promover a global",
+		"duplicates.definition":    "Definición",
+		"duplicates.global_name":   "Nombre global",
+		"duplicates.remove_copies": "Copias a eliminar:",
+		"toolbar.cleanup":         "Limpieza",
+		"tree.missing":            "carpeta no encontrada",
+		"detail.project_missing":  "La carpeta del proyecto ya no existe: su entrada ocupa %s en ~/.claude.json y se puede eliminar.",
+		"cleanup.title":           "Limpieza de proyectos",
+		"cleanup.hint":            "Proyectos registrados en ~/.claude.json cuya carpeta se eliminó o movió. Al quitarlos se borran sus servidores y los datos de Claude Code (historial, permisos, estado); antes de escribir se crea una copia de seguridad del archivo.",
+		"cleanup.none":            "No hay proyectos con la carpeta ausente",
+		"cleanup.project_details": "%d servidores · %s",
+		"cleanup.total":           "Seleccionados: %d proyectos (%s)",
+		"cleanup.archive_first":   "Guardar un archivo de las entradas antes de eliminarlas",
+		"cleanup.include_secrets": "Incluir los secretos en el archivo",
+		"cleanup.export_only":     "Exportar archivo…",
+		"cleanup.remove":          "Eliminar seleccionados",
+		"cleanup.confirm":         "¿Eliminar %d proyectos (%s) de ~/.claude.json?",
+		"cleanup.archived":        "%d proyectos archivados en %s",
+		"cleanup.removed":         "%d proyectos eliminados",
+		"toolbar.discover":          "Descubrir",
+		"tree.discovered":           "Proyectos descubiertos",
+		"detail.discovered_project": "Proyecto descubierto",
+		"detail.discovered_hint":    "Esta carpeta contiene una configuración MCP pero no está registrada en ~/.claude.json. Al registrarla aparece entre los proyectos con los servidores de sus archivos.",
+		"discovery.title":           "Descubrir proyectos",
+		"discovery.hint":            "Busca en las carpetas indicadas (p. ej. ~/src) proyectos con .mcp.json o .mcp.local.json que aún no están registrados en ~/.claude.json. Se omiten las carpetas ocultas, node_modules y vendor.",
+		"discovery.roots":           "Carpetas donde buscar",
+		"discovery.no_roots":        "Ninguna carpeta: añade una",
+		"discovery.add_root":        "Añadir carpeta…",
+		"discovery.scan":            "Buscar",
+		"discovery.none":            "No se encontraron proyectos sin registrar",
+		"discovery.register":        "Registrar seleccionados",
+		"discovery.register_one":    "Registrar proyecto",
+		"discovery.registered":      "%d proyectos registrados",
+		"tree.search_placeholder":          "Buscar por nombre, comando, argumentos, URL, env o ruta…",
+		"tree.filter_all_types":            "Todos los tipos",
+		"tree.filter_all_sources":          "Todos los archivos",
+		"tree.filter_source_global":        "Global (~/.claude.json)",
+		"tree.filter_source_project":       "Proyecto (~/.claude.json)",
+		"tree.filter_source_project_file":  ".mcp.json",
+		"tree.filter_source_project_local": ".mcp.local.json",
+		"tree.sort_name":                   "Ordenar por nombre",
+		"tree.sort_type":                   "Ordenar por tipo",
+		"tree.sort_usage":                  "Ordenar por proyectos",
+		"tree.multi_select":      "Selección múltiple",
+		"bulk.title":             "Operación en bloque",
+		"bulk.selected":          "%d servidores seleccionados",
+		"bulk.delete":            "Eliminar",
+		"bulk.move":              "Mover",
+		"bulk.clone":             "Clonar",
+		"bulk.export":            "Exportar",
+		"bulk.clear":             "Deseleccionar",
+		"bulk.move_title":        "Mover %d servidores",
+		"bulk.clone_title":       "Clonar %d servidores",
+		"bulk.summary":           "Servidores seleccionados: %d — cambios: %s",
+		"bulk.skipped_exists":    "'%s' ya existe en %s: omitido",
+		"bulk.overwrite":         "Sobrescribir los servidores que ya existen en los destinos",
+		"bulk.apply":             "Aplicar",
+		"bulk.nothing":           "No hay cambios que aplicar",
+		"export.selection":       "Selección del árbol (%d servidores)",
+		"history.op_bulk_remove": "%s servidores eliminados",
+		"history.op_bulk_move":   "%s servidores movidos",
+		"history.op_bulk_clone":  "%s servidores clonados",
+		"toolbar.compare":         "Comparar",
+		"compare.title":           "Comparar",
+		"compare.hint":            "Compara dos servidores, las configuraciones efectivas de dos proyectos o un proyecto y el ámbito global. Las flechas copian un valor o la definición completa al otro lado, en el archivo donde está escrito el servidor.",
+		"compare.left":            "Izquierda",
+		"compare.right":           "Derecha",
+		"compare.effective":       "%s (configuración efectiva)",
+		"compare.show_identical":  "Mostrar servidores idénticos",
+		"compare.pick_sides":      "Elige los dos lados a comparar",
+		"compare.same_side":       "Los dos lados coinciden",
+		"compare.mismatch":        "Un servidor solo se compara con otro servidor",
+		"compare.summary":         "Idénticos: %d, distintos: %d, solo a la izquierda: %d, solo a la derecha: %d",
+		"compare.empty":           "Ningún servidor en ninguno de los lados",
+		"compare.only_left":       "Solo a la izquierda, en %s",
+		"compare.only_right":      "Solo a la derecha, en %s",
+		"compare.clone_to":        "Copiar a %s",
+		"compare.identical":       "Idénticos",
+		"compare.copy_definition": "Definición",
+		"toolbar.tools": "Herramientas",
+		"cli.usage_line":               "uso: mcp-curator %s",
+		"cli.error":                    "error: %s",
+		"cli.unknown_command":          "comando desconocido: %s",
+		"cli.unknown_lang":             "idioma no soportado: %s (disponibles: %s)",
+		"cli.help_gui":                 "Sin argumentos inicia la interfaz gráfica.",
+		"cli.help_usage":               "Uso: mcp-curator [--config PATH] [--lang IDIOMA] <comando> [argumentos]",
+		"cli.help_commands":            "Comandos:",
+		"cli.help_scope":               "Sin --project los comandos actúan sobre el ámbito global.\n--scope elige el archivo del proyecto: project (~/.claude.json, predeterminado),\nproject-file (.mcp.json) o project-local (.mcp.local.json).",
+		"cli.flag_config":              "ruta alternativa de ~/.claude.json",
+		"cli.flag_lang":                "idioma de salida: IT, EN, FR, DE, ES, PT, JA, KO, CN, UK",
+		"cli.cmd.list":                 "Lista los servidores globales y de proyecto (secretos ocultos salvo --reveal)",
+		"cli.cmd.show":                 "Muestra la configuración de un servidor (secretos ocultos salvo --reveal)",
+		"cli.cmd.add":                  "Añade un servidor, también desde una plantilla del catálogo",
+		"cli.cmd.update":               "Modifica los campos indicados de un servidor",
+		"cli.cmd.remove":               "Elimina un servidor",
+		"cli.cmd.move":                 "Mueve un servidor entre ámbitos y archivos de proyecto",
+		"cli.cmd.clone":                "Copia un servidor a otros ámbitos",
+		"cli.cmd.bulk":                 "Elimina, mueve o copia en una sola operación los servidores seleccionados como con search",
+		"cli.cmd.import":               "Importa los servidores de Claude Desktop, Cursor, VS Code, Codex o Goose (sin PATH lista los archivos encontrados)",
+		"cli.cmd.export":               "Exporta los servidores para Claude Desktop, Cursor, VS Code, Codex, Goose o como fragmento mcpServers",
+		"cli.cmd.search":               "Busca servidores en todos los ámbitos por nombre, comando, argumentos, URL, claves env o ruta del proyecto",
+		"cli.cmd.compare":              "Compara campo por campo dos servidores (NOMBRE@global, NOMBRE@PATH), dos proyectos (PATH) o un proyecto y global",
+		"cli.cmd.duplicates":           "Encuentra los servidores con varias copias entre el ámbito global y los proyectos, con las diferencias",
+		"cli.cmd.promote":              "Hace global un servidor y elimina las copias de proyecto idénticas",
+		"cli.cmd.discover":             "Busca las carpetas con .mcp.json o .mcp.local.json aún no registradas como proyectos",
+		"cli.cmd.register":             "Registra carpetas como proyectos en ~/.claude.json",
+		"cli.cmd.cleanup":              "Elimina de ~/.claude.json los proyectos cuya carpeta ya no existe, con archivo opcional",
+		"cli.cmd.validate":             "Comprueba la configuración de todos los servidores",
+		"cli.cmd.test":                 "Ejecuta el handshake MCP initialize con un servidor",
+		"cli.cmd.inventory":            "Lista las herramientas, recursos y prompts que expone un servidor",
+		"cli.cmd.templates":            "Lista las plantillas de servidor y gestiona los catálogos adicionales del equipo",
+		"cli.cmd.vault":                "Gestiona el almacén cifrado de secretos usados en env y headers",
+		"cli.cmd.backups":              "Lista las copias de seguridad de ~/.claude.json y las diferencias con el archivo actual",
+		"cli.cmd.restore":              "Restaura los servidores MCP desde una copia de seguridad",
+		"cli.cmd.version":              "Muestra la versión",
+		"cli.location_global":          "ámbito global",
+		"cli.location_project":         "proyecto %s",
+		"cli.source_global":            "global",
+		"cli.source_project":           "proyecto",
+		"cli.severity_error":           "error",
+		"cli.severity_warning":         "aviso",
+		"cli.warning":                  "Atención: %s",
+		"cli.absent":                   "(ausente)",
+		"cli.no_servers":               "(ningún servidor)",
+		"cli.field_name":               "Nombre",
+		"cli.field_type":               "Tipo",
+		"cli.field_command":            "Comando",
+		"cli.field_category":           "Categoría",
+		"cli.field_description":        "Descripción",
+		"cli.field_catalog":            "Catálogo",
+		"cli.field_parameter":          "Parámetro",
+		"cli.field_required":           "obligatorio",
+		"cli.field_result":             "Resultado",
+		"cli.field_protocol":           "Protocolo",
+		"cli.field_error":              "Error",
+		"cli.field_destination":        "Destino",
+		"cli.list_global":              "Global (%s)",
+		"cli.list_projects":            "Proyectos",
+		"cli.list_project_missing":     "carpeta no encontrada: ver cleanup",
+		"cli.server_added":             "Servidor '%s' añadido en %s",
+		"cli.server_updated":           "Servidor '%s' actualizado",
+		"cli.server_removed":           "Servidor '%s' eliminado de %s",
+		"cli.server_moved":             "Servidor '%s' movido a %s",
+		"cli.server_cloned":            "Servidor '%s' clonado en %d destinos",
+		"cli.test_ok":                  "OK (%dms)",
+		"cli.test_failed":              "FALLIDO (%s)",
+		"cli.inventory_header":         "%s %s (protocolo %s, leído el %s)",
+		"cli.inventory_tools":          "Herramientas (%d):",
+		"cli.inventory_resources":      "Recursos (%d):",
+		"cli.inventory_prompts":        "Prompts (%d):",
+		"cli.inventory_failed":         "%s falló: %s",
+		"cli.valid_servers":            "%d servidores válidos",
+		"cli.no_backups":               "(ninguna copia de seguridad)",
+		"cli.nothing_to_restore":       "Ninguna diferencia que restaurar",
+		"cli.restored":                 "Restaurados %d servidores desde %s",
+		"cli.bulk_none":                "Ningún servidor seleccionado",
+		"cli.bulk_plan":                "Servidores seleccionados: %d, cambios: %s",
+		"cli.bulk_done":                "Servidores seleccionados: %d, cambios guardados: %s",
+		"cli.cleanup_none":             "Ningún proyecto con la carpeta ausente",
+		"cli.cleanup_plan":             "%d proyectos por eliminar (%s)",
+		"cli.archive_saved":            "Archivo guardado en %s",
+		"cli.cleanup_done":             "Eliminados %d proyectos de %s (%s)",
+		"cli.compare_left_only":        "solo a la izquierda (%s)",
+		"cli.compare_right_only":       "solo a la derecha (%s)",
+		"cli.compare_identical":        "Servidores idénticos: %d (--all para mostrarlos)",
+		"cli.discover_none":            "No se encontró ningún proyecto sin registrar",
+		"cli.discover_hint":            "Para registrarlos: mcp-curator discover --register, o mcp-curator register PATH",
+		"cli.registered_count":         "Registrados %d proyectos en %s",
+		"cli.already_registered":       "%s: ya registrado",
+		"cli.registered":               "Registrado %s (%s)",
+		"cli.no_mcp_files":             "ningún archivo MCP",
+		"cli.duplicates_none":          "Ningún servidor duplicado",
+		"cli.duplicates_group":         "copias: %d, distintas: %d",
+		"cli.promote_hint":             "elimina las copias de proyecto idénticas",
+		"cli.promote_plan":             "'%s' pasaría a ser global, copias de proyecto eliminadas: %d",
+		"cli.promote_done":             "Servidor '%s' global, copias de proyecto eliminadas: %d",
+		"cli.exported":                 "Exportados %d servidores a %s",
+		"cli.import_empty":             "Ningún servidor MCP en %s",
+		"cli.imported":                 "Importados %d servidores en %s",
+		"cli.import_nothing":           "Ningún servidor que importar: ya están todos",
+		"cli.import_no_sources":        "No se encontró ningún archivo de configuración de otros clientes",
+		"cli.search_none":              "No se encontró ningún servidor",
+		"cli.search_usage":             "proyectos: %d",
+		"cli.catalogs":                 "Catálogos adicionales",
+		"cli.catalog_added":            "Catálogo añadido: %s",
+		"cli.catalog_removed":          "Catálogo eliminado: %s",
+		"cli.vault_created":            "Almacén creado: %s",
+		"cli.no_secrets":               "(ningún secreto)",
+		"cli.secret_usages":            "%d usos",
+		"cli.secret_added":             "Secreto %s añadido al almacén",
+		"cli.secret_updated":           "Secreto %s actualizado",
+		"cli.secret_server_updated":    "actualizado %s (%s)",
+		"cli.secret_deleted":           "Secreto %s eliminado",
+		"cli.secret_bound":             "%s %s de %s vinculado al secreto %s (%s)",
+		"cli.launcher_hint":            "Inicie Claude Code con el lanzador (mcp-curator vault launcher) para resolver ${%s}",
+		"cli.launcher_created":         "Lanzador creado: %s",
+		"cli.launcher_usage":           "Úselo en lugar de `claude` para iniciar Claude Code con los secretos referenciados como ${NOMBRE}.",
+		"cli.passphrase_changed":       "Frase de contraseña del almacén cambiada",
+		"cli.prompt_passphrase":        "Frase de contraseña del almacén: ",
+		"cli.prompt_new_passphrase":    "Nueva frase de contraseña del almacén: ",
+		"cli.prompt_repeat_passphrase": "Repita la frase de contraseña: ",
+		"cli.prompt_secret_value":      "Valor de %s: ",
+		"cli.prompt_new_secret_value":  "Nuevo valor de %s: ",
+		"cli.flag.project_server":      "proyecto del servidor (predeterminado: global)",
+		"cli.flag.project_add":         "añadir al proyecto indicado (predeterminado: global)",
+		"cli.flag.project_source":      "proyecto de origen (predeterminado: global)",
+		"cli.flag.project_promote":     "proyecto de la copia que promover (predeterminado: global, para eliminar solo las copias)",
+		"cli.flag.project_export":      "exportar desde el proyecto indicado (predeterminado: global)",
+		"cli.flag.project_import":      "importar en el proyecto indicado (predeterminado: global)",
+		"cli.flag.project_only":        "solo los servidores de este proyecto (todos sus archivos)",
+		"cli.flag.project_list":        "muestra solo los servidores del proyecto indicado",
+		"cli.flag.scope":               "archivo del proyecto: project (~/.claude.json), project-file (.mcp.json), project-local (.mcp.local.json)",
+		"cli.flag.type":                "tipo de servidor: stdio, http, sse",
+		"cli.flag.command":             "comando que ejecutar (stdio)",
+		"cli.flag.arg":                 "argumento del comando (repetible)",
+		"cli.flag.url":                 "URL del servidor (http/sse)",
+		"cli.flag.env":                 "variable de entorno KEY=value (repetible)",
+		"cli.flag.header":              "cabecera HTTP KEY=value (repetible)",
+		"cli.flag.timeout":             "tiempo de espera en milisegundos",
+		"cli.flag.effective":           "muestra los servidores efectivos del proyecto (fusión de todos los ámbitos)",
+		"cli.flag.effective_export":    "exporta los servidores efectivos del proyecto (fusión de todos los ámbitos)",
+		"cli.flag.json":                "salida en formato JSON",
+		"cli.flag.json_import":         "salida en formato JSON (con --dry-run o sin PATH)",
+		"cli.flag.json_cleanup":        "lista los proyectos en formato JSON sin eliminarlos",
+		"cli.flag.json_server":         "configuración JSON del servidor (los flags tienen prioridad)",
+		"cli.flag.reveal":              "muestra los secretos en lugar de [REDACTED]",
+		"cli.flag.reveal_archive":      "incluye los secretos en el archivo en lugar de [REDACTED]",
+		"cli.flag.reveal_export":       "incluye los secretos en lugar de [REDACTED]",
+		"cli.flag.template":            "partir de una plantilla del catálogo (ver `templates`)",
+		"cli.flag.param":               "parámetro de la plantilla K=valor (repetible)",
+		"cli.flag.clear_args":          "elimina todos los argumentos",
+		"cli.flag.unset_env":           "elimina una variable de entorno (repetible)",
+		"cli.flag.unset_header":        "elimina una cabecera HTTP (repetible)",
+		"cli.flag.from_scope":          "archivo del proyecto de origen (project, project-file, project-local)",
+		"cli.flag.to":                  "destino: 'global' o ruta de proyecto",
+		"cli.flag.to_repeat":           "destino: 'global' o ruta de proyecto (repetible)",
+		"cli.flag.to_bulk":             "destino: 'global' o ruta de proyecto (repetible para clone)",
+		"cli.flag.to_global":           "mueve al ámbito global (equivale a --to global)",
+		"cli.flag.to_scope":            "archivo del proyecto de destino (project, project-file, project-local)",
+		"cli.flag.to_scope_many":       "archivo de los proyectos de destino (project, project-file, project-local)",
+		"cli.flag.force_one":           "sobrescribe un servidor con el mismo nombre en el destino",
+		"cli.flag.force_many":          "sobrescribe los servidores con el mismo nombre en los destinos",
+		"cli.flag.force_import":        "sustituye los servidores distintos con el mismo nombre",
+		"cli.flag.dry_run":             "muestra qué cambiaría sin escribir",
+		"cli.flag.dry_run_promote":     "muestra qué se haría sin escribir",
+		"cli.flag.dry_run_import":      "muestra qué se importaría sin escribir",
+		"cli.flag.dry_run_cleanup":     "lista los proyectos sin eliminarlos",
+		"cli.flag.archive":             "guarda las entradas de los proyectos en este archivo antes de eliminarlas",
+		"cli.flag.cached":              "usa el último inventario leído sin conectarse",
+		"cli.flag.diff":                "muestra los servidores que cambiarían al restaurar cada copia de seguridad",
+		"cli.flag.restore_server":      "restaura solo el servidor indicado (repetible)",
+		"cli.flag.import_server":       "importa solo el servidor indicado (repetible)",
+		"cli.flag.all":                 "muestra también los servidores idénticos",
+		"cli.flag.depth":               "profundidad máxima de búsqueda (predeterminado: preferencias, si no 4)",
+		"cli.flag.save_roots":          "añade las carpetas indicadas a las preferencias",
+		"cli.flag.register":            "registra todos los proyectos encontrados",
+		"cli.flag.as":                  "nombre del servidor global (predeterminado: el nombre de la copia)",
+		"cli.flag.keep_copies":         "no eliminar las copias de proyecto idénticas",
+		"cli.flag.export_format":       "formato: mcp-servers, claude-desktop, cursor, vscode, codex, goose",
+		"cli.flag.output":              "escribir en un archivo en lugar de stdout",
+		"cli.flag.import_format":       "formato del archivo: claude-desktop, cursor, vscode, codex, goose (predeterminado: deducido de la ruta)",
+		"cli.flag.filter_type":         "solo los servidores de este tipo: stdio, http, sse",
+		"cli.flag.filter_source":       "solo los servidores de este archivo: global, project, project-file, project-local",
+		"cli.flag.sort":                "orden: name, type, usage (proyectos que usan el servidor)",
+		"cli.flag.unlock":              "desbloquea el almacén para reconocer también los valores resueltos en los archivos",
+		"cli.flag.bind_env":            "variable de entorno del servidor que vincular",
+		"cli.flag.bind_header":         "cabecera HTTP del servidor que vincular",
+		"cli.flag.secret":              "nombre del secreto (predeterminado: la clave env o header); se crea si no existe",
+		"cli.flag.inline":              "escribir en el archivo el valor resuelto en lugar de ${NOMBRE}",
+		"cli.shadows":                  "sobrescribe %s",
+		"cli.file_servers":             "%s: %d servidores",
+		"cli.server_count":             "%d servidores",
+		"cli.template_default":         "predeterminado %s",
 	}
 
 	// Portuguese
@@ -493,8 +3115,6 @@ func (t *Translator) loadStrings() {
 		"dialog.delete_server":         "Excluir Servidor",
 		"dialog.delete_confirm":        "Tem certeza de que deseja excluir o servidor '%s'?",
 		"dialog.move_server":           "Mover Servidor",
-		"dialog.move_to_project":       "Selecione o projeto de destino:",
-		"dialog.move_to_global":        "Deseja mover o servidor '%s' para o escopo global?",
 		"dialog.no_projects":           "Sem Projetos",
 		"dialog.no_projects_msg":       "Não há projetos configurados para mover o servidor.",
 		"dialog.clone_server":          "Clonar Servidor",
@@ -516,11 +3136,525 @@ func (t *Translator) loadStrings() {
 		"form.command":        "Comando",
 		"form.command_hint":   "Comando (ex: uvx, npx)",
 		"form.args":           "Argumentos",
-		"form.args_hint":      "Argumentos separados por espaço (ex: -y mcp-server)",
 		"form.url":            "URL",
 		"form.url_hint":       "URL (para http/sse)",
 		"form.env":            "Variáveis de Ambiente",
-		"form.env_hint":       "CHAVE=valor, CHAVE2=valor2 (separados por vírgula)",
+		"form.file":                "Arquivo",
+		"form.file_settings":       "~/.claude.json (configurações do projeto)",
+		"form.file_mcp_json":       ".mcp.json (compartilhado)",
+		"form.file_mcp_local":      ".mcp.local.json (local)",
+		"form.project_required":    "Selecione um projeto",
+		"dialog.move_to":           "Selecione o destino:",
+		"dialog.clone_file":        "Arquivo de destino nos projetos:",
+		"dialog.overwrite_title":   "Servidor já existe",
+		"dialog.overwrite_confirm": "Já existe um servidor '%s' em %s. Deseja sobrescrevê-lo?",
+		"conflict.title":       "Configuração modificada externamente",
+		"conflict.message":     "%s foi modificado por outro programa (ex. Claude Code) após o carregamento. As outras alterações serão mantidas; escolha qual versão manter para os servidores alterados nos dois lados.",
+		"conflict.mine":        "Minha versão",
+		"conflict.theirs":      "Versão no disco",
+		"conflict.keep_mine":   "Manter a minha",
+		"conflict.keep_theirs": "Usar a do disco",
+		"conflict.removed":     "(removido)",
+		"conflict.apply":       "Aplicar",
+		"banner.changed_externally": "Configuração modificada externamente: %s",
+		"banner.added":              "%d adicionados",
+		"banner.modified":           "%d modificados",
+		"banner.removed":            "%d removidos",
+		"toolbar.backups":         "Backups",
+		"backup.title":            "Backups de ~/.claude.json",
+		"backup.select":           "Selecione um backup para ver as diferenças",
+		"backup.none":             "Nenhum backup disponível",
+		"backup.no_changes":       "Os servidores MCP do backup coincidem com os atuais",
+		"backup.diff_hint":        "Efeito da restauração nos servidores MCP (selecione os que deseja restaurar):",
+		"backup.change_added":     "será adicionado",
+		"backup.change_removed":   "será removido",
+		"backup.change_modified":  "será restaurado",
+		"backup.restore_selected": "Restaurar selecionados",
+		"backup.restore_all":      "Restaurar tudo",
+		"backup.nothing_selected": "Nenhum servidor selecionado",
+		"backup.restore_confirm":  "Restaurar %d servidores do backup de %s? O resto de ~/.claude.json não será alterado.",
+		"backup.retention":        "Retenção",
+		"backup.max_count":        "Número máximo",
+		"backup.max_age":          "Idade máxima (dias, 0 = ilimitada)",
+		"backup.dir":              "Diretório",
+		"backup.dir_hint":         "Ao lado de ~/.claude.json",
+		"toolbar.history":    "Histórico",
+		"btn.close":          "Fechar",
+		"history.title":      "Histórico de alterações",
+		"history.empty":      "Nenhuma alteração nesta sessão",
+		"history.undone":     "desfeita",
+		"history.op_add":     "Adicionado '%s'",
+		"history.op_update":  "Editado '%s'",
+		"history.op_remove":  "Excluído '%s'",
+		"history.op_move":    "Movido '%s'",
+		"history.op_clone":   "Clonado '%s'",
+		"history.op_restore": "Restauração de %s",
+		"btn.test":                 "Testar",
+		"test.title":               "Teste de conexão: %s",
+		"test.running":             "Handshake MCP com %s em andamento (tempo limite %s)...",
+		"test.success":             "Handshake concluído em %s",
+		"test.failed":              "Falha no handshake",
+		"test.protocol":            "Versão do protocolo",
+		"test.server_info":         "Servidor",
+		"test.capabilities":        "Capacidades",
+		"test.instructions":        "Instruções",
+		"test.none":                "nenhuma",
+		"test.reason":              "Motivo",
+		"test.detail":              "Detalhe",
+		"test.stderr":              "Saída stderr",
+		"test.failure_not_found":   "Comando não encontrado",
+		"test.failure_exited":      "O processo terminou com código %d",
+		"test.failure_timeout":     "Sem resposta em %s",
+		"test.failure_tls":         "Erro TLS",
+		"test.failure_http_status": "O servidor respondeu HTTP %d",
+		"test.failure_network":     "Erro de rede",
+		"test.failure_protocol":    "Resposta não conforme ao protocolo MCP",
+		"test.failure_config":      "Configuração do servidor incompleta",
+		"inventory.title":          "Inventário",
+		"inventory.load":           "Ler inventário",
+		"inventory.refresh":        "Atualizar",
+		"inventory.not_loaded":     "Conecte-se ao servidor para ver as ferramentas, recursos e prompts que ele expõe",
+		"inventory.loading":        "Lendo ferramentas, recursos e prompts de %s (tempo limite %s)...",
+		"inventory.fetched_at":     "Lido em %s",
+		"inventory.tools":          "Ferramentas (%d)",
+		"inventory.resources":      "Recursos (%d)",
+		"inventory.prompts":        "Prompts (%d)",
+		"inventory.schema":         "Esquema de entrada",
+		"inventory.no_description": "Sem descrição",
+		"inventory.list_error":     "%s falhou: %s",
+		"tree.tool_count":          "%d ferramentas",
+		"validation.name_required":       "o nome é obrigatório",
+		"validation.name_invalid":        "o nome só pode conter letras, números, '-' e '_' (as ferramentas viram mcp__<nome>__<ferramenta>)",
+		"validation.name_too_long":       "nome longo: os nomes mcp__<nome>__<ferramenta> podem passar de 64 caracteres",
+		"validation.type_invalid":        "tipo desconhecido: %s (valores aceitos: stdio, http, sse)",
+		"validation.type_missing":        "informe um comando (stdio) ou uma URL (http/sse)",
+		"validation.command_required":    "o comando é obrigatório para servidores stdio",
+		"validation.command_with_url":    "um servidor não pode ter comando e URL ao mesmo tempo",
+		"validation.command_for_remote":  "o comando é ignorado por servidores %s",
+		"validation.command_has_spaces":  "o comando contém espaços: os argumentos vão em args",
+		"validation.args_empty":          "argumento vazio na posição %s",
+		"validation.args_for_remote":     "args são ignorados por servidores remotos",
+		"validation.url_required":        "a URL é obrigatória para servidores %s",
+		"validation.url_for_stdio":       "a URL é ignorada por servidores stdio",
+		"validation.url_invalid":         "URL inválida: %s",
+		"validation.url_scheme":          "esquema de URL não suportado: %s (use http ou https)",
+		"validation.url_insecure":        "conexão não criptografada (http) com um host remoto: %s",
+		"validation.sse_deprecated":      "o transporte SSE está obsoleto: prefira http se o servidor suportar",
+		"validation.env_name_empty":      "nome de variável de ambiente vazio",
+		"validation.env_name_invalid":    "nome de variável de ambiente incomum: %s",
+		"validation.headers_for_stdio":   "headers são ignorados por servidores stdio",
+		"validation.header_name_invalid": "nome de header inválido: %s",
+		"validation.timeout_negative":    "o tempo limite não pode ser negativo",
+		"validation.title":               "Problemas de configuração",
+		"validation.invalid_server":      "O servidor %s não é válido",
+		"validation.variable_missing":   "a variável ${%s} não está definida e não tem valor padrão",
+		"detail.headers":                "Headers",
+		"detail.expanded":               "expandido",
+		"detail.missing_variables":      "Variáveis de ambiente ausentes",
+		"detail.missing_variables_hint": "Não definidas no ambiente e sem valor padrão: os servidores que as usam podem não iniciar.",
+		"toolbar.vault":             "Cofre",
+		"history.op_bind":           "Segredo '%s' vinculado",
+		"history.op_rotate":         "Rotação do segredo '%s'",
+		"vault.title":               "Cofre de segredos",
+		"vault.intro":               "Os segredos são cifrados com uma frase-senha em %s. Env e headers podem usá-los como ${NOME}: ao iniciar o Claude Code com o lançador, os valores são exportados sem aparecer nos arquivos de configuração.",
+		"vault.create":              "Criar cofre",
+		"vault.passphrase":          "Frase-senha",
+		"vault.passphrase_confirm":  "Repetir frase-senha",
+		"vault.passphrase_mismatch": "As frases-senha não coincidem",
+		"vault.unlock":              "Desbloquear",
+		"vault.lock":                "Bloquear",
+		"vault.locked_hint":         "O cofre está bloqueado: insira a frase-senha para gerenciar os segredos.",
+		"vault.no_secrets":          "Nenhum segredo no cofre",
+		"vault.usages":              "%d usos",
+		"vault.add_secret":          "Adicionar segredo",
+		"vault.secret_name":         "Nome",
+		"vault.secret_value":        "Valor",
+		"vault.rotate":              "Rotacionar",
+		"vault.rotate_title":        "Rotacionar %s",
+		"vault.new_value":           "Novo valor",
+		"vault.rotated":             "Segredo %s atualizado: %d servidores alterados",
+		"vault.delete_confirm":      "Excluir o segredo %s do cofre?",
+		"vault.in_use":              "O segredo %s ainda é usado como ${%s} por:",
+		"vault.change_passphrase":   "Alterar frase-senha",
+		"vault.launcher":            "Gerar lançador",
+		"vault.launcher_created":    "Lançador criado em %s. Use-o no lugar de `claude` para iniciar o Claude Code com os segredos referenciados como ${NOME}.",
+		"vault.copy_path":           "Copiar caminho",
+		"vault.mode":                "Modo",
+		"vault.mode_reference":      "referência ${NOME}",
+		"vault.mode_inline":         "valor resolvido no arquivo",
+		"vault.binding":             "cofre: %s (%s)",
+		"vault.bind":                "Cofre",
+		"vault.bind_title":          "Vincular %s ao cofre",
+		"vault.bind_hint":           "Se o segredo não existir, ele é criado com o valor atual.",
+		"vault.needs_unlock":        "Desbloqueie o cofre para vincular valores a segredos.",
+		"detail.reveal": "Mostrar",
+		"detail.hide":   "Ocultar",
+		"btn.copy_json": "Copiar JSON",
+		"form.headers":                  "Cabeçalhos",
+		"form.timeout":                  "Tempo limite (ms)",
+		"form.timeout_hint":             "Vazio: padrão do Claude Code",
+		"form.add_row":                  "Adicionar",
+		"form.add_arg":                  "Adicionar argumento",
+		"form.paste_command":            "Importar",
+		"form.paste_command_hint":       "Cole uma linha de comando (ex.: npx -y pkg --dir \"My Docs\")",
+		"form.env_name_hint":            "NOME",
+		"form.header_name_hint":         "Nome do cabeçalho",
+		"form.value_hint":               "Valor",
+		"form.preview":                  "JSON que será escrito",
+		"validation.command_line_quote": "linha de comando inválida: aspas não fechadas",
+		"validation.key_duplicate":      "chave repetida: %s (vale a última linha)",
+		"validation.timeout_invalid":    "o tempo limite deve ser um número inteiro de milissegundos",
+		"dialog.add_via_template":        "A partir de modelo",
+		"template.title":                 "Modelos de servidores",
+		"template.select":                "Selecione um modelo",
+		"template.filter":                "Pesquisar modelos...",
+		"template.source":                "Catálogo: %s",
+		"template.add":                   "Adicionar servidor",
+		"template.catalogs":              "Catálogos",
+		"template.catalogs_hint":         "Catálogos adicionais (arquivos JSON ou pastas compartilhadas pela equipe) lidos além do incluído; um modelo com o mesmo ID substitui o incluído.",
+		"template.no_catalogs":           "Nenhum catálogo adicional",
+		"template.add_file":              "Adicionar arquivo...",
+		"template.add_folder":            "Adicionar pasta...",
+		"validation.template_catalog":    "%s",
+		"validation.template_parameters": "%s",
+		"toolbar.import":                  "Importar",
+		"history.op_import":               "Importação de %s",
+		"import.title":                    "Importar de outros clientes",
+		"import.source":                   "Arquivo de origem",
+		"import.select_source":            "Selecione um arquivo de configuração",
+		"import.no_sources":               "Nenhuma configuração de outros clientes encontrada",
+		"import.format":                   "Formato",
+		"import.browse":                   "Procurar...",
+		"import.target":                   "Destino",
+		"import.overwrite":                "Substituir servidores diferentes com o mesmo nome",
+		"import.run":                      "Importar selecionados",
+		"import.empty":                    "Nenhum servidor MCP no arquivo",
+		"import.partial":                  "%d servidores importados, alguns foram ignorados:",
+		"import.done":                     "%d servidores importados em %s",
+		"import.status_new":               "Novo",
+		"import.status_identical":         "Já presente",
+		"import.status_conflict":          "Conflito",
+		"validation.import_field_ignored": "campo '%s' não suportado pelo Claude Code: não importado",
+		"validation.import_variable":      "variável %s do cliente de origem não suportada: substitua-a por um valor ou ${VAR}",
+		"validation.import_disabled":      "servidor desativado no cliente de origem",
+		"validation.import_renamed":       "nome original '%s' não utilizável: renomeado",
+		"validation.import_conflict":      "já existe um servidor diferente com este nome no destino",
+		"validation.import_invalid":       "entrada inválida, não pode ser importada: %s",
+		"import.format_claude-desktop":    "Claude Desktop",
+		"import.format_cursor":            "Cursor",
+		"import.format_vscode":            "VS Code",
+		"import.format_codex":             "Codex CLI",
+		"import.format_goose":             "Goose",
+		"validation.import_read":          "%s",
+		"toolbar.export":                      "Exportar",
+		"export.title":                        "Exportar para outros clientes",
+		"export.source":                       "Servidores",
+		"export.format":                       "Formato",
+		"export.servers":                      "Servidores a exportar",
+		"export.preview":                      "Pré-visualização (segredos ocultos)",
+		"export.effective":                    "%s — servidores efetivos",
+		"export.empty":                        "Nenhum servidor neste local",
+		"export.include_secrets":              "Incluir os segredos no arquivo salvo",
+		"export.copy":                         "Copiar",
+		"export.save":                         "Salvar em arquivo…",
+		"export.done":                         "%d servidores exportados para %s",
+		"export.format_mcp-servers":           "Trecho mcpServers (.mcp.json)",
+		"export.format_claude-desktop":        "Claude Desktop",
+		"export.format_cursor":                "Cursor",
+		"export.format_vscode":                "VS Code",
+		"export.format_codex":                 "Codex CLI (TOML)",
+		"export.format_goose":                 "Goose (YAML)",
+		"validation.export_field_unsupported": "o campo '%s' não pode ser representado no formato de destino: não exportado",
+		"validation.export_type_unsupported":  "servidores %s não suportados pelo formato de destino: servidor não exportado",
+		"validation.export_variable":          "%s será escrito literalmente: o cliente de destino não expande variáveis",
+		"validation.export_variable_default":  "o padrão de %s não é suportado pelo cliente de destino",
+		"detail.effective_configs":    "Configuração Efetiva",
+		"detail.no_effective_servers": "Nenhum servidor ativo neste projeto",
+		"detail.shadowing_count":      "%d sobrescritos",
+		"detail.shadows":              "      sobrescreve: %s",
+		"detail.show_diff":            "Diferenças",
+		"detail.diff_title":           "Definições de %s",
+		"detail.diff_against":         "%s em relação a %s",
+		"detail.diff_identical":       "Definição idêntica",
+		"toolbar.duplicates":       "Duplicados",
+		"history.op_promote":       "'%s' promovido a global",
+		"duplicates.title":         "Servidores duplicados",
+		"duplicates.hint":          "Servidores com o mesmo comando e argumentos (ou a mesma URL) presentes em vários projetos. Ao promovê-los a globais, as cópias idênticas tornam-se supérfluas e são removidas numa única operação que pode ser desfeita.",
+		"duplicates.none":          "Nenhum servidor duplicado",
+		"duplicates.summary":       "cópias: %d, diferentes: %d",
+		"duplicates.promote":       "Promover a global",
+		"duplicates.definition":    "Definição",
+		"duplicates.global_name":   "Nome global",
+		"duplicates.remove_copies": "Cópias a remover:",
+		"toolbar.cleanup":         "Limpeza",
+		"tree.missing":            "pasta não encontrada",
+		"detail.project_missing":  "A pasta do projeto já não existe: a sua entrada ocupa %s em ~/.claude.json e pode ser removida.",
+		"cleanup.title":           "Limpeza de projetos",
+		"cleanup.hint":            "Projetos registados em ~/.claude.json cuja pasta foi apagada ou movida. Removê-los apaga os seus servidores e os dados do Claude Code (histórico, permissões, estado); é criado um backup do arquivo antes da escrita.",
+		"cleanup.none":            "Nenhum projeto com a pasta em falta",
+		"cleanup.project_details": "%d servidores · %s",
+		"cleanup.total":           "Selecionados: %d projetos (%s)",
+		"cleanup.archive_first":   "Guardar um arquivo das entradas antes de as remover",
+		"cleanup.include_secrets": "Incluir os segredos no arquivo",
+		"cleanup.export_only":     "Exportar arquivo…",
+		"cleanup.remove":          "Remover selecionados",
+		"cleanup.confirm":         "Remover %d projetos (%s) de ~/.claude.json?",
+		"cleanup.archived":        "%d projetos arquivados em %s",
+		"cleanup.removed":         "%d projetos removidos",
+		"toolbar.discover":          "Descobrir",
+		"tree.discovered":           "Projetos descobertos",
+		"detail.discovered_project": "Projeto descoberto",
+		"detail.discovered_hint":    "Esta pasta contém uma configuração MCP mas não está registada em ~/.claude.json. Depois de registada aparece entre os projetos com os servidores dos seus arquivos.",
+		"discovery.title":           "Descobrir projetos",
+		"discovery.hint":            "Procura nas pastas indicadas (ex. ~/src) projetos com .mcp.json ou .mcp.local.json ainda não registados em ~/.claude.json. As pastas ocultas, node_modules e vendor são ignoradas.",
+		"discovery.roots":           "Pastas onde procurar",
+		"discovery.no_roots":        "Nenhuma pasta: adicione uma",
+		"discovery.add_root":        "Adicionar pasta…",
+		"discovery.scan":            "Procurar",
+		"discovery.none":            "Nenhum projeto não registado encontrado",
+		"discovery.register":        "Registar selecionados",
+		"discovery.register_one":    "Registar projeto",
+		"discovery.registered":      "%d projetos registados",
+		"tree.search_placeholder":          "Procurar por nome, comando, argumentos, URL, env ou caminho…",
+		"tree.filter_all_types":            "Todos os tipos",
+		"tree.filter_all_sources":          "Todos os arquivos",
+		"tree.filter_source_global":        "Global (~/.claude.json)",
+		"tree.filter_source_project":       "Projeto (~/.claude.json)",
+		"tree.filter_source_project_file":  ".mcp.json",
+		"tree.filter_source_project_local": ".mcp.local.json",
+		"tree.sort_name":                   "Ordenar por nome",
+		"tree.sort_type":                   "Ordenar por tipo",
+		"tree.sort_usage":                  "Ordenar por projetos",
+		"tree.multi_select":      "Seleção múltipla",
+		"bulk.title":             "Operação em lote",
+		"bulk.selected":          "%d servidores selecionados",
+		"bulk.delete":            "Excluir",
+		"bulk.move":              "Mover",
+		"bulk.clone":             "Clonar",
+		"bulk.export":            "Exportar",
+		"bulk.clear":             "Desmarcar",
+		"bulk.move_title":        "Mover %d servidores",
+		"bulk.clone_title":       "Clonar %d servidores",
+		"bulk.summary":           "Servidores selecionados: %d — alterações: %s",
+		"bulk.skipped_exists":    "'%s' já existe em %s: ignorado",
+		"bulk.overwrite":         "Substituir os servidores já existentes nos destinos",
+		"bulk.apply":             "Aplicar",
+		"bulk.nothing":           "Nenhuma alteração a aplicar",
+		"export.selection":       "Seleção da árvore (%d servidores)",
+		"history.op_bulk_remove": "%s servidores excluídos",
+		"history.op_bulk_move":   "%s servidores movidos",
+		"history.op_bulk_clone":  "%s servidores clonados",
+		"toolbar.compare":         "Comparar",
+		"compare.title":           "Comparar",
+		"compare.hint":            "Compare dois servidores, as configurações efetivas de dois projetos ou um projeto e o escopo global. As setas copiam um valor ou a definição inteira para o outro lado, no arquivo onde o servidor está escrito.",
+		"compare.left":            "Esquerda",
+		"compare.right":           "Direita",
+		"compare.effective":       "%s (configuração efetiva)",
+		"compare.show_identical":  "Mostrar servidores idênticos",
+		"compare.pick_sides":      "Escolha os dois lados a comparar",
+		"compare.same_side":       "Os dois lados são iguais",
+		"compare.mismatch":        "Um servidor só pode ser comparado com outro servidor",
+		"compare.summary":         "Idênticos: %d, diferentes: %d, só à esquerda: %d, só à direita: %d",
+		"compare.empty":           "Nenhum servidor em nenhum dos lados",
+		"compare.only_left":       "Só à esquerda, em %s",
+		"compare.only_right":      "Só à direita, em %s",
+		"compare.clone_to":        "Copiar para %s",
+		"compare.identical":       "Idênticos",
+		"compare.copy_definition": "Definição",
+		"toolbar.tools": "Ferramentas",
+		"cli.usage_line":               "uso: mcp-curator %s",
+		"cli.error":                    "erro: %s",
+		"cli.unknown_command":          "comando desconhecido: %s",
+		"cli.unknown_lang":             "idioma não suportado: %s (disponíveis: %s)",
+		"cli.help_gui":                 "Sem argumentos inicia a interface gráfica.",
+		"cli.help_usage":               "Uso: mcp-curator [--config PATH] [--lang IDIOMA] <comando> [argumentos]",
+		"cli.help_commands":            "Comandos:",
+		"cli.help_scope":               "Sem --project os comandos atuam no escopo global.\n--scope escolhe o arquivo do projeto: project (~/.claude.json, padrão),\nproject-file (.mcp.json) ou project-local (.mcp.local.json).",
+		"cli.flag_config":              "caminho alternativo de ~/.claude.json",
+		"cli.flag_lang":                "idioma da saída: IT, EN, FR, DE, ES, PT, JA, KO, CN, UK",
+		"cli.cmd.list":                 "Lista os servidores globais e de projeto (segredos ocultos salvo --reveal)",
+		"cli.cmd.show":                 "Mostra a configuração de um servidor (segredos ocultos salvo --reveal)",
+		"cli.cmd.add":                  "Adiciona um servidor, também a partir de um modelo do catálogo",
+		"cli.cmd.update":               "Modifica os campos indicados de um servidor",
+		"cli.cmd.remove":               "Remove um servidor",
+		"cli.cmd.move":                 "Move um servidor entre escopos e arquivos de projeto",
+		"cli.cmd.clone":                "Copia um servidor para outros escopos",
+		"cli.cmd.bulk":                 "Remove, move ou copia numa única operação os servidores selecionados como com search",
+		"cli.cmd.import":               "Importa os servidores do Claude Desktop, Cursor, VS Code, Codex ou Goose (sem PATH lista os arquivos encontrados)",
+		"cli.cmd.export":               "Exporta os servidores para Claude Desktop, Cursor, VS Code, Codex, Goose ou como trecho mcpServers",
+		"cli.cmd.search":               "Procura servidores em todos os escopos por nome, comando, argumentos, URL, chaves env ou caminho do projeto",
+		"cli.cmd.compare":              "Compara campo a campo dois servidores (NOME@global, NOME@PATH), dois projetos (PATH) ou um projeto e global",
+		"cli.cmd.duplicates":           "Encontra os servidores com várias cópias entre o escopo global e os projetos, com as diferenças",
+		"cli.cmd.promote":              "Torna um servidor global e remove as cópias de projeto idênticas",
+		"cli.cmd.discover":             "Procura as pastas com .mcp.json ou .mcp.local.json ainda não registadas como projetos",
+		"cli.cmd.register":             "Regista pastas como projetos em ~/.claude.json",
+		"cli.cmd.cleanup":              "Remove do ~/.claude.json os projetos cuja pasta já não existe, com arquivo opcional",
+		"cli.cmd.validate":             "Verifica a configuração de todos os servidores",
+		"cli.cmd.test":                 "Executa o handshake MCP initialize com um servidor",
+		"cli.cmd.inventory":            "Lista as ferramentas, recursos e prompts expostos por um servidor",
+		"cli.cmd.templates":            "Lista os modelos de servidor e gere os catálogos adicionais da equipa",
+		"cli.cmd.vault":                "Gere o cofre cifrado dos segredos usados em env e headers",
+		"cli.cmd.backups":              "Lista as cópias de segurança do ~/.claude.json e as diferenças com o arquivo atual",
+		"cli.cmd.restore":              "Restaura os servidores MCP a partir de uma cópia de segurança",
+		"cli.cmd.version":              "Mostra a versão",
+		"cli.location_global":          "escopo global",
+		"cli.location_project":         "projeto %s",
+		"cli.source_global":            "global",
+		"cli.source_project":           "projeto",
+		"cli.severity_error":           "erro",
+		"cli.severity_warning":         "aviso",
+		"cli.warning":                  "Atenção: %s",
+		"cli.absent":                   "(ausente)",
+		"cli.no_servers":               "(nenhum servidor)",
+		"cli.field_name":               "Nome",
+		"cli.field_type":               "Tipo",
+		"cli.field_command":            "Comando",
+		"cli.field_category":           "Categoria",
+		"cli.field_description":        "Descrição",
+		"cli.field_catalog":            "Catálogo",
+		"cli.field_parameter":          "Parâmetro",
+		"cli.field_required":           "obrigatório",
+		"cli.field_result":             "Resultado",
+		"cli.field_protocol":           "Protocolo",
+		"cli.field_error":              "Erro",
+		"cli.field_destination":        "Destino",
+		"cli.list_global":              "Global (%s)",
+		"cli.list_projects":            "Projetos",
+		"cli.list_project_missing":     "pasta não encontrada: ver cleanup",
+		"cli.server_added":             "Servidor '%s' adicionado em %s",
+		"cli.server_updated":           "Servidor '%s' atualizado",
+		"cli.server_removed":           "Servidor '%s' removido de %s",
+		"cli.server_moved":             "Servidor '%s' movido para %s",
+		"cli.server_cloned":            "Servidor '%s' clonado em %d destinos",
+		"cli.test_ok":                  "OK (%dms)",
+		"cli.test_failed":              "FALHOU (%s)",
+		"cli.inventory_header":         "%s %s (protocolo %s, lido em %s)",
+		"cli.inventory_tools":          "Ferramentas (%d):",
+		"cli.inventory_resources":      "Recursos (%d):",
+		"cli.inventory_prompts":        "Prompts (%d):",
+		"cli.inventory_failed":         "%s falhou: %s",
+		"cli.valid_servers":            "%d servidores válidos",
+		"cli.no_backups":               "(nenhuma cópia de segurança)",
+		"cli.nothing_to_restore":       "Nenhuma diferença a restaurar",
+		"cli.restored":                 "Restaurados %d servidores de %s",
+		"cli.bulk_none":                "Nenhum servidor selecionado",
+		"cli.bulk_plan":                "Servidores selecionados: %d, alterações: %s",
+		"cli.bulk_done":                "Servidores selecionados: %d, alterações guardadas: %s",
+		"cli.cleanup_none":             "Nenhum projeto com a pasta em falta",
+		"cli.cleanup_plan":             "%d projetos a remover (%s)",
+		"cli.archive_saved":            "Arquivo guardado em %s",
+		"cli.cleanup_done":             "Removidos %d projetos de %s (%s)",
+		"cli.compare_left_only":        "só à esquerda (%s)",
+		"cli.compare_right_only":       "só à direita (%s)",
+		"cli.compare_identical":        "Servidores idênticos: %d (--all para mostrá-los)",
+		"cli.discover_none":            "Nenhum projeto não registado encontrado",
+		"cli.discover_hint":            "Para registá-los: mcp-curator discover --register, ou mcp-curator register PATH",
+		"cli.registered_count":         "Registados %d projetos em %s",
+		"cli.already_registered":       "%s: já registado",
+		"cli.registered":               "Registado %s (%s)",
+		"cli.no_mcp_files":             "nenhum arquivo MCP",
+		"cli.duplicates_none":          "Nenhum servidor duplicado",
+		"cli.duplicates_group":         "cópias: %d, diferentes: %d",
+		"cli.promote_hint":             "remove as cópias de projeto idênticas",
+		"cli.promote_plan":             "'%s' passaria a global, cópias de projeto removidas: %d",
+		"cli.promote_done":             "Servidor '%s' global, cópias de projeto removidas: %d",
+		"cli.exported":                 "Exportados %d servidores para %s",
+		"cli.import_empty":             "Nenhum servidor MCP em %s",
+		"cli.imported":                 "Importados %d servidores em %s",
+		"cli.import_nothing":           "Nenhum servidor a importar: já estão todos presentes",
+		"cli.import_no_sources":        "Nenhum arquivo de configuração de outros clientes encontrado",
+		"cli.search_none":              "Nenhum servidor encontrado",
+		"cli.search_usage":             "projetos: %d",
+		"cli.catalogs":                 "Catálogos adicionais",
+		"cli.catalog_added":            "Catálogo adicionado: %s",
+		"cli.catalog_removed":          "Catálogo removido: %s",
+		"cli.vault_created":            "Cofre criado: %s",
+		"cli.no_secrets":               "(nenhum segredo)",
+		"cli.secret_usages":            "%d usos",
+		"cli.secret_added":             "Segredo %s adicionado ao cofre",
+		"cli.secret_updated":           "Segredo %s atualizado",
+		"cli.secret_server_updated":    "atualizado %s (%s)",
+		"cli.secret_deleted":           "Segredo %s eliminado",
+		"cli.secret_bound":             "%s %s de %s ligado ao segredo %s (%s)",
+		"cli.launcher_hint":            "Inicie o Claude Code com o lançador (mcp-curator vault launcher) para resolver ${%s}",
+		"cli.launcher_created":         "Lançador criado: %s",
+		"cli.launcher_usage":           "Use-o em vez de `claude` para iniciar o Claude Code com os segredos referenciados como ${NOME}.",
+		"cli.passphrase_changed":       "Frase-passe do cofre alterada",
+		"cli.prompt_passphrase":        "Frase-passe do cofre: ",
+		"cli.prompt_new_passphrase":    "Nova frase-passe do cofre: ",
+		"cli.prompt_repeat_passphrase": "Repita a frase-passe: ",
+		"cli.prompt_secret_value":      "Valor de %s: ",
+		"cli.prompt_new_secret_value":  "Novo valor de %s: ",
+		"cli.flag.project_server":      "projeto do servidor (padrão: global)",
+		"cli.flag.project_add":         "adicionar ao projeto indicado (padrão: global)",
+		"cli.flag.project_source":      "projeto de origem (padrão: global)",
+		"cli.flag.project_promote":     "projeto da cópia a promover (padrão: global, para remover só as cópias)",
+		"cli.flag.project_export":      "exportar do projeto indicado (padrão: global)",
+		"cli.flag.project_import":      "importar no projeto indicado (padrão: global)",
+		"cli.flag.project_only":        "só os servidores deste projeto (todos os seus arquivos)",
+		"cli.flag.project_list":        "mostra só os servidores do projeto indicado",
+		"cli.flag.scope":               "arquivo do projeto: project (~/.claude.json), project-file (.mcp.json), project-local (.mcp.local.json)",
+		"cli.flag.type":                "tipo de servidor: stdio, http, sse",
+		"cli.flag.command":             "comando a executar (stdio)",
+		"cli.flag.arg":                 "argumento do comando (repetível)",
+		"cli.flag.url":                 "URL do servidor (http/sse)",
+		"cli.flag.env":                 "variável de ambiente KEY=value (repetível)",
+		"cli.flag.header":              "cabeçalho HTTP KEY=value (repetível)",
+		"cli.flag.timeout":             "tempo limite em milissegundos",
+		"cli.flag.effective":           "mostra os servidores efetivos do projeto (fusão de todos os escopos)",
+		"cli.flag.effective_export":    "exporta os servidores efetivos do projeto (fusão de todos os escopos)",
+		"cli.flag.json":                "saída em formato JSON",
+		"cli.flag.json_import":         "saída em formato JSON (com --dry-run ou sem PATH)",
+		"cli.flag.json_cleanup":        "lista os projetos em formato JSON sem os remover",
+		"cli.flag.json_server":         "configuração JSON do servidor (os flags têm precedência)",
+		"cli.flag.reveal":              "mostra os segredos em vez de [REDACTED]",
+		"cli.flag.reveal_archive":      "inclui os segredos no arquivo em vez de [REDACTED]",
+		"cli.flag.reveal_export":       "inclui os segredos em vez de [REDACTED]",
+		"cli.flag.template":            "partir de um modelo do catálogo (ver `templates`)",
+		"cli.flag.param":               "parâmetro do modelo K=valor (repetível)",
+		"cli.flag.clear_args":          "remove todos os argumentos",
+		"cli.flag.unset_env":           "remove uma variável de ambiente (repetível)",
+		"cli.flag.unset_header":        "remove um cabeçalho HTTP (repetível)",
+		"cli.flag.from_scope":          "arquivo do projeto de origem (project, project-file, project-local)",
+		"cli.flag.to":                  "destino: 'global' ou caminho de projeto",
+		"cli.flag.to_repeat":           "destino: 'global' ou caminho de projeto (repetível)",
+		"cli.flag.to_bulk":             "destino: 'global' ou caminho de projeto (repetível para clone)",
+		"cli.flag.to_global":           "move para o escopo global (equivale a --to global)",
+		"cli.flag.to_scope":            "arquivo do projeto de destino (project, project-file, project-local)",
+		"cli.flag.to_scope_many":       "arquivo dos projetos de destino (project, project-file, project-local)",
+		"cli.flag.force_one":           "substitui um servidor com o mesmo nome no destino",
+		"cli.flag.force_many":          "substitui os servidores com o mesmo nome nos destinos",
+		"cli.flag.force_import":        "substitui os servidores diferentes com o mesmo nome",
+		"cli.flag.dry_run":             "mostra o que mudaria sem escrever",
+		"cli.flag.dry_run_promote":     "mostra o que seria feito sem escrever",
+		"cli.flag.dry_run_import":      "mostra o que seria importado sem escrever",
+		"cli.flag.dry_run_cleanup":     "lista os projetos sem os remover",
+		"cli.flag.archive":             "guarda as entradas dos projetos neste arquivo antes de as remover",
+		"cli.flag.cached":              "usa o último inventário lido sem se ligar",
+		"cli.flag.diff":                "mostra os servidores que mudariam ao restaurar cada cópia de segurança",
+		"cli.flag.restore_server":      "restaura só o servidor indicado (repetível)",
+		"cli.flag.import_server":       "importa só o servidor indicado (repetível)",
+		"cli.flag.all":                 "mostra também os servidores idênticos",
+		"cli.flag.depth":               "profundidade máxima de procura (padrão: preferências, caso contrário 4)",
+		"cli.flag.save_roots":          "adiciona as pastas indicadas às preferências",
+		"cli.flag.register":            "regista todos os projetos encontrados",
+		"cli.flag.as":                  "nome do servidor global (padrão: o nome da cópia)",
+		"cli.flag.keep_copies":         "não remover as cópias de projeto idênticas",
+		"cli.flag.export_format":       "formato: mcp-servers, claude-desktop, cursor, vscode, codex, goose",
+		"cli.flag.output":              "escrever num arquivo em vez de stdout",
+		"cli.flag.import_format":       "formato do arquivo: claude-desktop, cursor, vscode, codex, goose (padrão: deduzido do caminho)",
+		"cli.flag.filter_type":         "só os servidores deste tipo: stdio, http, sse",
+		"cli.flag.filter_source":       "só os servidores deste arquivo: global, project, project-file, project-local",
+		"cli.flag.sort":                "ordenação: name, type, usage (projetos que usam o servidor)",
+		"cli.flag.unlock":              "desbloqueia o cofre para reconhecer também os valores resolvidos nos arquivos",
+		"cli.flag.bind_env":            "variável de ambiente do servidor a ligar",
+		"cli.flag.bind_header":         "cabeçalho HTTP do servidor a ligar",
+		"cli.flag.secret":              "nome do segredo (padrão: a chave env ou header); criado se não existir",
+		"cli.flag.inline":              "escrever no arquivo o valor resolvido em vez de ${NOME}",
+		"cli.shadows":                  "substitui %s",
+		"cli.file_servers":             "%s: %d servidores",
+		"cli.server_count":             "%d servidores",
+		"cli.template_default":         "padrão %s",
 	}
 
 	// Japanese
@@ -559,8 +3693,6 @@ func (t *Translator) loadStrings() {
 		"dialog.delete_server":         "サーバー削除",
 		"dialog.delete_confirm":        "サーバー '%s' を削除しますか？",
 		"dialog.move_server":           "サーバー移動",
-		"dialog.move_to_project":       "移動先プロジェクトを選択:",
-		"dialog.move_to_global":        "サーバー '%s' をグローバルスコープに移動しますか？",
 		"dialog.no_projects":           "プロジェクトなし",
 		"dialog.no_projects_msg":       "サーバーを移動できるプロジェクトがありません。",
 		"dialog.clone_server":          "サーバー複製",
@@ -582,11 +3714,525 @@ func (t *Translator) loadStrings() {
 		"form.command":        "コマンド",
 		"form.command_hint":   "コマンド (例: uvx, npx)",
 		"form.args":           "引数",
-		"form.args_hint":      "スペース区切りの引数 (例: -y mcp-server)",
 		"form.url":            "URL",
 		"form.url_hint":       "URL (http/sse用)",
 		"form.env":            "環境変数",
-		"form.env_hint":       "KEY=value, KEY2=value2 (カンマ区切り)",
+		"form.file":                "ファイル",
+		"form.file_settings":       "~/.claude.json (プロジェクト設定)",
+		"form.file_mcp_json":       ".mcp.json (共有)",
+		"form.file_mcp_local":      ".mcp.local.json (ローカル)",
+		"form.project_required":    "プロジェクトを選択してください",
+		"dialog.move_to":           "移動先を選択:",
+		"dialog.clone_file":        "プロジェクト内の保存先ファイル:",
+		"dialog.overwrite_title":   "サーバーは既に存在します",
+		"dialog.overwrite_confirm": "%[2]s にはサーバー '%[1]s' が既に存在します。上書きしますか?",
+		"conflict.title":       "設定が外部で変更されました",
+		"conflict.message":     "%s は読み込み後に別のプログラム (Claude Code など) によって変更されました。その他の変更は保持されます。両方で変更されたサーバーについて、保持するバージョンを選択してください。",
+		"conflict.mine":        "自分のバージョン",
+		"conflict.theirs":      "ディスク上のバージョン",
+		"conflict.keep_mine":   "自分のものを保持",
+		"conflict.keep_theirs": "ディスク上のものを使用",
+		"conflict.removed":     "(削除済み)",
+		"conflict.apply":       "適用",
+		"banner.changed_externally": "設定が外部で変更されました: %s",
+		"banner.added":              "%d 件追加",
+		"banner.modified":           "%d 件変更",
+		"banner.removed":            "%d 件削除",
+		"toolbar.backups":         "バックアップ",
+		"backup.title":            "~/.claude.json のバックアップ",
+		"backup.select":           "差分を表示するバックアップを選択してください",
+		"backup.none":             "バックアップはありません",
+		"backup.no_changes":       "バックアップの MCP サーバーは現在のものと一致しています",
+		"backup.diff_hint":        "復元による MCP サーバーへの影響 (復元するものを選択):",
+		"backup.change_added":     "追加されます",
+		"backup.change_removed":   "削除されます",
+		"backup.change_modified":  "復元されます",
+		"backup.restore_selected": "選択項目を復元",
+		"backup.restore_all":      "すべて復元",
+		"backup.nothing_selected": "サーバーが選択されていません",
+		"backup.restore_confirm":  "%[2]s のバックアップから %[1]d 個のサーバーを復元しますか? ~/.claude.json のその他の内容は変更されません。",
+		"backup.retention":        "保持",
+		"backup.max_count":        "最大数",
+		"backup.max_age":          "最大保持期間 (日, 0 = 無制限)",
+		"backup.dir":              "ディレクトリ",
+		"backup.dir_hint":         "~/.claude.json と同じ場所",
+		"toolbar.history":    "履歴",
+		"btn.close":          "閉じる",
+		"history.title":      "変更履歴",
+		"history.empty":      "このセッションでの変更はありません",
+		"history.undone":     "取り消し済み",
+		"history.op_add":     "'%s' を追加",
+		"history.op_update":  "'%s' を編集",
+		"history.op_remove":  "'%s' を削除",
+		"history.op_move":    "'%s' を移動",
+		"history.op_clone":   "'%s' を複製",
+		"history.op_restore": "%s から復元",
+		"btn.test":                 "テスト",
+		"test.title":               "接続テスト: %s",
+		"test.running":             "%[1]s と MCP ハンドシェイク中 (タイムアウト %[2]s)...",
+		"test.success":             "ハンドシェイク成功 (%s)",
+		"test.failed":              "ハンドシェイク失敗",
+		"test.protocol":            "プロトコルバージョン",
+		"test.server_info":         "サーバー",
+		"test.capabilities":        "機能",
+		"test.instructions":        "説明",
+		"test.none":                "なし",
+		"test.reason":              "理由",
+		"test.detail":              "詳細",
+		"test.stderr":              "stderr 出力",
+		"test.failure_not_found":   "コマンドが見つかりません",
+		"test.failure_exited":      "プロセスがコード %d で終了しました",
+		"test.failure_timeout":     "%s 以内に応答がありません",
+		"test.failure_tls":         "TLS エラー",
+		"test.failure_http_status": "サーバーが HTTP %d を返しました",
+		"test.failure_network":     "ネットワークエラー",
+		"test.failure_protocol":    "MCP プロトコルに準拠しない応答",
+		"test.failure_config":      "サーバー設定が不完全です",
+		"inventory.title":          "インベントリ",
+		"inventory.load":           "インベントリを取得",
+		"inventory.refresh":        "更新",
+		"inventory.not_loaded":     "サーバーに接続して、公開されているツール、リソース、プロンプトを確認します",
+		"inventory.loading":        "%[1]s からツール、リソース、プロンプトを取得中 (タイムアウト %[2]s)...",
+		"inventory.fetched_at":     "取得日時 %s",
+		"inventory.tools":          "ツール (%d)",
+		"inventory.resources":      "リソース (%d)",
+		"inventory.prompts":        "プロンプト (%d)",
+		"inventory.schema":         "入力スキーマ",
+		"inventory.no_description": "説明なし",
+		"inventory.list_error":     "%[1]s に失敗しました: %[2]s",
+		"tree.tool_count":          "%d ツール",
+		"validation.name_required":       "名前は必須です",
+		"validation.name_invalid":        "名前に使えるのは英数字、'-'、'_' のみです (ツール名は mcp__<名前>__<ツール> になります)",
+		"validation.name_too_long":       "名前が長すぎます: ツール名 mcp__<名前>__<ツール> が 64 文字を超える可能性があります",
+		"validation.type_invalid":        "不明なタイプ: %s (stdio、http、sse のいずれか)",
+		"validation.type_missing":        "コマンド (stdio) または URL (http/sse) を指定してください",
+		"validation.command_required":    "stdio サーバーにはコマンドが必要です",
+		"validation.command_with_url":    "コマンドと URL を同時に指定することはできません",
+		"validation.command_for_remote":  "%s サーバーではコマンドは無視されます",
+		"validation.command_has_spaces":  "コマンドに空白が含まれています: 引数は args に指定してください",
+		"validation.args_empty":          "%s 番目の引数が空です",
+		"validation.args_for_remote":     "リモートサーバーでは args は無視されます",
+		"validation.url_required":        "%s サーバーには URL が必要です",
+		"validation.url_for_stdio":       "stdio サーバーでは URL は無視されます",
+		"validation.url_invalid":         "無効な URL: %s",
+		"validation.url_scheme":          "サポートされていない URL スキーム: %s (http または https を使用)",
+		"validation.url_insecure":        "リモートホストへの暗号化されていない接続 (http): %s",
+		"validation.sse_deprecated":      "SSE トランスポートは非推奨です: サーバーが対応していれば http を使用してください",
+		"validation.env_name_empty":      "環境変数名が空です",
+		"validation.env_name_invalid":    "通常と異なる環境変数名: %s",
+		"validation.headers_for_stdio":   "stdio サーバーではヘッダーは無視されます",
+		"validation.header_name_invalid": "無効なヘッダー名: %s",
+		"validation.timeout_negative":    "タイムアウトに負の値は指定できません",
+		"validation.title":               "設定の問題",
+		"validation.invalid_server":      "サーバー %s は無効です",
+		"validation.variable_missing":   "変数 ${%s} は未定義でデフォルト値もありません",
+		"detail.headers":                "ヘッダー",
+		"detail.expanded":               "展開後",
+		"detail.missing_variables":      "不足している環境変数",
+		"detail.missing_variables_hint": "環境に定義されておらずデフォルト値もありません: これらを使うサーバーは起動できない可能性があります。",
+		"toolbar.vault":             "Vault",
+		"history.op_bind":           "シークレット '%s' を関連付け",
+		"history.op_rotate":         "シークレット '%s' をローテーション",
+		"vault.title":               "シークレット Vault",
+		"vault.intro":               "シークレットは %s にパスフレーズで暗号化されて保存されます。env と headers では ${NAME} として参照できます。ランチャーで Claude Code を起動すると、値は設定ファイルに現れることなくエクスポートされます。",
+		"vault.create":              "Vault を作成",
+		"vault.passphrase":          "パスフレーズ",
+		"vault.passphrase_confirm":  "パスフレーズ(確認)",
+		"vault.passphrase_mismatch": "パスフレーズが一致しません",
+		"vault.unlock":              "ロック解除",
+		"vault.lock":                "ロック",
+		"vault.locked_hint":         "Vault はロックされています。シークレットを管理するにはパスフレーズを入力してください。",
+		"vault.no_secrets":          "Vault にシークレットはありません",
+		"vault.usages":              "使用箇所 %d",
+		"vault.add_secret":          "シークレットを追加",
+		"vault.secret_name":         "名前",
+		"vault.secret_value":        "値",
+		"vault.rotate":              "ローテーション",
+		"vault.rotate_title":        "%s をローテーション",
+		"vault.new_value":           "新しい値",
+		"vault.rotated":             "シークレット %[1]s を更新しました: %[2]d 個のサーバーを変更",
+		"vault.delete_confirm":      "シークレット %s を Vault から削除しますか?",
+		"vault.in_use":              "シークレット %[1]s は次で ${%[2]s} として使用されています:",
+		"vault.change_passphrase":   "パスフレーズを変更",
+		"vault.launcher":            "ランチャーを生成",
+		"vault.launcher_created":    "ランチャーを %s に作成しました。`claude` の代わりに使うと、${NAME} で参照されるシークレット付きで Claude Code を起動します。",
+		"vault.copy_path":           "パスをコピー",
+		"vault.mode":                "モード",
+		"vault.mode_reference":      "${NAME} 参照",
+		"vault.mode_inline":         "ファイルに解決済みの値",
+		"vault.binding":             "vault: %s (%s)",
+		"vault.bind":                "Vault",
+		"vault.bind_title":          "%s を Vault に関連付け",
+		"vault.bind_hint":           "シークレットが存在しない場合は現在の値で作成されます。",
+		"vault.needs_unlock":        "値をシークレットに関連付けるには Vault のロックを解除してください。",
+		"detail.reveal": "表示",
+		"detail.hide":   "隠す",
+		"btn.copy_json": "JSON をコピー",
+		"form.headers":                  "ヘッダー",
+		"form.timeout":                  "タイムアウト (ms)",
+		"form.timeout_hint":             "空欄: Claude Code の既定値",
+		"form.add_row":                  "追加",
+		"form.add_arg":                  "引数を追加",
+		"form.paste_command":            "取り込む",
+		"form.paste_command_hint":       "コマンドラインを貼り付け (例: npx -y pkg --dir \"My Docs\")",
+		"form.env_name_hint":            "名前",
+		"form.header_name_hint":         "ヘッダー名",
+		"form.value_hint":               "値",
+		"form.preview":                  "書き込まれる JSON",
+		"validation.command_line_quote": "無効なコマンドライン: 引用符が閉じられていません",
+		"validation.key_duplicate":      "キーが重複しています: %s (最後の行が有効)",
+		"validation.timeout_invalid":    "タイムアウトはミリ秒単位の整数で指定してください",
+		"dialog.add_via_template":        "テンプレートから",
+		"template.title":                 "サーバーテンプレート",
+		"template.select":                "テンプレートを選択してください",
+		"template.filter":                "テンプレートを検索...",
+		"template.source":                "カタログ: %s",
+		"template.add":                   "サーバーを追加",
+		"template.catalogs":              "カタログ",
+		"template.catalogs_hint":         "組み込みカタログに加えて読み込む追加カタログ (JSON ファイルまたはチームで共有するフォルダ)。同じ ID のテンプレートは組み込みのものを置き換えます。",
+		"template.no_catalogs":           "追加カタログはありません",
+		"template.add_file":              "ファイルを追加...",
+		"template.add_folder":            "フォルダを追加...",
+		"validation.template_catalog":    "%s",
+		"validation.template_parameters": "%s",
+		"toolbar.import":                  "インポート",
+		"history.op_import":               "%s からインポート",
+		"import.title":                    "他のクライアントからインポート",
+		"import.source":                   "元のファイル",
+		"import.select_source":            "設定ファイルを選択してください",
+		"import.no_sources":               "他のクライアントの設定が見つかりません",
+		"import.format":                   "形式",
+		"import.browse":                   "参照...",
+		"import.target":                   "インポート先",
+		"import.overwrite":                "同じ名前の異なるサーバーを置き換える",
+		"import.run":                      "選択項目をインポート",
+		"import.empty":                    "ファイルに MCP サーバーがありません",
+		"import.partial":                  "%d 個のサーバーをインポートしました。一部はスキップされました:",
+		"import.done":                     "%d 個のサーバーを %s にインポートしました",
+		"import.status_new":               "新規",
+		"import.status_identical":         "既に存在",
+		"import.status_conflict":          "競合",
+		"validation.import_field_ignored": "フィールド '%s' は Claude Code でサポートされていません: インポートされません",
+		"validation.import_variable":      "元のクライアントの変数 %s はサポートされていません: 値または ${VAR} に置き換えてください",
+		"validation.import_disabled":      "元のクライアントで無効になっているサーバー",
+		"validation.import_renamed":       "元の名前 '%s' は使用できません: 名前を変更しました",
+		"validation.import_conflict":      "インポート先に同じ名前の異なるサーバーが既に存在します",
+		"validation.import_invalid":       "無効なエントリのためインポートできません: %s",
+		"import.format_claude-desktop":    "Claude Desktop",
+		"import.format_cursor":            "Cursor",
+		"import.format_vscode":            "VS Code",
+		"import.format_codex":             "Codex CLI",
+		"import.format_goose":             "Goose",
+		"validation.import_read":          "%s",
+		"toolbar.export":                      "エクスポート",
+		"export.title":                        "他のクライアントへエクスポート",
+		"export.source":                       "サーバー",
+		"export.format":                       "形式",
+		"export.servers":                      "エクスポートするサーバー",
+		"export.preview":                      "プレビュー(シークレット非表示)",
+		"export.effective":                    "%s — 有効なサーバー",
+		"export.empty":                        "この場所にサーバーはありません",
+		"export.include_secrets":              "保存するファイルにシークレットを含める",
+		"export.copy":                         "コピー",
+		"export.save":                         "ファイルに保存…",
+		"export.done":                         "%d 個のサーバーを %s にエクスポートしました",
+		"export.format_mcp-servers":           "mcpServers スニペット (.mcp.json)",
+		"export.format_claude-desktop":        "Claude Desktop",
+		"export.format_cursor":                "Cursor",
+		"export.format_vscode":                "VS Code",
+		"export.format_codex":                 "Codex CLI (TOML)",
+		"export.format_goose":                 "Goose (YAML)",
+		"validation.export_field_unsupported": "フィールド '%s' は出力先の形式で表現できません: エクスポートされません",
+		"validation.export_type_unsupported":  "%s サーバーは出力先の形式でサポートされていません: エクスポートされません",
+		"validation.export_variable":          "%s はそのまま書き込まれます: 出力先のクライアントは変数を展開しません",
+		"validation.export_variable_default":  "%s のデフォルト値は出力先のクライアントでサポートされていません",
+		"detail.effective_configs":    "有効な構成",
+		"detail.no_effective_servers": "このプロジェクトに有効なサーバーはありません",
+		"detail.shadowing_count":      "%d 件を上書き",
+		"detail.shadows":              "      上書き: %s",
+		"detail.show_diff":            "差分",
+		"detail.diff_title":           "%s の定義",
+		"detail.diff_against":         "%s と %s の比較",
+		"detail.diff_identical":       "同一の定義",
+		"toolbar.duplicates":       "重複",
+		"history.op_promote":       "'%s' をグローバルに昇格",
+		"duplicates.title":         "重複したサーバー",
+		"duplicates.hint":          "同じコマンドと引数(または同じ URL)を持つサーバーが複数のプロジェクトにあります。グローバルに昇格すると同一のコピーは不要になり、元に戻せる 1 回の操作で削除されます。",
+		"duplicates.none":          "重複したサーバーはありません",
+		"duplicates.summary":       "コピー: %d、相違: %d",
+		"duplicates.promote":       "グローバルに昇格",
+		"duplicates.definition":    "定義",
+		"duplicates.global_name":   "グローバル名",
+		"duplicates.remove_copies": "削除するコピー:",
+		"toolbar.cleanup":         "クリーンアップ",
+		"tree.missing":            "フォルダーが見つかりません",
+		"detail.project_missing":  "プロジェクトのフォルダーは存在しません: このエントリは ~/.claude.json で %s を占めており、削除できます。",
+		"cleanup.title":           "プロジェクトのクリーンアップ",
+		"cleanup.hint":            "フォルダーが削除または移動された、~/.claude.json に登録済みのプロジェクトです。削除するとサーバーと Claude Code のデータ(履歴、権限、状態)も消えます。書き込み前にファイルのバックアップが作成されます。",
+		"cleanup.none":            "フォルダーが見つからないプロジェクトはありません",
+		"cleanup.project_details": "%d サーバー · %s",
+		"cleanup.total":           "選択: %d プロジェクト (%s)",
+		"cleanup.archive_first":   "削除する前にエントリのアーカイブを保存",
+		"cleanup.include_secrets": "アーカイブにシークレットを含める",
+		"cleanup.export_only":     "アーカイブをエクスポート…",
+		"cleanup.remove":          "選択項目を削除",
+		"cleanup.confirm":         "%d 件のプロジェクト (%s) を ~/.claude.json から削除しますか?",
+		"cleanup.archived":        "%d 件のプロジェクトを %s にアーカイブしました",
+		"cleanup.removed":         "%d 件のプロジェクトを削除しました",
+		"toolbar.discover":          "検出",
+		"tree.discovered":           "検出されたプロジェクト",
+		"detail.discovered_project": "検出されたプロジェクト",
+		"detail.discovered_hint":    "このフォルダーには MCP 設定がありますが、~/.claude.json に登録されていません。登録すると、ファイル内のサーバーとともにプロジェクト一覧に表示されます。",
+		"discovery.title":           "プロジェクトの検出",
+		"discovery.hint":            "指定したフォルダー (例: ~/src) から、~/.claude.json にまだ登録されていない .mcp.json または .mcp.local.json を持つプロジェクトを探します。隠しフォルダー、node_modules、vendor はスキップされます。",
+		"discovery.roots":           "検索するフォルダー",
+		"discovery.no_roots":        "フォルダーがありません: 追加してください",
+		"discovery.add_root":        "フォルダーを追加…",
+		"discovery.scan":            "検索",
+		"discovery.none":            "未登録のプロジェクトは見つかりませんでした",
+		"discovery.register":        "選択項目を登録",
+		"discovery.register_one":    "プロジェクトを登録",
+		"discovery.registered":      "%d 件のプロジェクトを登録しました",
+		"tree.search_placeholder":          "名前、コマンド、引数、URL、env、パスで検索…",
+		"tree.filter_all_types":            "すべての種類",
+		"tree.filter_all_sources":          "すべてのファイル",
+		"tree.filter_source_global":        "グローバル (~/.claude.json)",
+		"tree.filter_source_project":       "プロジェクト (~/.claude.json)",
+		"tree.filter_source_project_file":  ".mcp.json",
+		"tree.filter_source_project_local": ".mcp.local.json",
+		"tree.sort_name":                   "名前順",
+		"tree.sort_type":                   "種類順",
+		"tree.sort_usage":                  "プロジェクト数順",
+		"tree.multi_select":      "複数選択",
+		"bulk.title":             "一括操作",
+		"bulk.selected":          "%d 個のサーバーを選択中",
+		"bulk.delete":            "削除",
+		"bulk.move":              "移動",
+		"bulk.clone":             "複製",
+		"bulk.export":            "エクスポート",
+		"bulk.clear":             "選択解除",
+		"bulk.move_title":        "%d 個のサーバーを移動",
+		"bulk.clone_title":       "%d 個のサーバーを複製",
+		"bulk.summary":           "選択したサーバー: %d — 変更: %s",
+		"bulk.skipped_exists":    "'%s' は %s に既に存在します: スキップ",
+		"bulk.overwrite":         "移動先・複製先の既存サーバーを上書き",
+		"bulk.apply":             "適用",
+		"bulk.nothing":           "適用する変更はありません",
+		"export.selection":       "ツリーの選択 (%d 個のサーバー)",
+		"history.op_bulk_remove": "%s 個のサーバーを削除",
+		"history.op_bulk_move":   "%s 個のサーバーを移動",
+		"history.op_bulk_clone":  "%s 個のサーバーを複製",
+		"toolbar.compare":         "比較",
+		"compare.title":           "比較",
+		"compare.hint":            "2 つのサーバー、2 つのプロジェクトの実効設定、またはプロジェクトとグローバルスコープを比較します。矢印で値または定義全体を反対側 (サーバーが書かれているファイル) にコピーします。",
+		"compare.left":            "左",
+		"compare.right":           "右",
+		"compare.effective":       "%s (実効設定)",
+		"compare.show_identical":  "同一のサーバーを表示",
+		"compare.pick_sides":      "比較する 2 つの側を選択してください",
+		"compare.same_side":       "両側が同じです",
+		"compare.mismatch":        "サーバーは別のサーバーとのみ比較できます",
+		"compare.summary":         "同一: %d、相違: %d、左のみ: %d、右のみ: %d",
+		"compare.empty":           "どちらの側にもサーバーがありません",
+		"compare.only_left":       "左のみ (%s)",
+		"compare.only_right":      "右のみ (%s)",
+		"compare.clone_to":        "%s にコピー",
+		"compare.identical":       "同一",
+		"compare.copy_definition": "定義",
+		"toolbar.tools": "ツール",
+		"cli.usage_line":               "使い方: mcp-curator %s",
+		"cli.error":                    "エラー: %s",
+		"cli.unknown_command":          "不明なコマンド: %s",
+		"cli.unknown_lang":             "サポートされていない言語: %s (利用可能: %s)",
+		"cli.help_gui":                 "引数なしで起動するとグラフィカルインターフェースが開きます。",
+		"cli.help_usage":               "使い方: mcp-curator [--config PATH] [--lang 言語] <コマンド> [引数]",
+		"cli.help_commands":            "コマンド:",
+		"cli.help_scope":               "--project を指定しない場合、コマンドはグローバルスコープに対して動作します。\n--scope でプロジェクトのファイルを選択します: project (~/.claude.json、既定)、\nproject-file (.mcp.json)、project-local (.mcp.local.json)。",
+		"cli.flag_config":              "~/.claude.json の代替パス",
+		"cli.flag_lang":                "出力言語: IT, EN, FR, DE, ES, PT, JA, KO, CN, UK",
+		"cli.cmd.list":                 "グローバルとプロジェクトのサーバーを一覧表示 (--reveal 以外ではシークレットを隠す)",
+		"cli.cmd.show":                 "サーバーの設定を表示 (--reveal 以外ではシークレットを隠す)",
+		"cli.cmd.add":                  "サーバーを追加 (カタログのテンプレートからも可)",
+		"cli.cmd.update":               "サーバーの指定したフィールドを変更",
+		"cli.cmd.remove":               "サーバーを削除",
+		"cli.cmd.move":                 "スコープやプロジェクトファイル間でサーバーを移動",
+		"cli.cmd.clone":                "サーバーを他のスコープにコピー",
+		"cli.cmd.bulk":                 "search と同じ条件で選んだサーバーを一括で削除・移動・コピー",
+		"cli.cmd.import":               "Claude Desktop、Cursor、VS Code、Codex、Goose からサーバーをインポート (PATH なしで見つかったファイルを一覧表示)",
+		"cli.cmd.export":               "Claude Desktop、Cursor、VS Code、Codex、Goose 向け、または mcpServers スニペットとしてサーバーをエクスポート",
+		"cli.cmd.search":               "名前、コマンド、引数、URL、env キー、プロジェクトパスで全スコープのサーバーを検索",
+		"cli.cmd.compare":              "2 つのサーバー (名前@global、名前@PATH)、2 つのプロジェクト (PATH)、またはプロジェクトと global をフィールドごとに比較",
+		"cli.cmd.duplicates":           "グローバルスコープとプロジェクトにまたがって複数存在するサーバーを差分付きで検出",
+		"cli.cmd.promote":              "サーバーをグローバルにし、同一のプロジェクトコピーを削除",
+		"cli.cmd.discover":             "プロジェクトとして未登録の .mcp.json または .mcp.local.json を含むフォルダーを検索",
+		"cli.cmd.register":             "フォルダーを ~/.claude.json にプロジェクトとして登録",
+		"cli.cmd.cleanup":              "フォルダーが存在しないプロジェクトを ~/.claude.json から削除 (アーカイブは任意)",
+		"cli.cmd.validate":             "すべてのサーバーの設定をチェック",
+		"cli.cmd.test":                 "サーバーと MCP initialize ハンドシェイクを実行",
+		"cli.cmd.inventory":            "サーバーが公開するツール、リソース、プロンプトを一覧表示",
+		"cli.cmd.templates":            "サーバーテンプレートを一覧表示し、チームの追加カタログを管理",
+		"cli.cmd.vault":                "env と headers で使うシークレットの暗号化保管庫を管理",
+		"cli.cmd.backups":              "~/.claude.json のバックアップと現在のファイルとの差分を一覧表示",
+		"cli.cmd.restore":              "バックアップから MCP サーバーを復元",
+		"cli.cmd.version":              "バージョンを表示",
+		"cli.location_global":          "グローバルスコープ",
+		"cli.location_project":         "プロジェクト %s",
+		"cli.source_global":            "グローバル",
+		"cli.source_project":           "プロジェクト",
+		"cli.severity_error":           "エラー",
+		"cli.severity_warning":         "警告",
+		"cli.warning":                  "注意: %s",
+		"cli.absent":                   "(なし)",
+		"cli.no_servers":               "(サーバーなし)",
+		"cli.field_name":               "名前",
+		"cli.field_type":               "種類",
+		"cli.field_command":            "コマンド",
+		"cli.field_category":           "カテゴリ",
+		"cli.field_description":        "説明",
+		"cli.field_catalog":            "カタログ",
+		"cli.field_parameter":          "パラメーター",
+		"cli.field_required":           "必須",
+		"cli.field_result":             "結果",
+		"cli.field_protocol":           "プロトコル",
+		"cli.field_error":              "エラー",
+		"cli.field_destination":        "宛先",
+		"cli.list_global":              "グローバル (%s)",
+		"cli.list_projects":            "プロジェクト",
+		"cli.list_project_missing":     "フォルダーが見つかりません: cleanup を参照",
+		"cli.server_added":             "サーバー '%s' を %s に追加しました",
+		"cli.server_updated":           "サーバー '%s' を更新しました",
+		"cli.server_removed":           "サーバー '%s' を %s から削除しました",
+		"cli.server_moved":             "サーバー '%s' を %s に移動しました",
+		"cli.server_cloned":            "サーバー '%s' を %d か所に複製しました",
+		"cli.test_ok":                  "OK (%dms)",
+		"cli.test_failed":              "失敗 (%s)",
+		"cli.inventory_header":         "%s %s (プロトコル %s、読み取り日時 %s)",
+		"cli.inventory_tools":          "ツール (%d):",
+		"cli.inventory_resources":      "リソース (%d):",
+		"cli.inventory_prompts":        "プロンプト (%d):",
+		"cli.inventory_failed":         "%s に失敗しました: %s",
+		"cli.valid_servers":            "有効なサーバー: %d",
+		"cli.no_backups":               "(バックアップなし)",
+		"cli.nothing_to_restore":       "復元する差分はありません",
+		"cli.restored":                 "%d 個のサーバーを %s から復元しました",
+		"cli.bulk_none":                "サーバーが選択されていません",
+		"cli.bulk_plan":                "選択したサーバー: %d、変更: %s",
+		"cli.bulk_done":                "選択したサーバー: %d、保存した変更: %s",
+		"cli.cleanup_none":             "フォルダーが見つからないプロジェクトはありません",
+		"cli.cleanup_plan":             "削除するプロジェクト: %d (%s)",
+		"cli.archive_saved":            "アーカイブを %s に保存しました",
+		"cli.cleanup_done":             "%d 個のプロジェクトを %s から削除しました (%s)",
+		"cli.compare_left_only":        "左のみ (%s)",
+		"cli.compare_right_only":       "右のみ (%s)",
+		"cli.compare_identical":        "同一のサーバー: %d (表示するには --all)",
+		"cli.discover_none":            "未登録のプロジェクトは見つかりませんでした",
+		"cli.discover_hint":            "登録するには: mcp-curator discover --register または mcp-curator register PATH",
+		"cli.registered_count":         "%d 個のプロジェクトを %s に登録しました",
+		"cli.already_registered":       "%s: 登録済み",
+		"cli.registered":               "%s を登録しました (%s)",
+		"cli.no_mcp_files":             "MCP ファイルなし",
+		"cli.duplicates_none":          "重複したサーバーはありません",
+		"cli.duplicates_group":         "コピー: %d、相違: %d",
+		"cli.promote_hint":             "同一のプロジェクトコピーを削除",
+		"cli.promote_plan":             "'%s' はグローバルになり、削除されるプロジェクトコピー: %d",
+		"cli.promote_done":             "サーバー '%s' をグローバルにしました。削除したプロジェクトコピー: %d",
+		"cli.exported":                 "%d 個のサーバーを %s にエクスポートしました",
+		"cli.import_empty":             "%s に MCP サーバーはありません",
+		"cli.imported":                 "%d 個のサーバーを %s にインポートしました",
+		"cli.import_nothing":           "インポートするサーバーはありません: すべて登録済みです",
+		"cli.import_no_sources":        "他のクライアントの設定ファイルは見つかりませんでした",
+		"cli.search_none":              "サーバーが見つかりません",
+		"cli.search_usage":             "プロジェクト: %d",
+		"cli.catalogs":                 "追加カタログ",
+		"cli.catalog_added":            "カタログを追加しました: %s",
+		"cli.catalog_removed":          "カタログを削除しました: %s",
+		"cli.vault_created":            "保管庫を作成しました: %s",
+		"cli.no_secrets":               "(シークレットなし)",
+		"cli.secret_usages":            "使用 %d 件",
+		"cli.secret_added":             "シークレット %s を保管庫に追加しました",
+		"cli.secret_updated":           "シークレット %s を更新しました",
+		"cli.secret_server_updated":    "更新: %s (%s)",
+		"cli.secret_deleted":           "シークレット %s を削除しました",
+		"cli.secret_bound":             "%s %s (%s) をシークレット %s に関連付けました (%s)",
+		"cli.launcher_hint":            "${%s} を解決するにはランチャー (mcp-curator vault launcher) で Claude Code を起動してください",
+		"cli.launcher_created":         "ランチャーを作成しました: %s",
+		"cli.launcher_usage":           "`claude` の代わりに使うと、${名前} で参照されたシークレット付きで Claude Code を起動します。",
+		"cli.passphrase_changed":       "保管庫のパスフレーズを変更しました",
+		"cli.prompt_passphrase":        "保管庫のパスフレーズ: ",
+		"cli.prompt_new_passphrase":    "新しい保管庫のパスフレーズ: ",
+		"cli.prompt_repeat_passphrase": "パスフレーズを再入力: ",
+		"cli.prompt_secret_value":      "%s の値: ",
+		"cli.prompt_new_secret_value":  "%s の新しい値: ",
+		"cli.flag.project_server":      "サーバーのプロジェクト (既定: グローバル)",
+		"cli.flag.project_add":         "指定したプロジェクトに追加 (既定: グローバル)",
+		"cli.flag.project_source":      "移動元のプロジェクト (既定: グローバル)",
+		"cli.flag.project_promote":     "昇格するコピーのプロジェクト (既定: グローバル、コピーの削除のみ)",
+		"cli.flag.project_export":      "指定したプロジェクトからエクスポート (既定: グローバル)",
+		"cli.flag.project_import":      "指定したプロジェクトにインポート (既定: グローバル)",
+		"cli.flag.project_only":        "このプロジェクトのサーバーのみ (すべてのファイル)",
+		"cli.flag.project_list":        "指定したプロジェクトのサーバーのみ表示",
+		"cli.flag.scope":               "プロジェクトのファイル: project (~/.claude.json)、project-file (.mcp.json)、project-local (.mcp.local.json)",
+		"cli.flag.type":                "サーバーの種類: stdio, http, sse",
+		"cli.flag.command":             "実行するコマンド (stdio)",
+		"cli.flag.arg":                 "コマンドの引数 (複数指定可)",
+		"cli.flag.url":                 "サーバーの URL (http/sse)",
+		"cli.flag.env":                 "環境変数 KEY=value (複数指定可)",
+		"cli.flag.header":              "HTTP ヘッダー KEY=value (複数指定可)",
+		"cli.flag.timeout":             "タイムアウト (ミリ秒)",
+		"cli.flag.effective":           "プロジェクトの有効なサーバーを表示 (全スコープのマージ)",
+		"cli.flag.effective_export":    "プロジェクトの有効なサーバーをエクスポート (全スコープのマージ)",
+		"cli.flag.json":                "JSON 形式で出力",
+		"cli.flag.json_import":         "JSON 形式で出力 (--dry-run 指定時または PATH なし)",
+		"cli.flag.json_cleanup":        "プロジェクトを削除せずに JSON 形式で一覧表示",
+		"cli.flag.json_server":         "サーバーの JSON 設定 (フラグが優先)",
+		"cli.flag.reveal":              "[REDACTED] の代わりにシークレットを表示",
+		"cli.flag.reveal_archive":      "[REDACTED] の代わりにシークレットをアーカイブに含める",
+		"cli.flag.reveal_export":       "[REDACTED] の代わりにシークレットを含める",
+		"cli.flag.template":            "カタログのテンプレートから作成 (`templates` を参照)",
+		"cli.flag.param":               "テンプレートのパラメーター K=値 (複数指定可)",
+		"cli.flag.clear_args":          "すべての引数を削除",
+		"cli.flag.unset_env":           "環境変数を削除 (複数指定可)",
+		"cli.flag.unset_header":        "HTTP ヘッダーを削除 (複数指定可)",
+		"cli.flag.from_scope":          "移動元プロジェクトのファイル (project, project-file, project-local)",
+		"cli.flag.to":                  "移動先: 'global' またはプロジェクトのパス",
+		"cli.flag.to_repeat":           "宛先: 'global' またはプロジェクトのパス (複数指定可)",
+		"cli.flag.to_bulk":             "宛先: 'global' またはプロジェクトのパス (clone では複数指定可)",
+		"cli.flag.to_global":           "グローバルスコープに移動 (--to global と同じ)",
+		"cli.flag.to_scope":            "移動先プロジェクトのファイル (project, project-file, project-local)",
+		"cli.flag.to_scope_many":       "宛先プロジェクトのファイル (project, project-file, project-local)",
+		"cli.flag.force_one":           "移動先の同名サーバーを上書き",
+		"cli.flag.force_many":          "宛先の同名サーバーを上書き",
+		"cli.flag.force_import":        "同名で内容の異なるサーバーを置き換え",
+		"cli.flag.dry_run":             "書き込まずに変更内容を表示",
+		"cli.flag.dry_run_promote":     "書き込まずに実行内容を表示",
+		"cli.flag.dry_run_import":      "書き込まずにインポート内容を表示",
+		"cli.flag.dry_run_cleanup":     "プロジェクトを削除せずに一覧表示",
+		"cli.flag.archive":             "削除前にプロジェクトのエントリをこのファイルに保存",
+		"cli.flag.cached":              "接続せずに最後に読み取ったインベントリを使用",
+		"cli.flag.diff":                "各バックアップを復元した場合に変わるサーバーを表示",
+		"cli.flag.restore_server":      "指定したサーバーのみ復元 (複数指定可)",
+		"cli.flag.import_server":       "指定したサーバーのみインポート (複数指定可)",
+		"cli.flag.all":                 "同一のサーバーも表示",
+		"cli.flag.depth":               "最大検索深度 (既定: 設定、なければ 4)",
+		"cli.flag.save_roots":          "指定したフォルダーを設定に追加",
+		"cli.flag.register":            "見つかったすべてのプロジェクトを登録",
+		"cli.flag.as":                  "グローバルサーバーの名前 (既定: コピーの名前)",
+		"cli.flag.keep_copies":         "同一のプロジェクトコピーを削除しない",
+		"cli.flag.export_format":       "形式: mcp-servers, claude-desktop, cursor, vscode, codex, goose",
+		"cli.flag.output":              "stdout の代わりにファイルへ書き込む",
+		"cli.flag.import_format":       "ファイル形式: claude-desktop, cursor, vscode, codex, goose (既定: パスから推定)",
+		"cli.flag.filter_type":         "この種類のサーバーのみ: stdio, http, sse",
+		"cli.flag.filter_source":       "このファイルのサーバーのみ: global, project, project-file, project-local",
+		"cli.flag.sort":                "並び順: name, type, usage (サーバーを使うプロジェクト数)",
+		"cli.flag.unlock":              "保管庫のロックを解除し、ファイル内の解決済みの値も認識",
+		"cli.flag.bind_env":            "関連付けるサーバーの環境変数",
+		"cli.flag.bind_header":         "関連付けるサーバーの HTTP ヘッダー",
+		"cli.flag.secret":              "シークレット名 (既定: env または header のキー)。存在しなければ作成",
+		"cli.flag.inline":              "${名前} の代わりに解決済みの値をファイルに書き込む",
+		"cli.shadows":                  "%s を上書き",
+		"cli.file_servers":             "%s: サーバー %d",
+		"cli.server_count":             "サーバー %d",
+		"cli.template_default":         "既定 %s",
 	}
 
 	// Korean
@@ -625,8 +4271,6 @@ func (t *Translator) loadStrings() {
 		"dialog.delete_server":         "서버 삭제",
 		"dialog.delete_confirm":        "서버 '%s'을(를) 삭제하시겠습니까?",
 		"dialog.move_server":           "서버 이동",
-		"dialog.move_to_project":       "대상 프로젝트 선택:",
-		"dialog.move_to_global":        "서버 '%s'을(를) 전역 범위로 이동하시겠습니까?",
 		"dialog.no_projects":           "프로젝트 없음",
 		"dialog.no_projects_msg":       "서버를 이동할 구성된 프로젝트가 없습니다.",
 		"dialog.clone_server":          "서버 복제",
@@ -648,11 +4292,525 @@ func (t *Translator) loadStrings() {
 		"form.command":        "명령어",
 		"form.command_hint":   "명령어 (예: uvx, npx)",
 		"form.args":           "인수",
-		"form.args_hint":      "공백으로 구분된 인수 (예: -y mcp-server)",
 		"form.url":            "URL",
 		"form.url_hint":       "URL (http/sse용)",
 		"form.env":            "환경 변수",
-		"form.env_hint":       "KEY=value, KEY2=value2 (쉼표로 구분)",
+		"form.file":                "파일",
+		"form.file_settings":       "~/.claude.json (프로젝트 설정)",
+		"form.file_mcp_json":       ".mcp.json (공유)",
+		"form.file_mcp_local":      ".mcp.local.json (로컬)",
+		"form.project_required":    "프로젝트를 선택하세요",
+		"dialog.move_to":           "대상 선택:",
+		"dialog.clone_file":        "프로젝트의 대상 파일:",
+		"dialog.overwrite_title":   "서버가 이미 존재합니다",
+		"dialog.overwrite_confirm": "%[2]s에 서버 '%[1]s'이(가) 이미 존재합니다. 덮어쓰시겠습니까?",
+		"conflict.title":       "외부에서 설정이 변경됨",
+		"conflict.message":     "%s 파일이 로드된 후 다른 프로그램(예: Claude Code)에 의해 변경되었습니다. 다른 변경 사항은 유지됩니다. 양쪽에서 변경된 서버에 대해 유지할 버전을 선택하세요.",
+		"conflict.mine":        "내 버전",
+		"conflict.theirs":      "디스크 버전",
+		"conflict.keep_mine":   "내 것 유지",
+		"conflict.keep_theirs": "디스크 버전 사용",
+		"conflict.removed":     "(삭제됨)",
+		"conflict.apply":       "적용",
+		"banner.changed_externally": "외부에서 설정이 변경됨: %s",
+		"banner.added":              "%d개 추가",
+		"banner.modified":           "%d개 변경",
+		"banner.removed":            "%d개 삭제",
+		"toolbar.backups":         "백업",
+		"backup.title":            "~/.claude.json 백업",
+		"backup.select":           "차이를 보려면 백업을 선택하세요",
+		"backup.none":             "사용 가능한 백업이 없습니다",
+		"backup.no_changes":       "백업의 MCP 서버가 현재와 동일합니다",
+		"backup.diff_hint":        "복원 시 MCP 서버에 미치는 영향 (복원할 항목 선택):",
+		"backup.change_added":     "추가됨",
+		"backup.change_removed":   "삭제됨",
+		"backup.change_modified":  "복원됨",
+		"backup.restore_selected": "선택 항목 복원",
+		"backup.restore_all":      "모두 복원",
+		"backup.nothing_selected": "선택된 서버가 없습니다",
+		"backup.restore_confirm":  "%[2]s 백업에서 서버 %[1]d개를 복원하시겠습니까? ~/.claude.json의 나머지 내용은 변경되지 않습니다.",
+		"backup.retention":        "보존",
+		"backup.max_count":        "최대 개수",
+		"backup.max_age":          "최대 보존 기간 (일, 0 = 무제한)",
+		"backup.dir":              "디렉터리",
+		"backup.dir_hint":         "~/.claude.json 옆",
+		"toolbar.history":    "기록",
+		"btn.close":          "닫기",
+		"history.title":      "변경 기록",
+		"history.empty":      "이 세션에서 변경 사항이 없습니다",
+		"history.undone":     "실행 취소됨",
+		"history.op_add":     "'%s' 추가",
+		"history.op_update":  "'%s' 편집",
+		"history.op_remove":  "'%s' 삭제",
+		"history.op_move":    "'%s' 이동",
+		"history.op_clone":   "'%s' 복제",
+		"history.op_restore": "%s에서 복원",
+		"btn.test":                 "테스트",
+		"test.title":               "연결 테스트: %s",
+		"test.running":             "%[1]s 와(과) MCP 핸드셰이크 중 (타임아웃 %[2]s)...",
+		"test.success":             "핸드셰이크 성공 (%s)",
+		"test.failed":              "핸드셰이크 실패",
+		"test.protocol":            "프로토콜 버전",
+		"test.server_info":         "서버",
+		"test.capabilities":        "기능",
+		"test.instructions":        "안내",
+		"test.none":                "없음",
+		"test.reason":              "원인",
+		"test.detail":              "상세",
+		"test.stderr":              "stderr 출력",
+		"test.failure_not_found":   "명령을 찾을 수 없음",
+		"test.failure_exited":      "프로세스가 코드 %d 로 종료됨",
+		"test.failure_timeout":     "%s 이내에 응답 없음",
+		"test.failure_tls":         "TLS 오류",
+		"test.failure_http_status": "서버가 HTTP %d 로 응답함",
+		"test.failure_network":     "네트워크 오류",
+		"test.failure_protocol":    "MCP 프로토콜을 따르지 않는 응답",
+		"test.failure_config":      "서버 설정이 불완전함",
+		"inventory.title":          "인벤토리",
+		"inventory.load":           "인벤토리 읽기",
+		"inventory.refresh":        "새로 고침",
+		"inventory.not_loaded":     "서버에 연결하여 제공하는 도구, 리소스, 프롬프트를 확인하세요",
+		"inventory.loading":        "%[1]s 에서 도구, 리소스, 프롬프트를 읽는 중 (타임아웃 %[2]s)...",
+		"inventory.fetched_at":     "읽은 시각 %s",
+		"inventory.tools":          "도구 (%d)",
+		"inventory.resources":      "리소스 (%d)",
+		"inventory.prompts":        "프롬프트 (%d)",
+		"inventory.schema":         "입력 스키마",
+		"inventory.no_description": "설명 없음",
+		"inventory.list_error":     "%[1]s 실패: %[2]s",
+		"tree.tool_count":          "도구 %d개",
+		"validation.name_required":       "이름은 필수입니다",
+		"validation.name_invalid":        "이름에는 문자, 숫자, '-', '_'만 사용할 수 있습니다 (도구 이름은 mcp__<이름>__<도구>)",
+		"validation.name_too_long":       "이름이 깁니다: 도구 이름 mcp__<이름>__<도구>가 64자를 넘을 수 있습니다",
+		"validation.type_invalid":        "알 수 없는 유형: %s (허용: stdio, http, sse)",
+		"validation.type_missing":        "명령(stdio) 또는 URL(http/sse)을 지정하세요",
+		"validation.command_required":    "stdio 서버에는 명령이 필요합니다",
+		"validation.command_with_url":    "서버에 명령과 URL을 동시에 지정할 수 없습니다",
+		"validation.command_for_remote":  "%s 서버는 명령을 무시합니다",
+		"validation.command_has_spaces":  "명령에 공백이 있습니다: 인수는 args에 지정하세요",
+		"validation.args_empty":          "%s번째 인수가 비어 있습니다",
+		"validation.args_for_remote":     "원격 서버는 args를 무시합니다",
+		"validation.url_required":        "%s 서버에는 URL이 필요합니다",
+		"validation.url_for_stdio":       "stdio 서버는 URL을 무시합니다",
+		"validation.url_invalid":         "잘못된 URL: %s",
+		"validation.url_scheme":          "지원되지 않는 URL 스킴: %s (http 또는 https 사용)",
+		"validation.url_insecure":        "원격 호스트로의 암호화되지 않은 연결(http): %s",
+		"validation.sse_deprecated":      "SSE 전송은 더 이상 권장되지 않습니다: 서버가 지원하면 http를 사용하세요",
+		"validation.env_name_empty":      "환경 변수 이름이 비어 있습니다",
+		"validation.env_name_invalid":    "일반적이지 않은 환경 변수 이름: %s",
+		"validation.headers_for_stdio":   "stdio 서버는 헤더를 무시합니다",
+		"validation.header_name_invalid": "잘못된 헤더 이름: %s",
+		"validation.timeout_negative":    "타임아웃은 음수일 수 없습니다",
+		"validation.title":               "설정 문제",
+		"validation.invalid_server":      "서버 %s 이(가) 유효하지 않습니다",
+		"validation.variable_missing":   "변수 ${%s} 이(가) 정의되지 않았고 기본값도 없습니다",
+		"detail.headers":                "헤더",
+		"detail.expanded":               "확장됨",
+		"detail.missing_variables":      "누락된 환경 변수",
+		"detail.missing_variables_hint": "환경에 정의되지 않았고 기본값도 없습니다: 이를 사용하는 서버가 시작되지 않을 수 있습니다.",
+		"toolbar.vault":             "Vault",
+		"history.op_bind":           "시크릿 '%s' 연결",
+		"history.op_rotate":         "시크릿 '%s' 교체",
+		"vault.title":               "시크릿 Vault",
+		"vault.intro":               "시크릿은 %s 에 패스프레이즈로 암호화되어 저장됩니다. env와 headers에서 ${NAME}으로 참조할 수 있으며, 런처로 Claude Code를 시작하면 값이 설정 파일에 나타나지 않고 내보내집니다.",
+		"vault.create":              "Vault 만들기",
+		"vault.passphrase":          "패스프레이즈",
+		"vault.passphrase_confirm":  "패스프레이즈 확인",
+		"vault.passphrase_mismatch": "패스프레이즈가 일치하지 않습니다",
+		"vault.unlock":              "잠금 해제",
+		"vault.lock":                "잠금",
+		"vault.locked_hint":         "Vault가 잠겨 있습니다. 시크릿을 관리하려면 패스프레이즈를 입력하세요.",
+		"vault.no_secrets":          "Vault에 시크릿이 없습니다",
+		"vault.usages":              "사용 %d건",
+		"vault.add_secret":          "시크릿 추가",
+		"vault.secret_name":         "이름",
+		"vault.secret_value":        "값",
+		"vault.rotate":              "교체",
+		"vault.rotate_title":        "%s 교체",
+		"vault.new_value":           "새 값",
+		"vault.rotated":             "시크릿 %[1]s 업데이트됨: 서버 %[2]d개 변경",
+		"vault.delete_confirm":      "Vault에서 시크릿 %s을(를) 삭제하시겠습니까?",
+		"vault.in_use":              "시크릿 %[1]s은(는) 다음에서 ${%[2]s}(으)로 사용 중입니다:",
+		"vault.change_passphrase":   "패스프레이즈 변경",
+		"vault.launcher":            "런처 생성",
+		"vault.launcher_created":    "런처가 %s 에 생성되었습니다. `claude` 대신 사용하면 ${NAME}으로 참조된 시크릿과 함께 Claude Code를 시작합니다.",
+		"vault.copy_path":           "경로 복사",
+		"vault.mode":                "모드",
+		"vault.mode_reference":      "${NAME} 참조",
+		"vault.mode_inline":         "파일에 해석된 값",
+		"vault.binding":             "vault: %s (%s)",
+		"vault.bind":                "Vault",
+		"vault.bind_title":          "%s을(를) Vault에 연결",
+		"vault.bind_hint":           "시크릿이 없으면 현재 값으로 생성됩니다.",
+		"vault.needs_unlock":        "값을 시크릿에 연결하려면 Vault 잠금을 해제하세요.",
+		"detail.reveal": "표시",
+		"detail.hide":   "숨기기",
+		"btn.copy_json": "JSON 복사",
+		"form.headers":                  "헤더",
+		"form.timeout":                  "타임아웃 (ms)",
+		"form.timeout_hint":             "비워 두면 Claude Code 기본값",
+		"form.add_row":                  "추가",
+		"form.add_arg":                  "인수 추가",
+		"form.paste_command":            "가져오기",
+		"form.paste_command_hint":       "명령줄 붙여넣기 (예: npx -y pkg --dir \"My Docs\")",
+		"form.env_name_hint":            "이름",
+		"form.header_name_hint":         "헤더 이름",
+		"form.value_hint":               "값",
+		"form.preview":                  "기록될 JSON",
+		"validation.command_line_quote": "잘못된 명령줄: 따옴표가 닫히지 않았습니다",
+		"validation.key_duplicate":      "중복된 키: %s (마지막 행이 적용됨)",
+		"validation.timeout_invalid":    "타임아웃은 밀리초 단위의 정수여야 합니다",
+		"dialog.add_via_template":        "템플릿에서",
+		"template.title":                 "서버 템플릿",
+		"template.select":                "템플릿을 선택하세요",
+		"template.filter":                "템플릿 검색...",
+		"template.source":                "카탈로그: %s",
+		"template.add":                   "서버 추가",
+		"template.catalogs":              "카탈로그",
+		"template.catalogs_hint":         "내장 카탈로그에 더해 읽는 추가 카탈로그(JSON 파일 또는 팀이 공유하는 폴더). 같은 ID의 템플릿은 내장 템플릿을 대체합니다.",
+		"template.no_catalogs":           "추가 카탈로그 없음",
+		"template.add_file":              "파일 추가...",
+		"template.add_folder":            "폴더 추가...",
+		"validation.template_catalog":    "%s",
+		"validation.template_parameters": "%s",
+		"toolbar.import":                  "가져오기",
+		"history.op_import":               "%s에서 가져오기",
+		"import.title":                    "다른 클라이언트에서 가져오기",
+		"import.source":                   "원본 파일",
+		"import.select_source":            "구성 파일을 선택하세요",
+		"import.no_sources":               "다른 클라이언트 구성을 찾을 수 없습니다",
+		"import.format":                   "형식",
+		"import.browse":                   "찾아보기...",
+		"import.target":                   "대상",
+		"import.overwrite":                "이름이 같은 다른 서버 바꾸기",
+		"import.run":                      "선택 항목 가져오기",
+		"import.empty":                    "파일에 MCP 서버가 없습니다",
+		"import.partial":                  "서버 %d개를 가져왔으며 일부는 건너뛰었습니다:",
+		"import.done":                     "서버 %d개를 %s(으)로 가져왔습니다",
+		"import.status_new":               "새로 추가",
+		"import.status_identical":         "이미 있음",
+		"import.status_conflict":          "충돌",
+		"validation.import_field_ignored": "'%s' 필드는 Claude Code에서 지원되지 않아 가져오지 않았습니다",
+		"validation.import_variable":      "원본 클라이언트의 변수 %s는 지원되지 않습니다: 값이나 ${VAR}로 바꾸세요",
+		"validation.import_disabled":      "원본 클라이언트에서 비활성화된 서버",
+		"validation.import_renamed":       "원래 이름 '%s'을(를) 사용할 수 없어 이름을 변경했습니다",
+		"validation.import_conflict":      "대상에 같은 이름의 다른 서버가 이미 있습니다",
+		"validation.import_invalid":       "잘못된 항목이라 가져올 수 없습니다: %s",
+		"import.format_claude-desktop":    "Claude Desktop",
+		"import.format_cursor":            "Cursor",
+		"import.format_vscode":            "VS Code",
+		"import.format_codex":             "Codex CLI",
+		"import.format_goose":             "Goose",
+		"validation.import_read":          "%s",
+		"toolbar.export":                      "내보내기",
+		"export.title":                        "다른 클라이언트로 내보내기",
+		"export.source":                       "서버",
+		"export.format":                       "형식",
+		"export.servers":                      "내보낼 서버",
+		"export.preview":                      "미리보기 (비밀 숨김)",
+		"export.effective":                    "%s — 유효 서버",
+		"export.empty":                        "이 위치에 서버가 없습니다",
+		"export.include_secrets":              "저장 파일에 비밀 포함",
+		"export.copy":                         "복사",
+		"export.save":                         "파일로 저장…",
+		"export.done":                         "서버 %d개를 %s(으)로 내보냈습니다",
+		"export.format_mcp-servers":           "mcpServers 스니펫 (.mcp.json)",
+		"export.format_claude-desktop":        "Claude Desktop",
+		"export.format_cursor":                "Cursor",
+		"export.format_vscode":                "VS Code",
+		"export.format_codex":                 "Codex CLI (TOML)",
+		"export.format_goose":                 "Goose (YAML)",
+		"validation.export_field_unsupported": "필드 '%s'은(는) 대상 형식으로 표현할 수 없습니다: 내보내지 않음",
+		"validation.export_type_unsupported":  "%s 서버는 대상 형식에서 지원되지 않습니다: 내보내지 않음",
+		"validation.export_variable":          "%s은(는) 그대로 기록됩니다: 대상 클라이언트는 변수를 확장하지 않습니다",
+		"validation.export_variable_default":  "%s의 기본값은 대상 클라이언트에서 지원되지 않습니다",
+		"detail.effective_configs":    "유효 구성",
+		"detail.no_effective_servers": "이 프로젝트에 활성 서버가 없습니다",
+		"detail.shadowing_count":      "%d개 재정의됨",
+		"detail.shadows":              "      재정의: %s",
+		"detail.show_diff":            "차이점",
+		"detail.diff_title":           "%s 정의",
+		"detail.diff_against":         "%s 대 %s",
+		"detail.diff_identical":       "동일한 정의",
+		"toolbar.duplicates":       "중복",
+		"history.op_promote":       "'%s'을(를) 전역으로 승격",
+		"duplicates.title":         "중복 서버",
+		"duplicates.hint":          "같은 명령과 인수(또는 같은 URL)를 가진 서버가 여러 프로젝트에 있습니다. 전역으로 승격하면 동일한 사본은 불필요해지며 되돌릴 수 있는 한 번의 작업으로 제거됩니다.",
+		"duplicates.none":          "중복 서버가 없습니다",
+		"duplicates.summary":       "사본: %d, 다름: %d",
+		"duplicates.promote":       "전역으로 승격",
+		"duplicates.definition":    "정의",
+		"duplicates.global_name":   "전역 이름",
+		"duplicates.remove_copies": "제거할 사본:",
+		"toolbar.cleanup":         "정리",
+		"tree.missing":            "폴더를 찾을 수 없음",
+		"detail.project_missing":  "프로젝트 폴더가 더 이상 존재하지 않습니다: 이 항목은 ~/.claude.json에서 %s를 차지하며 제거할 수 있습니다.",
+		"cleanup.title":           "프로젝트 정리",
+		"cleanup.hint":            "폴더가 삭제되었거나 이동된, ~/.claude.json에 등록된 프로젝트입니다. 제거하면 해당 서버와 Claude Code 데이터(기록, 권한, 상태)가 삭제되며, 쓰기 전에 파일 백업이 생성됩니다.",
+		"cleanup.none":            "폴더가 없는 프로젝트가 없습니다",
+		"cleanup.project_details": "서버 %d개 · %s",
+		"cleanup.total":           "선택됨: 프로젝트 %d개 (%s)",
+		"cleanup.archive_first":   "제거하기 전에 항목 아카이브 저장",
+		"cleanup.include_secrets": "아카이브에 비밀 포함",
+		"cleanup.export_only":     "아카이브 내보내기…",
+		"cleanup.remove":          "선택 항목 제거",
+		"cleanup.confirm":         "~/.claude.json에서 프로젝트 %d개(%s)를 제거하시겠습니까?",
+		"cleanup.archived":        "프로젝트 %d개를 %s에 보관했습니다",
+		"cleanup.removed":         "프로젝트 %d개를 제거했습니다",
+		"toolbar.discover":          "찾기",
+		"tree.discovered":           "발견된 프로젝트",
+		"detail.discovered_project": "발견된 프로젝트",
+		"detail.discovered_hint":    "이 폴더에는 MCP 구성이 있지만 ~/.claude.json에 등록되어 있지 않습니다. 등록하면 파일의 서버와 함께 프로젝트 목록에 나타납니다.",
+		"discovery.title":           "프로젝트 찾기",
+		"discovery.hint":            "지정한 폴더(예: ~/src)에서 ~/.claude.json에 아직 등록되지 않은 .mcp.json 또는 .mcp.local.json이 있는 프로젝트를 찾습니다. 숨김 폴더, node_modules, vendor는 건너뜁니다.",
+		"discovery.roots":           "검색할 폴더",
+		"discovery.no_roots":        "폴더 없음: 하나를 추가하세요",
+		"discovery.add_root":        "폴더 추가…",
+		"discovery.scan":            "검색",
+		"discovery.none":            "등록되지 않은 프로젝트가 없습니다",
+		"discovery.register":        "선택 항목 등록",
+		"discovery.register_one":    "프로젝트 등록",
+		"discovery.registered":      "프로젝트 %d개를 등록했습니다",
+		"tree.search_placeholder":          "이름, 명령, 인수, URL, env 또는 경로로 검색…",
+		"tree.filter_all_types":            "모든 유형",
+		"tree.filter_all_sources":          "모든 파일",
+		"tree.filter_source_global":        "전역 (~/.claude.json)",
+		"tree.filter_source_project":       "프로젝트 (~/.claude.json)",
+		"tree.filter_source_project_file":  ".mcp.json",
+		"tree.filter_source_project_local": ".mcp.local.json",
+		"tree.sort_name":                   "이름순 정렬",
+		"tree.sort_type":                   "유형순 정렬",
+		"tree.sort_usage":                  "프로젝트 수순 정렬",
+		"tree.multi_select":      "다중 선택",
+		"bulk.title":             "일괄 작업",
+		"bulk.selected":          "서버 %d개 선택됨",
+		"bulk.delete":            "삭제",
+		"bulk.move":              "이동",
+		"bulk.clone":             "복제",
+		"bulk.export":            "내보내기",
+		"bulk.clear":             "선택 해제",
+		"bulk.move_title":        "서버 %d개 이동",
+		"bulk.clone_title":       "서버 %d개 복제",
+		"bulk.summary":           "선택한 서버: %d — 변경: %s",
+		"bulk.skipped_exists":    "'%s'이(가) %s에 이미 있음: 건너뜀",
+		"bulk.overwrite":         "대상에 이미 있는 서버 덮어쓰기",
+		"bulk.apply":             "적용",
+		"bulk.nothing":           "적용할 변경 사항이 없습니다",
+		"export.selection":       "트리 선택 (서버 %d개)",
+		"history.op_bulk_remove": "서버 %s개 삭제",
+		"history.op_bulk_move":   "서버 %s개 이동",
+		"history.op_bulk_clone":  "서버 %s개 복제",
+		"toolbar.compare":         "비교",
+		"compare.title":           "비교",
+		"compare.hint":            "두 서버, 두 프로젝트의 유효 구성 또는 프로젝트와 전역 범위를 비교합니다. 화살표는 값이나 전체 정의를 반대쪽의 서버가 작성된 파일로 복사합니다.",
+		"compare.left":            "왼쪽",
+		"compare.right":           "오른쪽",
+		"compare.effective":       "%s (유효 구성)",
+		"compare.show_identical":  "동일한 서버 표시",
+		"compare.pick_sides":      "비교할 두 쪽을 선택하세요",
+		"compare.same_side":       "양쪽이 같습니다",
+		"compare.mismatch":        "서버는 다른 서버와만 비교할 수 있습니다",
+		"compare.summary":         "동일: %d, 다름: %d, 왼쪽만: %d, 오른쪽만: %d",
+		"compare.empty":           "양쪽 모두 서버가 없습니다",
+		"compare.only_left":       "왼쪽에만 있음 (%s)",
+		"compare.only_right":      "오른쪽에만 있음 (%s)",
+		"compare.clone_to":        "%s(으)로 복사",
+		"compare.identical":       "동일",
+		"compare.copy_definition": "정의",
+		"toolbar.tools": "도구",
+		"cli.usage_line":               "사용법: mcp-curator %s",
+		"cli.error":                    "오류: %s",
+		"cli.unknown_command":          "알 수 없는 명령: %s",
+		"cli.unknown_lang":             "지원되지 않는 언어: %s (사용 가능: %s)",
+		"cli.help_gui":                 "인수 없이 실행하면 그래픽 인터페이스가 시작됩니다.",
+		"cli.help_usage":               "사용법: mcp-curator [--config PATH] [--lang 언어] <명령> [인수]",
+		"cli.help_commands":            "명령:",
+		"cli.help_scope":               "--project가 없으면 명령은 전역 범위에서 동작합니다.\n--scope는 프로젝트 파일을 선택합니다: project (~/.claude.json, 기본값),\nproject-file (.mcp.json) 또는 project-local (.mcp.local.json).",
+		"cli.flag_config":              "~/.claude.json의 대체 경로",
+		"cli.flag_lang":                "출력 언어: IT, EN, FR, DE, ES, PT, JA, KO, CN, UK",
+		"cli.cmd.list":                 "전역 및 프로젝트 서버 목록 (--reveal 없이는 시크릿 숨김)",
+		"cli.cmd.show":                 "서버 설정 표시 (--reveal 없이는 시크릿 숨김)",
+		"cli.cmd.add":                  "서버 추가 (카탈로그 템플릿에서도 가능)",
+		"cli.cmd.update":               "서버의 지정한 필드 수정",
+		"cli.cmd.remove":               "서버 제거",
+		"cli.cmd.move":                 "범위와 프로젝트 파일 간에 서버 이동",
+		"cli.cmd.clone":                "서버를 다른 범위로 복사",
+		"cli.cmd.bulk":                 "search와 같이 선택한 서버를 한 번에 제거, 이동 또는 복사",
+		"cli.cmd.import":               "Claude Desktop, Cursor, VS Code, Codex 또는 Goose에서 서버 가져오기 (PATH가 없으면 찾은 파일 목록 표시)",
+		"cli.cmd.export":               "Claude Desktop, Cursor, VS Code, Codex, Goose용 또는 mcpServers 스니펫으로 서버 내보내기",
+		"cli.cmd.search":               "이름, 명령, 인수, URL, env 키 또는 프로젝트 경로로 모든 범위의 서버 검색",
+		"cli.cmd.compare":              "두 서버 (이름@global, 이름@PATH), 두 프로젝트 (PATH) 또는 프로젝트와 global을 필드별로 비교",
+		"cli.cmd.duplicates":           "전역 범위와 프로젝트에 여러 사본이 있는 서버를 차이점과 함께 찾기",
+		"cli.cmd.promote":              "서버를 전역으로 만들고 동일한 프로젝트 사본 제거",
+		"cli.cmd.discover":             "아직 프로젝트로 등록되지 않은 .mcp.json 또는 .mcp.local.json 폴더 찾기",
+		"cli.cmd.register":             "폴더를 ~/.claude.json에 프로젝트로 등록",
+		"cli.cmd.cleanup":              "폴더가 더 이상 없는 프로젝트를 ~/.claude.json에서 제거 (아카이브 선택)",
+		"cli.cmd.validate":             "모든 서버의 설정 확인",
+		"cli.cmd.test":                 "서버와 MCP initialize 핸드셰이크 실행",
+		"cli.cmd.inventory":            "서버가 제공하는 도구, 리소스, 프롬프트 목록",
+		"cli.cmd.templates":            "서버 템플릿 목록 및 팀 추가 카탈로그 관리",
+		"cli.cmd.vault":                "env와 headers에 쓰이는 시크릿의 암호화 보관소 관리",
+		"cli.cmd.backups":              "~/.claude.json 백업과 현재 파일과의 차이 목록",
+		"cli.cmd.restore":              "백업에서 MCP 서버 복원",
+		"cli.cmd.version":              "버전 표시",
+		"cli.location_global":          "전역 범위",
+		"cli.location_project":         "프로젝트 %s",
+		"cli.source_global":            "전역",
+		"cli.source_project":           "프로젝트",
+		"cli.severity_error":           "오류",
+		"cli.severity_warning":         "경고",
+		"cli.warning":                  "주의: %s",
+		"cli.absent":                   "(없음)",
+		"cli.no_servers":               "(서버 없음)",
+		"cli.field_name":               "이름",
+		"cli.field_type":               "유형",
+		"cli.field_command":            "명령",
+		"cli.field_category":           "카테고리",
+		"cli.field_description":        "설명",
+		"cli.field_catalog":            "카탈로그",
+		"cli.field_parameter":          "매개변수",
+		"cli.field_required":           "필수",
+		"cli.field_result":             "결과",
+		"cli.field_protocol":           "프로토콜",
+		"cli.field_error":              "오류",
+		"cli.field_destination":        "대상",
+		"cli.list_global":              "전역 (%s)",
+		"cli.list_projects":            "프로젝트",
+		"cli.list_project_missing":     "폴더를 찾을 수 없음: cleanup 참고",
+		"cli.server_added":             "서버 '%s'을(를) %s에 추가했습니다",
+		"cli.server_updated":           "서버 '%s'을(를) 수정했습니다",
+		"cli.server_removed":           "서버 '%s'을(를) %s에서 제거했습니다",
+		"cli.server_moved":             "서버 '%s'을(를) %s(으)로 이동했습니다",
+		"cli.server_cloned":            "서버 '%s'을(를) %d개 대상에 복제했습니다",
+		"cli.test_ok":                  "OK (%dms)",
+		"cli.test_failed":              "실패 (%s)",
+		"cli.inventory_header":         "%s %s (프로토콜 %s, 읽은 시각 %s)",
+		"cli.inventory_tools":          "도구 (%d):",
+		"cli.inventory_resources":      "리소스 (%d):",
+		"cli.inventory_prompts":        "프롬프트 (%d):",
+		"cli.inventory_failed":         "%s 실패: %s",
+		"cli.valid_servers":            "유효한 서버 %d개",
+		"cli.no_backups":               "(백업 없음)",
+		"cli.nothing_to_restore":       "복원할 차이가 없습니다",
+		"cli.restored":                 "%d개 서버를 %s에서 복원했습니다",
+		"cli.bulk_none":                "선택된 서버가 없습니다",
+		"cli.bulk_plan":                "선택한 서버: %d, 변경: %s",
+		"cli.bulk_done":                "선택한 서버: %d, 저장된 변경: %s",
+		"cli.cleanup_none":             "폴더가 없는 프로젝트가 없습니다",
+		"cli.cleanup_plan":             "제거할 프로젝트 %d개 (%s)",
+		"cli.archive_saved":            "아카이브를 %s에 저장했습니다",
+		"cli.cleanup_done":             "%d개 프로젝트를 %s에서 제거했습니다 (%s)",
+		"cli.compare_left_only":        "왼쪽에만 있음 (%s)",
+		"cli.compare_right_only":       "오른쪽에만 있음 (%s)",
+		"cli.compare_identical":        "동일한 서버: %d (--all로 표시)",
+		"cli.discover_none":            "등록되지 않은 프로젝트가 없습니다",
+		"cli.discover_hint":            "등록하려면: mcp-curator discover --register 또는 mcp-curator register PATH",
+		"cli.registered_count":         "%d개 프로젝트를 %s에 등록했습니다",
+		"cli.already_registered":       "%s: 이미 등록됨",
+		"cli.registered":               "%s 등록됨 (%s)",
+		"cli.no_mcp_files":             "MCP 파일 없음",
+		"cli.duplicates_none":          "중복된 서버가 없습니다",
+		"cli.duplicates_group":         "사본: %d, 다름: %d",
+		"cli.promote_hint":             "동일한 프로젝트 사본 제거",
+		"cli.promote_plan":             "'%s'이(가) 전역이 되며 제거될 프로젝트 사본: %d",
+		"cli.promote_done":             "서버 '%s'이(가) 전역이 되었습니다. 제거된 프로젝트 사본: %d",
+		"cli.exported":                 "%d개 서버를 %s(으)로 내보냈습니다",
+		"cli.import_empty":             "%s에 MCP 서버가 없습니다",
+		"cli.imported":                 "%d개 서버를 %s(으)로 가져왔습니다",
+		"cli.import_nothing":           "가져올 서버가 없습니다: 모두 이미 있습니다",
+		"cli.import_no_sources":        "다른 클라이언트의 설정 파일을 찾지 못했습니다",
+		"cli.search_none":              "서버를 찾지 못했습니다",
+		"cli.search_usage":             "프로젝트: %d",
+		"cli.catalogs":                 "추가 카탈로그",
+		"cli.catalog_added":            "카탈로그 추가됨: %s",
+		"cli.catalog_removed":          "카탈로그 제거됨: %s",
+		"cli.vault_created":            "보관소 생성됨: %s",
+		"cli.no_secrets":               "(시크릿 없음)",
+		"cli.secret_usages":            "사용 %d건",
+		"cli.secret_added":             "시크릿 %s을(를) 보관소에 추가했습니다",
+		"cli.secret_updated":           "시크릿 %s을(를) 수정했습니다",
+		"cli.secret_server_updated":    "수정됨: %s (%s)",
+		"cli.secret_deleted":           "시크릿 %s을(를) 삭제했습니다",
+		"cli.secret_bound":             "%s %s (%s)을(를) 시크릿 %s에 연결했습니다 (%s)",
+		"cli.launcher_hint":            "${%s}을(를) 해석하려면 런처 (mcp-curator vault launcher)로 Claude Code를 시작하세요",
+		"cli.launcher_created":         "런처 생성됨: %s",
+		"cli.launcher_usage":           "`claude` 대신 사용하면 ${이름}으로 참조된 시크릿과 함께 Claude Code를 시작합니다.",
+		"cli.passphrase_changed":       "보관소 암호 문구를 변경했습니다",
+		"cli.prompt_passphrase":        "보관소 암호 문구: ",
+		"cli.prompt_new_passphrase":    "새 보관소 암호 문구: ",
+		"cli.prompt_repeat_passphrase": "암호 문구 다시 입력: ",
+		"cli.prompt_secret_value":      "%s 값: ",
+		"cli.prompt_new_secret_value":  "%s의 새 값: ",
+		"cli.flag.project_server":      "서버의 프로젝트 (기본값: 전역)",
+		"cli.flag.project_add":         "지정한 프로젝트에 추가 (기본값: 전역)",
+		"cli.flag.project_source":      "원본 프로젝트 (기본값: 전역)",
+		"cli.flag.project_promote":     "승격할 사본의 프로젝트 (기본값: 전역, 사본만 제거)",
+		"cli.flag.project_export":      "지정한 프로젝트에서 내보내기 (기본값: 전역)",
+		"cli.flag.project_import":      "지정한 프로젝트로 가져오기 (기본값: 전역)",
+		"cli.flag.project_only":        "이 프로젝트의 서버만 (모든 파일)",
+		"cli.flag.project_list":        "지정한 프로젝트의 서버만 표시",
+		"cli.flag.scope":               "프로젝트 파일: project (~/.claude.json), project-file (.mcp.json), project-local (.mcp.local.json)",
+		"cli.flag.type":                "서버 유형: stdio, http, sse",
+		"cli.flag.command":             "실행할 명령 (stdio)",
+		"cli.flag.arg":                 "명령 인수 (반복 가능)",
+		"cli.flag.url":                 "서버 URL (http/sse)",
+		"cli.flag.env":                 "환경 변수 KEY=value (반복 가능)",
+		"cli.flag.header":              "HTTP 헤더 KEY=value (반복 가능)",
+		"cli.flag.timeout":             "타임아웃 (밀리초)",
+		"cli.flag.effective":           "프로젝트의 유효 서버 표시 (모든 범위 병합)",
+		"cli.flag.effective_export":    "프로젝트의 유효 서버 내보내기 (모든 범위 병합)",
+		"cli.flag.json":                "JSON 형식으로 출력",
+		"cli.flag.json_import":         "JSON 형식으로 출력 (--dry-run 또는 PATH 없이)",
+		"cli.flag.json_cleanup":        "프로젝트를 제거하지 않고 JSON 형식으로 나열",
+		"cli.flag.json_server":         "서버 JSON 설정 (플래그가 우선)",
+		"cli.flag.reveal":              "[REDACTED] 대신 시크릿 표시",
+		"cli.flag.reveal_archive":      "[REDACTED] 대신 시크릿을 아카이브에 포함",
+		"cli.flag.reveal_export":       "[REDACTED] 대신 시크릿 포함",
+		"cli.flag.template":            "카탈로그 템플릿에서 시작 (`templates` 참고)",
+		"cli.flag.param":               "템플릿 매개변수 K=값 (반복 가능)",
+		"cli.flag.clear_args":          "모든 인수 제거",
+		"cli.flag.unset_env":           "환경 변수 제거 (반복 가능)",
+		"cli.flag.unset_header":        "HTTP 헤더 제거 (반복 가능)",
+		"cli.flag.from_scope":          "원본 프로젝트 파일 (project, project-file, project-local)",
+		"cli.flag.to":                  "대상: 'global' 또는 프로젝트 경로",
+		"cli.flag.to_repeat":           "대상: 'global' 또는 프로젝트 경로 (반복 가능)",
+		"cli.flag.to_bulk":             "대상: 'global' 또는 프로젝트 경로 (clone은 반복 가능)",
+		"cli.flag.to_global":           "전역 범위로 이동 (--to global과 같음)",
+		"cli.flag.to_scope":            "대상 프로젝트 파일 (project, project-file, project-local)",
+		"cli.flag.to_scope_many":       "대상 프로젝트들의 파일 (project, project-file, project-local)",
+		"cli.flag.force_one":           "대상에 있는 같은 이름의 서버 덮어쓰기",
+		"cli.flag.force_many":          "대상에 있는 같은 이름의 서버 덮어쓰기",
+		"cli.flag.force_import":        "이름이 같고 내용이 다른 서버 교체",
+		"cli.flag.dry_run":             "쓰지 않고 변경될 내용 표시",
+		"cli.flag.dry_run_promote":     "쓰지 않고 수행될 작업 표시",
+		"cli.flag.dry_run_import":      "쓰지 않고 가져올 내용 표시",
+		"cli.flag.dry_run_cleanup":     "프로젝트를 제거하지 않고 나열",
+		"cli.flag.archive":             "제거하기 전에 프로젝트 항목을 이 파일에 저장",
+		"cli.flag.cached":              "연결하지 않고 마지막으로 읽은 인벤토리 사용",
+		"cli.flag.diff":                "각 백업을 복원하면 바뀔 서버 표시",
+		"cli.flag.restore_server":      "지정한 서버만 복원 (반복 가능)",
+		"cli.flag.import_server":       "지정한 서버만 가져오기 (반복 가능)",
+		"cli.flag.all":                 "동일한 서버도 표시",
+		"cli.flag.depth":               "최대 검색 깊이 (기본값: 환경설정, 없으면 4)",
+		"cli.flag.save_roots":          "지정한 폴더를 환경설정에 추가",
+		"cli.flag.register":            "찾은 모든 프로젝트 등록",
+		"cli.flag.as":                  "전역 서버 이름 (기본값: 사본 이름)",
+		"cli.flag.keep_copies":         "동일한 프로젝트 사본을 제거하지 않음",
+		"cli.flag.export_format":       "형식: mcp-servers, claude-desktop, cursor, vscode, codex, goose",
+		"cli.flag.output":              "stdout 대신 파일에 쓰기",
+		"cli.flag.import_format":       "파일 형식: claude-desktop, cursor, vscode, codex, goose (기본값: 경로에서 추론)",
+		"cli.flag.filter_type":         "이 유형의 서버만: stdio, http, sse",
+		"cli.flag.filter_source":       "이 파일의 서버만: global, project, project-file, project-local",
+		"cli.flag.sort":                "정렬: name, type, usage (서버를 사용하는 프로젝트)",
+		"cli.flag.unlock":              "보관소를 잠금 해제해 파일의 해석된 값도 인식",
+		"cli.flag.bind_env":            "연결할 서버 환경 변수",
+		"cli.flag.bind_header":         "연결할 서버 HTTP 헤더",
+		"cli.flag.secret":              "시크릿 이름 (기본값: env 또는 header 키); 없으면 생성",
+		"cli.flag.inline":              "${이름} 대신 해석된 값을 파일에 쓰기",
+		"cli.shadows":                  "%s 덮어씀",
+		"cli.file_servers":             "%s: 서버 %d개",
+		"cli.server_count":             "서버 %d개",
+		"cli.template_default":         "기본값 %s",
 	}
 
 	// Chinese (Simplified)
@@ -691,8 +4849,6 @@ func (t *Translator) loadStrings() {
 		"dialog.delete_server":         "删除服务器",
 		"dialog.delete_confirm":        "确定要删除服务器 '%s' 吗？",
 		"dialog.move_server":           "移动服务器",
-		"dialog.move_to_project":       "选择目标项目：",
-		"dialog.move_to_global":        "要将服务器 '%s' 移动到全局范围吗？",
 		"dialog.no_projects":           "没有项目",
 		"dialog.no_projects_msg":       "没有可以移动服务器的已配置项目。",
 		"dialog.clone_server":          "克隆服务器",
@@ -714,11 +4870,525 @@ func (t *Translator) loadStrings() {
 		"form.command":        "命令",
 		"form.command_hint":   "命令 (例: uvx, npx)",
 		"form.args":           "参数",
-		"form.args_hint":      "空格分隔的参数 (例: -y mcp-server)",
 		"form.url":            "URL",
 		"form.url_hint":       "URL (用于 http/sse)",
 		"form.env":            "环境变量",
-		"form.env_hint":       "KEY=value, KEY2=value2 (逗号分隔)",
+		"form.file":                "文件",
+		"form.file_settings":       "~/.claude.json (项目设置)",
+		"form.file_mcp_json":       ".mcp.json (共享)",
+		"form.file_mcp_local":      ".mcp.local.json (本地)",
+		"form.project_required":    "请选择项目",
+		"dialog.move_to":           "选择目标:",
+		"dialog.clone_file":        "项目中的目标文件:",
+		"dialog.overwrite_title":   "服务器已存在",
+		"dialog.overwrite_confirm": "%[2]s 中已存在服务器 '%[1]s'。是否覆盖?",
+		"conflict.title":       "配置已被外部修改",
+		"conflict.message":     "%s 在加载后被其他程序(如 Claude Code)修改。其他更改将被保留;请为双方都修改过的服务器选择要保留的版本。",
+		"conflict.mine":        "我的版本",
+		"conflict.theirs":      "磁盘上的版本",
+		"conflict.keep_mine":   "保留我的",
+		"conflict.keep_theirs": "使用磁盘上的",
+		"conflict.removed":     "(已删除)",
+		"conflict.apply":       "应用",
+		"banner.changed_externally": "配置已被外部修改:%s",
+		"banner.added":              "新增 %d 个",
+		"banner.modified":           "修改 %d 个",
+		"banner.removed":            "删除 %d 个",
+		"toolbar.backups":         "备份",
+		"backup.title":            "~/.claude.json 的备份",
+		"backup.select":           "选择一个备份以查看差异",
+		"backup.none":             "没有可用的备份",
+		"backup.no_changes":       "备份中的 MCP 服务器与当前一致",
+		"backup.diff_hint":        "恢复对 MCP 服务器的影响(选择要恢复的项):",
+		"backup.change_added":     "将被添加",
+		"backup.change_removed":   "将被删除",
+		"backup.change_modified":  "将被恢复",
+		"backup.restore_selected": "恢复所选",
+		"backup.restore_all":      "全部恢复",
+		"backup.nothing_selected": "未选择服务器",
+		"backup.restore_confirm":  "从 %[2]s 的备份中恢复 %[1]d 个服务器?~/.claude.json 的其余内容不会改变。",
+		"backup.retention":        "保留策略",
+		"backup.max_count":        "最大数量",
+		"backup.max_age":          "最长保留(天,0 = 不限)",
+		"backup.dir":              "目录",
+		"backup.dir_hint":         "与 ~/.claude.json 相同位置",
+		"toolbar.history":    "历史",
+		"btn.close":          "关闭",
+		"history.title":      "修改历史",
+		"history.empty":      "本次会话没有修改",
+		"history.undone":     "已撤销",
+		"history.op_add":     "添加 '%s'",
+		"history.op_update":  "编辑 '%s'",
+		"history.op_remove":  "删除 '%s'",
+		"history.op_move":    "移动 '%s'",
+		"history.op_clone":   "克隆 '%s'",
+		"history.op_restore": "从 %s 恢复",
+		"btn.test":                 "测试",
+		"test.title":               "连接测试: %s",
+		"test.running":             "正在与 %[1]s 进行 MCP 握手 (超时 %[2]s)...",
+		"test.success":             "握手成功 (%s)",
+		"test.failed":              "握手失败",
+		"test.protocol":            "协议版本",
+		"test.server_info":         "服务器",
+		"test.capabilities":        "能力",
+		"test.instructions":        "说明",
+		"test.none":                "无",
+		"test.reason":              "原因",
+		"test.detail":              "详情",
+		"test.stderr":              "stderr 输出",
+		"test.failure_not_found":   "找不到命令",
+		"test.failure_exited":      "进程以代码 %d 退出",
+		"test.failure_timeout":     "%s 内无响应",
+		"test.failure_tls":         "TLS 错误",
+		"test.failure_http_status": "服务器返回 HTTP %d",
+		"test.failure_network":     "网络错误",
+		"test.failure_protocol":    "响应不符合 MCP 协议",
+		"test.failure_config":      "服务器配置不完整",
+		"inventory.title":          "清单",
+		"inventory.load":           "读取清单",
+		"inventory.refresh":        "刷新",
+		"inventory.not_loaded":     "连接服务器以查看其提供的工具、资源和提示",
+		"inventory.loading":        "正在从 %[1]s 读取工具、资源和提示 (超时 %[2]s)...",
+		"inventory.fetched_at":     "读取于 %s",
+		"inventory.tools":          "工具 (%d)",
+		"inventory.resources":      "资源 (%d)",
+		"inventory.prompts":        "提示 (%d)",
+		"inventory.schema":         "输入模式",
+		"inventory.no_description": "无描述",
+		"inventory.list_error":     "%[1]s 失败: %[2]s",
+		"tree.tool_count":          "%d 个工具",
+		"validation.name_required":       "名称为必填项",
+		"validation.name_invalid":        "名称只能包含字母、数字、'-' 和 '_' (工具名为 mcp__<名称>__<工具>)",
+		"validation.name_too_long":       "名称过长: 工具名 mcp__<名称>__<工具> 可能超过 64 个字符",
+		"validation.type_invalid":        "未知类型: %s (可选值: stdio、http、sse)",
+		"validation.type_missing":        "请指定命令 (stdio) 或 URL (http/sse)",
+		"validation.command_required":    "stdio 服务器必须指定命令",
+		"validation.command_with_url":    "服务器不能同时有命令和 URL",
+		"validation.command_for_remote":  "%s 服务器会忽略命令",
+		"validation.command_has_spaces":  "命令包含空格: 参数应放在 args 中",
+		"validation.args_empty":          "第 %s 个参数为空",
+		"validation.args_for_remote":     "远程服务器会忽略 args",
+		"validation.url_required":        "%s 服务器必须指定 URL",
+		"validation.url_for_stdio":       "stdio 服务器会忽略 URL",
+		"validation.url_invalid":         "无效的 URL: %s",
+		"validation.url_scheme":          "不支持的 URL 协议: %s (请使用 http 或 https)",
+		"validation.url_insecure":        "到远程主机的未加密连接 (http): %s",
+		"validation.sse_deprecated":      "SSE 传输已弃用: 如果服务器支持请使用 http",
+		"validation.env_name_empty":      "环境变量名为空",
+		"validation.env_name_invalid":    "不常见的环境变量名: %s",
+		"validation.headers_for_stdio":   "stdio 服务器会忽略 headers",
+		"validation.header_name_invalid": "无效的 header 名称: %s",
+		"validation.timeout_negative":    "超时不能为负数",
+		"validation.title":               "配置问题",
+		"validation.invalid_server":      "服务器 %s 无效",
+		"validation.variable_missing":   "变量 ${%s} 未定义且没有默认值",
+		"detail.headers":                "请求头",
+		"detail.expanded":               "展开后",
+		"detail.missing_variables":      "缺少的环境变量",
+		"detail.missing_variables_hint": "环境中未定义且没有默认值: 使用它们的服务器可能无法启动。",
+		"toolbar.vault":             "保险库",
+		"history.op_bind":           "已关联密钥 '%s'",
+		"history.op_rotate":         "已轮换密钥 '%s'",
+		"vault.title":               "密钥保险库",
+		"vault.intro":               "密钥使用口令加密保存在 %s 中。env 和 headers 可以通过 ${NAME} 引用它们:使用启动器启动 Claude Code 时,这些值会被导出,而不会出现在配置文件中。",
+		"vault.create":              "创建保险库",
+		"vault.passphrase":          "口令",
+		"vault.passphrase_confirm":  "重复口令",
+		"vault.passphrase_mismatch": "两次输入的口令不一致",
+		"vault.unlock":              "解锁",
+		"vault.lock":                "锁定",
+		"vault.locked_hint":         "保险库已锁定:请输入口令以管理密钥。",
+		"vault.no_secrets":          "保险库中没有密钥",
+		"vault.usages":              "%d 处使用",
+		"vault.add_secret":          "添加密钥",
+		"vault.secret_name":         "名称",
+		"vault.secret_value":        "值",
+		"vault.rotate":              "轮换",
+		"vault.rotate_title":        "轮换 %s",
+		"vault.new_value":           "新值",
+		"vault.rotated":             "密钥 %[1]s 已更新:修改了 %[2]d 个服务器",
+		"vault.delete_confirm":      "从保险库中删除密钥 %s?",
+		"vault.in_use":              "密钥 %[1]s 仍以 ${%[2]s} 的形式被以下位置使用:",
+		"vault.change_passphrase":   "更改口令",
+		"vault.launcher":            "生成启动器",
+		"vault.launcher_created":    "启动器已创建于 %s。用它代替 `claude` 启动 Claude Code,即可使用以 ${NAME} 引用的密钥。",
+		"vault.copy_path":           "复制路径",
+		"vault.mode":                "模式",
+		"vault.mode_reference":      "${NAME} 引用",
+		"vault.mode_inline":         "文件中的解析值",
+		"vault.binding":             "保险库: %s (%s)",
+		"vault.bind":                "保险库",
+		"vault.bind_title":          "将 %s 关联到保险库",
+		"vault.bind_hint":           "如果密钥不存在,将使用当前值创建。",
+		"vault.needs_unlock":        "请解锁保险库以将值关联到密钥。",
+		"detail.reveal": "显示",
+		"detail.hide":   "隐藏",
+		"btn.copy_json": "复制 JSON",
+		"form.headers":                  "请求头",
+		"form.timeout":                  "超时 (毫秒)",
+		"form.timeout_hint":             "留空：使用 Claude Code 默认值",
+		"form.add_row":                  "添加",
+		"form.add_arg":                  "添加参数",
+		"form.paste_command":            "导入",
+		"form.paste_command_hint":       "粘贴命令行 (例如: npx -y pkg --dir \"My Docs\")",
+		"form.env_name_hint":            "名称",
+		"form.header_name_hint":         "请求头名称",
+		"form.value_hint":               "值",
+		"form.preview":                  "将写入的 JSON",
+		"validation.command_line_quote": "命令行无效：引号未闭合",
+		"validation.key_duplicate":      "重复的键：%s（以最后一行为准）",
+		"validation.timeout_invalid":    "超时必须是以毫秒为单位的整数",
+		"dialog.add_via_template":        "从模板",
+		"template.title":                 "服务器模板",
+		"template.select":                "请选择模板",
+		"template.filter":                "搜索模板...",
+		"template.source":                "目录：%s",
+		"template.add":                   "添加服务器",
+		"template.catalogs":              "目录",
+		"template.catalogs_hint":         "在内置目录之外读取的附加目录（JSON 文件或团队共享的文件夹）；相同 ID 的模板会替换内置模板。",
+		"template.no_catalogs":           "没有附加目录",
+		"template.add_file":              "添加文件...",
+		"template.add_folder":            "添加文件夹...",
+		"validation.template_catalog":    "%s",
+		"validation.template_parameters": "%s",
+		"toolbar.import":                  "导入",
+		"history.op_import":               "从 %s 导入",
+		"import.title":                    "从其他客户端导入",
+		"import.source":                   "源文件",
+		"import.select_source":            "请选择配置文件",
+		"import.no_sources":               "未找到其他客户端的配置",
+		"import.format":                   "格式",
+		"import.browse":                   "浏览...",
+		"import.target":                   "目标",
+		"import.overwrite":                "替换同名的不同服务器",
+		"import.run":                      "导入所选",
+		"import.empty":                    "文件中没有 MCP 服务器",
+		"import.partial":                  "已导入 %d 个服务器，部分被跳过：",
+		"import.done":                     "已将 %d 个服务器导入到 %s",
+		"import.status_new":               "新增",
+		"import.status_identical":         "已存在",
+		"import.status_conflict":          "冲突",
+		"validation.import_field_ignored": "claude Code 不支持字段 '%s'：未导入",
+		"validation.import_variable":      "不支持源客户端的变量 %s：请替换为值或 ${VAR}",
+		"validation.import_disabled":      "服务器在源客户端中已禁用",
+		"validation.import_renamed":       "原名称 '%s' 不可用：已重命名",
+		"validation.import_conflict":      "目标中已存在同名的不同服务器",
+		"validation.import_invalid":       "条目无效，无法导入：%s",
+		"import.format_claude-desktop":    "Claude Desktop",
+		"import.format_cursor":            "Cursor",
+		"import.format_vscode":            "VS Code",
+		"import.format_codex":             "Codex CLI",
+		"import.format_goose":             "Goose",
+		"validation.import_read":          "%s",
+		"toolbar.export":                      "导出",
+		"export.title":                        "导出到其他客户端",
+		"export.source":                       "服务器",
+		"export.format":                       "格式",
+		"export.servers":                      "要导出的服务器",
+		"export.preview":                      "预览(已隐藏密钥)",
+		"export.effective":                    "%s — 生效的服务器",
+		"export.empty":                        "此位置没有服务器",
+		"export.include_secrets":              "在保存的文件中包含密钥",
+		"export.copy":                         "复制",
+		"export.save":                         "保存到文件…",
+		"export.done":                         "已将 %d 个服务器导出到 %s",
+		"export.format_mcp-servers":           "mcpServers 片段 (.mcp.json)",
+		"export.format_claude-desktop":        "Claude Desktop",
+		"export.format_cursor":                "Cursor",
+		"export.format_vscode":                "VS Code",
+		"export.format_codex":                 "Codex CLI (TOML)",
+		"export.format_goose":                 "Goose (YAML)",
+		"validation.export_field_unsupported": "字段 '%s' 无法在目标格式中表示:未导出",
+		"validation.export_type_unsupported":  "目标格式不支持 %s 服务器:未导出",
+		"validation.export_variable":          "%s 将按原样写入:目标客户端不展开变量",
+		"validation.export_variable_default":  "目标客户端不支持 %s 的默认值",
+		"detail.effective_configs":    "生效配置",
+		"detail.no_effective_servers": "此项目中没有生效的服务器",
+		"detail.shadowing_count":      "%d 个被覆盖",
+		"detail.shadows":              "      覆盖:%s",
+		"detail.show_diff":            "差异",
+		"detail.diff_title":           "%s 的定义",
+		"detail.diff_against":         "%s 与 %s 对比",
+		"detail.diff_identical":       "定义相同",
+		"toolbar.duplicates":       "重复项",
+		"history.op_promote":       "已将 '%s' 提升为全局",
+		"duplicates.title":         "重复的服务器",
+		"duplicates.hint":          "多个项目中存在命令和参数相同(或 URL 相同)的服务器。将其提升为全局后,相同的副本变得多余,并会在一次可撤销的操作中被删除。",
+		"duplicates.none":          "没有重复的服务器",
+		"duplicates.summary":       "副本:%d,不同:%d",
+		"duplicates.promote":       "提升为全局",
+		"duplicates.definition":    "定义",
+		"duplicates.global_name":   "全局名称",
+		"duplicates.remove_copies": "要删除的副本:",
+		"toolbar.cleanup":         "清理",
+		"tree.missing":            "未找到文件夹",
+		"detail.project_missing":  "项目文件夹已不存在:其条目在 ~/.claude.json 中占用 %s,可以删除。",
+		"cleanup.title":           "项目清理",
+		"cleanup.hint":            "在 ~/.claude.json 中注册但文件夹已被删除或移动的项目。删除它们会同时删除其服务器和 Claude Code 数据(历史、权限、状态);写入前会创建文件备份。",
+		"cleanup.none":            "没有缺少文件夹的项目",
+		"cleanup.project_details": "%d 个服务器 · %s",
+		"cleanup.total":           "已选择:%d 个项目(%s)",
+		"cleanup.archive_first":   "删除前保存条目的归档",
+		"cleanup.include_secrets": "在归档中包含密钥",
+		"cleanup.export_only":     "导出归档…",
+		"cleanup.remove":          "删除所选",
+		"cleanup.confirm":         "从 ~/.claude.json 中删除 %d 个项目(%s)?",
+		"cleanup.archived":        "已将 %d 个项目归档到 %s",
+		"cleanup.removed":         "已删除 %d 个项目",
+		"toolbar.discover":          "发现",
+		"tree.discovered":           "发现的项目",
+		"detail.discovered_project": "发现的项目",
+		"detail.discovered_hint":    "此文件夹包含 MCP 配置,但未在 ~/.claude.json 中注册。注册后将与其文件中的服务器一起显示在项目中。",
+		"discovery.title":           "发现项目",
+		"discovery.hint":            "在指定的文件夹(例如 ~/src)中查找带有 .mcp.json 或 .mcp.local.json 且尚未在 ~/.claude.json 中注册的项目。隐藏文件夹、node_modules 和 vendor 会被跳过。",
+		"discovery.roots":           "要搜索的文件夹",
+		"discovery.no_roots":        "没有文件夹:请添加一个",
+		"discovery.add_root":        "添加文件夹…",
+		"discovery.scan":            "搜索",
+		"discovery.none":            "未找到未注册的项目",
+		"discovery.register":        "注册所选",
+		"discovery.register_one":    "注册项目",
+		"discovery.registered":      "已注册 %d 个项目",
+		"tree.search_placeholder":          "按名称、命令、参数、URL、env 或路径搜索…",
+		"tree.filter_all_types":            "所有类型",
+		"tree.filter_all_sources":          "所有文件",
+		"tree.filter_source_global":        "全局 (~/.claude.json)",
+		"tree.filter_source_project":       "项目 (~/.claude.json)",
+		"tree.filter_source_project_file":  ".mcp.json",
+		"tree.filter_source_project_local": ".mcp.local.json",
+		"tree.sort_name":                   "按名称排序",
+		"tree.sort_type":                   "按类型排序",
+		"tree.sort_usage":                  "按项目数排序",
+		"tree.multi_select":      "多选",
+		"bulk.title":             "批量操作",
+		"bulk.selected":          "已选择 %d 个服务器",
+		"bulk.delete":            "删除",
+		"bulk.move":              "移动",
+		"bulk.clone":             "克隆",
+		"bulk.export":            "导出",
+		"bulk.clear":             "取消选择",
+		"bulk.move_title":        "移动 %d 个服务器",
+		"bulk.clone_title":       "克隆 %d 个服务器",
+		"bulk.summary":           "已选服务器：%d — 更改：%s",
+		"bulk.skipped_exists":    "'%s' 已存在于 %s：已跳过",
+		"bulk.overwrite":         "覆盖目标中已存在的服务器",
+		"bulk.apply":             "应用",
+		"bulk.nothing":           "没有要应用的更改",
+		"export.selection":       "树中的选择（%d 个服务器）",
+		"history.op_bulk_remove": "删除了 %s 个服务器",
+		"history.op_bulk_move":   "移动了 %s 个服务器",
+		"history.op_bulk_clone":  "克隆了 %s 个服务器",
+		"toolbar.compare":         "比较",
+		"compare.title":           "比较",
+		"compare.hint":            "比较两个服务器、两个项目的有效配置，或一个项目与全局范围。箭头会将某个值或整个定义复制到另一侧服务器所在的文件中。",
+		"compare.left":            "左侧",
+		"compare.right":           "右侧",
+		"compare.effective":       "%s（有效配置）",
+		"compare.show_identical":  "显示相同的服务器",
+		"compare.pick_sides":      "请选择要比较的两侧",
+		"compare.same_side":       "两侧相同",
+		"compare.mismatch":        "服务器只能与另一个服务器比较",
+		"compare.summary":         "相同：%d，不同：%d，仅左侧：%d，仅右侧：%d",
+		"compare.empty":           "两侧都没有服务器",
+		"compare.only_left":       "仅在左侧，位于 %s",
+		"compare.only_right":      "仅在右侧，位于 %s",
+		"compare.clone_to":        "复制到 %s",
+		"compare.identical":       "相同",
+		"compare.copy_definition": "定义",
+		"toolbar.tools": "工具",
+		"cli.usage_line":               "用法：mcp-curator %s",
+		"cli.error":                    "错误：%s",
+		"cli.unknown_command":          "未知命令：%s",
+		"cli.unknown_lang":             "不支持的语言：%s（可用：%s）",
+		"cli.help_gui":                 "不带参数时启动图形界面。",
+		"cli.help_usage":               "用法：mcp-curator [--config PATH] [--lang 语言] <命令> [参数]",
+		"cli.help_commands":            "命令：",
+		"cli.help_scope":               "不带 --project 时，命令作用于全局范围。\n--scope 选择项目文件：project（~/.claude.json，默认），\nproject-file（.mcp.json）或 project-local（.mcp.local.json）。",
+		"cli.flag_config":              "~/.claude.json 的替代路径",
+		"cli.flag_lang":                "输出语言：IT, EN, FR, DE, ES, PT, JA, KO, CN, UK",
+		"cli.cmd.list":                 "列出全局和项目服务器（除非使用 --reveal，否则隐藏密钥）",
+		"cli.cmd.show":                 "显示服务器配置（除非使用 --reveal，否则隐藏密钥）",
+		"cli.cmd.add":                  "添加服务器，也可基于目录模板",
+		"cli.cmd.update":               "修改服务器的指定字段",
+		"cli.cmd.remove":               "删除服务器",
+		"cli.cmd.move":                 "在范围和项目文件之间移动服务器",
+		"cli.cmd.clone":                "将服务器复制到其他范围",
+		"cli.cmd.bulk":                 "一次性删除、移动或复制按 search 方式选出的服务器",
+		"cli.cmd.import":               "从 Claude Desktop、Cursor、VS Code、Codex 或 Goose 导入服务器（不带 PATH 时列出找到的文件）",
+		"cli.cmd.export":               "为 Claude Desktop、Cursor、VS Code、Codex、Goose 导出服务器，或导出为 mcpServers 片段",
+		"cli.cmd.search":               "按名称、命令、参数、URL、env 键或项目路径在所有范围中搜索服务器",
+		"cli.cmd.compare":              "逐字段比较两个服务器（名称@global、名称@PATH）、两个项目（PATH）或一个项目与 global",
+		"cli.cmd.duplicates":           "查找在全局范围和项目中存在多个副本的服务器及其差异",
+		"cli.cmd.promote":              "将服务器设为全局并删除相同的项目副本",
+		"cli.cmd.discover":             "查找尚未注册为项目、包含 .mcp.json 或 .mcp.local.json 的文件夹",
+		"cli.cmd.register":             "将文件夹注册为 ~/.claude.json 中的项目",
+		"cli.cmd.cleanup":              "从 ~/.claude.json 删除文件夹已不存在的项目，可选归档",
+		"cli.cmd.validate":             "检查所有服务器的配置",
+		"cli.cmd.test":                 "与服务器执行 MCP initialize 握手",
+		"cli.cmd.inventory":            "列出服务器提供的工具、资源和提示",
+		"cli.cmd.templates":            "列出服务器模板并管理团队的附加目录",
+		"cli.cmd.vault":                "管理 env 和 headers 中使用的加密密钥库",
+		"cli.cmd.backups":              "列出 ~/.claude.json 的备份及其与当前文件的差异",
+		"cli.cmd.restore":              "从备份恢复 MCP 服务器",
+		"cli.cmd.version":              "显示版本",
+		"cli.location_global":          "全局范围",
+		"cli.location_project":         "项目 %s",
+		"cli.source_global":            "全局",
+		"cli.source_project":           "项目",
+		"cli.severity_error":           "错误",
+		"cli.severity_warning":         "警告",
+		"cli.warning":                  "注意：%s",
+		"cli.absent":                   "（无）",
+		"cli.no_servers":               "（无服务器）",
+		"cli.field_name":               "名称",
+		"cli.field_type":               "类型",
+		"cli.field_command":            "命令",
+		"cli.field_category":           "类别",
+		"cli.field_description":        "描述",
+		"cli.field_catalog":            "目录",
+		"cli.field_parameter":          "参数",
+		"cli.field_required":           "必填",
+		"cli.field_result":             "结果",
+		"cli.field_protocol":           "协议",
+		"cli.field_error":              "错误",
+		"cli.field_destination":        "目标",
+		"cli.list_global":              "全局（%s）",
+		"cli.list_projects":            "项目",
+		"cli.list_project_missing":     "未找到文件夹：参见 cleanup",
+		"cli.server_added":             "已将服务器 '%s' 添加到 %s",
+		"cli.server_updated":           "已更新服务器 '%s'",
+		"cli.server_removed":           "已将服务器 '%s' 从 %s 删除",
+		"cli.server_moved":             "已将服务器 '%s' 移动到 %s",
+		"cli.server_cloned":            "已将服务器 '%s' 克隆到 %d 个目标",
+		"cli.test_ok":                  "成功（%dms）",
+		"cli.test_failed":              "失败（%s）",
+		"cli.inventory_header":         "%s %s（协议 %s，读取于 %s）",
+		"cli.inventory_tools":          "工具（%d）：",
+		"cli.inventory_resources":      "资源（%d）：",
+		"cli.inventory_prompts":        "提示（%d）：",
+		"cli.inventory_failed":         "%s 失败：%s",
+		"cli.valid_servers":            "%d 个有效服务器",
+		"cli.no_backups":               "（无备份）",
+		"cli.nothing_to_restore":       "没有需要恢复的差异",
+		"cli.restored":                 "已从 %[2]s 恢复 %[1]d 个服务器",
+		"cli.bulk_none":                "未选择服务器",
+		"cli.bulk_plan":                "已选服务器：%d，变更：%s",
+		"cli.bulk_done":                "已选服务器：%d，已保存变更：%s",
+		"cli.cleanup_none":             "没有缺失文件夹的项目",
+		"cli.cleanup_plan":             "%d 个待删除项目（%s）",
+		"cli.archive_saved":            "归档已保存到 %s",
+		"cli.cleanup_done":             "已删除 %d 个项目，来源 %s（%s）",
+		"cli.compare_left_only":        "仅左侧（%s）",
+		"cli.compare_right_only":       "仅右侧（%s）",
+		"cli.compare_identical":        "相同的服务器：%d（使用 --all 显示）",
+		"cli.discover_none":            "未找到未注册的项目",
+		"cli.discover_hint":            "注册方法：mcp-curator discover --register，或 mcp-curator register PATH",
+		"cli.registered_count":         "已注册 %d 个项目到 %s",
+		"cli.already_registered":       "%s：已注册",
+		"cli.registered":               "已注册 %s（%s）",
+		"cli.no_mcp_files":             "无 MCP 文件",
+		"cli.duplicates_none":          "没有重复的服务器",
+		"cli.duplicates_group":         "副本：%d，不同：%d",
+		"cli.promote_hint":             "删除相同的项目副本",
+		"cli.promote_plan":             "'%s' 将成为全局，删除的项目副本：%d",
+		"cli.promote_done":             "服务器 '%s' 已设为全局，删除的项目副本：%d",
+		"cli.exported":                 "已导出 %d 个服务器到 %s",
+		"cli.import_empty":             "%s 中没有 MCP 服务器",
+		"cli.imported":                 "已导入 %d 个服务器到 %s",
+		"cli.import_nothing":           "没有可导入的服务器：全部已存在",
+		"cli.import_no_sources":        "未找到其他客户端的配置文件",
+		"cli.search_none":              "未找到服务器",
+		"cli.search_usage":             "项目：%d",
+		"cli.catalogs":                 "附加目录",
+		"cli.catalog_added":            "已添加目录：%s",
+		"cli.catalog_removed":          "已删除目录：%s",
+		"cli.vault_created":            "已创建密钥库：%s",
+		"cli.no_secrets":               "（无密钥）",
+		"cli.secret_usages":            "%d 处使用",
+		"cli.secret_added":             "已将密钥 %s 添加到密钥库",
+		"cli.secret_updated":           "已更新密钥 %s",
+		"cli.secret_server_updated":    "已更新 %s（%s）",
+		"cli.secret_deleted":           "已删除密钥 %s",
+		"cli.secret_bound":             "%s %s（%s）已绑定到密钥 %s（%s）",
+		"cli.launcher_hint":            "请使用启动器（mcp-curator vault launcher）启动 Claude Code 以解析 ${%s}",
+		"cli.launcher_created":         "已创建启动器：%s",
+		"cli.launcher_usage":           "用它代替 `claude` 启动 Claude Code，即可使用以 ${名称} 引用的密钥。",
+		"cli.passphrase_changed":       "已更改密钥库口令",
+		"cli.prompt_passphrase":        "密钥库口令：",
+		"cli.prompt_new_passphrase":    "新的密钥库口令：",
+		"cli.prompt_repeat_passphrase": "再次输入口令：",
+		"cli.prompt_secret_value":      "%s 的值：",
+		"cli.prompt_new_secret_value":  "%s 的新值：",
+		"cli.flag.project_server":      "服务器所在项目（默认：全局）",
+		"cli.flag.project_add":         "添加到指定项目（默认：全局）",
+		"cli.flag.project_source":      "源项目（默认：全局）",
+		"cli.flag.project_promote":     "要提升的副本所在项目（默认：全局，仅删除副本）",
+		"cli.flag.project_export":      "从指定项目导出（默认：全局）",
+		"cli.flag.project_import":      "导入到指定项目（默认：全局）",
+		"cli.flag.project_only":        "仅此项目的服务器（所有文件）",
+		"cli.flag.project_list":        "仅显示指定项目的服务器",
+		"cli.flag.scope":               "项目文件：project（~/.claude.json）、project-file（.mcp.json）、project-local（.mcp.local.json）",
+		"cli.flag.type":                "服务器类型：stdio、http、sse",
+		"cli.flag.command":             "要执行的命令（stdio）",
+		"cli.flag.arg":                 "命令参数（可重复）",
+		"cli.flag.url":                 "服务器 URL（http/sse）",
+		"cli.flag.env":                 "环境变量 KEY=value（可重复）",
+		"cli.flag.header":              "HTTP 头 KEY=value（可重复）",
+		"cli.flag.timeout":             "超时（毫秒）",
+		"cli.flag.effective":           "显示项目的有效服务器（合并所有范围）",
+		"cli.flag.effective_export":    "导出项目的有效服务器（合并所有范围）",
+		"cli.flag.json":                "以 JSON 格式输出",
+		"cli.flag.json_import":         "以 JSON 格式输出（使用 --dry-run 或不带 PATH 时）",
+		"cli.flag.json_cleanup":        "以 JSON 格式列出项目而不删除",
+		"cli.flag.json_server":         "服务器的 JSON 配置（标志优先）",
+		"cli.flag.reveal":              "显示密钥而非 [REDACTED]",
+		"cli.flag.reveal_archive":      "在归档中包含密钥而非 [REDACTED]",
+		"cli.flag.reveal_export":       "包含密钥而非 [REDACTED]",
+		"cli.flag.template":            "基于目录模板（参见 `templates`）",
+		"cli.flag.param":               "模板参数 K=值（可重复）",
+		"cli.flag.clear_args":          "删除所有参数",
+		"cli.flag.unset_env":           "删除环境变量（可重复）",
+		"cli.flag.unset_header":        "删除 HTTP 头（可重复）",
+		"cli.flag.from_scope":          "源项目文件（project、project-file、project-local）",
+		"cli.flag.to":                  "目标：'global' 或项目路径",
+		"cli.flag.to_repeat":           "目标：'global' 或项目路径（可重复）",
+		"cli.flag.to_bulk":             "目标：'global' 或项目路径（clone 时可重复）",
+		"cli.flag.to_global":           "移动到全局范围（等同于 --to global）",
+		"cli.flag.to_scope":            "目标项目文件（project、project-file、project-local）",
+		"cli.flag.to_scope_many":       "目标项目的文件（project、project-file、project-local）",
+		"cli.flag.force_one":           "覆盖目标中同名的服务器",
+		"cli.flag.force_many":          "覆盖目标中同名的服务器",
+		"cli.flag.force_import":        "替换同名但不同的服务器",
+		"cli.flag.dry_run":             "显示将发生的变更而不写入",
+		"cli.flag.dry_run_promote":     "显示将执行的操作而不写入",
+		"cli.flag.dry_run_import":      "显示将导入的内容而不写入",
+		"cli.flag.dry_run_cleanup":     "列出项目而不删除",
+		"cli.flag.archive":             "删除前将项目条目保存到此文件",
+		"cli.flag.cached":              "使用上次读取的清单而不连接",
+		"cli.flag.diff":                "显示恢复每个备份时会变更的服务器",
+		"cli.flag.restore_server":      "仅恢复指定服务器（可重复）",
+		"cli.flag.import_server":       "仅导入指定服务器（可重复）",
+		"cli.flag.all":                 "同时显示相同的服务器",
+		"cli.flag.depth":               "最大搜索深度（默认：偏好设置，否则为 4）",
+		"cli.flag.save_roots":          "将指定文件夹添加到偏好设置",
+		"cli.flag.register":            "注册找到的所有项目",
+		"cli.flag.as":                  "全局服务器名称（默认：副本名称）",
+		"cli.flag.keep_copies":         "不删除相同的项目副本",
+		"cli.flag.export_format":       "格式：mcp-servers、claude-desktop、cursor、vscode、codex、goose",
+		"cli.flag.output":              "写入文件而非 stdout",
+		"cli.flag.import_format":       "文件格式：claude-desktop、cursor、vscode、codex、goose（默认：根据路径推断）",
+		"cli.flag.filter_type":         "仅此类型的服务器：stdio、http、sse",
+		"cli.flag.filter_source":       "仅此文件的服务器：global、project、project-file、project-local",
+		"cli.flag.sort":                "排序：name、type、usage（使用该服务器的项目）",
+		"cli.flag.unlock":              "解锁密钥库以识别文件中已解析的值",
+		"cli.flag.bind_env":            "要绑定的服务器环境变量",
+		"cli.flag.bind_header":         "要绑定的服务器 HTTP 头",
+		"cli.flag.secret":              "密钥名称（默认：env 或 header 键）；不存在时创建",
+		"cli.flag.inline":              "将解析后的值写入文件而非 ${名称}",
+		"cli.shadows":                  "覆盖 %s",
+		"cli.file_servers":             "%s：%d 个服务器",
+		"cli.server_count":             "%d 个服务器",
+		"cli.template_default":         "默认 %s",
 	}
 
 	// Ukrainian
@@ -757,8 +5427,6 @@ func (t *Translator) loadStrings() {
 		"dialog.delete_server":         "Видалити сервер",
 		"dialog.delete_confirm":        "Ви впевнені, що хочете видалити сервер '%s'?",
 		"dialog.move_server":           "Перемістити сервер",
-		"dialog.move_to_project":       "Виберіть проект призначення:",
-		"dialog.move_to_global":        "Перемістити сервер '%s' до глобальної області?",
 		"dialog.no_projects":           "Немає проектів",
 		"dialog.no_projects_msg":       "Немає налаштованих проектів для переміщення сервера.",
 		"dialog.clone_server":          "Клонувати сервер",
@@ -780,10 +5448,524 @@ func (t *Translator) loadStrings() {
 		"form.command":        "Команда",
 		"form.command_hint":   "Команда (напр.: uvx, npx)",
 		"form.args":           "Аргументи",
-		"form.args_hint":      "Аргументи розділені пробілом (напр.: -y mcp-server)",
 		"form.url":            "URL",
 		"form.url_hint":       "URL (для http/sse)",
 		"form.env":            "Змінні середовища",
-		"form.env_hint":       "КЛЮЧ=значення, КЛЮЧ2=значення2 (розділені комою)",
+		"form.file":                "Файл",
+		"form.file_settings":       "~/.claude.json (налаштування проекту)",
+		"form.file_mcp_json":       ".mcp.json (спільний)",
+		"form.file_mcp_local":      ".mcp.local.json (локальний)",
+		"form.project_required":    "Виберіть проект",
+		"dialog.move_to":           "Виберіть місце призначення:",
+		"dialog.clone_file":        "Файл призначення в проектах:",
+		"dialog.overwrite_title":   "Сервер вже існує",
+		"dialog.overwrite_confirm": "Сервер '%s' вже існує в %s. Перезаписати його?",
+		"conflict.title":       "Конфігурацію змінено ззовні",
+		"conflict.message":     "%s було змінено іншою програмою (напр. Claude Code) після завантаження. Інші зміни буде збережено; виберіть, яку версію залишити для серверів, змінених з обох боків.",
+		"conflict.mine":        "Моя версія",
+		"conflict.theirs":      "Версія на диску",
+		"conflict.keep_mine":   "Залишити мою",
+		"conflict.keep_theirs": "Використати з диска",
+		"conflict.removed":     "(видалено)",
+		"conflict.apply":       "Застосувати",
+		"banner.changed_externally": "Конфігурацію змінено ззовні: %s",
+		"banner.added":              "додано: %d",
+		"banner.modified":           "змінено: %d",
+		"banner.removed":            "видалено: %d",
+		"toolbar.backups":         "Резервні копії",
+		"backup.title":            "Резервні копії ~/.claude.json",
+		"backup.select":           "Виберіть резервну копію, щоб побачити відмінності",
+		"backup.none":             "Резервних копій немає",
+		"backup.no_changes":       "MCP-сервери резервної копії збігаються з поточними",
+		"backup.diff_hint":        "Вплив відновлення на MCP-сервери (виберіть, що відновити):",
+		"backup.change_added":     "буде додано",
+		"backup.change_removed":   "буде видалено",
+		"backup.change_modified":  "буде відновлено",
+		"backup.restore_selected": "Відновити вибрані",
+		"backup.restore_all":      "Відновити все",
+		"backup.nothing_selected": "Жодного сервера не вибрано",
+		"backup.restore_confirm":  "Відновити серверів: %d з резервної копії від %s? Решта ~/.claude.json не зміниться.",
+		"backup.retention":        "Зберігання",
+		"backup.max_count":        "Максимальна кількість",
+		"backup.max_age":          "Максимальний вік (днів, 0 = без обмежень)",
+		"backup.dir":              "Каталог",
+		"backup.dir_hint":         "Поруч із ~/.claude.json",
+		"toolbar.history":    "Історія",
+		"btn.close":          "Закрити",
+		"history.title":      "Історія змін",
+		"history.empty":      "У цьому сеансі змін немає",
+		"history.undone":     "скасовано",
+		"history.op_add":     "Додано '%s'",
+		"history.op_update":  "Змінено '%s'",
+		"history.op_remove":  "Видалено '%s'",
+		"history.op_move":    "Переміщено '%s'",
+		"history.op_clone":   "Клоновано '%s'",
+		"history.op_restore": "Відновлення з %s",
+		"btn.test":                 "Перевірити",
+		"test.title":               "Перевірка з'єднання: %s",
+		"test.running":             "MCP-рукостискання з %s триває (тайм-аут %s)...",
+		"test.success":             "Рукостискання успішне за %s",
+		"test.failed":              "Рукостискання не вдалося",
+		"test.protocol":            "Версія протоколу",
+		"test.server_info":         "Сервер",
+		"test.capabilities":        "Можливості",
+		"test.instructions":        "Інструкції",
+		"test.none":                "немає",
+		"test.reason":              "Причина",
+		"test.detail":              "Деталі",
+		"test.stderr":              "Вивід stderr",
+		"test.failure_not_found":   "Команду не знайдено",
+		"test.failure_exited":      "Процес завершився з кодом %d",
+		"test.failure_timeout":     "Немає відповіді протягом %s",
+		"test.failure_tls":         "Помилка TLS",
+		"test.failure_http_status": "Сервер відповів HTTP %d",
+		"test.failure_network":     "Помилка мережі",
+		"test.failure_protocol":    "Відповідь не відповідає протоколу MCP",
+		"test.failure_config":      "Неповна конфігурація сервера",
+		"inventory.title":          "Інвентар",
+		"inventory.load":           "Прочитати інвентар",
+		"inventory.refresh":        "Оновити",
+		"inventory.not_loaded":     "Підключіться до сервера, щоб побачити його інструменти, ресурси та промпти",
+		"inventory.loading":        "Читання інструментів, ресурсів і промптів з %s (тайм-аут %s)...",
+		"inventory.fetched_at":     "Прочитано %s",
+		"inventory.tools":          "Інструменти (%d)",
+		"inventory.resources":      "Ресурси (%d)",
+		"inventory.prompts":        "Промпти (%d)",
+		"inventory.schema":         "Схема вводу",
+		"inventory.no_description": "Немає опису",
+		"inventory.list_error":     "%s не вдалося: %s",
+		"tree.tool_count":          "%d інструментів",
+		"validation.name_required":       "ім'я обов'язкове",
+		"validation.name_invalid":        "ім'я може містити лише літери, цифри, '-' і '_' (інструменти називаються mcp__<ім'я>__<інструмент>)",
+		"validation.name_too_long":       "довге ім'я: назви інструментів mcp__<ім'я>__<інструмент> можуть перевищити 64 символи",
+		"validation.type_invalid":        "невідомий тип: %s (допустимі: stdio, http, sse)",
+		"validation.type_missing":        "вкажіть команду (stdio) або URL (http/sse)",
+		"validation.command_required":    "для stdio-серверів потрібна команда",
+		"validation.command_with_url":    "сервер не може мати водночас команду і URL",
+		"validation.command_for_remote":  "сервери %s ігнорують команду",
+		"validation.command_has_spaces":  "команда містить пробіли: аргументи слід вказати в args",
+		"validation.args_empty":          "порожній аргумент у позиції %s",
+		"validation.args_for_remote":     "віддалені сервери ігнорують args",
+		"validation.url_required":        "для серверів %s потрібен URL",
+		"validation.url_for_stdio":       "stdio-сервери ігнорують URL",
+		"validation.url_invalid":         "некоректний URL: %s",
+		"validation.url_scheme":          "непідтримувана схема URL: %s (використовуйте http або https)",
+		"validation.url_insecure":        "незашифроване з'єднання (http) з віддаленим хостом: %s",
+		"validation.sse_deprecated":      "транспорт SSE застарів: використовуйте http, якщо сервер його підтримує",
+		"validation.env_name_empty":      "порожнє ім'я змінної середовища",
+		"validation.env_name_invalid":    "незвичне ім'я змінної середовища: %s",
+		"validation.headers_for_stdio":   "stdio-сервери ігнорують заголовки",
+		"validation.header_name_invalid": "некоректне ім'я заголовка: %s",
+		"validation.timeout_negative":    "тайм-аут не може бути від'ємним",
+		"validation.title":               "Проблеми конфігурації",
+		"validation.invalid_server":      "Сервер %s некоректний",
+		"validation.variable_missing":   "змінна ${%s} не визначена і не має значення за замовчуванням",
+		"detail.headers":                "Заголовки",
+		"detail.expanded":               "розгорнуто",
+		"detail.missing_variables":      "Відсутні змінні середовища",
+		"detail.missing_variables_hint": "Не визначені в середовищі й без значення за замовчуванням: сервери, що їх використовують, можуть не запуститися.",
+		"toolbar.vault":             "Сховище",
+		"history.op_bind":           "Пов'язано секрет '%s'",
+		"history.op_rotate":         "Ротація секрету '%s'",
+		"vault.title":               "Сховище секретів",
+		"vault.intro":               "Секрети зашифровані парольною фразою в %s. Env і headers можуть використовувати їх як ${NAME}: під час запуску Claude Code через лаунчер значення експортуються, не з'являючись у файлах конфігурації.",
+		"vault.create":              "Створити сховище",
+		"vault.passphrase":          "Парольна фраза",
+		"vault.passphrase_confirm":  "Повторіть парольну фразу",
+		"vault.passphrase_mismatch": "Парольні фрази не збігаються",
+		"vault.unlock":              "Розблокувати",
+		"vault.lock":                "Заблокувати",
+		"vault.locked_hint":         "Сховище заблоковане: введіть парольну фразу, щоб керувати секретами.",
+		"vault.no_secrets":          "У сховищі немає секретів",
+		"vault.usages":              "використань: %d",
+		"vault.add_secret":          "Додати секрет",
+		"vault.secret_name":         "Назва",
+		"vault.secret_value":        "Значення",
+		"vault.rotate":              "Ротувати",
+		"vault.rotate_title":        "Ротувати %s",
+		"vault.new_value":           "Нове значення",
+		"vault.rotated":             "Секрет %s оновлено: змінено серверів: %d",
+		"vault.delete_confirm":      "Видалити секрет %s зі сховища?",
+		"vault.in_use":              "Секрет %s досі використовується як ${%s} у:",
+		"vault.change_passphrase":   "Змінити парольну фразу",
+		"vault.launcher":            "Створити лаунчер",
+		"vault.launcher_created":    "Лаунчер створено в %s. Використовуйте його замість `claude`, щоб запускати Claude Code із секретами, на які посилаються як ${NAME}.",
+		"vault.copy_path":           "Копіювати шлях",
+		"vault.mode":                "Режим",
+		"vault.mode_reference":      "посилання ${NAME}",
+		"vault.mode_inline":         "розв'язане значення у файлі",
+		"vault.binding":             "сховище: %s (%s)",
+		"vault.bind":                "Сховище",
+		"vault.bind_title":          "Пов'язати %s зі сховищем",
+		"vault.bind_hint":           "Якщо секрету не існує, його буде створено з поточним значенням.",
+		"vault.needs_unlock":        "Розблокуйте сховище, щоб пов'язувати значення із секретами.",
+		"detail.reveal": "Показати",
+		"detail.hide":   "Приховати",
+		"btn.copy_json": "Копіювати JSON",
+		"form.headers":                  "Заголовки",
+		"form.timeout":                  "Тайм-аут (мс)",
+		"form.timeout_hint":             "Порожньо: типове значення Claude Code",
+		"form.add_row":                  "Додати",
+		"form.add_arg":                  "Додати аргумент",
+		"form.paste_command":            "Імпортувати",
+		"form.paste_command_hint":       "Вставте командний рядок (напр.: npx -y pkg --dir \"My Docs\")",
+		"form.env_name_hint":            "НАЗВА",
+		"form.header_name_hint":         "Назва заголовка",
+		"form.value_hint":               "Значення",
+		"form.preview":                  "JSON, який буде записано",
+		"validation.command_line_quote": "недійсний командний рядок: лапки не закрито",
+		"validation.key_duplicate":      "повторений ключ: %s (діє останній рядок)",
+		"validation.timeout_invalid":    "тайм-аут має бути цілим числом мілісекунд",
+		"dialog.add_via_template":        "З шаблону",
+		"template.title":                 "Шаблони серверів",
+		"template.select":                "Виберіть шаблон",
+		"template.filter":                "Пошук шаблонів...",
+		"template.source":                "Каталог: %s",
+		"template.add":                   "Додати сервер",
+		"template.catalogs":              "Каталоги",
+		"template.catalogs_hint":         "Додаткові каталоги (файли JSON або спільні папки команди), які читаються разом із вбудованим; шаблон із тим самим ID замінює вбудований.",
+		"template.no_catalogs":           "Немає додаткових каталогів",
+		"template.add_file":              "Додати файл...",
+		"template.add_folder":            "Додати папку...",
+		"validation.template_catalog":    "%s",
+		"validation.template_parameters": "%s",
+		"toolbar.import":                  "Імпорт",
+		"history.op_import":               "Імпорт з %s",
+		"import.title":                    "Імпорт з інших клієнтів",
+		"import.source":                   "Вихідний файл",
+		"import.select_source":            "Виберіть файл конфігурації",
+		"import.no_sources":               "Конфігурацій інших клієнтів не знайдено",
+		"import.format":                   "Формат",
+		"import.browse":                   "Огляд...",
+		"import.target":                   "Призначення",
+		"import.overwrite":                "Замінити інші сервери з тією самою назвою",
+		"import.run":                      "Імпортувати вибрані",
+		"import.empty":                    "У файлі немає серверів MCP",
+		"import.partial":                  "Імпортовано серверів: %d, деякі пропущено:",
+		"import.done":                     "Імпортовано серверів: %d до %s",
+		"import.status_new":               "Новий",
+		"import.status_identical":         "Уже є",
+		"import.status_conflict":          "Конфлікт",
+		"validation.import_field_ignored": "поле '%s' не підтримується Claude Code: не імпортовано",
+		"validation.import_variable":      "змінна %s вихідного клієнта не підтримується: замініть її значенням або ${VAR}",
+		"validation.import_disabled":      "сервер вимкнено у вихідному клієнті",
+		"validation.import_renamed":       "початкова назва '%s' непридатна: перейменовано",
+		"validation.import_conflict":      "у призначенні вже є інший сервер із цією назвою",
+		"validation.import_invalid":       "недійсний запис, імпорт неможливий: %s",
+		"import.format_claude-desktop":    "Claude Desktop",
+		"import.format_cursor":            "Cursor",
+		"import.format_vscode":            "VS Code",
+		"import.format_codex":             "Codex CLI",
+		"import.format_goose":             "Goose",
+		"validation.import_read":          "%s",
+		"toolbar.export":                      "Експорт",
+		"export.title":                        "Експорт до інших клієнтів",
+		"export.source":                       "Сервери",
+		"export.format":                       "Формат",
+		"export.servers":                      "Сервери для експорту",
+		"export.preview":                      "Попередній перегляд (секрети приховано)",
+		"export.effective":                    "%s — ефективні сервери",
+		"export.empty":                        "У цьому розташуванні немає серверів",
+		"export.include_secrets":              "Включити секрети у збережений файл",
+		"export.copy":                         "Копіювати",
+		"export.save":                         "Зберегти у файл…",
+		"export.done":                         "Експортовано %d серверів до %s",
+		"export.format_mcp-servers":           "Фрагмент mcpServers (.mcp.json)",
+		"export.format_claude-desktop":        "Claude Desktop",
+		"export.format_cursor":                "Cursor",
+		"export.format_vscode":                "VS Code",
+		"export.format_codex":                 "Codex CLI (TOML)",
+		"export.format_goose":                 "Goose (YAML)",
+		"validation.export_field_unsupported": "поле '%s' неможливо подати в цільовому форматі: не експортовано",
+		"validation.export_type_unsupported":  "сервери %s не підтримуються цільовим форматом: не експортовано",
+		"validation.export_variable":          "%s буде записано буквально: цільовий клієнт не розгортає змінні",
+		"validation.export_variable_default":  "значення за замовчуванням для %s не підтримується цільовим клієнтом",
+		"detail.effective_configs":    "Ефективна конфігурація",
+		"detail.no_effective_servers": "У цьому проєкті немає активних серверів",
+		"detail.shadowing_count":      "%d перевизначено",
+		"detail.shadows":              "      перевизначає: %s",
+		"detail.show_diff":            "Відмінності",
+		"detail.diff_title":           "Визначення %s",
+		"detail.diff_against":         "%s порівняно з %s",
+		"detail.diff_identical":       "Ідентичне визначення",
+		"toolbar.duplicates":       "Дублікати",
+		"history.op_promote":       "'%s' зроблено глобальним",
+		"duplicates.title":         "Дубльовані сервери",
+		"duplicates.hint":          "Сервери з однаковою командою та аргументами (або однаковим URL) у кількох проєктах. Якщо зробити їх глобальними, ідентичні копії стають зайвими й видаляються однією операцією, яку можна скасувати.",
+		"duplicates.none":          "Дубльованих серверів немає",
+		"duplicates.summary":       "копій: %d, відмінних: %d",
+		"duplicates.promote":       "Зробити глобальним",
+		"duplicates.definition":    "Визначення",
+		"duplicates.global_name":   "Глобальна назва",
+		"duplicates.remove_copies": "Копії для видалення:",
+		"toolbar.cleanup":         "Очищення",
+		"tree.missing":            "теку не знайдено",
+		"detail.project_missing":  "Теки проєкту більше не існує: її запис займає %s у ~/.claude.json і його можна видалити.",
+		"cleanup.title":           "Очищення проєктів",
+		"cleanup.hint":            "Проєкти, зареєстровані в ~/.claude.json, теку яких видалено або переміщено. Їх видалення прибирає їхні сервери та дані Claude Code (історію, дозволи, стан); перед записом створюється резервна копія файлу.",
+		"cleanup.none":            "Немає проєктів із відсутньою текою",
+		"cleanup.project_details": "%d серверів · %s",
+		"cleanup.total":           "Вибрано: %d проєктів (%s)",
+		"cleanup.archive_first":   "Зберегти архів записів перед видаленням",
+		"cleanup.include_secrets": "Включити секрети в архів",
+		"cleanup.export_only":     "Експортувати архів…",
+		"cleanup.remove":          "Видалити вибрані",
+		"cleanup.confirm":         "Видалити %d проєктів (%s) з ~/.claude.json?",
+		"cleanup.archived":        "Заархівовано %d проєктів у %s",
+		"cleanup.removed":         "Видалено %d проєктів",
+		"toolbar.discover":          "Знайти",
+		"tree.discovered":           "Знайдені проєкти",
+		"detail.discovered_project": "Знайдений проєкт",
+		"detail.discovered_hint":    "Ця тека містить конфігурацію MCP, але не зареєстрована в ~/.claude.json. Після реєстрації вона з'явиться серед проєктів разом із серверами своїх файлів.",
+		"discovery.title":           "Пошук проєктів",
+		"discovery.hint":            "Шукає у вказаних теках (напр. ~/src) проєкти з .mcp.json або .mcp.local.json, які ще не зареєстровані в ~/.claude.json. Приховані теки, node_modules і vendor пропускаються.",
+		"discovery.roots":           "Теки для пошуку",
+		"discovery.no_roots":        "Немає тек: додайте одну",
+		"discovery.add_root":        "Додати теку…",
+		"discovery.scan":            "Шукати",
+		"discovery.none":            "Незареєстрованих проєктів не знайдено",
+		"discovery.register":        "Зареєструвати вибрані",
+		"discovery.register_one":    "Зареєструвати проєкт",
+		"discovery.registered":      "Зареєстровано %d проєктів",
+		"tree.search_placeholder":          "Пошук за назвою, командою, аргументами, URL, env або шляхом…",
+		"tree.filter_all_types":            "Усі типи",
+		"tree.filter_all_sources":          "Усі файли",
+		"tree.filter_source_global":        "Глобальний (~/.claude.json)",
+		"tree.filter_source_project":       "Проєкт (~/.claude.json)",
+		"tree.filter_source_project_file":  ".mcp.json",
+		"tree.filter_source_project_local": ".mcp.local.json",
+		"tree.sort_name":                   "За назвою",
+		"tree.sort_type":                   "За типом",
+		"tree.sort_usage":                  "За кількістю проєктів",
+		"tree.multi_select":      "Множинний вибір",
+		"bulk.title":             "Групова операція",
+		"bulk.selected":          "Вибрано серверів: %d",
+		"bulk.delete":            "Видалити",
+		"bulk.move":              "Перемістити",
+		"bulk.clone":             "Клонувати",
+		"bulk.export":            "Експортувати",
+		"bulk.clear":             "Зняти вибір",
+		"bulk.move_title":        "Перемістити серверів: %d",
+		"bulk.clone_title":       "Клонувати серверів: %d",
+		"bulk.summary":           "Вибрано серверів: %d — зміни: %s",
+		"bulk.skipped_exists":    "'%s' вже існує в %s: пропущено",
+		"bulk.overwrite":         "Перезаписати сервери, що вже є в місцях призначення",
+		"bulk.apply":             "Застосувати",
+		"bulk.nothing":           "Немає змін для застосування",
+		"export.selection":       "Вибране в дереві (серверів: %d)",
+		"history.op_bulk_remove": "Видалено серверів: %s",
+		"history.op_bulk_move":   "Переміщено серверів: %s",
+		"history.op_bulk_clone":  "Клоновано серверів: %s",
+		"toolbar.compare":         "Порівняти",
+		"compare.title":           "Порівняти",
+		"compare.hint":            "Порівняйте два сервери, ефективні конфігурації двох проєктів або проєкт і глобальну область. Стрілки копіюють значення або все визначення на інший бік, у файл, де записано сервер.",
+		"compare.left":            "Ліворуч",
+		"compare.right":           "Праворуч",
+		"compare.effective":       "%s (ефективна конфігурація)",
+		"compare.show_identical":  "Показати однакові сервери",
+		"compare.pick_sides":      "Виберіть дві сторони для порівняння",
+		"compare.same_side":       "Обидві сторони однакові",
+		"compare.mismatch":        "Сервер можна порівняти лише з іншим сервером",
+		"compare.summary":         "Однакові: %d, різні: %d, лише ліворуч: %d, лише праворуч: %d",
+		"compare.empty":           "Немає серверів з жодного боку",
+		"compare.only_left":       "Лише ліворуч, у %s",
+		"compare.only_right":      "Лише праворуч, у %s",
+		"compare.clone_to":        "Копіювати в %s",
+		"compare.identical":       "Однакові",
+		"compare.copy_definition": "Визначення",
+		"toolbar.tools": "Інструменти",
+		"cli.usage_line":               "використання: mcp-curator %s",
+		"cli.error":                    "помилка: %s",
+		"cli.unknown_command":          "невідома команда: %s",
+		"cli.unknown_lang":             "мова не підтримується: %s (доступні: %s)",
+		"cli.help_gui":                 "Без аргументів запускає графічний інтерфейс.",
+		"cli.help_usage":               "Використання: mcp-curator [--config PATH] [--lang МОВА] <команда> [аргументи]",
+		"cli.help_commands":            "Команди:",
+		"cli.help_scope":               "Без --project команди працюють із глобальною областю.\n--scope обирає файл проєкту: project (~/.claude.json, типово),\nproject-file (.mcp.json) або project-local (.mcp.local.json).",
+		"cli.flag_config":              "альтернативний шлях до ~/.claude.json",
+		"cli.flag_lang":                "мова виводу: IT, EN, FR, DE, ES, PT, JA, KO, CN, UK",
+		"cli.cmd.list":                 "Перелічує глобальні та проєктні сервери (секрети приховано, якщо не --reveal)",
+		"cli.cmd.show":                 "Показує конфігурацію сервера (секрети приховано, якщо не --reveal)",
+		"cli.cmd.add":                  "Додає сервер, також із шаблону каталогу",
+		"cli.cmd.update":               "Змінює вказані поля сервера",
+		"cli.cmd.remove":               "Видаляє сервер",
+		"cli.cmd.move":                 "Переміщує сервер між областями та файлами проєкту",
+		"cli.cmd.clone":                "Копіює сервер до інших областей",
+		"cli.cmd.bulk":                 "Видаляє, переміщує або копіює однією операцією сервери, вибрані як у search",
+		"cli.cmd.import":               "Імпортує сервери з Claude Desktop, Cursor, VS Code, Codex або Goose (без PATH перелічує знайдені файли)",
+		"cli.cmd.export":               "Експортує сервери для Claude Desktop, Cursor, VS Code, Codex, Goose або як фрагмент mcpServers",
+		"cli.cmd.search":               "Шукає сервери в усіх областях за назвою, командою, аргументами, URL, ключами env або шляхом проєкту",
+		"cli.cmd.compare":              "Порівнює поле за полем два сервери (НАЗВА@global, НАЗВА@PATH), два проєкти (PATH) або проєкт і global",
+		"cli.cmd.duplicates":           "Знаходить сервери з кількома копіями в глобальній області та проєктах, з відмінностями",
+		"cli.cmd.promote":              "Робить сервер глобальним і видаляє ідентичні копії в проєктах",
+		"cli.cmd.discover":             "Шукає теки з .mcp.json або .mcp.local.json, ще не зареєстровані як проєкти",
+		"cli.cmd.register":             "Реєструє теки як проєкти в ~/.claude.json",
+		"cli.cmd.cleanup":              "Видаляє з ~/.claude.json проєкти, теки яких більше не існує, з необов'язковим архівом",
+		"cli.cmd.validate":             "Перевіряє конфігурацію всіх серверів",
+		"cli.cmd.test":                 "Виконує рукостискання MCP initialize із сервером",
+		"cli.cmd.inventory":            "Перелічує інструменти, ресурси та промпти сервера",
+		"cli.cmd.templates":            "Перелічує шаблони серверів і керує додатковими каталогами команди",
+		"cli.cmd.vault":                "Керує зашифрованим сховищем секретів для env і headers",
+		"cli.cmd.backups":              "Перелічує резервні копії ~/.claude.json і відмінності від поточного файлу",
+		"cli.cmd.restore":              "Відновлює сервери MCP із резервної копії",
+		"cli.cmd.version":              "Показує версію",
+		"cli.location_global":          "глобальна область",
+		"cli.location_project":         "проєкт %s",
+		"cli.source_global":            "глобальний",
+		"cli.source_project":           "проєкт",
+		"cli.severity_error":           "помилка",
+		"cli.severity_warning":         "попередження",
+		"cli.warning":                  "Увага: %s",
+		"cli.absent":                   "(відсутнє)",
+		"cli.no_servers":               "(немає серверів)",
+		"cli.field_name":               "Назва",
+		"cli.field_type":               "Тип",
+		"cli.field_command":            "Команда",
+		"cli.field_category":           "Категорія",
+		"cli.field_description":        "Опис",
+		"cli.field_catalog":            "Каталог",
+		"cli.field_parameter":          "Параметр",
+		"cli.field_required":           "обов'язковий",
+		"cli.field_result":             "Результат",
+		"cli.field_protocol":           "Протокол",
+		"cli.field_error":              "Помилка",
+		"cli.field_destination":        "Призначення",
+		"cli.list_global":              "Глобальні (%s)",
+		"cli.list_projects":            "Проєкти",
+		"cli.list_project_missing":     "теку не знайдено: див. cleanup",
+		"cli.server_added":             "Сервер '%s' додано до %s",
+		"cli.server_updated":           "Сервер '%s' оновлено",
+		"cli.server_removed":           "Сервер '%s' видалено з %s",
+		"cli.server_moved":             "Сервер '%s' переміщено до %s",
+		"cli.server_cloned":            "Сервер '%s' скопійовано до %d призначень",
+		"cli.test_ok":                  "OK (%dms)",
+		"cli.test_failed":              "НЕВДАЛО (%s)",
+		"cli.inventory_header":         "%s %s (протокол %s, прочитано %s)",
+		"cli.inventory_tools":          "Інструменти (%d):",
+		"cli.inventory_resources":      "Ресурси (%d):",
+		"cli.inventory_prompts":        "Промпти (%d):",
+		"cli.inventory_failed":         "%s не вдалося: %s",
+		"cli.valid_servers":            "Коректних серверів: %d",
+		"cli.no_backups":               "(немає резервних копій)",
+		"cli.nothing_to_restore":       "Немає відмінностей для відновлення",
+		"cli.restored":                 "Відновлено серверів: %d з %s",
+		"cli.bulk_none":                "Сервери не вибрано",
+		"cli.bulk_plan":                "Вибрано серверів: %d, зміни: %s",
+		"cli.bulk_done":                "Вибрано серверів: %d, збережені зміни: %s",
+		"cli.cleanup_none":             "Немає проєктів із відсутньою текою",
+		"cli.cleanup_plan":             "Проєктів до видалення: %d (%s)",
+		"cli.archive_saved":            "Архів збережено в %s",
+		"cli.cleanup_done":             "Видалено проєктів: %d з %s (%s)",
+		"cli.compare_left_only":        "лише ліворуч (%s)",
+		"cli.compare_right_only":       "лише праворуч (%s)",
+		"cli.compare_identical":        "Ідентичних серверів: %d (--all, щоб показати)",
+		"cli.discover_none":            "Незареєстрованих проєктів не знайдено",
+		"cli.discover_hint":            "Щоб зареєструвати: mcp-curator discover --register або mcp-curator register PATH",
+		"cli.registered_count":         "Зареєстровано проєктів: %d у %s",
+		"cli.already_registered":       "%s: уже зареєстровано",
+		"cli.registered":               "Зареєстровано %s (%s)",
+		"cli.no_mcp_files":             "немає файлів MCP",
+		"cli.duplicates_none":          "Дублікатів серверів немає",
+		"cli.duplicates_group":         "копій: %d, відмінних: %d",
+		"cli.promote_hint":             "видаляє ідентичні копії в проєктах",
+		"cli.promote_plan":             "'%s' стане глобальним, видалених копій у проєктах: %d",
+		"cli.promote_done":             "Сервер '%s' глобальний, видалено копій у проєктах: %d",
+		"cli.exported":                 "Експортовано серверів: %d до %s",
+		"cli.import_empty":             "У %s немає серверів MCP",
+		"cli.imported":                 "Імпортовано серверів: %d до %s",
+		"cli.import_nothing":           "Немає серверів для імпорту: усі вже є",
+		"cli.import_no_sources":        "Файлів конфігурації інших клієнтів не знайдено",
+		"cli.search_none":              "Серверів не знайдено",
+		"cli.search_usage":             "проєктів: %d",
+		"cli.catalogs":                 "Додаткові каталоги",
+		"cli.catalog_added":            "Каталог додано: %s",
+		"cli.catalog_removed":          "Каталог видалено: %s",
+		"cli.vault_created":            "Сховище створено: %s",
+		"cli.no_secrets":               "(немає секретів)",
+		"cli.secret_usages":            "використань: %d",
+		"cli.secret_added":             "Секрет %s додано до сховища",
+		"cli.secret_updated":           "Секрет %s оновлено",
+		"cli.secret_server_updated":    "оновлено %s (%s)",
+		"cli.secret_deleted":           "Секрет %s видалено",
+		"cli.secret_bound":             "%s %s сервера %s пов'язано із секретом %s (%s)",
+		"cli.launcher_hint":            "Запустіть Claude Code через лаунчер (mcp-curator vault launcher), щоб розв'язати ${%s}",
+		"cli.launcher_created":         "Лаунчер створено: %s",
+		"cli.launcher_usage":           "Використовуйте його замість `claude`, щоб запускати Claude Code із секретами, вказаними як ${НАЗВА}.",
+		"cli.passphrase_changed":       "Парольну фразу сховища змінено",
+		"cli.prompt_passphrase":        "Парольна фраза сховища: ",
+		"cli.prompt_new_passphrase":    "Нова парольна фраза сховища: ",
+		"cli.prompt_repeat_passphrase": "Повторіть парольну фразу: ",
+		"cli.prompt_secret_value":      "Значення %s: ",
+		"cli.prompt_new_secret_value":  "Нове значення %s: ",
+		"cli.flag.project_server":      "проєкт сервера (типово: глобальний)",
+		"cli.flag.project_add":         "додати до вказаного проєкту (типово: глобальний)",
+		"cli.flag.project_source":      "вихідний проєкт (типово: глобальний)",
+		"cli.flag.project_promote":     "проєкт копії для підвищення (типово: глобальний, щоб лише видалити копії)",
+		"cli.flag.project_export":      "експортувати з указаного проєкту (типово: глобальний)",
+		"cli.flag.project_import":      "імпортувати до вказаного проєкту (типово: глобальний)",
+		"cli.flag.project_only":        "лише сервери цього проєкту (усі його файли)",
+		"cli.flag.project_list":        "показати лише сервери вказаного проєкту",
+		"cli.flag.scope":               "файл проєкту: project (~/.claude.json), project-file (.mcp.json), project-local (.mcp.local.json)",
+		"cli.flag.type":                "тип сервера: stdio, http, sse",
+		"cli.flag.command":             "команда для запуску (stdio)",
+		"cli.flag.arg":                 "аргумент команди (можна повторювати)",
+		"cli.flag.url":                 "URL сервера (http/sse)",
+		"cli.flag.env":                 "змінна середовища KEY=value (можна повторювати)",
+		"cli.flag.header":              "HTTP-заголовок KEY=value (можна повторювати)",
+		"cli.flag.timeout":             "тайм-аут у мілісекундах",
+		"cli.flag.effective":           "показати ефективні сервери проєкту (злиття всіх областей)",
+		"cli.flag.effective_export":    "експортувати ефективні сервери проєкту (злиття всіх областей)",
+		"cli.flag.json":                "вивід у форматі JSON",
+		"cli.flag.json_import":         "вивід у форматі JSON (з --dry-run або без PATH)",
+		"cli.flag.json_cleanup":        "перелічити проєкти у форматі JSON без видалення",
+		"cli.flag.json_server":         "JSON-конфігурація сервера (прапорці мають пріоритет)",
+		"cli.flag.reveal":              "показувати секрети замість [REDACTED]",
+		"cli.flag.reveal_archive":      "включати секрети в архів замість [REDACTED]",
+		"cli.flag.reveal_export":       "включати секрети замість [REDACTED]",
+		"cli.flag.template":            "почати з шаблону каталогу (див. `templates`)",
+		"cli.flag.param":               "параметр шаблону K=значення (можна повторювати)",
+		"cli.flag.clear_args":          "видалити всі аргументи",
+		"cli.flag.unset_env":           "видалити змінну середовища (можна повторювати)",
+		"cli.flag.unset_header":        "видалити HTTP-заголовок (можна повторювати)",
+		"cli.flag.from_scope":          "файл вихідного проєкту (project, project-file, project-local)",
+		"cli.flag.to":                  "призначення: 'global' або шлях проєкту",
+		"cli.flag.to_repeat":           "призначення: 'global' або шлях проєкту (можна повторювати)",
+		"cli.flag.to_bulk":             "призначення: 'global' або шлях проєкту (для clone можна повторювати)",
+		"cli.flag.to_global":           "перемістити до глобальної області (те саме, що --to global)",
+		"cli.flag.to_scope":            "файл проєкту призначення (project, project-file, project-local)",
+		"cli.flag.to_scope_many":       "файл проєктів призначення (project, project-file, project-local)",
+		"cli.flag.force_one":           "перезаписати сервер із тією самою назвою в призначенні",
+		"cli.flag.force_many":          "перезаписати сервери з тією самою назвою в призначеннях",
+		"cli.flag.force_import":        "замінити відмінні сервери з тією самою назвою",
+		"cli.flag.dry_run":             "показати, що зміниться, без запису",
+		"cli.flag.dry_run_promote":     "показати, що буде зроблено, без запису",
+		"cli.flag.dry_run_import":      "показати, що буде імпортовано, без запису",
+		"cli.flag.dry_run_cleanup":     "перелічити проєкти без видалення",
+		"cli.flag.archive":             "зберегти записи проєктів у цей файл перед видаленням",
+		"cli.flag.cached":              "використати останній прочитаний перелік без підключення",
+		"cli.flag.diff":                "показати сервери, які зміняться після відновлення кожної копії",
+		"cli.flag.restore_server":      "відновити лише вказаний сервер (можна повторювати)",
+		"cli.flag.import_server":       "імпортувати лише вказаний сервер (можна повторювати)",
+		"cli.flag.all":                 "показувати також ідентичні сервери",
+		"cli.flag.depth":               "максимальна глибина пошуку (типово: налаштування, інакше 4)",
+		"cli.flag.save_roots":          "додати вказані теки до налаштувань",
+		"cli.flag.register":            "зареєструвати всі знайдені проєкти",
+		"cli.flag.as":                  "назва глобального сервера (типово: назва копії)",
+		"cli.flag.keep_copies":         "не видаляти ідентичні копії в проєктах",
+		"cli.flag.export_format":       "формат: mcp-servers, claude-desktop, cursor, vscode, codex, goose",
+		"cli.flag.output":              "записати у файл замість stdout",
+		"cli.flag.import_format":       "формат файлу: claude-desktop, cursor, vscode, codex, goose (типово: визначається за шляхом)",
+		"cli.flag.filter_type":         "лише сервери цього типу: stdio, http, sse",
+		"cli.flag.filter_source":       "лише сервери цього файлу: global, project, project-file, project-local",
+		"cli.flag.sort":                "сортування: name, type, usage (проєкти, що використовують сервер)",
+		"cli.flag.unlock":              "розблокувати сховище, щоб розпізнавати й розв'язані значення у файлах",
+		"cli.flag.bind_env":            "змінна середовища сервера для прив'язки",
+		"cli.flag.bind_header":         "HTTP-заголовок сервера для прив'язки",
+		"cli.flag.secret":              "назва секрету (типово: ключ env або header); створюється, якщо відсутній",
+		"cli.flag.inline":              "записати у файл розв'язане значення замість ${НАЗВА}",
+		"cli.shadows":                  "перекриває %s",
+		"cli.file_servers":             "%s: серверів %d",
+		"cli.server_count":             "серверів: %d",
+		"cli.template_default":         "типово %s",
 	}
 }
@@ -0,0 +1,49 @@
+package infrastructure
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+)
+
+// writeFileAtomic scrive un file in modo atomico (file temporaneo + rename) mantenendo
+// i permessi del file esistente; defaultPerm si applica solo ai file nuovi.
+// Se path è un symlink viene aggiornato il file a cui punta.
+func writeFileAtomic(path string, data []byte, defaultPerm os.FileMode) error {
+	if resolved, err := filepath.EvalSymlinks(path); err == nil {
+		path = resolved
+	}
+
+	perm := defaultPerm
+	if info, err := os.Stat(path); err == nil {
+		perm = info.Mode().Perm()
+	}
+
+	tmp, err := os.CreateTemp(filepath.Dir(path), "."+filepath.Base(path)+".tmp-*")
+	if err != nil {
+		return fmt.Errorf("impossibile creare file temporaneo: %w", err)
+	}
+	tmpPath := tmp.Name()
+	// In caso di errore il file temporaneo non deve restare sul disco
+	defer os.Remove(tmpPath)
+
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		return fmt.Errorf("impossibile scrivere %s: %w", tmpPath, err)
+	}
+	if err := tmp.Sync(); err != nil {
+		tmp.Close()
+		return fmt.Errorf("impossibile scrivere %s: %w", tmpPath, err)
+	}
+	if err := tmp.Close(); err != nil {
+		return fmt.Errorf("impossibile scrivere %s: %w", tmpPath, err)
+	}
+	if err := os.Chmod(tmpPath, perm); err != nil {
+		return fmt.Errorf("impossibile impostare i permessi di %s: %w", tmpPath, err)
+	}
+
+	if err := os.Rename(tmpPath, path); err != nil {
+		return fmt.Errorf("impossibile sostituire %s: %w", path, err)
+	}
+	return nil
+}
@@ -0,0 +1,172 @@
+package infrastructure
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// backupTimeFormat è il formato del timestamp nei nomi dei backup
+const backupTimeFormat = "20060102-150405"
+
+// BackupPolicy definisce dove salvare i backup e quanti conservarne
+type BackupPolicy struct {
+	Dir      string        // directory dei backup ("" = accanto al file di configurazione)
+	MaxCount int           // numero massimo di backup (0 = illimitato)
+	MaxAge   time.Duration // età massima dei backup (0 = illimitata)
+}
+
+// DefaultBackupPolicy restituisce la politica predefinita: ultimi 5 backup accanto al file
+func DefaultBackupPolicy() BackupPolicy {
+	return BackupPolicy{MaxCount: 5}
+}
+
+// Backup descrive una copia con timestamp del file di configurazione
+type Backup struct {
+	Path string
+	Time time.Time
+	Size int64
+
+	seq int // progressivo per backup creati nello stesso secondo
+}
+
+// BackupStore gestisce i backup con timestamp di un file di configurazione.
+// I nomi hanno la forma <file>.<AAAAMMGG-hhmmss>[-N].bak: il suffisso -N evita
+// di sovrascrivere un backup creato nello stesso secondo.
+type BackupStore struct {
+	configPath string
+	policy     BackupPolicy
+}
+
+// NewBackupStore crea un gestore di backup per il file indicato
+func NewBackupStore(configPath string, policy BackupPolicy) *BackupStore {
+	return &BackupStore{configPath: configPath, policy: policy}
+}
+
+// Policy restituisce la politica di conservazione corrente
+func (s *BackupStore) Policy() BackupPolicy {
+	return s.policy
+}
+
+// SetPolicy imposta la politica di conservazione
+func (s *BackupStore) SetPolicy(policy BackupPolicy) {
+	s.policy = policy
+}
+
+// Dir restituisce la directory in cui vengono salvati i backup
+func (s *BackupStore) Dir() string {
+	if s.policy.Dir != "" {
+		return s.policy.Dir
+	}
+	return filepath.Dir(s.configPath)
+}
+
+// Create salva data come nuovo backup e applica la politica di conservazione
+func (s *BackupStore) Create(data []byte) (string, error) {
+	dir := s.Dir()
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return "", fmt.Errorf("impossibile creare la directory dei backup: %w", err)
+	}
+
+	stamp := time.Now().Format(backupTimeFormat)
+	base := filepath.Base(s.configPath)
+	for seq := 0; ; seq++ {
+		name := fmt.Sprintf("%s.%s.bak", base, stamp)
+		if seq > 0 {
+			name = fmt.Sprintf("%s.%s-%d.bak", base, stamp, seq)
+		}
+		path := filepath.Join(dir, name)
+
+		// O_EXCL garantisce di non sovrascrivere un backup esistente
+		f, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0600)
+		if os.IsExist(err) {
+			continue
+		}
+		if err != nil {
+			return "", fmt.Errorf("impossibile creare il backup: %w", err)
+		}
+		_, err = f.Write(data)
+		if closeErr := f.Close(); err == nil {
+			err = closeErr
+		}
+		if err != nil {
+			os.Remove(path)
+			return "", fmt.Errorf("impossibile scrivere il backup: %w", err)
+		}
+
+		s.Prune()
+		return path, nil
+	}
+}
+
+// List restituisce i backup esistenti, dal più recente
+func (s *BackupStore) List() ([]Backup, error) {
+	base := filepath.Base(s.configPath)
+	matches, err := filepath.Glob(filepath.Join(s.Dir(), base+".*.bak"))
+	if err != nil {
+		return nil, err
+	}
+
+	backups := make([]Backup, 0, len(matches))
+	for _, path := range matches {
+		backup, ok := parseBackupName(base, path)
+		if !ok {
+			continue
+		}
+		if info, err := os.Stat(path); err == nil {
+			backup.Size = info.Size()
+		}
+		backups = append(backups, backup)
+	}
+
+	sort.Slice(backups, func(i, j int) bool {
+		if !backups[i].Time.Equal(backups[j].Time) {
+			return backups[i].Time.After(backups[j].Time)
+		}
+		return backups[i].seq > backups[j].seq
+	})
+	return backups, nil
+}
+
+// Prune rimuove i backup oltre il numero massimo o più vecchi dell'età massima
+func (s *BackupStore) Prune() {
+	backups, err := s.List()
+	if err != nil {
+		return
+	}
+
+	now := time.Now()
+	for i, backup := range backups {
+		tooMany := s.policy.MaxCount > 0 && i >= s.policy.MaxCount
+		tooOld := s.policy.MaxAge > 0 && now.Sub(backup.Time) > s.policy.MaxAge
+		if tooMany || tooOld {
+			os.Remove(backup.Path)
+		}
+	}
+}
+
+// parseBackupName estrae data e progressivo dal nome di un backup
+func parseBackupName(base, path string) (Backup, bool) {
+	name := filepath.Base(path)
+	stamp := strings.TrimSuffix(strings.TrimPrefix(name, base+"."), ".bak")
+
+	seq := 0
+	if len(stamp) > len(backupTimeFormat) {
+		n, err := strconv.Atoi(strings.TrimPrefix(stamp[len(backupTimeFormat):], "-"))
+		if err != nil {
+			return Backup{}, false
+		}
+		seq = n
+		stamp = stamp[:len(backupTimeFormat)]
+	}
+
+	t, err := time.ParseInLocation(backupTimeFormat, stamp, time.Local)
+	if err != nil {
+		return Backup{}, false
+	}
+	return Backup{Path: path, Time: t, seq: seq}, true
+}
@@ -2,10 +2,11 @@ package infrastructure
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
 	"os"
 	"path/filepath"
-	"time"
+	"slices"
 
 	"github.com/strawberry-code/mcp-curator/internal/domain"
 )
@@ -13,7 +14,11 @@ import (
 // ClaudeConfigRepository gestisce la lettura/scrittura di ~/.claude.json
 type ClaudeConfigRepository struct {
 	configPath string
-	rawConfig  map[string]interface{}
+	backups    *BackupStore
+
+	// baseServers sono i server letti all'ultimo Load/Save: servono come base
+	// del merge a tre vie quando il file viene modificato da Claude Code nel frattempo
+	baseServers map[domain.ServerRef]interface{}
 }
 
 // NewClaudeConfigRepository crea un nuovo repository per ~/.claude.json
@@ -23,18 +28,22 @@ func NewClaudeConfigRepository() (*ClaudeConfigRepository, error) {
 		return nil, fmt.Errorf("impossibile determinare home directory: %w", err)
 	}
 
-	return &ClaudeConfigRepository{
-		configPath: filepath.Join(home, ".claude.json"),
-	}, nil
+	return NewClaudeConfigRepositoryWithPath(filepath.Join(home, ".claude.json")), nil
 }
 
 // NewClaudeConfigRepositoryWithPath crea un repository con path personalizzato (per test)
 func NewClaudeConfigRepositoryWithPath(path string) *ClaudeConfigRepository {
 	return &ClaudeConfigRepository{
 		configPath: path,
+		backups:    NewBackupStore(path, DefaultBackupPolicy()),
 	}
 }
 
+// Backups restituisce il gestore dei backup con timestamp
+func (r *ClaudeConfigRepository) Backups() *BackupStore {
+	return r.backups
+}
+
 // GetConfigPath restituisce il path del file di configurazione
 func (r *ClaudeConfigRepository) GetConfigPath() string {
 	return r.configPath
@@ -42,23 +51,34 @@ func (r *ClaudeConfigRepository) GetConfigPath() string {
 
 // Load carica la configurazione da disco
 func (r *ClaudeConfigRepository) Load() (*domain.Configuration, error) {
-	config := domain.NewConfiguration(r.configPath)
-
-	data, err := os.ReadFile(r.configPath)
+	rawConfig, err := readRawFile(r.configPath)
 	if err != nil {
-		if os.IsNotExist(err) {
-			return config, nil
-		}
-		return nil, fmt.Errorf("impossibile leggere %s: %w", r.configPath, err)
+		return nil, err
 	}
+	r.baseServers = rawServers(rawConfig)
 
-	r.rawConfig = make(map[string]interface{})
-	if err := json.Unmarshal(data, &r.rawConfig); err != nil {
-		return nil, fmt.Errorf("JSON non valido in %s: %w", r.configPath, err)
+	return parseConfiguration(r.configPath, rawConfig), nil
+}
+
+// LoadFile carica i server da un file nel formato di ~/.claude.json (es. un backup)
+// senza modificare lo stato del repository
+func (r *ClaudeConfigRepository) LoadFile(path string) (*domain.Configuration, error) {
+	if !fileExists(path) {
+		return nil, fmt.Errorf("file %s non trovato", path)
+	}
+	rawConfig, err := readRawFile(path)
+	if err != nil {
+		return nil, err
 	}
+	return parseConfiguration(path, rawConfig), nil
+}
+
+// parseConfiguration estrae server globali e progetti da ~/.claude.json decodificato
+func parseConfiguration(path string, rawConfig map[string]interface{}) *domain.Configuration {
+	config := domain.NewConfiguration(path)
 
 	// Estrai mcpServers globali
-	if mcpServers, ok := r.rawConfig["mcpServers"].(map[string]interface{}); ok {
+	if mcpServers, ok := rawConfig["mcpServers"].(map[string]interface{}); ok {
 		for name, serverData := range mcpServers {
 			server, err := ParseServer(serverData)
 			if err != nil {
@@ -69,7 +89,7 @@ func (r *ClaudeConfigRepository) Load() (*domain.Configuration, error) {
 	}
 
 	// Estrai projects con i loro mcpServers
-	if projects, ok := r.rawConfig["projects"].(map[string]interface{}); ok {
+	if projects, ok := rawConfig["projects"].(map[string]interface{}); ok {
 		for path, projectData := range projects {
 			projectMap, ok := projectData.(map[string]interface{})
 			if !ok {
@@ -90,123 +110,222 @@ func (r *ClaudeConfigRepository) Load() (*domain.Configuration, error) {
 			}
 
 			// Verifica esistenza file .mcp.json e .mcp.local.json
-			project.HasMCPJson = fileExists(filepath.Join(path, ".mcp.json"))
-			project.HasMCPLocal = fileExists(filepath.Join(path, ".mcp.local.json"))
+			project.HasMCPJson = fileExists(filepath.Join(path, domain.MCPJsonFile))
+			project.HasMCPLocal = fileExists(filepath.Join(path, domain.MCPLocalFile))
+
+			// Progetti di cartelle cancellate o spostate e spazio occupato nel file
+			project.Missing = pathMissing(path)
+			if data, err := json.Marshal(projectMap); err == nil {
+				project.DataSize = int64(len(data))
+			}
 
 			config.AddProject(project)
 		}
 	}
 
-	return config, nil
+	return config
 }
 
-// Save salva la configurazione su disco
+// Save salva la configurazione su disco.
+// Il file viene riletto prima di scrivere: le modifiche fatte da altri programmi dopo il Load
+// (history, stato dei progetti, server aggiunti con `claude mcp add`) vengono mantenute e
+// il curator applica sopra solo i server che ha cambiato. Se lo stesso server è stato
+// modificato da entrambe le parti restituisce un *domain.ConflictError senza scrivere nulla.
+// I server cambiati esternamente vengono riportati in config.
 func (r *ClaudeConfigRepository) Save(config *domain.Configuration) error {
+	rawConfig, result, err := r.merge(config)
+	if err != nil {
+		return err
+	}
+
 	if err := r.backup(); err != nil {
 		return fmt.Errorf("impossibile creare backup: %w", err)
 	}
 
-	// Se non abbiamo un rawConfig, creane uno nuovo
-	if r.rawConfig == nil {
-		r.rawConfig = make(map[string]interface{})
+	for ref, value := range result.ours {
+		setRawServer(rawConfig, ref, value)
+	}
+
+	// Serializza e scrivi
+	data, err := json.MarshalIndent(rawConfig, "", "  ")
+	if err != nil {
+		return fmt.Errorf("impossibile serializzare configurazione: %w", err)
 	}
 
-	// Aggiorna mcpServers globali
-	mcpServers := make(map[string]interface{})
-	for name, server := range config.GlobalServers {
-		mcpServers[name] = ServerToMap(server)
+	if err := writeFileAtomic(r.configPath, data, 0600); err != nil {
+		return err
 	}
-	r.rawConfig["mcpServers"] = mcpServers
 
-	// Aggiorna projects
-	projects := make(map[string]interface{})
-	if existingProjects, ok := r.rawConfig["projects"].(map[string]interface{}); ok {
-		// Mantieni i dati esistenti dei progetti
-		for path, data := range existingProjects {
-			projects[path] = data
+	applyExternalChanges(config, result.theirs)
+	r.baseServers = rawServers(rawConfig)
+
+	return nil
+}
+
+// Check verifica senza scrivere nulla che la configurazione si possa salvare: restituisce
+// il *domain.ConflictError che Save restituirebbe con il file attuale
+func (r *ClaudeConfigRepository) Check(config *domain.Configuration) error {
+	_, _, err := r.merge(config)
+	return err
+}
+
+// merge rilegge il file e lo confronta con la configurazione in memoria e con la base
+// dell'ultimo Load
+func (r *ClaudeConfigRepository) merge(config *domain.Configuration) (map[string]interface{}, mergeResult, error) {
+	rawConfig, err := readRawFile(r.configPath)
+	if err != nil {
+		return nil, mergeResult{}, err
+	}
+	result, err := mergeServers(r.baseServers, configServers(config), rawServers(rawConfig))
+	if err != nil {
+		var conflictErr *domain.ConflictError
+		if errors.As(err, &conflictErr) {
+			conflictErr.Path = r.configPath
 		}
+		return nil, mergeResult{}, err
 	}
+	return rawConfig, result, nil
+}
 
-	for path, project := range config.Projects {
-		projectData := make(map[string]interface{})
+// AcceptExternal usa come base del prossimo merge la versione su disco di un server in conflitto:
+// al salvataggio successivo vincerà la versione presente in memoria
+func (r *ClaudeConfigRepository) AcceptExternal(conflict domain.ServerConflict) {
+	if r.baseServers == nil {
+		r.baseServers = make(map[domain.ServerRef]interface{})
+	}
+	if conflict.Theirs == nil {
+		delete(r.baseServers, conflict.Ref)
+		return
+	}
+	r.baseServers[conflict.Ref] = ServerToMap(*conflict.Theirs)
+}
 
-		// Mantieni dati esistenti del progetto
-		if existing, ok := projects[path].(map[string]interface{}); ok {
-			for k, v := range existing {
-				projectData[k] = v
-			}
+// ProjectEntries restituisce le voci dei progetti indicati così come sono in ~/.claude.json,
+// con history, permessi e stato oltre ai server
+func (r *ClaudeConfigRepository) ProjectEntries(paths []string) (map[string]interface{}, error) {
+	rawConfig, err := readRawFile(r.configPath)
+	if err != nil {
+		return nil, err
+	}
+	projects, _ := rawConfig["projects"].(map[string]interface{})
+	entries := make(map[string]interface{}, len(paths))
+	for _, path := range paths {
+		entry, ok := projects[path]
+		if !ok {
+			return nil, fmt.Errorf("progetto '%s' non trovato in %s", path, r.configPath)
 		}
+		entries[path] = entry
+	}
+	return entries, nil
+}
 
-		// Aggiorna mcpServers del progetto
-		projectServers := make(map[string]interface{})
-		for name, server := range project.MCPServers {
-			projectServers[name] = ServerToMap(server)
+// RemoveProjects elimina le voci dei progetti indicati da ~/.claude.json dopo un backup.
+// Il resto del file, incluse le modifiche esterne successive al Load, resta invariato.
+func (r *ClaudeConfigRepository) RemoveProjects(paths []string) error {
+	rawConfig, err := readRawFile(r.configPath)
+	if err != nil {
+		return err
+	}
+	projects, _ := rawConfig["projects"].(map[string]interface{})
+	for _, path := range paths {
+		delete(projects, path)
+	}
+	if err := r.writeRaw(rawConfig); err != nil {
+		return err
+	}
+	// Solo i server dei progetti rimossi escono dalla base: le altre modifiche esterne
+	// lette qui non sono in memoria e il prossimo merge deve ancora riportarle
+	for ref := range r.baseServers {
+		if ref.Scope == domain.ScopeProject && slices.Contains(paths, ref.ProjectPath) {
+			delete(r.baseServers, ref)
 		}
-		projectData["mcpServers"] = projectServers
+	}
+	return nil
+}
 
-		projects[path] = projectData
+// RegisterProjects aggiunge a ~/.claude.json una voce vuota per i progetti indicati, come
+// farebbe Claude Code alla prima apertura della cartella. Le voci esistenti restano invariate,
+// come i server: la base del merge non cambia.
+func (r *ClaudeConfigRepository) RegisterProjects(paths []string) error {
+	rawConfig, err := readRawFile(r.configPath)
+	if err != nil {
+		return err
+	}
+	projects := childObject(rawConfig, "projects", true)
+	for _, path := range paths {
+		entry := childObject(projects, path, true)
+		childObject(entry, "mcpServers", true)
 	}
-	r.rawConfig["projects"] = projects
+	return r.writeRaw(rawConfig)
+}
 
-	// Serializza e scrivi
-	data, err := json.MarshalIndent(r.rawConfig, "", "  ")
+// writeRaw scrive ~/.claude.json decodificato dopo un backup. La base del merge non
+// cambia: il chiamante la aggiorna solo per le voci che ha toccato.
+func (r *ClaudeConfigRepository) writeRaw(rawConfig map[string]interface{}) error {
+	if err := r.backup(); err != nil {
+		return fmt.Errorf("impossibile creare backup: %w", err)
+	}
+	data, err := json.MarshalIndent(rawConfig, "", "  ")
 	if err != nil {
 		return fmt.Errorf("impossibile serializzare configurazione: %w", err)
 	}
+	return writeFileAtomic(r.configPath, data, 0600)
+}
 
-	if err := os.WriteFile(r.configPath, data, 0644); err != nil {
-		return fmt.Errorf("impossibile scrivere %s: %w", r.configPath, err)
+// WriteProjectArchive salva le voci di progetto in un file nel formato di ~/.claude.json,
+// leggibile da LoadFile e quindi ripristinabile come un backup
+func WriteProjectArchive(path string, entries map[string]interface{}) error {
+	data, err := json.MarshalIndent(map[string]interface{}{"projects": entries}, "", "  ")
+	if err != nil {
+		return fmt.Errorf("impossibile serializzare l'archivio: %w", err)
 	}
+	return writeFileAtomic(path, data, 0600)
+}
 
-	return nil
+// readRawFile legge un file come ~/.claude.json come JSON generico (mappa vuota se non esiste)
+func readRawFile(path string) (map[string]interface{}, error) {
+	rawConfig := make(map[string]interface{})
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return rawConfig, nil
+		}
+		return nil, fmt.Errorf("impossibile leggere %s: %w", path, err)
+	}
+
+	if err := decodeJSON(data, &rawConfig); err != nil {
+		return nil, fmt.Errorf("JSON non valido in %s: %w", path, err)
+	}
+	return rawConfig, nil
 }
 
-// backup crea un backup del file di configurazione
+// backup crea un backup del file di configurazione: una copia .bak accanto al file
+// e una copia con timestamp gestita dal BackupStore
 func (r *ClaudeConfigRepository) backup() error {
 	if !fileExists(r.configPath) {
 		return nil
 	}
 
-	backupPath := r.configPath + ".bak"
-	timestampedPath := fmt.Sprintf("%s.%s.bak", r.configPath, time.Now().Format("20060102-150405"))
-
-	// Copia nel backup principale
 	data, err := os.ReadFile(r.configPath)
 	if err != nil {
 		return err
 	}
 
-	if err := os.WriteFile(backupPath, data, 0644); err != nil {
-		return err
-	}
-
-	// Copia nel backup con timestamp
-	if err := os.WriteFile(timestampedPath, data, 0644); err != nil {
+	// Copia nel backup principale
+	if err := os.WriteFile(r.configPath+".bak", data, 0600); err != nil {
 		return err
 	}
 
-	// Pulisci vecchi backup (mantieni ultimi 5)
-	r.cleanOldBackups()
-
-	return nil
+	// Copia nel backup con timestamp (applica anche la politica di conservazione)
+	_, err = r.backups.Create(data)
+	return err
 }
 
-// cleanOldBackups rimuove i backup più vecchi mantenendo gli ultimi 5
-func (r *ClaudeConfigRepository) cleanOldBackups() {
-	dir := filepath.Dir(r.configPath)
-	base := filepath.Base(r.configPath)
-	pattern := base + ".*.bak"
-
-	matches, err := filepath.Glob(filepath.Join(dir, pattern))
-	if err != nil || len(matches) <= 5 {
-		return
-	}
-
-	// I file sono già ordinati per nome (quindi per timestamp)
-	// Rimuovi i più vecchi
-	for i := 0; i < len(matches)-5; i++ {
-		os.Remove(matches[i])
-	}
+// pathMissing verifica se un percorso non esiste più (un errore di permessi non basta)
+func pathMissing(path string) bool {
+	_, err := os.Stat(path)
+	return os.IsNotExist(err)
 }
 
 // fileExists verifica se un file esiste
@@ -0,0 +1,198 @@
+package infrastructure
+
+import (
+	"bytes"
+	"encoding/json"
+	"sort"
+
+	"github.com/strawberry-code/mcp-curator/internal/domain"
+)
+
+// mergeResult contiene le modifiche da applicare dopo un merge a tre vie
+type mergeResult struct {
+	ours   map[domain.ServerRef]interface{} // da scrivere su disco (nil rimuove)
+	theirs map[domain.ServerRef]interface{} // da riportare in memoria (nil rimuove)
+}
+
+// mergeServers confronta i server di ~/.claude.json in tre versioni: base (ultimo Load),
+// ours (configurazione in memoria) e theirs (file attuale su disco).
+// Un server cambiato solo da una parte prende quella versione; se è cambiato da entrambe
+// in modo diverso viene restituito un *domain.ConflictError.
+func mergeServers(base map[domain.ServerRef]interface{}, ours map[domain.ServerRef]domain.MCPServer, theirs map[domain.ServerRef]interface{}) (mergeResult, error) {
+	result := mergeResult{
+		ours:   make(map[domain.ServerRef]interface{}),
+		theirs: make(map[domain.ServerRef]interface{}),
+	}
+	var conflicts []domain.ServerConflict
+
+	for _, ref := range unionRefs(base, ours, theirs) {
+		baseValue, inBase := base[ref]
+		theirValue, inTheirs := theirs[ref]
+		var ourValue interface{}
+		server, inOurs := ours[ref]
+		if inOurs {
+			ourValue = ServerToMap(server)
+		}
+
+		switch {
+		case sameServer(ourValue, inOurs, baseValue, inBase):
+			// Non modificato dal curator: vale la versione su disco
+			if !sameServer(ourValue, inOurs, theirValue, inTheirs) {
+				result.theirs[ref] = theirValue
+			}
+		case sameServer(theirValue, inTheirs, baseValue, inBase), sameServer(ourValue, inOurs, theirValue, inTheirs):
+			result.ours[ref] = ourValue
+		default:
+			conflict := domain.ServerConflict{Ref: ref}
+			if inOurs {
+				conflict.Ours = &server
+			}
+			if inTheirs {
+				if parsed, err := ParseServer(theirValue); err == nil {
+					parsed.Name = ref.Name
+					conflict.Theirs = &parsed
+				}
+			}
+			conflicts = append(conflicts, conflict)
+		}
+	}
+
+	if len(conflicts) > 0 {
+		return mergeResult{}, &domain.ConflictError{Conflicts: conflicts}
+	}
+	return result, nil
+}
+
+// sameServer confronta due versioni di un server tramite la loro forma JSON
+func sameServer(a interface{}, hasA bool, b interface{}, hasB bool) bool {
+	if hasA != hasB {
+		return false
+	}
+	if !hasA {
+		return true
+	}
+	dataA, errA := json.Marshal(a)
+	dataB, errB := json.Marshal(b)
+	return errA == nil && errB == nil && bytes.Equal(dataA, dataB)
+}
+
+// unionRefs restituisce i riferimenti presenti in almeno una versione, in ordine stabile
+func unionRefs(base map[domain.ServerRef]interface{}, ours map[domain.ServerRef]domain.MCPServer, theirs map[domain.ServerRef]interface{}) []domain.ServerRef {
+	seen := make(map[domain.ServerRef]bool)
+	for ref := range base {
+		seen[ref] = true
+	}
+	for ref := range ours {
+		seen[ref] = true
+	}
+	for ref := range theirs {
+		seen[ref] = true
+	}
+
+	refs := make([]domain.ServerRef, 0, len(seen))
+	for ref := range seen {
+		refs = append(refs, ref)
+	}
+	sort.Slice(refs, func(i, j int) bool {
+		if refs[i].ProjectPath != refs[j].ProjectPath {
+			return refs[i].ProjectPath < refs[j].ProjectPath
+		}
+		return refs[i].Name < refs[j].Name
+	})
+	return refs
+}
+
+// rawServers estrae i server globali e di progetto da ~/.claude.json decodificato
+func rawServers(rawConfig map[string]interface{}) map[domain.ServerRef]interface{} {
+	result := make(map[domain.ServerRef]interface{})
+
+	if mcpServers, ok := rawConfig["mcpServers"].(map[string]interface{}); ok {
+		for name, data := range mcpServers {
+			result[domain.ServerRef{Location: domain.GlobalLocation(), Name: name}] = data
+		}
+	}
+
+	projects, _ := rawConfig["projects"].(map[string]interface{})
+	for path, projectData := range projects {
+		projectMap, _ := projectData.(map[string]interface{})
+		mcpServers, _ := projectMap["mcpServers"].(map[string]interface{})
+		loc := domain.ProjectLocation(path, domain.ScopeProject)
+		for name, data := range mcpServers {
+			result[domain.ServerRef{Location: loc, Name: name}] = data
+		}
+	}
+
+	return result
+}
+
+// configServers raccoglie i server in memoria che vivono in ~/.claude.json
+func configServers(config *domain.Configuration) map[domain.ServerRef]domain.MCPServer {
+	result := make(map[domain.ServerRef]domain.MCPServer)
+
+	for name, server := range config.GlobalServers {
+		result[domain.ServerRef{Location: domain.GlobalLocation(), Name: name}] = server
+	}
+	for path, project := range config.Projects {
+		loc := domain.ProjectLocation(path, domain.ScopeProject)
+		for name, server := range project.MCPServers {
+			result[domain.ServerRef{Location: loc, Name: name}] = server
+		}
+	}
+
+	return result
+}
+
+// setRawServer scrive (o rimuove, se value è nil) un server in ~/.claude.json decodificato,
+// creando le sezioni mancanti solo quando serve
+func setRawServer(rawConfig map[string]interface{}, ref domain.ServerRef, value interface{}) {
+	container := rawConfig
+	if !ref.IsGlobal() {
+		projects := childObject(rawConfig, "projects", value != nil)
+		if projects == nil {
+			return
+		}
+		container = childObject(projects, ref.ProjectPath, value != nil)
+		if container == nil {
+			return
+		}
+	}
+
+	mcpServers := childObject(container, "mcpServers", value != nil)
+	if mcpServers == nil {
+		return
+	}
+	if value == nil {
+		delete(mcpServers, ref.Name)
+		return
+	}
+	mcpServers[ref.Name] = value
+}
+
+// childObject restituisce l'oggetto JSON figlio di parent, creandolo se create è vero
+func childObject(parent map[string]interface{}, key string, create bool) map[string]interface{} {
+	if child, ok := parent[key].(map[string]interface{}); ok {
+		return child
+	}
+	if !create {
+		return nil
+	}
+	child := make(map[string]interface{})
+	parent[key] = child
+	return child
+}
+
+// applyExternalChanges riporta in memoria i server modificati da altri programmi
+func applyExternalChanges(config *domain.Configuration, changes map[domain.ServerRef]interface{}) {
+	for ref, value := range changes {
+		if value == nil {
+			config.RemoveServer(ref.Location, ref.Name)
+			continue
+		}
+		server, err := ParseServer(value)
+		if err != nil {
+			continue
+		}
+		server.Name = ref.Name
+		config.SetServer(ref.Location, ref.Name, server)
+	}
+}
@@ -0,0 +1,279 @@
+package infrastructure
+
+import (
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/strawberry-code/mcp-curator/internal/domain"
+)
+
+func TestMergeServers(t *testing.T) {
+	global := func(name string) domain.ServerRef {
+		return domain.ServerRef{Location: domain.GlobalLocation(), Name: name}
+	}
+	project := domain.ServerRef{Location: domain.ProjectLocation("/work/app", domain.ScopeProject), Name: "a"}
+	server := func(command string) domain.MCPServer {
+		return domain.MCPServer{Type: domain.ServerTypeStdio, Command: command}
+	}
+	raw := func(command string) interface{} {
+		return ServerToMap(server(command))
+	}
+	a, b := global("a"), global("b")
+
+	tests := []struct {
+		name   string
+		base   map[domain.ServerRef]interface{}
+		ours   map[domain.ServerRef]domain.MCPServer
+		theirs map[domain.ServerRef]interface{}
+		// wantOurs e wantTheirs: comando atteso, "" per la rimozione
+		wantOurs      map[domain.ServerRef]string
+		wantTheirs    map[domain.ServerRef]string
+		wantConflicts []domain.ServerRef
+	}{
+		{
+			name:   "nessuna modifica",
+			base:   map[domain.ServerRef]interface{}{a: raw("npx")},
+			ours:   map[domain.ServerRef]domain.MCPServer{a: server("npx")},
+			theirs: map[domain.ServerRef]interface{}{a: raw("npx")},
+		},
+		{
+			name:       "aggiunta esterna",
+			base:       map[domain.ServerRef]interface{}{a: raw("npx")},
+			ours:       map[domain.ServerRef]domain.MCPServer{a: server("npx")},
+			theirs:     map[domain.ServerRef]interface{}{a: raw("npx"), b: raw("uvx")},
+			wantTheirs: map[domain.ServerRef]string{b: "uvx"},
+		},
+		{
+			name:       "rimozione esterna",
+			base:       map[domain.ServerRef]interface{}{a: raw("npx"), b: raw("uvx")},
+			ours:       map[domain.ServerRef]domain.MCPServer{a: server("npx"), b: server("uvx")},
+			theirs:     map[domain.ServerRef]interface{}{a: raw("npx")},
+			wantTheirs: map[domain.ServerRef]string{b: ""},
+		},
+		{
+			name:       "modifica esterna di un server di progetto",
+			base:       map[domain.ServerRef]interface{}{project: raw("npx")},
+			ours:       map[domain.ServerRef]domain.MCPServer{project: server("npx")},
+			theirs:     map[domain.ServerRef]interface{}{project: raw("node")},
+			wantTheirs: map[domain.ServerRef]string{project: "node"},
+		},
+		{
+			name:       "modifica del curator e aggiunta esterna di un altro server",
+			base:       map[domain.ServerRef]interface{}{a: raw("npx")},
+			ours:       map[domain.ServerRef]domain.MCPServer{a: server("node")},
+			theirs:     map[domain.ServerRef]interface{}{a: raw("npx"), b: raw("uvx")},
+			wantOurs:   map[domain.ServerRef]string{a: "node"},
+			wantTheirs: map[domain.ServerRef]string{b: "uvx"},
+		},
+		{
+			name:     "rimozione del curator",
+			base:     map[domain.ServerRef]interface{}{a: raw("npx")},
+			ours:     map[domain.ServerRef]domain.MCPServer{},
+			theirs:   map[domain.ServerRef]interface{}{a: raw("npx")},
+			wantOurs: map[domain.ServerRef]string{a: ""},
+		},
+		{
+			name:     "stessa modifica da entrambe le parti",
+			base:     map[domain.ServerRef]interface{}{a: raw("npx")},
+			ours:     map[domain.ServerRef]domain.MCPServer{a: server("node")},
+			theirs:   map[domain.ServerRef]interface{}{a: raw("node")},
+			wantOurs: map[domain.ServerRef]string{a: "node"},
+		},
+		{
+			name:          "modifiche diverse dello stesso server",
+			base:          map[domain.ServerRef]interface{}{a: raw("npx"), b: raw("uvx")},
+			ours:          map[domain.ServerRef]domain.MCPServer{a: server("node"), b: server("uvx")},
+			theirs:        map[domain.ServerRef]interface{}{a: raw("deno"), b: raw("uvx")},
+			wantConflicts: []domain.ServerRef{a},
+		},
+		{
+			name:          "modifica del curator di un server rimosso esternamente",
+			base:          map[domain.ServerRef]interface{}{a: raw("npx")},
+			ours:          map[domain.ServerRef]domain.MCPServer{a: server("node")},
+			theirs:        map[domain.ServerRef]interface{}{},
+			wantConflicts: []domain.ServerRef{a},
+		},
+		{
+			name:          "aggiunte diverse con lo stesso nome",
+			base:          map[domain.ServerRef]interface{}{},
+			ours:          map[domain.ServerRef]domain.MCPServer{b: server("node")},
+			theirs:        map[domain.ServerRef]interface{}{b: raw("uvx")},
+			wantConflicts: []domain.ServerRef{b},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := mergeServers(tt.base, tt.ours, tt.theirs)
+			if len(tt.wantConflicts) > 0 {
+				var conflictErr *domain.ConflictError
+				if !errors.As(err, &conflictErr) {
+					t.Fatalf("atteso un conflitto, ottenuto %v", err)
+				}
+				if len(conflictErr.Conflicts) != len(tt.wantConflicts) {
+					t.Fatalf("conflitti %v, attesi %v", conflictErr.Conflicts, tt.wantConflicts)
+				}
+				for i, conflict := range conflictErr.Conflicts {
+					if conflict.Ref != tt.wantConflicts[i] {
+						t.Errorf("conflitto su %v, atteso %v", conflict.Ref, tt.wantConflicts[i])
+					}
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("errore inatteso: %v", err)
+			}
+			assertMergeChanges(t, "ours", result.ours, tt.wantOurs)
+			assertMergeChanges(t, "theirs", result.theirs, tt.wantTheirs)
+		})
+	}
+}
+
+// assertMergeChanges confronta le modifiche di un merge con i comandi attesi
+func assertMergeChanges(t *testing.T, side string, got map[domain.ServerRef]interface{}, want map[domain.ServerRef]string) {
+	t.Helper()
+	if len(got) != len(want) {
+		t.Errorf("%s: %d modifiche, attese %d: %v", side, len(got), len(want), got)
+	}
+	for ref, command := range want {
+		value, ok := got[ref]
+		switch {
+		case !ok:
+			t.Errorf("%s: manca la modifica di %v", side, ref)
+		case command == "" && value != nil:
+			t.Errorf("%s: %v dovrebbe essere rimosso, ottenuto %v", side, ref, value)
+		case command != "":
+			parsed, err := ParseServer(value)
+			if err != nil || parsed.Command != command {
+				t.Errorf("%s: %v ha %v, atteso il comando %s", side, ref, value, command)
+			}
+		}
+	}
+}
+
+func TestSaveKeepsExternalChanges(t *testing.T) {
+	path := filepath.Join(t.TempDir(), ".claude.json")
+	writeJSON(t, path, map[string]interface{}{
+		"numStartups": 3,
+		"mcpServers": map[string]interface{}{
+			"a": map[string]interface{}{"type": "stdio", "command": "npx"},
+		},
+		"projects": map[string]interface{}{
+			"/work/app": map[string]interface{}{"allowedTools": []interface{}{"Bash"}, "mcpServers": map[string]interface{}{}},
+		},
+	})
+
+	repo := NewClaudeConfigRepositoryWithPath(path)
+	config, err := repo.Load()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Un altro programma aggiorna il file dopo il Load
+	writeJSON(t, path, map[string]interface{}{
+		"numStartups": 4,
+		"mcpServers": map[string]interface{}{
+			"a": map[string]interface{}{"type": "stdio", "command": "npx"},
+			"b": map[string]interface{}{"type": "http", "url": "https://example.com/mcp"},
+		},
+		"projects": map[string]interface{}{
+			"/work/app": map[string]interface{}{"allowedTools": []interface{}{"Bash", "Read"}, "mcpServers": map[string]interface{}{}},
+		},
+	})
+
+	config.SetServer(domain.GlobalLocation(), "a", domain.MCPServer{Type: domain.ServerTypeStdio, Command: "node"})
+	if err := repo.Save(config); err != nil {
+		t.Fatalf("Save: %v", err)
+	}
+
+	var saved map[string]interface{}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := json.Unmarshal(data, &saved); err != nil {
+		t.Fatal(err)
+	}
+	if saved["numStartups"] != float64(4) {
+		t.Errorf("numStartups = %v, atteso 4", saved["numStartups"])
+	}
+	tools := saved["projects"].(map[string]interface{})["/work/app"].(map[string]interface{})["allowedTools"].([]interface{})
+	if len(tools) != 2 {
+		t.Errorf("allowedTools = %v, attesi i valori esterni", tools)
+	}
+	servers := saved["mcpServers"].(map[string]interface{})
+	if command := servers["a"].(map[string]interface{})["command"]; command != "node" {
+		t.Errorf("server a: comando %v, atteso node", command)
+	}
+	if _, ok := servers["b"]; !ok {
+		t.Error("il server b aggiunto esternamente è stato perso")
+	}
+	if _, ok := config.GetServer(domain.GlobalLocation(), "b"); !ok {
+		t.Error("il server b aggiunto esternamente non è stato riportato in memoria")
+	}
+}
+
+func writeJSON(t *testing.T, path string, value interface{}) {
+	t.Helper()
+	data, err := json.MarshalIndent(value, "", "  ")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(path, data, 0600); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestRemoveProjectsKeepsExternalChangesForMerge(t *testing.T) {
+	path := filepath.Join(t.TempDir(), ".claude.json")
+	writeJSON(t, path, map[string]interface{}{
+		"mcpServers": map[string]interface{}{},
+		"projects": map[string]interface{}{
+			"/work/app": map[string]interface{}{"mcpServers": map[string]interface{}{}},
+			"/work/old": map[string]interface{}{"mcpServers": map[string]interface{}{}},
+		},
+	})
+	repo := NewClaudeConfigRepositoryWithPath(path)
+	config, err := repo.Load()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Un server aggiunto esternamente prima della pulizia non è ancora in memoria
+	writeJSON(t, path, map[string]interface{}{
+		"mcpServers": map[string]interface{}{
+			"b": map[string]interface{}{"type": "http", "url": "https://example.com/mcp"},
+		},
+		"projects": map[string]interface{}{
+			"/work/app": map[string]interface{}{"mcpServers": map[string]interface{}{}},
+			"/work/old": map[string]interface{}{"mcpServers": map[string]interface{}{}},
+		},
+	})
+	if err := repo.RemoveProjects([]string{"/work/old"}); err != nil {
+		t.Fatalf("RemoveProjects: %v", err)
+	}
+	delete(config.Projects, "/work/old")
+
+	config.SetServer(domain.GlobalLocation(), "a", domain.MCPServer{Type: domain.ServerTypeStdio, Command: "npx"})
+	if err := repo.Save(config); err != nil {
+		t.Fatalf("Save: %v", err)
+	}
+
+	var saved map[string]interface{}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := json.Unmarshal(data, &saved); err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := saved["mcpServers"].(map[string]interface{})["b"]; !ok {
+		t.Error("il server b aggiunto esternamente è stato rimosso dal salvataggio dopo la pulizia")
+	}
+	if _, ok := config.GetServer(domain.GlobalLocation(), "b"); !ok {
+		t.Error("il server b aggiunto esternamente non è stato riportato in memoria")
+	}
+}
@@ -0,0 +1,387 @@
+package infrastructure
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/BurntSushi/toml"
+	"gopkg.in/yaml.v3"
+
+	"github.com/strawberry-code/mcp-curator/internal/domain"
+)
+
+// exportConverter converte un server nella voce del formato di destinazione.
+// ok è false se il formato non può rappresentare il server.
+type exportConverter func(server domain.MCPServer) (entry map[string]interface{}, issues domain.ValidationIssues, ok bool)
+
+// EncodeClientConfig converte i server nel file di configurazione di un altro client.
+// I campi che il formato non può rappresentare sono segnalati in Issues, i server
+// del tutto non rappresentabili in Skipped.
+func EncodeClientConfig(format domain.ExportFormat, servers map[string]domain.MCPServer) (domain.ExportResult, error) {
+	var convert exportConverter
+	rootKey := "mcpServers"
+	switch format {
+	case domain.ExportMCPServers:
+		convert = func(server domain.MCPServer) (map[string]interface{}, domain.ValidationIssues, bool) {
+			return ServerToMap(server), nil, true
+		}
+	case domain.ExportClaudeDesktop:
+		convert = exportClaudeDesktop
+	case domain.ExportCursor:
+		convert = exportCursor
+	case domain.ExportVSCode:
+		convert, rootKey = exportVSCode, "servers"
+	case domain.ExportCodex:
+		convert, rootKey = exportCodex, "mcp_servers"
+	case domain.ExportGoose:
+		convert, rootKey = exportGoose, "extensions"
+	default:
+		return domain.ExportResult{}, fmt.Errorf("formato di esportazione sconosciuto: %s", format)
+	}
+
+	result := domain.ExportResult{Format: format, Issues: make(map[string]domain.ValidationIssues)}
+	entries := make(map[string]interface{}, len(servers))
+	names := make([]string, 0, len(servers))
+	for name := range servers {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		server := servers[name]
+		entry, issues, ok := convert(server)
+		if format != domain.ExportMCPServers {
+			issues = append(append(unsupportedRawFields(server), nonStringValues(server)...), issues...)
+		}
+		if len(issues) > 0 {
+			result.Issues[name] = issues
+		}
+		if !ok {
+			result.Skipped = append(result.Skipped, name)
+			continue
+		}
+		if format == domain.ExportGoose {
+			entry["name"] = name
+		}
+		entries[name] = entry
+	}
+
+	data, err := encodeExport(format, map[string]interface{}{rootKey: entries})
+	if err != nil {
+		return domain.ExportResult{}, err
+	}
+	result.Data = data
+	return result, nil
+}
+
+// encodeExport serializza il file nel formato del client (JSON, TOML o YAML)
+func encodeExport(format domain.ExportFormat, root map[string]interface{}) ([]byte, error) {
+	var buf bytes.Buffer
+	switch format {
+	case domain.ExportCodex:
+		if err := toml.NewEncoder(&buf).Encode(root); err != nil {
+			return nil, fmt.Errorf("impossibile generare il TOML: %w", err)
+		}
+	case domain.ExportGoose:
+		encoder := yaml.NewEncoder(&buf)
+		encoder.SetIndent(2)
+		if err := encoder.Encode(root); err != nil {
+			return nil, fmt.Errorf("impossibile generare lo YAML: %w", err)
+		}
+	default:
+		data, err := json.MarshalIndent(root, "", "  ")
+		if err != nil {
+			return nil, err
+		}
+		buf.Write(data)
+		buf.WriteByte('\n')
+	}
+	return buf.Bytes(), nil
+}
+
+// exportType restituisce il trasporto effettivo del server (stdio se ha un comando)
+func exportType(server domain.MCPServer) domain.ServerType {
+	switch {
+	case server.Type != "":
+		return server.Type
+	case server.Command != "":
+		return domain.ServerTypeStdio
+	}
+	return domain.ServerTypeHTTP
+}
+
+// exportClaudeDesktop converte per Claude Desktop, che nel file accetta solo server stdio
+// e non espande le variabili
+func exportClaudeDesktop(server domain.MCPServer) (map[string]interface{}, domain.ValidationIssues, bool) {
+	if kind := exportType(server); kind != domain.ServerTypeStdio {
+		return nil, domain.ValidationIssues{unsupportedType(kind)}, false
+	}
+	entry := stdioEntry(server, "command", "args", "env")
+	issues := unsupportedFields(server, domain.FieldHeaders, domain.FieldTimeout)
+	return entry, append(issues, unexpandedVariables(server)...), true
+}
+
+// exportCursor converte per Cursor, che riconosce il trasporto dai campi e legge
+// le variabili d'ambiente come ${env:NOME}
+func exportCursor(server domain.MCPServer) (map[string]interface{}, domain.ValidationIssues, bool) {
+	server, issues := envVariableSyntax(server)
+	var entry map[string]interface{}
+	if exportType(server) == domain.ServerTypeStdio {
+		entry = stdioEntry(server, "command", "args", "env")
+		issues = append(issues, unsupportedFields(server, domain.FieldHeaders)...)
+	} else {
+		entry = remoteEntry(server, "url", "headers")
+	}
+	return entry, append(issues, unsupportedFields(server, domain.FieldTimeout)...), true
+}
+
+// exportVSCode converte per .vscode/mcp.json: tipo esplicito e variabili ${env:NOME}
+func exportVSCode(server domain.MCPServer) (map[string]interface{}, domain.ValidationIssues, bool) {
+	server, issues := envVariableSyntax(server)
+	kind := exportType(server)
+	var entry map[string]interface{}
+	if kind == domain.ServerTypeStdio {
+		entry = stdioEntry(server, "command", "args", "env")
+		issues = append(issues, unsupportedFields(server, domain.FieldHeaders)...)
+	} else {
+		entry = remoteEntry(server, "url", "headers")
+	}
+	entry["type"] = string(kind)
+	return entry, append(issues, unsupportedFields(server, domain.FieldTimeout)...), true
+}
+
+// exportCodex converte per Codex CLI: stdio o HTTP streamable, con gli header che
+// leggono variabili d'ambiente convertiti in bearer_token_env_var ed env_http_headers
+func exportCodex(server domain.MCPServer) (map[string]interface{}, domain.ValidationIssues, bool) {
+	kind := exportType(server)
+	var entry map[string]interface{}
+	var issues domain.ValidationIssues
+	switch kind {
+	case domain.ServerTypeStdio:
+		entry = stdioEntry(server, "command", "args", "env")
+		issues = unsupportedFields(server, domain.FieldHeaders)
+	case domain.ServerTypeHTTP:
+		entry = map[string]interface{}{"url": server.URL}
+		literal := make(map[string]string)
+		fromEnv := make(map[string]string)
+		for key, value := range server.Headers {
+			if name, ok := strings.CutPrefix(value, "Bearer "); ok && strings.EqualFold(key, "Authorization") && wholeVariable(name) != "" {
+				entry["bearer_token_env_var"] = wholeVariable(name)
+			} else if name := wholeVariable(value); name != "" {
+				fromEnv[key] = name
+			} else {
+				literal[key] = value
+			}
+		}
+		if len(literal) > 0 {
+			entry["http_headers"] = literal
+		}
+		if len(fromEnv) > 0 {
+			entry["env_http_headers"] = fromEnv
+		}
+		server.Headers = literal
+	default:
+		return nil, domain.ValidationIssues{unsupportedType(kind)}, false
+	}
+	if server.Timeout > 0 {
+		entry["startup_timeout_sec"] = float64(server.Timeout) / 1000
+	}
+	return entry, append(issues, unexpandedVariables(server)...), true
+}
+
+// exportGoose converte in un'estensione di Goose: timeout in secondi, le voci env
+// del tipo NOME=${NOME} diventano env_keys (lette dall'ambiente o dal portachiavi)
+func exportGoose(server domain.MCPServer) (map[string]interface{}, domain.ValidationIssues, bool) {
+	entry := map[string]interface{}{"enabled": true}
+	var issues domain.ValidationIssues
+	switch kind := exportType(server); kind {
+	case domain.ServerTypeStdio:
+		entry["type"] = "stdio"
+		entry["cmd"] = server.Command
+		entry["args"] = nonNilArgs(server.Args)
+		envs := make(map[string]string)
+		var keys []string
+		for key, value := range server.Env {
+			if wholeVariable(value) == key {
+				keys = append(keys, key)
+			} else {
+				envs[key] = value
+			}
+		}
+		sort.Strings(keys)
+		entry["envs"] = envs
+		if len(keys) > 0 {
+			entry["env_keys"] = keys
+		}
+		server.Env = envs
+		issues = unsupportedFields(server, domain.FieldHeaders)
+	case domain.ServerTypeHTTP:
+		entry["type"] = "streamable_http"
+		entry["uri"] = server.URL
+		if len(server.Headers) > 0 {
+			entry["headers"] = server.Headers
+		}
+	case domain.ServerTypeSSE:
+		entry["type"] = "sse"
+		entry["uri"] = server.URL
+		issues = unsupportedFields(server, domain.FieldHeaders)
+	default:
+		return nil, domain.ValidationIssues{unsupportedType(kind)}, false
+	}
+	if server.Timeout > 0 {
+		// Goose usa i secondi: si arrotonda per eccesso per non accorciare il timeout
+		entry["timeout"] = (server.Timeout + 999) / 1000
+	}
+	return entry, append(issues, unexpandedVariables(server)...), true
+}
+
+// stdioEntry crea la voce di un server stdio con i nomi dei campi del formato
+func stdioEntry(server domain.MCPServer, commandKey, argsKey, envKey string) map[string]interface{} {
+	entry := map[string]interface{}{commandKey: server.Command}
+	if len(server.Args) > 0 {
+		entry[argsKey] = server.Args
+	}
+	if len(server.Env) > 0 {
+		entry[envKey] = server.Env
+	}
+	return entry
+}
+
+// remoteEntry crea la voce di un server remoto con i nomi dei campi del formato
+func remoteEntry(server domain.MCPServer, urlKey, headersKey string) map[string]interface{} {
+	entry := map[string]interface{}{urlKey: server.URL}
+	if len(server.Headers) > 0 {
+		entry[headersKey] = server.Headers
+	}
+	return entry
+}
+
+// nonNilArgs restituisce args, vuoto invece di nil (Goose si aspetta sempre una lista)
+func nonNilArgs(args []string) []string {
+	if args == nil {
+		return []string{}
+	}
+	return args
+}
+
+// envVariableSyntax riscrive ${NOME} come ${env:NOME} (sintassi di Cursor e VS Code).
+// Il default di ${NOME:-default} non è rappresentabile e viene segnalato.
+func envVariableSyntax(server domain.MCPServer) (domain.MCPServer, domain.ValidationIssues) {
+	var issues domain.ValidationIssues
+	for _, ref := range server.VariableRefs() {
+		if ref.HasDefault {
+			issues = append(issues, domain.ValidationIssue{Severity: domain.SeverityWarning, Code: "export_variable_default", Detail: ref.Text})
+		}
+	}
+	rewrite := func(s string) string {
+		return domain.ReplaceVariables(s, func(ref domain.VariableRef) string {
+			return "${env:" + ref.Name + "}"
+		})
+	}
+	converted := server.Clone()
+	converted.Command = rewrite(converted.Command)
+	converted.URL = rewrite(converted.URL)
+	for i, arg := range converted.Args {
+		converted.Args[i] = rewrite(arg)
+	}
+	for key, value := range converted.Env {
+		converted.Env[key] = rewrite(value)
+	}
+	for key, value := range converted.Headers {
+		converted.Headers[key] = rewrite(value)
+	}
+	return converted, issues
+}
+
+// unexpandedVariables segnala i riferimenti ${...} che il formato di destinazione
+// scriverebbe letteralmente, senza espanderli
+func unexpandedVariables(server domain.MCPServer) domain.ValidationIssues {
+	var issues domain.ValidationIssues
+	for _, ref := range server.VariableRefs() {
+		issues = append(issues, domain.ValidationIssue{Severity: domain.SeverityWarning, Code: "export_variable", Detail: ref.Text})
+	}
+	return issues
+}
+
+// wholeVariable restituisce NOME se value è esattamente ${NOME} ("" altrimenti)
+func wholeVariable(value string) string {
+	var name string
+	rest := domain.ReplaceVariables(value, func(ref domain.VariableRef) string {
+		if !ref.HasDefault && ref.Text == value {
+			name = ref.Name
+		}
+		return ""
+	})
+	if rest != "" {
+		return ""
+	}
+	return name
+}
+
+// unsupportedFields segnala i campi valorizzati che il formato non può rappresentare
+func unsupportedFields(server domain.MCPServer, fields ...string) domain.ValidationIssues {
+	var issues domain.ValidationIssues
+	for _, field := range fields {
+		present := false
+		switch field {
+		case domain.FieldHeaders:
+			present = len(server.Headers) > 0
+		case domain.FieldTimeout:
+			present = server.Timeout > 0
+		}
+		if present {
+			issues = append(issues, domain.ValidationIssue{Field: field, Severity: domain.SeverityWarning, Code: "export_field_unsupported", Detail: field})
+		}
+	}
+	return issues
+}
+
+// unsupportedRawFields segnala i campi sconosciuti del server (aggiunti da Claude Code
+// o da altri tool), che esistono solo nel formato di Claude Code
+func unsupportedRawFields(server domain.MCPServer) domain.ValidationIssues {
+	keys := make([]string, 0, len(server.Raw))
+	for key := range server.Raw {
+		if !claudeServerKeys[key] {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+	issues := make(domain.ValidationIssues, 0, len(keys))
+	for _, key := range keys {
+		issues = append(issues, domain.ValidationIssue{Severity: domain.SeverityWarning, Code: "export_field_unsupported", Detail: key})
+	}
+	return issues
+}
+
+// nonStringValues segnala i valori env e headers non testuali (es. "N": 5): restano solo
+// in Raw e i formati di destinazione, che scrivono le mappe di stringhe, li perderebbero
+func nonStringValues(server domain.MCPServer) domain.ValidationIssues {
+	var issues domain.ValidationIssues
+	for _, field := range []string{domain.FieldEnv, domain.FieldHeaders} {
+		values, _ := server.Raw[field].(map[string]interface{})
+		keys := make([]string, 0, len(values))
+		for key, value := range values {
+			if _, ok := value.(string); !ok {
+				keys = append(keys, key)
+			}
+		}
+		sort.Strings(keys)
+		for _, key := range keys {
+			issues = append(issues, domain.ValidationIssue{Field: field, Severity: domain.SeverityWarning, Code: "export_field_unsupported", Detail: field + "." + key})
+		}
+	}
+	return issues
+}
+
+// unsupportedType segnala un trasporto che il formato non supporta (server non esportato)
+func unsupportedType(kind domain.ServerType) domain.ValidationIssue {
+	return domain.ValidationIssue{Field: domain.FieldType, Severity: domain.SeverityError, Code: "export_type_unsupported", Detail: string(kind)}
+}
+
+// WriteExportFile scrive un file esportato; se non esiste viene creato leggibile solo
+// dall'utente, perché può contenere segreti
+func WriteExportFile(path string, data []byte) error {
+	return writeFileAtomic(path, data, 0o600)
+}
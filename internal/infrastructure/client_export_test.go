@@ -0,0 +1,43 @@
+package infrastructure
+
+import (
+	"testing"
+
+	"github.com/strawberry-code/mcp-curator/internal/domain"
+)
+
+func TestEncodeClientConfigReportsNonStringValues(t *testing.T) {
+	server, err := ParseServer(map[string]interface{}{
+		"command": "npx",
+		"env":     map[string]interface{}{"TOKEN": "abc", "N": float64(5)},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	servers := map[string]domain.MCPServer{"demo": server}
+
+	for _, format := range []domain.ExportFormat{domain.ExportClaudeDesktop, domain.ExportCursor, domain.ExportCodex, domain.ExportGoose} {
+		result, err := EncodeClientConfig(format, servers)
+		if err != nil {
+			t.Fatalf("%s: %v", format, err)
+		}
+		found := false
+		for _, issue := range result.Issues["demo"] {
+			if issue.Code == "export_field_unsupported" && issue.Detail == "env.N" {
+				found = true
+			}
+		}
+		if !found {
+			t.Errorf("%s: valore non testuale env.N non segnalato: %v", format, result.Issues["demo"])
+		}
+	}
+
+	// Il formato di Claude Code conserva il valore originale e non segnala nulla
+	result, err := EncodeClientConfig(domain.ExportMCPServers, servers)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(result.Issues["demo"]) > 0 {
+		t.Errorf("problemi inattesi: %v", result.Issues["demo"])
+	}
+}
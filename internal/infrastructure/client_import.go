@@ -0,0 +1,477 @@
+package infrastructure
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"runtime"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/BurntSushi/toml"
+	"gopkg.in/yaml.v3"
+
+	"github.com/strawberry-code/mcp-curator/internal/domain"
+)
+
+// claudeServerKeys sono i campi di un server che Claude Code riconosce
+var claudeServerKeys = map[string]bool{
+	"type": true, "command": true, "args": true, "url": true, "headers": true, "env": true, "timeout": true,
+}
+
+// clientMetadataKeys sono i campi descrittivi degli altri client, ignorati senza avvisi
+var clientMetadataKeys = map[string]bool{
+	"name": true, "description": true, "display_name": true, "bundled": true, "available_tools": true,
+	"enabled": true, "disabled": true,
+}
+
+// vscodeEnvVariable riconosce ${env:NOME} di VS Code, equivalente a ${NOME} di Claude Code
+var vscodeEnvVariable = regexp.MustCompile(`\$\{env:([A-Za-z_][A-Za-z0-9_]*)\}`)
+
+// clientVariable riconosce le variabili degli altri client senza equivalente
+// (es. ${input:token}, ${workspaceFolder}, ${userHome})
+var clientVariable = regexp.MustCompile(`\$\{(input:[^}]+|workspaceFolder[^}]*|userHome|pathSeparator|cwd)\}`)
+
+// clientConverter converte una voce del file di un altro client nel formato di Claude Code;
+// false per le voci da saltare (es. estensioni di Goose che non sono server MCP)
+type clientConverter func(entry map[string]interface{}) (map[string]interface{}, domain.ValidationIssues, bool)
+
+// ClientConfigPaths restituisce i file di configurazione utente degli altri client
+// presenti su questa macchina
+func ClientConfigPaths() []domain.ImportSource {
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return nil
+	}
+	configDir, err := os.UserConfigDir()
+	if err != nil {
+		configDir = filepath.Join(home, ".config")
+	}
+	codexHome := os.Getenv("CODEX_HOME")
+	if codexHome == "" {
+		codexHome = filepath.Join(home, ".codex")
+	}
+	gooseConfig := filepath.Join(home, ".config", "goose", "config.yaml")
+	if runtime.GOOS == "windows" {
+		gooseConfig = filepath.Join(configDir, "Block", "goose", "config", "config.yaml")
+	}
+
+	candidates := []domain.ImportSource{
+		{Format: domain.ImportClaudeDesktop, Path: filepath.Join(configDir, "Claude", "claude_desktop_config.json")},
+		{Format: domain.ImportCursor, Path: filepath.Join(home, ".cursor", "mcp.json")},
+		{Format: domain.ImportVSCode, Path: filepath.Join(configDir, "Code", "User", "mcp.json")},
+		{Format: domain.ImportVSCode, Path: filepath.Join(configDir, "Code", "User", "settings.json")},
+		{Format: domain.ImportCodex, Path: filepath.Join(codexHome, "config.toml")},
+		{Format: domain.ImportGoose, Path: gooseConfig},
+	}
+	return existingSources(candidates)
+}
+
+// ProjectClientConfigPaths restituisce i file di configurazione degli altri client
+// presenti nella directory di un progetto
+func ProjectClientConfigPaths(projectPath string) []domain.ImportSource {
+	return existingSources([]domain.ImportSource{
+		{Format: domain.ImportCursor, Path: filepath.Join(projectPath, ".cursor", "mcp.json"), ProjectPath: projectPath},
+		{Format: domain.ImportVSCode, Path: filepath.Join(projectPath, ".vscode", "mcp.json"), ProjectPath: projectPath},
+	})
+}
+
+// existingSources filtra i file che esistono
+func existingSources(candidates []domain.ImportSource) []domain.ImportSource {
+	var sources []domain.ImportSource
+	for _, c := range candidates {
+		if info, err := os.Stat(c.Path); err == nil && !info.IsDir() {
+			sources = append(sources, c)
+		}
+	}
+	return sources
+}
+
+// DetectImportFormat riconosce il formato di un file dal nome e dalla directory
+// (stringa vuota se non riconosciuto)
+func DetectImportFormat(path string) domain.ImportFormat {
+	base := strings.ToLower(filepath.Base(path))
+	dir := strings.ToLower(filepath.Base(filepath.Dir(path)))
+	switch {
+	case base == "claude_desktop_config.json":
+		return domain.ImportClaudeDesktop
+	case strings.HasSuffix(base, ".toml"):
+		return domain.ImportCodex
+	case strings.HasSuffix(base, ".yaml"), strings.HasSuffix(base, ".yml"):
+		return domain.ImportGoose
+	case dir == ".cursor":
+		return domain.ImportCursor
+	case dir == ".vscode", dir == "user" && strings.HasSuffix(base, ".json"):
+		return domain.ImportVSCode
+	}
+	return ""
+}
+
+// ReadClientConfig legge i server MCP dal file di configurazione di un altro client
+// e li converte nel formato di Claude Code, ordinati per nome. Le voci che non si
+// possono convertire restano nell'elenco con un problema di severità errore.
+func ReadClientConfig(format domain.ImportFormat, path string) ([]domain.ImportedServer, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("impossibile leggere %s: %w", path, err)
+	}
+
+	var entries map[string]interface{}
+	var convert clientConverter
+	switch format {
+	case domain.ImportClaudeDesktop, domain.ImportCursor:
+		root, err := decodeClientJSON(data, false)
+		if err != nil {
+			return nil, err
+		}
+		entries, _ = root["mcpServers"].(map[string]interface{})
+		convert = convertStandardServer
+	case domain.ImportVSCode:
+		root, err := decodeClientJSON(data, true)
+		if err != nil {
+			return nil, err
+		}
+		entries, _ = root["servers"].(map[string]interface{})
+		if mcp, ok := root["mcp"].(map[string]interface{}); ok && entries == nil {
+			entries, _ = mcp["servers"].(map[string]interface{})
+		}
+		convert = convertVSCodeServer
+	case domain.ImportCodex:
+		var root map[string]interface{}
+		if _, err := toml.Decode(string(data), &root); err != nil {
+			return nil, fmt.Errorf("TOML non valido: %w", err)
+		}
+		entries, _ = root["mcp_servers"].(map[string]interface{})
+		convert = convertCodexServer
+	case domain.ImportGoose:
+		var root map[string]interface{}
+		if err := yaml.Unmarshal(data, &root); err != nil {
+			return nil, fmt.Errorf("YAML non valido: %w", err)
+		}
+		entries, _ = root["extensions"].(map[string]interface{})
+		convert = convertGooseServer
+	default:
+		return nil, fmt.Errorf("formato di importazione sconosciuto: %s", format)
+	}
+
+	names := make([]string, 0, len(entries))
+	for name := range entries {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	used := make(map[string]bool)
+	var servers []domain.ImportedServer
+	for _, name := range names {
+		server, issues, ok := convertEntry(entries[name], convert)
+		if !ok {
+			continue
+		}
+
+		target := name
+		if domain.ValidateServerName(name).HasErrors() {
+			target = domain.SanitizeServerName(name)
+			issues = append(issues, domain.ValidationIssue{Field: domain.FieldName, Severity: domain.SeverityWarning, Code: "import_renamed", Detail: name})
+		}
+		for base, i := target, 2; used[target]; i++ {
+			target = base + "-" + strconv.Itoa(i)
+		}
+		used[target] = true
+		server.Name = target
+		servers = append(servers, domain.ImportedServer{Name: target, Server: server, Issues: issues})
+	}
+	return servers, nil
+}
+
+// convertEntry converte una voce del file di origine; false per le voci da saltare.
+// Una voce non valida non blocca le altre: resta nell'elenco, vuota e con l'errore.
+func convertEntry(value interface{}, convert clientConverter) (domain.MCPServer, domain.ValidationIssues, bool) {
+	invalid := func(err error) domain.ValidationIssues {
+		return domain.ValidationIssues{{Severity: domain.SeverityError, Code: "import_invalid", Detail: err.Error()}}
+	}
+	entry, ok := value.(map[string]interface{})
+	if !ok {
+		return domain.MCPServer{}, invalid(fmt.Errorf("formato server non valido")), true
+	}
+	converted, issues, ok := convert(entry)
+	if !ok {
+		return domain.MCPServer{}, nil, false
+	}
+	server, err := ParseServer(converted)
+	if err != nil {
+		return domain.MCPServer{}, append(issues, invalid(err)...), true
+	}
+	return server, issues, true
+}
+
+// decodeClientJSON decodifica un file JSON; con comments accetta anche i commenti
+// e le virgole finali del JSONC usato da VS Code
+func decodeClientJSON(data []byte, comments bool) (map[string]interface{}, error) {
+	if comments {
+		data = stripJSONC(data)
+	}
+	var root map[string]interface{}
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+	if err := decoder.Decode(&root); err != nil {
+		return nil, fmt.Errorf("JSON non valido: %w", err)
+	}
+	return root, nil
+}
+
+// stripJSONC rimuove commenti // e /* */ e virgole finali fuori dalle stringhe
+func stripJSONC(data []byte) []byte {
+	var out bytes.Buffer
+	inString := false
+	for i := 0; i < len(data); i++ {
+		c := data[i]
+		switch {
+		case inString:
+			out.WriteByte(c)
+			if c == '\\' && i+1 < len(data) {
+				i++
+				out.WriteByte(data[i])
+			} else if c == '"' {
+				inString = false
+			}
+		case c == '"':
+			inString = true
+			out.WriteByte(c)
+		case c == '/' && i+1 < len(data) && data[i+1] == '/':
+			for i < len(data) && data[i] != '\n' {
+				i++
+			}
+			if i < len(data) {
+				out.WriteByte('\n')
+			}
+		case c == '/' && i+1 < len(data) && data[i+1] == '*':
+			end := bytes.Index(data[i+2:], []byte("*/"))
+			if end < 0 {
+				i = len(data)
+			} else {
+				i += end + 3
+			}
+		case c == ',':
+			// Una virgola seguita solo da spazi e da } o ] è una virgola finale
+			j := i + 1
+			for j < len(data) && strings.ContainsRune(" \t\r\n", rune(data[j])) {
+				j++
+			}
+			if j < len(data) && (data[j] == '}' || data[j] == ']') {
+				continue
+			}
+			out.WriteByte(c)
+		default:
+			out.WriteByte(c)
+		}
+	}
+	return out.Bytes()
+}
+
+// convertStandardServer converte un server di Claude Desktop o Cursor, che usano già
+// il formato mcpServers: restano solo i campi riconosciuti da Claude Code
+func convertStandardServer(entry map[string]interface{}) (map[string]interface{}, domain.ValidationIssues, bool) {
+	result := make(map[string]interface{})
+	var issues domain.ValidationIssues
+	for key, value := range entry {
+		switch {
+		case claudeServerKeys[key]:
+			result[key] = value
+		case !clientMetadataKeys[key]:
+			issues = append(issues, ignoredField(key))
+		}
+	}
+	// Cursor deduce il trasporto dall'URL; Claude Code vuole il tipo esplicito
+	if _, hasType := result["type"]; !hasType && result["url"] != nil && result["command"] == nil {
+		result["type"] = string(domain.ServerTypeHTTP)
+	}
+	return result, append(issues, disabledIssue(entry)...), true
+}
+
+// convertVSCodeServer converte un server di .vscode/mcp.json: ${env:NOME} diventa ${NOME},
+// le altre variabili di VS Code (${input:...}, ${workspaceFolder}) vengono segnalate
+func convertVSCodeServer(entry map[string]interface{}) (map[string]interface{}, domain.ValidationIssues, bool) {
+	result, issues, _ := convertStandardServer(entry)
+	replaced := replaceStrings(result, func(s string) string {
+		return vscodeEnvVariable.ReplaceAllString(s, "$${$1}")
+	}).(map[string]interface{})
+
+	seen := make(map[string]bool)
+	replaceStrings(replaced, func(s string) string {
+		for _, variable := range clientVariable.FindAllString(s, -1) {
+			if !seen[variable] {
+				seen[variable] = true
+				issues = append(issues, domain.ValidationIssue{Severity: domain.SeverityWarning, Code: "import_variable", Detail: variable})
+			}
+		}
+		return s
+	})
+	return replaced, issues, true
+}
+
+// convertCodexServer converte una tabella [mcp_servers.NOME] di ~/.codex/config.toml
+func convertCodexServer(entry map[string]interface{}) (map[string]interface{}, domain.ValidationIssues, bool) {
+	result := make(map[string]interface{})
+	var issues domain.ValidationIssues
+	headers := make(map[string]interface{})
+	for key, value := range entry {
+		switch key {
+		case "command", "args", "env", "url":
+			result[key] = value
+		case "http_headers":
+			if values, ok := value.(map[string]interface{}); ok {
+				for k, v := range values {
+					headers[k] = v
+				}
+			}
+		case "env_http_headers":
+			if values, ok := value.(map[string]interface{}); ok {
+				for k, v := range values {
+					if name, ok := v.(string); ok {
+						headers[k] = "${" + name + "}"
+					}
+				}
+			}
+		case "bearer_token_env_var":
+			if name, ok := value.(string); ok {
+				headers["Authorization"] = "Bearer ${" + name + "}"
+			}
+		case "startup_timeout_sec":
+			if seconds, ok := number(value); ok {
+				result["timeout"] = seconds * 1000
+			}
+		case "startup_timeout_ms":
+			if ms, ok := number(value); ok {
+				result["timeout"] = ms
+			}
+		default:
+			if !clientMetadataKeys[key] {
+				issues = append(issues, ignoredField(key))
+			}
+		}
+	}
+	if len(headers) > 0 {
+		result["headers"] = headers
+	}
+	if result["url"] != nil {
+		result["type"] = string(domain.ServerTypeHTTP)
+	}
+	return result, append(issues, disabledIssue(entry)...), true
+}
+
+// convertGooseServer converte un'estensione di Goose. Le estensioni integrate
+// (builtin, platform, frontend) non sono server MCP e vengono saltate.
+func convertGooseServer(entry map[string]interface{}) (map[string]interface{}, domain.ValidationIssues, bool) {
+	result := make(map[string]interface{})
+	var issues domain.ValidationIssues
+	kind, _ := entry["type"].(string)
+	switch kind {
+	case "stdio":
+		result["type"] = string(domain.ServerTypeStdio)
+	case "sse":
+		result["type"] = string(domain.ServerTypeSSE)
+	case "streamable_http":
+		result["type"] = string(domain.ServerTypeHTTP)
+	default:
+		return nil, nil, false
+	}
+
+	env := make(map[string]interface{})
+	for key, value := range entry {
+		switch key {
+		case "type":
+		case "cmd":
+			result["command"] = value
+		case "args":
+			result["args"] = value
+		case "uri":
+			result["url"] = value
+		case "headers":
+			result["headers"] = value
+		case "envs":
+			if values, ok := value.(map[string]interface{}); ok {
+				for k, v := range values {
+					env[k] = v
+				}
+			}
+		case "env_keys":
+			// I valori sono nel portachiavi di Goose: Claude Code li legge dall'ambiente
+			if keys, ok := value.([]interface{}); ok {
+				for _, k := range keys {
+					if name, ok := k.(string); ok {
+						env[name] = "${" + name + "}"
+					}
+				}
+			}
+		case "timeout":
+			if seconds, ok := number(value); ok {
+				result["timeout"] = seconds * 1000
+			}
+		default:
+			if !clientMetadataKeys[key] {
+				issues = append(issues, ignoredField(key))
+			}
+		}
+	}
+	if len(env) > 0 {
+		result["env"] = env
+	}
+	return result, append(issues, disabledIssue(entry)...), true
+}
+
+// replaceStrings applica replace a tutte le stringhe di un valore JSON, anche annidate
+func replaceStrings(value interface{}, replace func(string) string) interface{} {
+	switch v := value.(type) {
+	case string:
+		return replace(v)
+	case map[string]interface{}:
+		result := make(map[string]interface{}, len(v))
+		for key, item := range v {
+			result[replace(key)] = replaceStrings(item, replace)
+		}
+		return result
+	case []interface{}:
+		result := make([]interface{}, len(v))
+		for i, item := range v {
+			result[i] = replaceStrings(item, replace)
+		}
+		return result
+	}
+	return value
+}
+
+// number converte i numeri letti da JSON, TOML e YAML
+func number(value interface{}) (float64, bool) {
+	switch n := value.(type) {
+	case int:
+		return float64(n), true
+	case int64:
+		return float64(n), true
+	case float64:
+		return n, true
+	case json.Number:
+		f, err := n.Float64()
+		return f, err == nil
+	}
+	return 0, false
+}
+
+// ignoredField segnala un campo del client di origine che Claude Code non supporta
+func ignoredField(key string) domain.ValidationIssue {
+	return domain.ValidationIssue{Severity: domain.SeverityWarning, Code: "import_field_ignored", Detail: key}
+}
+
+// disabledIssue segnala un server disabilitato nel client di origine
+// (enabled: false in Codex e Goose, disabled: true in Cursor e VS Code)
+func disabledIssue(entry map[string]interface{}) domain.ValidationIssues {
+	enabled, hasEnabled := entry["enabled"].(bool)
+	disabled, _ := entry["disabled"].(bool)
+	if (hasEnabled && !enabled) || disabled {
+		return domain.ValidationIssues{{Severity: domain.SeverityWarning, Code: "import_disabled"}}
+	}
+	return nil
+}
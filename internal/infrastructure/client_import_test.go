@@ -0,0 +1,187 @@
+package infrastructure
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"reflect"
+	"slices"
+	"testing"
+
+	"github.com/strawberry-code/mcp-curator/internal/domain"
+)
+
+func TestStripJSONC(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		want  interface{}
+	}{
+		{
+			name:  "commento di riga",
+			input: "{\n  // server\n  \"a\": 1\n}",
+			want:  map[string]interface{}{"a": float64(1)},
+		},
+		{
+			name: "commento di blocco",
+			input: `{ /* server
+			di prova */ "a": 1 }`,
+			want: map[string]interface{}{"a": float64(1)},
+		},
+		{
+			name:  "virgole finali",
+			input: "{\"a\": [1, 2,\n], \"b\": {\"c\": 3, },\n}",
+			want:  map[string]interface{}{"a": []interface{}{float64(1), float64(2)}, "b": map[string]interface{}{"c": float64(3)}},
+		},
+		{
+			name:  "commenti e virgole dentro le stringhe",
+			input: `{"url": "https://example.com/mcp", "note": "a /* b */ c,}"}`,
+			want:  map[string]interface{}{"url": "https://example.com/mcp", "note": "a /* b */ c,}"},
+		},
+		{
+			name:  "virgolette escapate",
+			input: `{"a": "x\" // y", "b": "z\\"} // fine`,
+			want:  map[string]interface{}{"a": `x" // y`, "b": `z\`},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got interface{}
+			stripped := stripJSONC([]byte(tt.input))
+			if err := json.Unmarshal(stripped, &got); err != nil {
+				t.Fatalf("JSON non valido dopo stripJSONC: %v\n%s", err, stripped)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ottenuto %v, atteso %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestClientConverters(t *testing.T) {
+	tests := []struct {
+		name       string
+		convert    clientConverter
+		entry      map[string]interface{}
+		want       map[string]interface{}
+		wantIssues []string
+		skipped    bool
+	}{
+		{
+			name:    "VS Code: variabili d'ambiente",
+			convert: convertVSCodeServer,
+			entry: map[string]interface{}{
+				"type": "stdio", "command": "npx",
+				"env": map[string]interface{}{"TOKEN": "${env:GITHUB_TOKEN}", "ROOT": "${workspaceFolder}"},
+			},
+			want: map[string]interface{}{
+				"type": "stdio", "command": "npx",
+				"env": map[string]interface{}{"TOKEN": "${GITHUB_TOKEN}", "ROOT": "${workspaceFolder}"},
+			},
+			wantIssues: []string{"import_variable"},
+		},
+		{
+			name:       "VS Code: campi non supportati e server disabilitato",
+			convert:    convertVSCodeServer,
+			entry:      map[string]interface{}{"url": "https://example.com/mcp", "gallery": true, "disabled": true},
+			want:       map[string]interface{}{"type": "http", "url": "https://example.com/mcp"},
+			wantIssues: []string{"import_field_ignored", "import_disabled"},
+		},
+		{
+			name:    "Codex: stdio con timeout in secondi",
+			convert: convertCodexServer,
+			entry: map[string]interface{}{
+				"command": "npx", "args": []interface{}{"-y", "server"}, "startup_timeout_sec": int64(20),
+			},
+			want: map[string]interface{}{"command": "npx", "args": []interface{}{"-y", "server"}, "timeout": float64(20000)},
+		},
+		{
+			name:    "Codex: headers da variabili d'ambiente",
+			convert: convertCodexServer,
+			entry: map[string]interface{}{
+				"url":                  "https://example.com/mcp",
+				"bearer_token_env_var": "API_TOKEN",
+				"env_http_headers":     map[string]interface{}{"X-Team": "TEAM_ID"},
+				"enabled":              false,
+			},
+			want: map[string]interface{}{
+				"type": "http", "url": "https://example.com/mcp",
+				"headers": map[string]interface{}{"Authorization": "Bearer ${API_TOKEN}", "X-Team": "${TEAM_ID}"},
+			},
+			wantIssues: []string{"import_disabled"},
+		},
+		{
+			name:    "Goose: estensione stdio",
+			convert: convertGooseServer,
+			entry: map[string]interface{}{
+				"type": "stdio", "name": "GitHub", "cmd": "npx", "args": []interface{}{"server"},
+				"envs": map[string]interface{}{"MODE": "ro"}, "env_keys": []interface{}{"GITHUB_TOKEN"},
+				"timeout": 300, "extra": "x",
+			},
+			want: map[string]interface{}{
+				"type": "stdio", "command": "npx", "args": []interface{}{"server"},
+				"env":     map[string]interface{}{"MODE": "ro", "GITHUB_TOKEN": "${GITHUB_TOKEN}"},
+				"timeout": float64(300000),
+			},
+			wantIssues: []string{"import_field_ignored"},
+		},
+		{
+			name:    "Goose: streamable_http",
+			convert: convertGooseServer,
+			entry:   map[string]interface{}{"type": "streamable_http", "uri": "https://example.com/mcp"},
+			want:    map[string]interface{}{"type": "http", "url": "https://example.com/mcp"},
+		},
+		{
+			name:    "Goose: estensione integrata saltata",
+			convert: convertGooseServer,
+			entry:   map[string]interface{}{"type": "builtin", "name": "developer"},
+			skipped: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, issues, ok := tt.convert(tt.entry)
+			if ok == tt.skipped {
+				t.Fatalf("ok = %v, atteso %v", ok, !tt.skipped)
+			}
+			if tt.skipped {
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ottenuto %v, atteso %v", got, tt.want)
+			}
+			codes := make([]string, 0, len(issues))
+			for _, issue := range issues {
+				codes = append(codes, issue.Code)
+			}
+			if !slices.Equal(codes, tt.wantIssues) {
+				t.Errorf("problemi %v, attesi %v", codes, tt.wantIssues)
+			}
+		})
+	}
+}
+
+func TestReadClientConfigKeepsValidServers(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "claude_desktop_config.json")
+	data := `{"mcpServers": {"broken": "npx server", "demo": {"command": "npx"}}}`
+	if err := os.WriteFile(path, []byte(data), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	servers, err := ReadClientConfig(domain.ImportClaudeDesktop, path)
+	if err != nil {
+		t.Fatalf("una voce non valida ha bloccato l'importazione: %v", err)
+	}
+	if len(servers) != 2 {
+		t.Fatalf("server letti %v, attesi broken e demo", servers)
+	}
+	broken, demo := servers[0], servers[1]
+	if !broken.Issues.HasErrors() || broken.Issues[0].Code != "import_invalid" {
+		t.Errorf("la voce non valida non è segnalata: %v", broken.Issues)
+	}
+	if demo.Server.Command != "npx" || demo.Issues.HasErrors() {
+		t.Errorf("server valido %+v con problemi %v", demo.Server, demo.Issues)
+	}
+}
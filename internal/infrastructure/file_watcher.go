@@ -0,0 +1,155 @@
+package infrastructure
+
+import (
+	"fmt"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/fsnotify/fsnotify"
+)
+
+// watchDebounce raggruppa le scritture ravvicinate (es. temp file + rename) in una sola notifica
+const watchDebounce = 300 * time.Millisecond
+
+// FileWatcher osserva un insieme di file di configurazione e notifica quando cambiano.
+// Osserva le directory che li contengono, così sopravvive alle scritture atomiche
+// che sostituiscono il file con un rename.
+type FileWatcher struct {
+	watcher  *fsnotify.Watcher
+	onChange func(paths []string)
+
+	mu      sync.Mutex
+	files   map[string]bool
+	dirs    map[string]bool
+	pending map[string]bool
+	timer   *time.Timer
+	done    chan struct{}
+}
+
+// NewFileWatcher crea un watcher che chiama onChange (da una goroutine separata)
+// con i path dei file modificati
+func NewFileWatcher(onChange func(paths []string)) (*FileWatcher, error) {
+	watcher, err := fsnotify.NewWatcher()
+	if err != nil {
+		return nil, fmt.Errorf("impossibile avviare il watcher: %w", err)
+	}
+
+	fw := &FileWatcher{
+		watcher:  watcher,
+		onChange: onChange,
+		files:    make(map[string]bool),
+		dirs:     make(map[string]bool),
+		pending:  make(map[string]bool),
+		done:     make(chan struct{}),
+	}
+	go fw.loop()
+	return fw, nil
+}
+
+// SetFiles sostituisce l'insieme dei file osservati.
+// Le directory inesistenti vengono ignorate: verranno aggiunte al prossimo SetFiles.
+func (fw *FileWatcher) SetFiles(paths []string) {
+	fw.mu.Lock()
+	defer fw.mu.Unlock()
+
+	files := make(map[string]bool, len(paths))
+	dirs := make(map[string]bool)
+	for _, path := range paths {
+		path = filepath.Clean(path)
+		files[path] = true
+		dirs[filepath.Dir(path)] = true
+
+		// Per i symlink (es. dotfile gestiti da un repository) osserva anche il file reale
+		if resolved, err := filepath.EvalSymlinks(path); err == nil && resolved != path {
+			files[resolved] = true
+			dirs[filepath.Dir(resolved)] = true
+		}
+	}
+
+	for dir := range fw.dirs {
+		if !dirs[dir] {
+			fw.watcher.Remove(dir)
+			delete(fw.dirs, dir)
+		}
+	}
+	for dir := range dirs {
+		if fw.dirs[dir] {
+			continue
+		}
+		if err := fw.watcher.Add(dir); err == nil {
+			fw.dirs[dir] = true
+		}
+	}
+	fw.files = files
+}
+
+// Close ferma il watcher
+func (fw *FileWatcher) Close() error {
+	fw.mu.Lock()
+	if fw.timer != nil {
+		fw.timer.Stop()
+	}
+	fw.mu.Unlock()
+
+	close(fw.done)
+	return fw.watcher.Close()
+}
+
+// loop riceve gli eventi di fsnotify finché il watcher non viene chiuso
+func (fw *FileWatcher) loop() {
+	for {
+		select {
+		case <-fw.done:
+			return
+		case event, ok := <-fw.watcher.Events:
+			if !ok {
+				return
+			}
+			if event.Has(fsnotify.Chmod) && !event.Has(fsnotify.Write) {
+				continue
+			}
+			fw.record(filepath.Clean(event.Name))
+		case _, ok := <-fw.watcher.Errors:
+			if !ok {
+				return
+			}
+		}
+	}
+}
+
+// record accoda un file modificato e (ri)avvia il timer di debounce
+func (fw *FileWatcher) record(path string) {
+	fw.mu.Lock()
+	defer fw.mu.Unlock()
+
+	if !fw.files[path] {
+		return
+	}
+	fw.pending[path] = true
+
+	if fw.timer != nil {
+		fw.timer.Stop()
+	}
+	fw.timer = time.AfterFunc(watchDebounce, fw.flush)
+}
+
+// flush notifica i file accumulati durante il debounce
+func (fw *FileWatcher) flush() {
+	fw.mu.Lock()
+	paths := make([]string, 0, len(fw.pending))
+	for path := range fw.pending {
+		paths = append(paths, path)
+	}
+	fw.pending = make(map[string]bool)
+	fw.mu.Unlock()
+
+	select {
+	case <-fw.done:
+		return
+	default:
+	}
+	if len(paths) > 0 {
+		fw.onChange(paths)
+	}
+}
@@ -0,0 +1,101 @@
+package infrastructure
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+
+	"github.com/strawberry-code/mcp-curator/internal/domain"
+)
+
+// maxCachedInventories limita le voci conservate nella cache degli inventari
+const maxCachedInventories = 200
+
+// InventoryCache conserva gli inventari dei server in <cache dir>/mcp-curator/inventory.json.
+// Le voci sono indicizzate per definizione del server: se la configurazione cambia,
+// l'inventario precedente non viene più usato.
+type InventoryCache struct {
+	path    string
+	entries map[string]Inventory
+}
+
+// NewInventoryCache crea la cache nella directory di cache utente
+func NewInventoryCache() (*InventoryCache, error) {
+	dir, err := os.UserCacheDir()
+	if err != nil {
+		return nil, fmt.Errorf("impossibile determinare la directory di cache: %w", err)
+	}
+	return NewInventoryCacheWithPath(filepath.Join(dir, "mcp-curator", "inventory.json")), nil
+}
+
+// NewInventoryCacheWithPath crea la cache nel file indicato
+func NewInventoryCacheWithPath(path string) *InventoryCache {
+	return &InventoryCache{path: path}
+}
+
+// ServerKey identifica la definizione di un server (comando, args, env, URL, headers, ...)
+func ServerKey(server domain.MCPServer) string {
+	data, _ := json.Marshal(ServerToMap(server))
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
+
+// Get restituisce l'inventario salvato per la definizione del server
+func (c *InventoryCache) Get(server domain.MCPServer) (Inventory, bool) {
+	if c.entries == nil {
+		c.entries = c.read()
+	}
+	inv, ok := c.entries[ServerKey(server)]
+	return inv, ok
+}
+
+// Put salva l'inventario della definizione del server. Il file viene riletto prima
+// di scrivere per non perdere le voci salvate nel frattempo da un'altra istanza.
+func (c *InventoryCache) Put(server domain.MCPServer, inv Inventory) error {
+	c.entries = c.read()
+	c.entries[ServerKey(server)] = inv
+	c.prune()
+
+	if err := os.MkdirAll(filepath.Dir(c.path), 0700); err != nil {
+		return fmt.Errorf("impossibile creare %s: %w", filepath.Dir(c.path), err)
+	}
+	data, err := json.Marshal(c.entries)
+	if err != nil {
+		return fmt.Errorf("impossibile serializzare la cache degli inventari: %w", err)
+	}
+	return writeFileAtomic(c.path, data, 0600)
+}
+
+// read carica le voci dal file; un file assente o corrotto equivale a una cache vuota
+func (c *InventoryCache) read() map[string]Inventory {
+	entries := make(map[string]Inventory)
+	data, err := os.ReadFile(c.path)
+	if err != nil {
+		return entries
+	}
+	if err := json.Unmarshal(data, &entries); err != nil {
+		return make(map[string]Inventory)
+	}
+	return entries
+}
+
+// prune elimina le voci più vecchie oltre maxCachedInventories
+func (c *InventoryCache) prune() {
+	if len(c.entries) <= maxCachedInventories {
+		return
+	}
+	keys := make([]string, 0, len(c.entries))
+	for key := range c.entries {
+		keys = append(keys, key)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		return c.entries[keys[i]].FetchedAt.After(c.entries[keys[j]].FetchedAt)
+	})
+	for _, key := range keys[maxCachedInventories:] {
+		delete(c.entries, key)
+	}
+}
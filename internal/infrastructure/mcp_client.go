@@ -0,0 +1,284 @@
+package infrastructure
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"net"
+	"os/exec"
+	"strings"
+	"time"
+
+	"github.com/strawberry-code/mcp-curator/internal/domain"
+	"github.com/strawberry-code/mcp-curator/internal/version"
+)
+
+// MCPProtocolVersion è la versione del protocollo MCP richiesta nell'handshake
+const MCPProtocolVersion = "2025-06-18"
+
+// DefaultMCPTimeout è il timeout dell'handshake per i server senza timeout configurato
+const DefaultMCPTimeout = 30 * time.Second
+
+// FailureReason classifica il motivo per cui un server non ha risposto all'handshake
+type FailureReason string
+
+const (
+	FailureNotFound   FailureReason = "not_found"   // comando stdio non trovato
+	FailureExited     FailureReason = "exited"      // processo terminato prima di rispondere
+	FailureTimeout    FailureReason = "timeout"     // nessuna risposta entro il timeout
+	FailureTLS        FailureReason = "tls"         // errore di certificato o handshake TLS
+	FailureHTTPStatus FailureReason = "http_status" // risposta HTTP non 2xx
+	FailureNetwork    FailureReason = "network"     // connessione rifiutata, DNS, ...
+	FailureProtocol   FailureReason = "protocol"    // risposta non conforme a MCP o errore JSON-RPC
+	FailureConfig     FailureReason = "config"      // configurazione del server incompleta
+)
+
+// ConnectionError descrive il fallimento di una connessione MCP
+type ConnectionError struct {
+	Reason     FailureReason
+	Detail     string
+	ExitCode   int    // per FailureExited
+	StatusCode int    // per FailureHTTPStatus
+	Stderr     string // output di errore del processo (solo stdio)
+	Err        error
+}
+
+func (e *ConnectionError) Error() string {
+	return e.Detail
+}
+
+func (e *ConnectionError) Unwrap() error {
+	return e.Err
+}
+
+// ServerInfo identifica l'implementazione del server MCP
+type ServerInfo struct {
+	Name    string `json:"name"`
+	Version string `json:"version"`
+}
+
+// InitializeResult è la risposta del server alla richiesta initialize
+type InitializeResult struct {
+	ProtocolVersion string                 `json:"protocolVersion"`
+	ServerInfo      ServerInfo             `json:"serverInfo"`
+	Capabilities    map[string]interface{} `json:"capabilities"`
+	Instructions    string                 `json:"instructions,omitempty"`
+}
+
+// rpcRequest è un messaggio JSON-RPC inviato al server (senza ID è una notifica)
+type rpcRequest struct {
+	JSONRPC string      `json:"jsonrpc"`
+	ID      *int64      `json:"id,omitempty"`
+	Method  string      `json:"method"`
+	Params  interface{} `json:"params,omitempty"`
+}
+
+// rpcResponse è un messaggio JSON-RPC ricevuto dal server
+type rpcResponse struct {
+	ID     json.RawMessage `json:"id,omitempty"`
+	Method string          `json:"method,omitempty"`
+	Result json.RawMessage `json:"result,omitempty"`
+	Error  *rpcError       `json:"error,omitempty"`
+}
+
+// rpcError è l'errore di una risposta JSON-RPC
+type rpcError struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+}
+
+// isResponseTo verifica se il messaggio è la risposta alla richiesta con l'ID indicato
+func (r rpcResponse) isResponseTo(id int64) bool {
+	if r.Method != "" || len(r.ID) == 0 {
+		return false
+	}
+	var got int64
+	return json.Unmarshal(r.ID, &got) == nil && got == id
+}
+
+// mcpTransport trasporta i messaggi JSON-RPC verso un server MCP
+type mcpTransport interface {
+	// call invia una richiesta e attende la risposta con lo stesso ID
+	call(ctx context.Context, req rpcRequest) (rpcResponse, error)
+	// notify invia una notifica senza attendere risposta
+	notify(ctx context.Context, req rpcRequest) error
+	// close chiude la connessione e termina l'eventuale processo
+	close() error
+	// stderr restituisce l'output di errore del processo (solo stdio)
+	stderr() string
+}
+
+// MCPSession è una connessione a un server MCP
+type MCPSession struct {
+	transport mcpTransport
+	nextID    int64
+	timeout   time.Duration
+
+	// Initialize è il risultato dell'handshake
+	Initialize InitializeResult
+}
+
+// ConnectMCP avvia o contatta il server, esegue l'handshake initialize e restituisce la sessione.
+// Il contesto limita la durata dell'handshake; se non ha scadenza si usa il timeout del server.
+func ConnectMCP(ctx context.Context, server domain.MCPServer) (*MCPSession, error) {
+	timeout := ServerTimeout(server)
+	if _, ok := ctx.Deadline(); !ok {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+		defer cancel()
+	}
+
+	transport, err := newTransport(ctx, server)
+	if err != nil {
+		return nil, classifyError(ctx, err)
+	}
+
+	session := &MCPSession{transport: transport, timeout: timeout}
+	if err := session.initialize(ctx); err != nil {
+		session.Close()
+		err = classifyError(ctx, err)
+		var connErr *ConnectionError
+		if errors.As(err, &connErr) {
+			connErr.Stderr = transport.stderr()
+		}
+		return nil, err
+	}
+	return session, nil
+}
+
+// ServerTimeout restituisce il timeout configurato del server o quello predefinito
+func ServerTimeout(server domain.MCPServer) time.Duration {
+	if server.Timeout > 0 {
+		return time.Duration(server.Timeout) * time.Millisecond
+	}
+	return DefaultMCPTimeout
+}
+
+// newTransport sceglie il trasporto in base al tipo di server
+func newTransport(ctx context.Context, server domain.MCPServer) (mcpTransport, error) {
+	switch {
+	case server.Type == domain.ServerTypeSSE:
+		if server.URL == "" {
+			return nil, &ConnectionError{Reason: FailureConfig, Detail: "URL del server mancante"}
+		}
+		return newSSETransport(ctx, server.URL, server.Headers)
+	case server.Type == domain.ServerTypeHTTP || (server.Type == "" && server.URL != ""):
+		if server.URL == "" {
+			return nil, &ConnectionError{Reason: FailureConfig, Detail: "URL del server mancante"}
+		}
+		return newHTTPTransport(server.URL, server.Headers), nil
+	default:
+		if server.Command == "" {
+			return nil, &ConnectionError{Reason: FailureConfig, Detail: "comando del server mancante"}
+		}
+		return newStdioTransport(server.Command, server.Args, server.Env)
+	}
+}
+
+// initialize esegue l'handshake MCP: richiesta initialize e notifica initialized
+func (s *MCPSession) initialize(ctx context.Context) error {
+	params := map[string]interface{}{
+		"protocolVersion": MCPProtocolVersion,
+		"capabilities":    map[string]interface{}{},
+		"clientInfo": map[string]string{
+			"name":    version.Name,
+			"version": version.Version,
+		},
+	}
+
+	result, err := s.Request(ctx, "initialize", params)
+	if err != nil {
+		return err
+	}
+	if err := json.Unmarshal(result, &s.Initialize); err != nil {
+		return &ConnectionError{Reason: FailureProtocol, Detail: fmt.Sprintf("risposta initialize non valida: %v", err), Err: err}
+	}
+	if s.Initialize.ProtocolVersion == "" {
+		return &ConnectionError{Reason: FailureProtocol, Detail: "risposta initialize senza protocolVersion"}
+	}
+
+	if h, ok := s.transport.(*httpTransport); ok {
+		h.protocolVersion = s.Initialize.ProtocolVersion
+	}
+	return s.transport.notify(ctx, rpcRequest{JSONRPC: "2.0", Method: "notifications/initialized"})
+}
+
+// Request invia una richiesta JSON-RPC e restituisce il campo result della risposta
+func (s *MCPSession) Request(ctx context.Context, method string, params interface{}) (json.RawMessage, error) {
+	if _, ok := ctx.Deadline(); !ok {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, s.timeout)
+		defer cancel()
+	}
+
+	s.nextID++
+	id := s.nextID
+	resp, err := s.transport.call(ctx, rpcRequest{JSONRPC: "2.0", ID: &id, Method: method, Params: params})
+	if err != nil {
+		return nil, classifyError(ctx, err)
+	}
+	if resp.Error != nil {
+		return nil, &ConnectionError{
+			Reason: FailureProtocol,
+			Detail: fmt.Sprintf("%s: errore %d: %s", method, resp.Error.Code, resp.Error.Message),
+		}
+	}
+	return resp.Result, nil
+}
+
+// Stderr restituisce l'output di errore raccolto dal processo del server (solo stdio)
+func (s *MCPSession) Stderr() string {
+	return s.transport.stderr()
+}
+
+// Close chiude la sessione
+func (s *MCPSession) Close() error {
+	return s.transport.close()
+}
+
+// classifyError converte un errore di trasporto in un *ConnectionError con motivo leggibile
+func classifyError(ctx context.Context, err error) error {
+	var connErr *ConnectionError
+	if errors.As(err, &connErr) {
+		return err
+	}
+
+	if errors.Is(err, context.DeadlineExceeded) || errors.Is(ctx.Err(), context.DeadlineExceeded) {
+		return &ConnectionError{Reason: FailureTimeout, Detail: "nessuna risposta entro il timeout", Err: err}
+	}
+
+	if errors.Is(err, exec.ErrNotFound) || errors.Is(err, fs.ErrNotExist) {
+		return &ConnectionError{Reason: FailureNotFound, Detail: fmt.Sprintf("comando non trovato: %v", err), Err: err}
+	}
+
+	var exitErr *exec.ExitError
+	if errors.As(err, &exitErr) {
+		return &ConnectionError{
+			Reason:   FailureExited,
+			Detail:   fmt.Sprintf("il processo è terminato con codice %d", exitErr.ExitCode()),
+			ExitCode: exitErr.ExitCode(),
+			Err:      err,
+		}
+	}
+
+	var certErr *tls.CertificateVerificationError
+	var unknownAuthority x509.UnknownAuthorityError
+	var hostnameErr x509.HostnameError
+	var recordErr tls.RecordHeaderError
+	if errors.As(err, &certErr) || errors.As(err, &unknownAuthority) || errors.As(err, &hostnameErr) || errors.As(err, &recordErr) {
+		return &ConnectionError{Reason: FailureTLS, Detail: fmt.Sprintf("errore TLS: %v", err), Err: err}
+	}
+
+	var netErr net.Error
+	var opErr *net.OpError
+	var dnsErr *net.DNSError
+	if errors.As(err, &opErr) || errors.As(err, &dnsErr) || errors.As(err, &netErr) {
+		return &ConnectionError{Reason: FailureNetwork, Detail: fmt.Sprintf("errore di rete: %v", err), Err: err}
+	}
+
+	return &ConnectionError{Reason: FailureProtocol, Detail: strings.TrimSpace(err.Error()), Err: err}
+}
@@ -0,0 +1,391 @@
+package infrastructure
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Limite per il corpo degli errori HTTP riportati all'utente
+const httpMaxErrorBody = 512
+
+// httpTransport implementa il trasporto "Streamable HTTP": ogni messaggio è una POST
+// e la risposta arriva come JSON o come stream SSE
+type httpTransport struct {
+	url     string
+	headers map[string]string
+	client  *http.Client
+
+	sessionID       string
+	protocolVersion string
+}
+
+// newHTTPTransport crea un trasporto Streamable HTTP verso l'URL indicato
+func newHTTPTransport(endpoint string, headers map[string]string) *httpTransport {
+	return &httpTransport{
+		url:     endpoint,
+		headers: headers,
+		client:  &http.Client{},
+	}
+}
+
+func (t *httpTransport) call(ctx context.Context, req rpcRequest) (rpcResponse, error) {
+	resp, err := t.post(ctx, req)
+	if err != nil {
+		return rpcResponse{}, err
+	}
+	defer resp.Body.Close()
+
+	if id := resp.Header.Get("Mcp-Session-Id"); id != "" {
+		t.sessionID = id
+	}
+
+	mediaType, _, _ := mime.ParseMediaType(resp.Header.Get("Content-Type"))
+	switch mediaType {
+	case "text/event-stream":
+		var result *rpcResponse
+		err := readSSE(resp.Body, func(event, data string) bool {
+			if event != "" && event != "message" {
+				return true
+			}
+			var msg rpcResponse
+			if json.Unmarshal([]byte(data), &msg) == nil && msg.isResponseTo(*req.ID) {
+				result = &msg
+				return false
+			}
+			return true
+		})
+		if result != nil {
+			return *result, nil
+		}
+		if err == nil {
+			err = fmt.Errorf("stream SSE chiuso senza risposta a %s", req.Method)
+		}
+		return rpcResponse{}, err
+	case "application/json":
+		var msg rpcResponse
+		if err := json.NewDecoder(resp.Body).Decode(&msg); err != nil {
+			return rpcResponse{}, fmt.Errorf("risposta JSON non valida: %w", err)
+		}
+		return msg, nil
+	default:
+		return rpcResponse{}, fmt.Errorf("Content-Type inatteso nella risposta: %q", resp.Header.Get("Content-Type"))
+	}
+}
+
+func (t *httpTransport) notify(ctx context.Context, req rpcRequest) error {
+	resp, err := t.post(ctx, req)
+	if err != nil {
+		return err
+	}
+	io.Copy(io.Discard, io.LimitReader(resp.Body, httpMaxErrorBody))
+	return resp.Body.Close()
+}
+
+// post invia un messaggio JSON-RPC e verifica lo stato HTTP della risposta
+func (t *httpTransport) post(ctx context.Context, req rpcRequest) (*http.Response, error) {
+	body, err := json.Marshal(req)
+	if err != nil {
+		return nil, err
+	}
+	httpReq, err := http.NewRequestWithContext(ctx, http.MethodPost, t.url, bytes.NewReader(body))
+	if err != nil {
+		return nil, &ConnectionError{Reason: FailureConfig, Detail: fmt.Sprintf("URL non valido: %v", err), Err: err}
+	}
+	httpReq.Header.Set("Content-Type", "application/json")
+	httpReq.Header.Set("Accept", "application/json, text/event-stream")
+	if t.sessionID != "" {
+		httpReq.Header.Set("Mcp-Session-Id", t.sessionID)
+	}
+	if t.protocolVersion != "" {
+		httpReq.Header.Set("MCP-Protocol-Version", t.protocolVersion)
+	}
+	setHeaders(httpReq, t.headers)
+
+	resp, err := t.client.Do(httpReq)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkStatus(resp); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (t *httpTransport) close() error {
+	if t.sessionID == "" {
+		return nil
+	}
+	// Termina la sessione lato server; un errore qui non è rilevante
+	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+	defer cancel()
+	req, err := http.NewRequestWithContext(ctx, http.MethodDelete, t.url, nil)
+	if err != nil {
+		return nil
+	}
+	req.Header.Set("Mcp-Session-Id", t.sessionID)
+	setHeaders(req, t.headers)
+	if resp, err := t.client.Do(req); err == nil {
+		resp.Body.Close()
+	}
+	return nil
+}
+
+func (t *httpTransport) stderr() string {
+	return ""
+}
+
+// sseTransport implementa il trasporto HTTP+SSE legacy: le risposte arrivano su uno
+// stream GET e i messaggi vanno inviati via POST all'endpoint annunciato dal server
+type sseTransport struct {
+	headers map[string]string
+	client  *http.Client
+	cancel  context.CancelFunc
+
+	endpoint string
+
+	mu      sync.Mutex
+	pending map[int64]chan rpcResponse
+	done    chan struct{} // chiuso quando lo stream termina
+	err     error
+}
+
+// newSSETransport apre lo stream SSE e attende l'evento "endpoint"
+func newSSETransport(ctx context.Context, streamURL string, headers map[string]string) (*sseTransport, error) {
+	base, err := url.Parse(streamURL)
+	if err != nil {
+		return nil, &ConnectionError{Reason: FailureConfig, Detail: fmt.Sprintf("URL non valido: %v", err), Err: err}
+	}
+
+	// Lo stream deve sopravvivere all'handshake: usa un contesto proprio chiuso da close()
+	streamCtx, cancel := context.WithCancel(context.Background())
+	req, err := http.NewRequestWithContext(streamCtx, http.MethodGet, streamURL, nil)
+	if err != nil {
+		cancel()
+		return nil, &ConnectionError{Reason: FailureConfig, Detail: fmt.Sprintf("URL non valido: %v", err), Err: err}
+	}
+	req.Header.Set("Accept", "text/event-stream")
+	setHeaders(req, headers)
+
+	t := &sseTransport{
+		headers: headers,
+		client:  &http.Client{},
+		cancel:  cancel,
+		pending: make(map[int64]chan rpcResponse),
+		done:    make(chan struct{}),
+	}
+
+	type opened struct {
+		resp *http.Response
+		err  error
+	}
+	openCh := make(chan opened, 1)
+	go func() {
+		resp, err := t.client.Do(req)
+		openCh <- opened{resp, err}
+	}()
+
+	var resp *http.Response
+	select {
+	case o := <-openCh:
+		if o.err != nil {
+			cancel()
+			return nil, o.err
+		}
+		resp = o.resp
+	case <-ctx.Done():
+		cancel()
+		return nil, ctx.Err()
+	}
+	if err := checkStatus(resp); err != nil {
+		cancel()
+		return nil, err
+	}
+
+	endpointCh := make(chan string, 1)
+	go t.readLoop(resp.Body, endpointCh)
+
+	select {
+	case endpoint := <-endpointCh:
+		ref, err := url.Parse(endpoint)
+		if err != nil {
+			t.close()
+			return nil, fmt.Errorf("endpoint SSE non valido: %q", endpoint)
+		}
+		t.endpoint = base.ResolveReference(ref).String()
+		return t, nil
+	case <-t.done:
+		t.close()
+		return nil, fmt.Errorf("stream SSE chiuso prima dell'evento endpoint: %v", t.err)
+	case <-ctx.Done():
+		t.close()
+		return nil, ctx.Err()
+	}
+}
+
+// readLoop legge gli eventi dello stream finché non viene chiuso
+func (t *sseTransport) readLoop(body io.ReadCloser, endpointCh chan<- string) {
+	defer body.Close()
+	sentEndpoint := false
+	err := readSSE(body, func(event, data string) bool {
+		switch event {
+		case "endpoint":
+			if !sentEndpoint {
+				endpointCh <- strings.TrimSpace(data)
+				sentEndpoint = true
+			}
+		case "", "message":
+			var msg rpcResponse
+			if json.Unmarshal([]byte(data), &msg) == nil {
+				t.dispatch(msg)
+			}
+		}
+		return true
+	})
+	if err == nil {
+		err = io.EOF
+	}
+	t.err = err
+	close(t.done)
+}
+
+// dispatch consegna una risposta alla richiesta in attesa con lo stesso ID
+func (t *sseTransport) dispatch(msg rpcResponse) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	for id, ch := range t.pending {
+		if msg.isResponseTo(id) {
+			ch <- msg
+			delete(t.pending, id)
+			return
+		}
+	}
+}
+
+func (t *sseTransport) call(ctx context.Context, req rpcRequest) (rpcResponse, error) {
+	ch := make(chan rpcResponse, 1)
+	t.mu.Lock()
+	t.pending[*req.ID] = ch
+	t.mu.Unlock()
+	defer func() {
+		t.mu.Lock()
+		delete(t.pending, *req.ID)
+		t.mu.Unlock()
+	}()
+
+	if err := t.notify(ctx, req); err != nil {
+		return rpcResponse{}, err
+	}
+
+	select {
+	case msg := <-ch:
+		return msg, nil
+	case <-t.done:
+		return rpcResponse{}, fmt.Errorf("stream SSE chiuso senza risposta a %s: %v", req.Method, t.err)
+	case <-ctx.Done():
+		return rpcResponse{}, ctx.Err()
+	}
+}
+
+func (t *sseTransport) notify(ctx context.Context, req rpcRequest) error {
+	body, err := json.Marshal(req)
+	if err != nil {
+		return err
+	}
+	httpReq, err := http.NewRequestWithContext(ctx, http.MethodPost, t.endpoint, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	httpReq.Header.Set("Content-Type", "application/json")
+	setHeaders(httpReq, t.headers)
+
+	resp, err := t.client.Do(httpReq)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if err := checkStatus(resp); err != nil {
+		return err
+	}
+	io.Copy(io.Discard, io.LimitReader(resp.Body, httpMaxErrorBody))
+	return nil
+}
+
+func (t *sseTransport) close() error {
+	t.cancel()
+	return nil
+}
+
+func (t *sseTransport) stderr() string {
+	return ""
+}
+
+// setHeaders applica gli header configurati per il server
+func setHeaders(req *http.Request, headers map[string]string) {
+	for k, v := range headers {
+		req.Header.Set(k, v)
+	}
+}
+
+// checkStatus restituisce un *ConnectionError se la risposta non ha uno stato 2xx;
+// in quel caso il corpo viene chiuso
+func checkStatus(resp *http.Response) error {
+	if resp.StatusCode >= 200 && resp.StatusCode < 300 {
+		return nil
+	}
+	defer resp.Body.Close()
+
+	detail := fmt.Sprintf("HTTP %s", resp.Status)
+	data, _ := io.ReadAll(io.LimitReader(resp.Body, httpMaxErrorBody))
+	if body := strings.TrimSpace(string(data)); body != "" {
+		detail += ": " + body
+	}
+	return &ConnectionError{Reason: FailureHTTPStatus, Detail: detail, StatusCode: resp.StatusCode}
+}
+
+// readSSE legge uno stream Server-Sent Events e chiama fn per ogni evento completo.
+// La lettura si interrompe quando fn restituisce false o lo stream termina.
+func readSSE(r io.Reader, fn func(event, data string) bool) error {
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 64<<10), stdioMaxMessage)
+
+	var event string
+	var data []string
+	for scanner.Scan() {
+		line := strings.TrimSuffix(scanner.Text(), "\r")
+		if line == "" {
+			if len(data) > 0 && !fn(event, strings.Join(data, "\n")) {
+				return nil
+			}
+			event, data = "", nil
+			continue
+		}
+		if strings.HasPrefix(line, ":") {
+			continue
+		}
+		field, value, _ := strings.Cut(line, ":")
+		value = strings.TrimPrefix(value, " ")
+		switch field {
+		case "event":
+			event = value
+		case "data":
+			data = append(data, value)
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return err
+	}
+	if len(data) > 0 {
+		fn(event, strings.Join(data, "\n"))
+	}
+	return nil
+}
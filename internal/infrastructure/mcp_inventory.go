@@ -0,0 +1,126 @@
+package infrastructure
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// maxListPages limita la paginazione delle liste per server che restituiscono sempre un cursore
+const maxListPages = 100
+
+// Tool è uno strumento esposto da un server MCP
+type Tool struct {
+	Name        string          `json:"name"`
+	Title       string          `json:"title,omitempty"`
+	Description string          `json:"description,omitempty"`
+	InputSchema json.RawMessage `json:"inputSchema,omitempty"`
+}
+
+// Resource è una risorsa esposta da un server MCP
+type Resource struct {
+	URI         string `json:"uri"`
+	Name        string `json:"name"`
+	Title       string `json:"title,omitempty"`
+	Description string `json:"description,omitempty"`
+	MimeType    string `json:"mimeType,omitempty"`
+}
+
+// PromptArgument è un argomento di un prompt
+type PromptArgument struct {
+	Name        string `json:"name"`
+	Description string `json:"description,omitempty"`
+	Required    bool   `json:"required,omitempty"`
+}
+
+// Prompt è un prompt esposto da un server MCP
+type Prompt struct {
+	Name        string           `json:"name"`
+	Title       string           `json:"title,omitempty"`
+	Description string           `json:"description,omitempty"`
+	Arguments   []PromptArgument `json:"arguments,omitempty"`
+}
+
+// Inventory raccoglie ciò che un server espone: strumenti, risorse e prompt
+type Inventory struct {
+	Initialize InitializeResult `json:"initialize"`
+	Tools      []Tool           `json:"tools,omitempty"`
+	Resources  []Resource       `json:"resources,omitempty"`
+	Prompts    []Prompt         `json:"prompts,omitempty"`
+	// Errors contiene gli errori delle singole liste, per metodo (es. "resources/list")
+	Errors    map[string]string `json:"errors,omitempty"`
+	FetchedAt time.Time         `json:"fetchedAt"`
+}
+
+// Inventory legge strumenti, risorse e prompt dichiarati nelle capability del server.
+// Un errore in una lista non impedisce di leggere le altre: viene riportato in Errors.
+func (s *MCPSession) Inventory(ctx context.Context) Inventory {
+	inv := Inventory{Initialize: s.Initialize, FetchedAt: time.Now()}
+
+	fail := func(method string, err error) {
+		if inv.Errors == nil {
+			inv.Errors = make(map[string]string)
+		}
+		inv.Errors[method] = err.Error()
+	}
+
+	if s.hasCapability("tools") {
+		if err := listAll(ctx, s, "tools/list", "tools", &inv.Tools); err != nil {
+			fail("tools/list", err)
+		}
+	}
+	if s.hasCapability("resources") {
+		if err := listAll(ctx, s, "resources/list", "resources", &inv.Resources); err != nil {
+			fail("resources/list", err)
+		}
+	}
+	if s.hasCapability("prompts") {
+		if err := listAll(ctx, s, "prompts/list", "prompts", &inv.Prompts); err != nil {
+			fail("prompts/list", err)
+		}
+	}
+	return inv
+}
+
+// hasCapability verifica se il server ha dichiarato la capability indicata
+func (s *MCPSession) hasCapability(name string) bool {
+	_, ok := s.Initialize.Capabilities[name]
+	return ok
+}
+
+// listAll esegue un metodo */list seguendo nextCursor e accoda gli elementi a out
+func listAll[T any](ctx context.Context, s *MCPSession, method, field string, out *[]T) error {
+	var cursor string
+	for page := 0; page < maxListPages; page++ {
+		var params interface{}
+		if cursor != "" {
+			params = map[string]string{"cursor": cursor}
+		}
+		result, err := s.Request(ctx, method, params)
+		if err != nil {
+			return err
+		}
+
+		var raw map[string]json.RawMessage
+		if err := json.Unmarshal(result, &raw); err != nil {
+			return fmt.Errorf("risposta %s non valida: %w", method, err)
+		}
+		var items []T
+		if data, ok := raw[field]; ok {
+			if err := json.Unmarshal(data, &items); err != nil {
+				return fmt.Errorf("campo %s non valido in %s: %w", field, method, err)
+			}
+		}
+		*out = append(*out, items...)
+
+		cursor = ""
+		if data, ok := raw["nextCursor"]; ok {
+			_ = json.Unmarshal(data, &cursor)
+		}
+		if cursor == "" {
+			return nil
+		}
+	}
+	return fmt.Errorf("%s: troppe pagine", method)
+}
@@ -0,0 +1,227 @@
+package infrastructure
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"sync"
+	"time"
+)
+
+// Limiti per la lettura dei messaggi e dello stderr dei server stdio
+const (
+	stdioMaxMessage = 16 << 20
+	stdioMaxStderr  = 64 << 10
+	stdioStopGrace  = 500 * time.Millisecond
+)
+
+// stdioTransport comunica con un server MCP avviato come processo figlio
+// tramite messaggi JSON delimitati da newline su stdin/stdout
+type stdioTransport struct {
+	cmd    *exec.Cmd
+	stdin  io.WriteCloser
+	stdout io.ReadCloser
+
+	writeMu sync.Mutex
+	mu      sync.Mutex
+	pending map[int64]chan rpcResponse
+
+	errBuf  *limitedBuffer
+	done    chan struct{} // chiuso quando il processo termina
+	waitErr error
+}
+
+// newStdioTransport avvia il processo del server
+func newStdioTransport(command string, args []string, env map[string]string) (*stdioTransport, error) {
+	path, err := exec.LookPath(command)
+	if err != nil {
+		return nil, err
+	}
+
+	cmd := exec.Command(path, args...)
+	startInProcessGroup(cmd)
+	// Un processo nipote che tiene aperto stderr non deve bloccare Wait
+	cmd.WaitDelay = stdioStopGrace
+	cmd.Env = os.Environ()
+	for k, v := range env {
+		cmd.Env = append(cmd.Env, k+"="+v)
+	}
+
+	stdin, err := cmd.StdinPipe()
+	if err != nil {
+		return nil, err
+	}
+	stdout, err := cmd.StdoutPipe()
+	if err != nil {
+		return nil, err
+	}
+	t := &stdioTransport{
+		cmd:     cmd,
+		stdin:   stdin,
+		stdout:  stdout,
+		pending: make(map[int64]chan rpcResponse),
+		errBuf:  &limitedBuffer{limit: stdioMaxStderr},
+		done:    make(chan struct{}),
+	}
+	cmd.Stderr = t.errBuf
+
+	if err := cmd.Start(); err != nil {
+		return nil, err
+	}
+
+	go t.readLoop(stdout)
+	return t, nil
+}
+
+// readLoop legge le risposte dal server finché stdout non viene chiuso
+func (t *stdioTransport) readLoop(stdout io.Reader) {
+	scanner := bufio.NewScanner(stdout)
+	scanner.Buffer(make([]byte, 0, 64<<10), stdioMaxMessage)
+	for scanner.Scan() {
+		line := bytes.TrimSpace(scanner.Bytes())
+		if len(line) == 0 {
+			continue
+		}
+		var resp rpcResponse
+		if err := json.Unmarshal(line, &resp); err != nil {
+			// Alcuni server scrivono log su stdout: li ignoriamo
+			continue
+		}
+		t.dispatch(resp)
+	}
+
+	t.waitErr = t.cmd.Wait()
+	close(t.done)
+}
+
+// dispatch consegna una risposta alla richiesta in attesa con lo stesso ID
+func (t *stdioTransport) dispatch(resp rpcResponse) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	for id, ch := range t.pending {
+		if resp.isResponseTo(id) {
+			ch <- resp
+			delete(t.pending, id)
+			return
+		}
+	}
+}
+
+func (t *stdioTransport) call(ctx context.Context, req rpcRequest) (rpcResponse, error) {
+	ch := make(chan rpcResponse, 1)
+	t.mu.Lock()
+	t.pending[*req.ID] = ch
+	t.mu.Unlock()
+	defer func() {
+		t.mu.Lock()
+		delete(t.pending, *req.ID)
+		t.mu.Unlock()
+	}()
+
+	if err := t.write(req); err != nil {
+		return rpcResponse{}, t.exitError(err)
+	}
+
+	select {
+	case resp := <-ch:
+		return resp, nil
+	case <-t.done:
+		return rpcResponse{}, t.exitError(fmt.Errorf("il processo ha chiuso stdout"))
+	case <-ctx.Done():
+		return rpcResponse{}, ctx.Err()
+	}
+}
+
+func (t *stdioTransport) notify(ctx context.Context, req rpcRequest) error {
+	return t.write(req)
+}
+
+// write invia un messaggio seguito da newline
+func (t *stdioTransport) write(req rpcRequest) error {
+	data, err := json.Marshal(req)
+	if err != nil {
+		return err
+	}
+	t.writeMu.Lock()
+	defer t.writeMu.Unlock()
+	_, err = t.stdin.Write(append(data, '\n'))
+	return err
+}
+
+// exitError restituisce l'errore di uscita del processo se è già terminato, altrimenti err
+func (t *stdioTransport) exitError(err error) error {
+	select {
+	case <-t.done:
+	case <-time.After(200 * time.Millisecond):
+		return err
+	}
+	if t.waitErr != nil {
+		return t.waitErr
+	}
+	return &ConnectionError{Reason: FailureExited, Detail: "il processo è terminato con codice 0 senza rispondere"}
+}
+
+// close ferma il server: prima chiudendo stdin, poi terminando l'intero gruppo di
+// processi, così che anche i processi avviati da launcher come npx o uvx non tengano
+// aperto stdout oltre il timeout
+func (t *stdioTransport) close() error {
+	// Chiudere stdin è il modo previsto da MCP per fermare un server stdio
+	t.stdin.Close()
+	if t.waitDone(stdioStopGrace) {
+		return nil
+	}
+	if err := killProcessGroup(t.cmd); err != nil {
+		return err
+	}
+	if !t.waitDone(stdioStopGrace) {
+		// Un processo uscito dal gruppo (es. con setsid) tiene ancora aperto stdout
+		t.stdout.Close()
+		<-t.done
+	}
+	return nil
+}
+
+// waitDone attende al massimo d che il processo termini e chiuda stdout
+func (t *stdioTransport) waitDone(d time.Duration) bool {
+	select {
+	case <-t.done:
+		return true
+	case <-time.After(d):
+		return false
+	}
+}
+
+func (t *stdioTransport) stderr() string {
+	return t.errBuf.String()
+}
+
+// limitedBuffer conserva al massimo limit byte, scartando il resto
+type limitedBuffer struct {
+	mu    sync.Mutex
+	buf   bytes.Buffer
+	limit int
+}
+
+func (b *limitedBuffer) Write(p []byte) (int, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if room := b.limit - b.buf.Len(); room > 0 {
+		if len(p) > room {
+			b.buf.Write(p[:room])
+		} else {
+			b.buf.Write(p)
+		}
+	}
+	return len(p), nil
+}
+
+func (b *limitedBuffer) String() string {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.buf.String()
+}
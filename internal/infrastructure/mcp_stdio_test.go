@@ -0,0 +1,33 @@
+//go:build unix
+
+package infrastructure
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/strawberry-code/mcp-curator/internal/domain"
+)
+
+// Un server che non risponde e lascia un processo nipote con stdout aperto deve
+// comunque fallire entro il timeout: "; true" impedisce alla shell di sostituirsi a sleep
+func TestConnectMCPStdioTimeoutKillsProcessGroup(t *testing.T) {
+	server := domain.MCPServer{Command: "sh", Args: []string{"-c", "sleep 30; true"}, Timeout: 1000}
+
+	start := time.Now()
+	session, err := ConnectMCP(context.Background(), server)
+	elapsed := time.Since(start)
+	if session != nil {
+		session.Close()
+	}
+
+	var connErr *ConnectionError
+	if !errors.As(err, &connErr) || connErr.Reason != FailureTimeout {
+		t.Fatalf("errore atteso di timeout, ottenuto %v", err)
+	}
+	if elapsed > 5*time.Second {
+		t.Fatalf("timeout di 1s non rispettato: %v", elapsed)
+	}
+}
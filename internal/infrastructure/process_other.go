@@ -0,0 +1,20 @@
+//go:build !unix
+
+package infrastructure
+
+import (
+	"errors"
+	"os"
+	"os/exec"
+)
+
+// startInProcessGroup non ha effetto dove i gruppi di processi non sono disponibili
+func startInProcessGroup(cmd *exec.Cmd) {}
+
+// killProcessGroup termina il solo processo figlio
+func killProcessGroup(cmd *exec.Cmd) error {
+	if err := cmd.Process.Kill(); err != nil && !errors.Is(err, os.ErrProcessDone) {
+		return err
+	}
+	return nil
+}
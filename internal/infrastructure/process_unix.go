@@ -0,0 +1,25 @@
+//go:build unix
+
+package infrastructure
+
+import (
+	"errors"
+	"os/exec"
+	"syscall"
+)
+
+// startInProcessGroup fa partire il processo in un gruppo tutto suo, così che
+// killProcessGroup raggiunga anche i processi che avvia (npx, uvx, docker run...)
+func startInProcessGroup(cmd *exec.Cmd) {
+	cmd.SysProcAttr = &syscall.SysProcAttr{Setpgid: true}
+}
+
+// killProcessGroup termina il processo e tutti i processi del suo gruppo
+func killProcessGroup(cmd *exec.Cmd) error {
+	err := syscall.Kill(-cmd.Process.Pid, syscall.SIGKILL)
+	if errors.Is(err, syscall.ESRCH) {
+		// Il gruppo non esiste più: i processi sono già terminati
+		return nil
+	}
+	return err
+}
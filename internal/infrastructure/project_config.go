@@ -9,7 +9,7 @@ import (
 	"github.com/strawberry-code/mcp-curator/internal/domain"
 )
 
-// ProjectConfigRepository gestisce la lettura di .mcp.json e .mcp.local.json
+// ProjectConfigRepository gestisce la lettura/scrittura di .mcp.json e .mcp.local.json
 type ProjectConfigRepository struct{}
 
 // NewProjectConfigRepository crea un nuovo repository per i file di progetto
@@ -17,14 +17,39 @@ func NewProjectConfigRepository() *ProjectConfigRepository {
 	return &ProjectConfigRepository{}
 }
 
+// FilePath restituisce il path del file di configurazione di uno scope di progetto
+func (r *ProjectConfigRepository) FilePath(projectPath string, scope domain.Scope) string {
+	return filepath.Join(projectPath, scope.FileName())
+}
+
+// LoadProject carica nel progetto i server di .mcp.json e .mcp.local.json
+func (r *ProjectConfigRepository) LoadProject(project *domain.Project) error {
+	project.HasMCPJson = r.HasMCPJson(project.Path)
+	project.HasMCPLocal = r.HasMCPLocal(project.Path)
+
+	fileServers, err := r.LoadProjectMCP(project.Path)
+	if err != nil {
+		return err
+	}
+	project.FileServers = fileServers
+
+	localServers, err := r.LoadProjectMCPLocal(project.Path)
+	if err != nil {
+		return err
+	}
+	project.LocalServers = localServers
+
+	return nil
+}
+
 // LoadProjectMCP carica i server MCP da .mcp.json di un progetto
 func (r *ProjectConfigRepository) LoadProjectMCP(projectPath string) (map[string]domain.MCPServer, error) {
-	return r.loadMCPFile(filepath.Join(projectPath, ".mcp.json"))
+	return r.loadMCPFile(r.FilePath(projectPath, domain.ScopeProjectFile))
 }
 
 // LoadProjectMCPLocal carica i server MCP da .mcp.local.json di un progetto
 func (r *ProjectConfigRepository) LoadProjectMCPLocal(projectPath string) (map[string]domain.MCPServer, error) {
-	return r.loadMCPFile(filepath.Join(projectPath, ".mcp.local.json"))
+	return r.loadMCPFile(r.FilePath(projectPath, domain.ScopeProjectLocal))
 }
 
 // loadMCPFile carica un file .mcp.json o .mcp.local.json
@@ -43,7 +68,7 @@ func (r *ProjectConfigRepository) loadMCPFile(path string) (map[string]domain.MC
 		MCPServers map[string]interface{} `json:"mcpServers"`
 	}
 
-	if err := json.Unmarshal(data, &raw); err != nil {
+	if err := decodeJSON(data, &raw); err != nil {
 		return nil, fmt.Errorf("JSON non valido in %s: %w", path, err)
 	}
 
@@ -59,42 +84,132 @@ func (r *ProjectConfigRepository) loadMCPFile(path string) (map[string]domain.MC
 	return result, nil
 }
 
-// HasMCPJson verifica se un progetto ha un file .mcp.json
-func (r *ProjectConfigRepository) HasMCPJson(projectPath string) bool {
-	return fileExists(filepath.Join(projectPath, ".mcp.json"))
+// UpdateServers aggiorna nel file di uno scope di progetto solo i server indicati
+// (nil rimuove il server), preservando gli altri server e le chiavi sconosciute del file
+func (r *ProjectConfigRepository) UpdateServers(projectPath string, scope domain.Scope, changes map[string]*domain.MCPServer) error {
+	if scope.FileName() == "" {
+		return fmt.Errorf("lo scope '%s' non corrisponde a un file di progetto", scope)
+	}
+	path := r.FilePath(projectPath, scope)
+
+	rawConfig := make(map[string]interface{})
+	data, err := os.ReadFile(path)
+	switch {
+	case err == nil:
+		if err := decodeJSON(data, &rawConfig); err != nil {
+			return fmt.Errorf("JSON non valido in %s: %w", path, err)
+		}
+		if err := writeBackup(path, data); err != nil {
+			return fmt.Errorf("impossibile creare backup: %w", err)
+		}
+	case os.IsNotExist(err):
+		// Non creare un file solo per rimuovere server
+		if !hasAdditions(changes) {
+			return nil
+		}
+	default:
+		return fmt.Errorf("impossibile leggere %s: %w", path, err)
+	}
+
+	mcpServers, ok := rawConfig["mcpServers"].(map[string]interface{})
+	if !ok {
+		mcpServers = make(map[string]interface{})
+	}
+	for name, server := range changes {
+		if server == nil {
+			delete(mcpServers, name)
+			continue
+		}
+		mcpServers[name] = ServerToMap(*server)
+	}
+	rawConfig["mcpServers"] = mcpServers
+
+	out, err := json.MarshalIndent(rawConfig, "", "  ")
+	if err != nil {
+		return fmt.Errorf("impossibile serializzare configurazione: %w", err)
+	}
+	out = append(out, '\n')
+
+	return writeFileAtomic(path, out, projectFilePerm(scope))
 }
 
-// HasMCPLocal verifica se un progetto ha un file .mcp.local.json
-func (r *ProjectConfigRepository) HasMCPLocal(projectPath string) bool {
-	return fileExists(filepath.Join(projectPath, ".mcp.local.json"))
+// projectFilePerm restituisce i permessi di un nuovo file di progetto: .mcp.json è
+// condiviso nel repository, .mcp.local.json è personale e può contenere segreti
+func projectFilePerm(scope domain.Scope) os.FileMode {
+	if scope == domain.ScopeProjectLocal {
+		return 0600
+	}
+	return 0644
 }
 
-// GetEffectiveServers restituisce i server effettivi per un progetto con merge completo
-// Ordine: globali < project settings (da ~/.claude.json) < .mcp.json < .mcp.local.json
-func (r *ProjectConfigRepository) GetEffectiveServers(
-	config *domain.Configuration,
-	projectPath string,
-) (map[string]domain.MCPServer, error) {
-	// Parti dal merge base (globali + project settings)
-	result := config.GetEffectiveServers(projectPath)
-
-	// Aggiungi server da .mcp.json
-	mcpServers, err := r.LoadProjectMCP(projectPath)
+// writeBackup salva data nella copia .bak accanto a path, con gli stessi permessi
+// del file originale
+func writeBackup(path string, data []byte) error {
+	info, err := os.Stat(path)
 	if err != nil {
-		return nil, err
+		return err
 	}
-	for name, server := range mcpServers {
-		result[name] = server
+	perm := info.Mode().Perm()
+	backup := path + ".bak"
+	// Un backup precedente con permessi più larghi non deve ricevere il nuovo contenuto
+	if err := os.Remove(backup); err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	if err := os.WriteFile(backup, data, perm); err != nil {
+		return err
 	}
+	return os.Chmod(backup, perm)
+}
 
-	// Aggiungi server da .mcp.local.json
-	localServers, err := r.LoadProjectMCPLocal(projectPath)
-	if err != nil {
-		return nil, err
+// ProjectFileSnapshot è il contenuto di un file di progetto prima di una scrittura
+type ProjectFileSnapshot struct {
+	Path   string
+	Data   []byte
+	Exists bool
+}
+
+// Snapshot legge il contenuto attuale del file di uno scope di progetto, per poterlo
+// ripristinare se il salvataggio di cui fa parte non va a buon fine
+func (r *ProjectConfigRepository) Snapshot(projectPath string, scope domain.Scope) (ProjectFileSnapshot, error) {
+	snapshot := ProjectFileSnapshot{Path: r.FilePath(projectPath, scope)}
+	data, err := os.ReadFile(snapshot.Path)
+	switch {
+	case err == nil:
+		snapshot.Data, snapshot.Exists = data, true
+	case !os.IsNotExist(err):
+		return snapshot, fmt.Errorf("impossibile leggere %s: %w", snapshot.Path, err)
 	}
-	for name, server := range localServers {
-		result[name] = server
+	return snapshot, nil
+}
+
+// Restore riporta un file di progetto al contenuto di uno Snapshot, eliminandolo se
+// prima non esisteva
+func (r *ProjectConfigRepository) Restore(snapshot ProjectFileSnapshot) error {
+	if !snapshot.Exists {
+		if err := os.Remove(snapshot.Path); err != nil && !os.IsNotExist(err) {
+			return fmt.Errorf("impossibile eliminare %s: %w", snapshot.Path, err)
+		}
+		return nil
 	}
+	return writeFileAtomic(snapshot.Path, snapshot.Data, 0600)
+}
 
-	return result, nil
+// hasAdditions verifica se tra le modifiche c'è almeno un server da scrivere
+func hasAdditions(changes map[string]*domain.MCPServer) bool {
+	for _, server := range changes {
+		if server != nil {
+			return true
+		}
+	}
+	return false
+}
+
+// HasMCPJson verifica se un progetto ha un file .mcp.json
+func (r *ProjectConfigRepository) HasMCPJson(projectPath string) bool {
+	return fileExists(r.FilePath(projectPath, domain.ScopeProjectFile))
+}
+
+// HasMCPLocal verifica se un progetto ha un file .mcp.local.json
+func (r *ProjectConfigRepository) HasMCPLocal(projectPath string) bool {
+	return fileExists(r.FilePath(projectPath, domain.ScopeProjectLocal))
 }
@@ -0,0 +1,54 @@
+//go:build unix
+
+package infrastructure
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/strawberry-code/mcp-curator/internal/domain"
+)
+
+func TestUpdateServersFilePermissions(t *testing.T) {
+	dir := t.TempDir()
+	repo := NewProjectConfigRepository()
+	server := &domain.MCPServer{Type: domain.ServerTypeStdio, Command: "npx"}
+	changes := map[string]*domain.MCPServer{"demo": server}
+
+	// I file nuovi: .mcp.local.json è personale, .mcp.json condiviso
+	for scope, want := range map[domain.Scope]os.FileMode{
+		domain.ScopeProjectLocal: 0600,
+		domain.ScopeProjectFile:  0644,
+	} {
+		if err := repo.UpdateServers(dir, scope, changes); err != nil {
+			t.Fatalf("UpdateServers(%s): %v", scope, err)
+		}
+		assertPerm(t, repo.FilePath(dir, scope), want)
+	}
+
+	// Il backup prende i permessi del file originale, anche se esisteva già più aperto
+	path := repo.FilePath(dir, domain.ScopeProjectLocal)
+	if err := os.WriteFile(path+".bak", []byte("{}"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Chmod(path+".bak", 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err := repo.UpdateServers(dir, domain.ScopeProjectLocal, changes); err != nil {
+		t.Fatalf("UpdateServers: %v", err)
+	}
+	assertPerm(t, path+".bak", 0600)
+	assertPerm(t, path, 0600)
+}
+
+func assertPerm(t *testing.T, path string, want os.FileMode) {
+	t.Helper()
+	info, err := os.Stat(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := info.Mode().Perm(); got != want {
+		t.Errorf("%s: permessi %o, attesi %o", filepath.Base(path), got, want)
+	}
+}
@@ -0,0 +1,102 @@
+package infrastructure
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/strawberry-code/mcp-curator/internal/domain"
+)
+
+// DefaultDiscoveryDepth è la profondità massima di ricerca sotto ogni cartella radice
+const DefaultDiscoveryDepth = 4
+
+// skippedDirs sono le cartelle di dipendenze, che non contengono progetti da cercare
+var skippedDirs = map[string]bool{
+	"node_modules": true,
+	"vendor":       true,
+}
+
+// ScanProjectFolders cerca sotto le cartelle radice quelle che contengono .mcp.json o
+// .mcp.local.json, fino a maxDepth livelli (0: DefaultDiscoveryDepth). Le cartelle nascoste
+// e quelle di dipendenze vengono saltate, così come le sottocartelle di un progetto
+// trovato. Le radici illeggibili sono riportate nell'errore, le sottocartelle ignorate.
+func ScanProjectFolders(roots []string, maxDepth int) ([]string, error) {
+	if maxDepth <= 0 {
+		maxDepth = DefaultDiscoveryDepth
+	}
+
+	found := make(map[string]bool)
+	var errs []error
+	for _, root := range roots {
+		root, err := ExpandPath(root)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		if info, err := os.Stat(root); err != nil || !info.IsDir() {
+			errs = append(errs, fmt.Errorf("cartella non valida: %s", root))
+			continue
+		}
+
+		_ = filepath.WalkDir(root, func(path string, d fs.DirEntry, err error) error {
+			if err != nil || !d.IsDir() {
+				return nil
+			}
+			if path != root && (strings.HasPrefix(d.Name(), ".") || skippedDirs[d.Name()]) {
+				return filepath.SkipDir
+			}
+			if hasProjectConfig(path) {
+				found[path] = true
+				return filepath.SkipDir
+			}
+			if depth(root, path) >= maxDepth {
+				return filepath.SkipDir
+			}
+			return nil
+		})
+	}
+
+	paths := make([]string, 0, len(found))
+	for path := range found {
+		paths = append(paths, path)
+	}
+	sort.Strings(paths)
+	return paths, errors.Join(errs...)
+}
+
+// hasProjectConfig verifica se una cartella contiene un file di configurazione MCP di progetto
+func hasProjectConfig(dir string) bool {
+	for _, scope := range domain.ProjectScopes {
+		if name := scope.FileName(); name != "" && fileExists(filepath.Join(dir, name)) {
+			return true
+		}
+	}
+	return false
+}
+
+// depth restituisce quanti livelli separano path dalla radice
+func depth(root, path string) int {
+	rel, err := filepath.Rel(root, path)
+	if err != nil || rel == "." {
+		return 0
+	}
+	return strings.Count(rel, string(filepath.Separator)) + 1
+}
+
+// ExpandPath espande ~ nella home dell'utente e rende il percorso assoluto, come le chiavi
+// dei progetti in ~/.claude.json
+func ExpandPath(path string) (string, error) {
+	if path == "~" || strings.HasPrefix(path, "~"+string(filepath.Separator)) {
+		home, err := os.UserHomeDir()
+		if err != nil {
+			return "", fmt.Errorf("impossibile determinare la home directory: %w", err)
+		}
+		path = filepath.Join(home, path[1:])
+	}
+	return filepath.Abs(path)
+}
@@ -1,20 +1,32 @@
 package infrastructure
 
 import (
+	"bytes"
 	"encoding/json"
 	"fmt"
-	"os"
+	"io"
+	"maps"
+	"slices"
 
 	"github.com/strawberry-code/mcp-curator/internal/domain"
 )
 
-// ParseServer converte un map[string]interface{} in MCPServer
+// ParseServer converte un map[string]interface{} in MCPServer.
+// Il map originale viene conservato in Raw, così i campi sconosciuti sopravvivono al salvataggio.
 func ParseServer(data interface{}) (domain.MCPServer, error) {
 	serverMap, ok := data.(map[string]interface{})
 	if !ok {
 		return domain.MCPServer{}, fmt.Errorf("formato server non valido")
 	}
 
+	server := parseKnownFields(serverMap)
+	server.Raw = domain.CloneRaw(serverMap)
+
+	return server, nil
+}
+
+// parseKnownFields estrae i campi gestiti dal curator, ignorando valori di tipo inatteso
+func parseKnownFields(serverMap map[string]interface{}) domain.MCPServer {
 	server := domain.MCPServer{}
 
 	if t, ok := serverMap["type"].(string); ok {
@@ -26,8 +38,13 @@ func ParseServer(data interface{}) (domain.MCPServer, error) {
 	if url, ok := serverMap["url"].(string); ok {
 		server.URL = url
 	}
-	if timeout, ok := serverMap["timeout"].(float64); ok {
+	switch timeout := serverMap["timeout"].(type) {
+	case float64:
 		server.Timeout = int(timeout)
+	case json.Number:
+		if n, err := timeout.Int64(); err == nil {
+			server.Timeout = int(n)
+		}
 	}
 
 	if args, ok := serverMap["args"].([]interface{}); ok {
@@ -39,81 +56,102 @@ func ParseServer(data interface{}) (domain.MCPServer, error) {
 		}
 	}
 
-	if headers, ok := serverMap["headers"].(map[string]interface{}); ok {
-		server.Headers = make(map[string]string)
-		for k, v := range headers {
-			if s, ok := v.(string); ok {
-				server.Headers[k] = s
-			}
-		}
-	}
+	server.Headers = parseStringMap(serverMap["headers"])
+	server.Env = parseStringMap(serverMap["env"])
 
-	if env, ok := serverMap["env"].(map[string]interface{}); ok {
-		server.Env = make(map[string]string)
-		for k, v := range env {
-			if s, ok := v.(string); ok {
-				server.Env[k] = s
-			}
+	return server
+}
+
+// parseStringMap estrae le coppie con valore stringa da un oggetto JSON
+func parseStringMap(data interface{}) map[string]string {
+	raw, ok := data.(map[string]interface{})
+	if !ok {
+		return nil
+	}
+	result := make(map[string]string)
+	for k, v := range raw {
+		if s, ok := v.(string); ok {
+			result[k] = s
 		}
 	}
-
-	return server, nil
+	return result
 }
 
-// ServerToMap converte MCPServer in map[string]interface{}
+// ServerToMap converte MCPServer in map[string]interface{}.
+// Se il server ha un JSON originale (Raw) parte da quello e aggiorna solo i campi
+// effettivamente modificati; env e headers vengono aggiornati chiave per chiave.
 func ServerToMap(server domain.MCPServer) map[string]interface{} {
-	result := make(map[string]interface{})
-
-	if server.Type != "" {
-		result["type"] = string(server.Type)
+	result := domain.CloneRaw(server.Raw)
+	if result == nil {
+		result = make(map[string]interface{})
 	}
-	if server.Command != "" {
-		result["command"] = server.Command
-	}
-	if server.URL != "" {
-		result["url"] = server.URL
+	original := parseKnownFields(result)
+
+	if server.Type != original.Type {
+		setOrDelete(result, "type", string(server.Type), server.Type != "")
 	}
-	if server.Timeout > 0 {
-		result["timeout"] = server.Timeout
+	if server.Command != original.Command {
+		setOrDelete(result, "command", server.Command, server.Command != "")
 	}
-	if len(server.Args) > 0 {
-		result["args"] = server.Args
+	if server.URL != original.URL {
+		setOrDelete(result, "url", server.URL, server.URL != "")
 	}
-	if len(server.Headers) > 0 {
-		result["headers"] = server.Headers
+	if server.Timeout != original.Timeout {
+		setOrDelete(result, "timeout", server.Timeout, server.Timeout > 0)
 	}
-	if len(server.Env) > 0 {
-		result["env"] = server.Env
+	if !slices.Equal(server.Args, original.Args) {
+		setOrDelete(result, "args", server.Args, len(server.Args) > 0)
 	}
+	mergeStringMap(result, "headers", original.Headers, server.Headers)
+	mergeStringMap(result, "env", original.Env, server.Env)
 
 	return result
 }
 
-// LoadMCPFileServers carica i server da un file .mcp.json o .mcp.local.json
-func LoadMCPFileServers(path string) map[string]domain.MCPServer {
-	result := make(map[string]domain.MCPServer)
-
-	data, err := os.ReadFile(path)
-	if err != nil {
-		return result
+// setOrDelete imposta una chiave se present è vero, altrimenti la rimuove
+func setOrDelete(result map[string]interface{}, key string, value interface{}, present bool) {
+	if present {
+		result[key] = value
+		return
 	}
+	delete(result, key)
+}
 
-	var raw struct {
-		MCPServers map[string]interface{} `json:"mcpServers"`
+// mergeStringMap applica a result[key] solo le differenze tra original e current,
+// lasciando intatte le chiavi con valori non stringa
+func mergeStringMap(result map[string]interface{}, key string, original, current map[string]string) {
+	if maps.Equal(original, current) {
+		return
 	}
 
-	if err := json.Unmarshal(data, &raw); err != nil {
-		return result
+	target, ok := result[key].(map[string]interface{})
+	if !ok {
+		target = make(map[string]interface{})
 	}
-
-	for name, serverData := range raw.MCPServers {
-		server, err := ParseServer(serverData)
-		if err != nil {
-			continue
+	for k := range original {
+		if _, ok := current[k]; !ok {
+			delete(target, k)
+		}
+	}
+	for k, v := range current {
+		if old, ok := original[k]; !ok || old != v {
+			target[k] = v
 		}
-		server.Name = name
-		result[name] = server
 	}
 
-	return result
+	setOrDelete(result, key, target, len(target) > 0)
+}
+
+// decodeJSON decodifica un documento JSON mantenendo i numeri come json.Number,
+// così vengono riscritti esattamente come erano
+func decodeJSON(data []byte, v interface{}) error {
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+	if err := decoder.Decode(v); err != nil {
+		return err
+	}
+	if _, err := decoder.Token(); err != io.EOF {
+		return fmt.Errorf("contenuto inatteso dopo il documento JSON")
+	}
+	return nil
 }
@@ -0,0 +1,83 @@
+package infrastructure
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/strawberry-code/mcp-curator/internal/domain"
+)
+
+// TestServerEditRoundTrip verifica che aprire e salvare un server nel form senza
+// modifiche riscriva lo stesso JSON, e che una modifica tocchi solo il campo cambiato
+func TestServerEditRoundTrip(t *testing.T) {
+	tests := []struct {
+		name string
+		raw  string
+		edit func(*domain.ServerEdit)
+		want string
+	}{
+		{
+			name: "stdio senza type",
+			raw:  `{"command":"npx","args":["-y","pkg"],"env":{"A":"1"}}`,
+		},
+		{
+			name: "remoto senza type",
+			raw:  `{"url":"https://example.com/mcp","headers":{"Authorization":"Bearer ${TOKEN}"}}`,
+		},
+		{
+			name: "sse esplicito con timeout",
+			raw:  `{"type":"sse","url":"https://example.com/sse","timeout":30000}`,
+		},
+		{
+			name: "campi sconosciuti e valori non stringa",
+			raw:  `{"command":"node","env":{"N":5,"A":"x"},"alwaysAllow":["read"]}`,
+		},
+		{
+			name: "modifica del comando senza type",
+			raw:  `{"command":"npx","args":["pkg"],"custom":true}`,
+			edit: func(e *domain.ServerEdit) { e.Command = "uvx" },
+			want: `{"command":"uvx","args":["pkg"],"custom":true}`,
+		},
+		{
+			name: "cambio di tipo scelto dall'utente",
+			raw:  `{"url":"https://example.com/mcp"}`,
+			edit: func(e *domain.ServerEdit) { e.Type = domain.ServerTypeSSE },
+			want: `{"type":"sse","url":"https://example.com/mcp"}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var raw map[string]interface{}
+			if err := decodeJSON([]byte(tt.raw), &raw); err != nil {
+				t.Fatal(err)
+			}
+			server, err := ParseServer(raw)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			edit := domain.NewServerEdit(&server)
+			if tt.edit != nil {
+				tt.edit(&edit)
+			}
+			got, err := json.Marshal(ServerToMap(edit.Apply(&server)))
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			want := tt.want
+			if want == "" {
+				want = tt.raw
+			}
+			var wantValue interface{}
+			if err := json.Unmarshal([]byte(want), &wantValue); err != nil {
+				t.Fatal(err)
+			}
+			wantJSON, _ := json.Marshal(wantValue)
+			if string(got) != string(wantJSON) {
+				t.Errorf("JSON salvato %s, atteso %s", got, wantJSON)
+			}
+		})
+	}
+}
@@ -0,0 +1,100 @@
+package infrastructure
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+// Settings sono le preferenze del curator, condivise tra GUI e CLI
+type Settings struct {
+	Backup    BackupSettings    `json:"backup"`
+	Templates TemplateSettings  `json:"templates"`
+	Discovery DiscoverySettings `json:"discovery"`
+}
+
+// BackupSettings configura la conservazione dei backup di ~/.claude.json
+type BackupSettings struct {
+	Dir        string `json:"dir,omitempty"`
+	MaxCount   int    `json:"maxCount"`
+	MaxAgeDays int    `json:"maxAgeDays,omitempty"`
+}
+
+// TemplateSettings elenca i cataloghi di template aggiuntivi (file JSON o directory)
+type TemplateSettings struct {
+	Catalogs []string `json:"catalogs,omitempty"`
+}
+
+// DiscoverySettings elenca le cartelle in cui cercare progetti non registrati
+type DiscoverySettings struct {
+	Roots    []string `json:"roots,omitempty"`
+	MaxDepth int      `json:"maxDepth,omitempty"`
+}
+
+// DefaultSettings restituisce le preferenze predefinite
+func DefaultSettings() Settings {
+	return Settings{
+		Backup: BackupSettings{MaxCount: DefaultBackupPolicy().MaxCount},
+	}
+}
+
+// Policy converte le preferenze nella politica di backup
+func (b BackupSettings) Policy() BackupPolicy {
+	return BackupPolicy{
+		Dir:      b.Dir,
+		MaxCount: b.MaxCount,
+		MaxAge:   time.Duration(b.MaxAgeDays) * 24 * time.Hour,
+	}
+}
+
+// SettingsRepository legge e scrive le preferenze in <config dir>/mcp-curator/settings.json
+type SettingsRepository struct {
+	path string
+}
+
+// NewSettingsRepository crea un repository per le preferenze nella directory di configurazione utente
+func NewSettingsRepository() (*SettingsRepository, error) {
+	dir, err := os.UserConfigDir()
+	if err != nil {
+		return nil, fmt.Errorf("impossibile determinare la directory di configurazione: %w", err)
+	}
+	return &SettingsRepository{path: filepath.Join(dir, "mcp-curator", "settings.json")}, nil
+}
+
+// GetPath restituisce il path del file delle preferenze
+func (r *SettingsRepository) GetPath() string {
+	return r.path
+}
+
+// Load carica le preferenze (quelle predefinite se il file non esiste)
+func (r *SettingsRepository) Load() (Settings, error) {
+	settings := DefaultSettings()
+
+	data, err := os.ReadFile(r.path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return settings, nil
+		}
+		return settings, fmt.Errorf("impossibile leggere %s: %w", r.path, err)
+	}
+
+	if err := json.Unmarshal(data, &settings); err != nil {
+		return DefaultSettings(), fmt.Errorf("JSON non valido in %s: %w", r.path, err)
+	}
+	return settings, nil
+}
+
+// Save salva le preferenze
+func (r *SettingsRepository) Save(settings Settings) error {
+	if err := os.MkdirAll(filepath.Dir(r.path), 0700); err != nil {
+		return fmt.Errorf("impossibile creare %s: %w", filepath.Dir(r.path), err)
+	}
+
+	data, err := json.MarshalIndent(settings, "", "  ")
+	if err != nil {
+		return fmt.Errorf("impossibile serializzare le preferenze: %w", err)
+	}
+	return writeFileAtomic(r.path, append(data, '\n'), 0600)
+}
@@ -0,0 +1,118 @@
+package infrastructure
+
+import (
+	"bytes"
+	_ "embed"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/strawberry-code/mcp-curator/internal/domain"
+)
+
+// builtinCatalog è il catalogo di template incluso nel programma
+//
+//go:embed templates/builtin.json
+var builtinCatalog []byte
+
+// catalogFile è il formato di un file di catalogo: i server usano lo stesso JSON
+// di mcpServers e possono contenere segnaposto {{parametro}}
+type catalogFile struct {
+	Templates []catalogTemplate `json:"templates"`
+}
+
+type catalogTemplate struct {
+	ID          string                     `json:"id"`
+	Name        string                     `json:"name"`
+	Description string                     `json:"description"`
+	Category    string                     `json:"category"`
+	Homepage    string                     `json:"homepage"`
+	Parameters  []domain.TemplateParameter `json:"parameters"`
+	Server      map[string]interface{}     `json:"server"`
+}
+
+// BuiltinTemplates restituisce i template inclusi nel programma
+func BuiltinTemplates() ([]domain.ServerTemplate, error) {
+	return parseCatalog(builtinCatalog, "")
+}
+
+// LoadTemplateCatalog legge un catalogo da un file JSON o da tutti i file .json di una
+// directory (es. una cartella condivisa dal team), in ordine alfabetico
+func LoadTemplateCatalog(path string) ([]domain.ServerTemplate, error) {
+	info, err := os.Stat(path)
+	if err != nil {
+		return nil, fmt.Errorf("catalogo di template non leggibile: %w", err)
+	}
+	if !info.IsDir() {
+		return loadCatalogFile(path)
+	}
+
+	files, err := filepath.Glob(filepath.Join(path, "*.json"))
+	if err != nil {
+		return nil, err
+	}
+	sort.Strings(files)
+	var templates []domain.ServerTemplate
+	for _, file := range files {
+		loaded, err := loadCatalogFile(file)
+		if err != nil {
+			return nil, err
+		}
+		templates = append(templates, loaded...)
+	}
+	return templates, nil
+}
+
+// loadCatalogFile legge un singolo file di catalogo
+func loadCatalogFile(path string) ([]domain.ServerTemplate, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("impossibile leggere %s: %w", path, err)
+	}
+	templates, err := parseCatalog(data, path)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	return templates, nil
+}
+
+// parseCatalog decodifica un catalogo e controlla ogni template
+func parseCatalog(data []byte, source string) ([]domain.ServerTemplate, error) {
+	var file catalogFile
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+	if err := decoder.Decode(&file); err != nil {
+		return nil, fmt.Errorf("catalogo JSON non valido: %w", err)
+	}
+
+	templates := make([]domain.ServerTemplate, 0, len(file.Templates))
+	for _, t := range file.Templates {
+		server, err := ParseServer(t.Server)
+		if err != nil {
+			return nil, fmt.Errorf("template '%s': %w", t.ID, err)
+		}
+		for i, p := range t.Parameters {
+			if p.Kind == "" {
+				t.Parameters[i].Kind = domain.ParameterString
+			}
+		}
+		template := domain.ServerTemplate{
+			ID:          strings.TrimSpace(t.ID),
+			Name:        t.Name,
+			Description: t.Description,
+			Category:    t.Category,
+			Homepage:    t.Homepage,
+			Parameters:  t.Parameters,
+			Server:      server,
+			Source:      source,
+		}
+		if err := template.Check(); err != nil {
+			return nil, err
+		}
+		templates = append(templates, template)
+	}
+	return templates, nil
+}
@@ -0,0 +1,338 @@
+package infrastructure
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/pbkdf2"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"time"
+)
+
+// Parametri di derivazione della chiave del vault (raccomandazioni OWASP per PBKDF2-SHA256)
+const (
+	vaultVersion    = 1
+	vaultKDF        = "pbkdf2-sha256"
+	vaultIterations = 600000
+	vaultSaltSize   = 16
+	vaultKeySize    = 32
+)
+
+var (
+	// ErrVaultNotFound indica che il vault non è ancora stato creato
+	ErrVaultNotFound = errors.New("vault non ancora creato")
+	// ErrVaultLocked indica che l'operazione richiede lo sblocco del vault
+	ErrVaultLocked = errors.New("vault bloccato: inserire la passphrase")
+	// ErrVaultPassphrase indica una passphrase errata (o un file alterato)
+	ErrVaultPassphrase = errors.New("passphrase del vault errata")
+)
+
+// vaultFile è il formato su disco del vault. Solo i nomi dei segreti e la data
+// di modifica sono in chiaro, così da riconoscere i riferimenti anche a vault bloccato;
+// i valori sono cifrati con AES-256-GCM.
+type vaultFile struct {
+	Version    int                  `json:"version"`
+	KDF        string               `json:"kdf"`
+	Iterations int                  `json:"iterations"`
+	Salt       []byte               `json:"salt"`
+	Nonce      []byte               `json:"nonce"`
+	Entries    map[string]time.Time `json:"entries"`
+	Data       []byte               `json:"data"`
+}
+
+// VaultRepository gestisce il vault dei segreti in <config dir>/mcp-curator/vault.json.
+// I segreti restano in memoria solo dopo Unlock e fino a Lock.
+type VaultRepository struct {
+	path string
+
+	// file è l'ultima versione letta o scritta (nil se il vault non esiste)
+	file *vaultFile
+	// key e secrets sono valorizzati solo a vault sbloccato
+	key     []byte
+	secrets map[string]string
+}
+
+// NewVaultRepository crea il repository del vault nella directory di configurazione utente
+func NewVaultRepository() (*VaultRepository, error) {
+	dir, err := os.UserConfigDir()
+	if err != nil {
+		return nil, fmt.Errorf("impossibile determinare la directory di configurazione: %w", err)
+	}
+	return NewVaultRepositoryWithPath(filepath.Join(dir, "mcp-curator", "vault.json")), nil
+}
+
+// NewVaultRepositoryWithPath crea il repository del vault nel file indicato
+func NewVaultRepositoryWithPath(path string) *VaultRepository {
+	return &VaultRepository{path: path}
+}
+
+// GetPath restituisce il path del file del vault
+func (v *VaultRepository) GetPath() string {
+	return v.path
+}
+
+// Exists verifica se il vault è stato creato
+func (v *VaultRepository) Exists() bool {
+	_, err := os.Stat(v.path)
+	return err == nil
+}
+
+// Unlocked indica se i segreti sono disponibili in memoria
+func (v *VaultRepository) Unlocked() bool {
+	return v.secrets != nil
+}
+
+// Create crea un vault vuoto protetto dalla passphrase e lo lascia sbloccato
+func (v *VaultRepository) Create(passphrase string) error {
+	if v.Exists() {
+		return fmt.Errorf("il vault esiste già: %s", v.path)
+	}
+	if passphrase == "" {
+		return fmt.Errorf("la passphrase non può essere vuota")
+	}
+	return v.rekey(passphrase, make(map[string]string), make(map[string]time.Time))
+}
+
+// Unlock decifra i segreti con la passphrase
+func (v *VaultRepository) Unlock(passphrase string) error {
+	file, err := v.read()
+	if err != nil {
+		return err
+	}
+	key, err := deriveVaultKey(passphrase, file.Salt, file.Iterations)
+	if err != nil {
+		return err
+	}
+	plain, err := vaultAEAD(key, func(aead cipher.AEAD) ([]byte, error) {
+		return aead.Open(nil, file.Nonce, file.Data, nil)
+	})
+	if err != nil {
+		return ErrVaultPassphrase
+	}
+
+	secrets := make(map[string]string)
+	if err := json.Unmarshal(plain, &secrets); err != nil {
+		return fmt.Errorf("contenuto del vault non valido: %w", err)
+	}
+	v.file = file
+	v.key = key
+	v.secrets = secrets
+	return nil
+}
+
+// Lock dimentica chiave e segreti
+func (v *VaultRepository) Lock() {
+	v.key = nil
+	v.secrets = nil
+}
+
+// Names restituisce i nomi dei segreti in ordine alfabetico, anche a vault bloccato
+func (v *VaultRepository) Names() []string {
+	file := v.file
+	if file == nil {
+		var err error
+		if file, err = v.read(); err != nil {
+			return nil
+		}
+		v.file = file
+	}
+	names := make([]string, 0, len(file.Entries))
+	for name := range file.Entries {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// Has verifica se esiste un segreto con il nome indicato, anche a vault bloccato
+func (v *VaultRepository) Has(name string) bool {
+	for _, n := range v.Names() {
+		if n == name {
+			return true
+		}
+	}
+	return false
+}
+
+// Updated restituisce la data dell'ultima modifica di un segreto
+func (v *VaultRepository) Updated(name string) time.Time {
+	if v.file == nil {
+		return time.Time{}
+	}
+	return v.file.Entries[name]
+}
+
+// Get restituisce il valore di un segreto (richiede il vault sbloccato)
+func (v *VaultRepository) Get(name string) (string, bool, error) {
+	if !v.Unlocked() {
+		return "", false, ErrVaultLocked
+	}
+	value, ok := v.secrets[name]
+	return value, ok, nil
+}
+
+// Set crea o sostituisce un segreto e salva il vault
+func (v *VaultRepository) Set(name, value string) error {
+	if !v.Unlocked() {
+		return ErrVaultLocked
+	}
+	secrets := copyStrings(v.secrets)
+	secrets[name] = value
+	entries := copyTimes(v.file.Entries)
+	entries[name] = time.Now()
+	return v.save(secrets, entries)
+}
+
+// Delete elimina un segreto e salva il vault
+func (v *VaultRepository) Delete(name string) error {
+	if !v.Unlocked() {
+		return ErrVaultLocked
+	}
+	if _, ok := v.secrets[name]; !ok {
+		return fmt.Errorf("segreto '%s' non presente nel vault", name)
+	}
+	secrets := copyStrings(v.secrets)
+	delete(secrets, name)
+	entries := copyTimes(v.file.Entries)
+	delete(entries, name)
+	return v.save(secrets, entries)
+}
+
+// ChangePassphrase cifra di nuovo il vault con una nuova passphrase (e un nuovo salt)
+func (v *VaultRepository) ChangePassphrase(passphrase string) error {
+	if !v.Unlocked() {
+		return ErrVaultLocked
+	}
+	if passphrase == "" {
+		return fmt.Errorf("la passphrase non può essere vuota")
+	}
+	return v.rekey(passphrase, v.secrets, v.file.Entries)
+}
+
+// rekey deriva una nuova chiave da passphrase e salt casuale e salva i segreti
+func (v *VaultRepository) rekey(passphrase string, secrets map[string]string, entries map[string]time.Time) error {
+	salt := make([]byte, vaultSaltSize)
+	if _, err := rand.Read(salt); err != nil {
+		return fmt.Errorf("impossibile generare il salt: %w", err)
+	}
+	key, err := deriveVaultKey(passphrase, salt, vaultIterations)
+	if err != nil {
+		return err
+	}
+
+	previousFile, previousKey := v.file, v.key
+	v.file = &vaultFile{Version: vaultVersion, KDF: vaultKDF, Iterations: vaultIterations, Salt: salt}
+	v.key = key
+	if err := v.save(secrets, entries); err != nil {
+		v.file, v.key = previousFile, previousKey
+		return err
+	}
+	return nil
+}
+
+// save cifra i segreti con un nonce nuovo e scrive il file; lo stato in memoria
+// viene aggiornato solo se la scrittura riesce
+func (v *VaultRepository) save(secrets map[string]string, entries map[string]time.Time) error {
+	plain, err := json.Marshal(secrets)
+	if err != nil {
+		return fmt.Errorf("impossibile serializzare il vault: %w", err)
+	}
+
+	file := *v.file
+	file.Entries = entries
+	file.Data, err = vaultAEAD(v.key, func(aead cipher.AEAD) ([]byte, error) {
+		file.Nonce = make([]byte, aead.NonceSize())
+		if _, err := rand.Read(file.Nonce); err != nil {
+			return nil, fmt.Errorf("impossibile generare il nonce: %w", err)
+		}
+		return aead.Seal(nil, file.Nonce, plain, nil), nil
+	})
+	if err != nil {
+		return err
+	}
+
+	data, err := json.MarshalIndent(file, "", "  ")
+	if err != nil {
+		return fmt.Errorf("impossibile serializzare il vault: %w", err)
+	}
+	if err := os.MkdirAll(filepath.Dir(v.path), 0700); err != nil {
+		return fmt.Errorf("impossibile creare %s: %w", filepath.Dir(v.path), err)
+	}
+	if err := writeFileAtomic(v.path, append(data, '\n'), 0600); err != nil {
+		return err
+	}
+
+	v.file = &file
+	v.secrets = secrets
+	return nil
+}
+
+// read legge il file del vault e ne verifica il formato
+func (v *VaultRepository) read() (*vaultFile, error) {
+	data, err := os.ReadFile(v.path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, ErrVaultNotFound
+		}
+		return nil, fmt.Errorf("impossibile leggere %s: %w", v.path, err)
+	}
+
+	var file vaultFile
+	if err := json.Unmarshal(data, &file); err != nil {
+		return nil, fmt.Errorf("JSON non valido in %s: %w", v.path, err)
+	}
+	if file.Version != vaultVersion || file.KDF != vaultKDF {
+		return nil, fmt.Errorf("formato del vault non supportato: versione %d, kdf %s", file.Version, file.KDF)
+	}
+	if file.Entries == nil {
+		file.Entries = make(map[string]time.Time)
+	}
+	return &file, nil
+}
+
+// deriveVaultKey deriva la chiave AES-256 dalla passphrase
+func deriveVaultKey(passphrase string, salt []byte, iterations int) ([]byte, error) {
+	if iterations <= 0 || len(salt) == 0 {
+		return nil, fmt.Errorf("parametri di derivazione della chiave non validi")
+	}
+	key, err := pbkdf2.Key(sha256.New, passphrase, salt, iterations, vaultKeySize)
+	if err != nil {
+		return nil, fmt.Errorf("impossibile derivare la chiave del vault: %w", err)
+	}
+	return key, nil
+}
+
+// vaultAEAD esegue fn con il cifrario AES-GCM della chiave
+func vaultAEAD(key []byte, fn func(cipher.AEAD) ([]byte, error)) ([]byte, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, fmt.Errorf("chiave del vault non valida: %w", err)
+	}
+	aead, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, fmt.Errorf("chiave del vault non valida: %w", err)
+	}
+	return fn(aead)
+}
+
+func copyStrings(m map[string]string) map[string]string {
+	out := make(map[string]string, len(m))
+	for k, val := range m {
+		out[k] = val
+	}
+	return out
+}
+
+func copyTimes(m map[string]time.Time) map[string]time.Time {
+	out := make(map[string]time.Time, len(m))
+	for k, val := range m {
+		out[k] = val
+	}
+	return out
+}
@@ -0,0 +1,59 @@
+package infrastructure
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// VaultPassphraseEnv è la variabile con cui il launcher passa la passphrase a `vault export`
+const VaultPassphraseEnv = "MCP_CURATOR_PASSPHRASE"
+
+// launcherTemplate avvia Claude Code con i segreti del vault esportati come variabili
+// d'ambiente: i server che usano ${NOME} li ricevono senza che compaiano nei file di configurazione
+const launcherTemplate = `#!/bin/sh
+# Generato da mcp-curator: esporta i segreti del vault usati come ${NOME}
+# nei server MCP e avvia Claude Code. Il file non contiene segreti.
+set -e
+
+if [ -z "$%[1]s" ]; then
+	printf 'Passphrase del vault mcp-curator: ' >&2
+	stty -echo 2>/dev/null || true
+	IFS= read -r %[1]s || true
+	stty echo 2>/dev/null || true
+	printf '\n' >&2
+fi
+export %[1]s
+
+secrets=$(%[2]s vault export)
+unset %[1]s
+eval "$secrets"
+unset secrets
+
+exec claude "$@"
+`
+
+// WriteVaultLauncher scrive lo script di avvio di Claude Code in path (eseguibile dal solo utente).
+// command è la riga di comando del curator usata per leggere i segreti (eseguibile e flag globali).
+func WriteVaultLauncher(path string, command []string) error {
+	quoted := make([]string, len(command))
+	for i, arg := range command {
+		quoted[i] = ShellQuote(arg)
+	}
+	script := fmt.Sprintf(launcherTemplate, VaultPassphraseEnv, strings.Join(quoted, " "))
+
+	if err := os.MkdirAll(filepath.Dir(path), 0700); err != nil {
+		return fmt.Errorf("impossibile creare %s: %w", filepath.Dir(path), err)
+	}
+	if err := writeFileAtomic(path, []byte(script), 0700); err != nil {
+		return err
+	}
+	// writeFileAtomic conserva i permessi di un file esistente: lo script deve restare eseguibile
+	return os.Chmod(path, 0700)
+}
+
+// ShellQuote racchiude un valore tra apici singoli per la shell POSIX
+func ShellQuote(value string) string {
+	return "'" + strings.ReplaceAll(value, "'", `'\''`) + "'"
+}
@@ -0,0 +1,79 @@
+package infrastructure
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestVaultRoundTrip(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "mcp-curator", "vault.json")
+	vault := NewVaultRepositoryWithPath(path)
+	if err := vault.Create("correct horse"); err != nil {
+		t.Fatalf("Create: %v", err)
+	}
+	if err := vault.Set("API_TOKEN", "s3cret-value"); err != nil {
+		t.Fatalf("Set: %v", err)
+	}
+
+	// Su disco i valori sono cifrati, i nomi restano in chiaro
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if bytes.Contains(data, []byte("s3cret-value")) {
+		t.Error("il valore del segreto è salvato in chiaro")
+	}
+
+	reopened := NewVaultRepositoryWithPath(path)
+	if names := reopened.Names(); len(names) != 1 || names[0] != "API_TOKEN" {
+		t.Errorf("Names a vault bloccato = %v", names)
+	}
+	if _, _, err := reopened.Get("API_TOKEN"); !errors.Is(err, ErrVaultLocked) {
+		t.Errorf("Get a vault bloccato: %v, atteso ErrVaultLocked", err)
+	}
+	if err := reopened.Unlock("correct horse"); err != nil {
+		t.Fatalf("Unlock: %v", err)
+	}
+	value, ok, err := reopened.Get("API_TOKEN")
+	if err != nil || !ok || value != "s3cret-value" {
+		t.Errorf("Get = %q, %v, %v", value, ok, err)
+	}
+}
+
+func TestVaultWrongPassphrase(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "vault.json")
+	vault := NewVaultRepositoryWithPath(path)
+	if err := vault.Create("correct horse"); err != nil {
+		t.Fatalf("Create: %v", err)
+	}
+	if err := vault.Set("API_TOKEN", "s3cret-value"); err != nil {
+		t.Fatalf("Set: %v", err)
+	}
+
+	reopened := NewVaultRepositoryWithPath(path)
+	if err := reopened.Unlock("battery staple"); !errors.Is(err, ErrVaultPassphrase) {
+		t.Fatalf("Unlock con passphrase errata: %v, atteso ErrVaultPassphrase", err)
+	}
+	if reopened.Unlocked() {
+		t.Error("il vault risulta sbloccato dopo una passphrase errata")
+	}
+
+	// Un file alterato non si decifra, come con una passphrase errata
+	var file map[string]interface{}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := json.Unmarshal(data, &file); err != nil {
+		t.Fatal(err)
+	}
+	file["nonce"] = "AAAAAAAAAAAAAAAA"
+	writeJSON(t, path, file)
+	if err := reopened.Unlock("correct horse"); !errors.Is(err, ErrVaultPassphrase) {
+		t.Errorf("Unlock di un file alterato: %v, atteso ErrVaultPassphrase", err)
+	}
+}
@@ -0,0 +1,151 @@
+package ui
+
+import (
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/container"
+	"fyne.io/fyne/v2/theme"
+	"fyne.io/fyne/v2/widget"
+
+	"github.com/strawberry-code/mcp-curator/internal/domain"
+	"github.com/strawberry-code/mcp-curator/internal/i18n"
+)
+
+// ArgsEditor modifica gli argomenti di un server stdio uno per riga, così gli argomenti
+// con spazi restano interi. Accetta anche una riga di comando incollata con apici da shell.
+type ArgsEditor struct {
+	args      []string
+	onChanged func()
+	// onPaste riceve le parole della riga incollata e restituisce quelle da usare come argomenti
+	onPaste func(words []string) []string
+
+	list       *fyne.Container
+	pasteEntry *widget.Entry
+	pasteIssue *widget.Label
+	container  *fyne.Container
+}
+
+// NewArgsEditor crea un editor inizializzato con args
+func NewArgsEditor(args []string) *ArgsEditor {
+	ae := &ArgsEditor{args: append([]string(nil), args...)}
+
+	ae.list = container.NewVBox()
+	addBtn := widget.NewButtonWithIcon(i18n.T("form.add_arg"), theme.ContentAddIcon(), func() {
+		ae.args = append(ae.args, "")
+		ae.rebuild()
+		ae.changed()
+	})
+	addBtn.Importance = widget.LowImportance
+
+	ae.pasteEntry = widget.NewEntry()
+	ae.pasteEntry.SetPlaceHolder(i18n.T("form.paste_command_hint"))
+	ae.pasteEntry.Wrapping = fyne.TextWrapOff
+	ae.pasteEntry.OnSubmitted = func(string) { ae.paste() }
+	pasteBtn := widget.NewButtonWithIcon(i18n.T("form.paste_command"), theme.ContentPasteIcon(), ae.paste)
+	ae.pasteIssue = newIssueLabel()
+
+	ae.container = container.NewVBox(
+		ae.list,
+		container.NewHBox(addBtn),
+		container.NewBorder(nil, nil, nil, pasteBtn, ae.pasteEntry),
+		ae.pasteIssue,
+	)
+	ae.rebuild()
+	return ae
+}
+
+// Container restituisce il container dell'editor
+func (ae *ArgsEditor) Container() *fyne.Container {
+	return ae.container
+}
+
+// SetOnChanged registra la funzione chiamata a ogni modifica
+func (ae *ArgsEditor) SetOnChanged(fn func()) {
+	ae.onChanged = fn
+}
+
+// SetOnPaste registra la funzione che filtra le parole di una riga di comando incollata
+// (es. per usare la prima come comando)
+func (ae *ArgsEditor) SetOnPaste(fn func(words []string) []string) {
+	ae.onPaste = fn
+}
+
+// Args restituisce gli argomenti (nil se non ce ne sono)
+func (ae *ArgsEditor) Args() []string {
+	if len(ae.args) == 0 {
+		return nil
+	}
+	return append([]string(nil), ae.args...)
+}
+
+// paste sostituisce gli argomenti con quelli della riga di comando incollata
+func (ae *ArgsEditor) paste() {
+	words, err := domain.SplitCommandLine(ae.pasteEntry.Text)
+	if err != nil {
+		showIssues(ae.pasteIssue, domain.ValidationIssues{{Field: domain.FieldArgs, Severity: domain.SeverityError, Code: "command_line_quote"}})
+		return
+	}
+	showIssues(ae.pasteIssue, nil)
+	if ae.onPaste != nil {
+		words = ae.onPaste(words)
+	}
+	ae.args = words
+	ae.pasteEntry.SetText("")
+	ae.rebuild()
+	ae.changed()
+}
+
+// rebuild ricrea le righe dopo un'aggiunta, una rimozione o uno spostamento
+func (ae *ArgsEditor) rebuild() {
+	ae.list.RemoveAll()
+	for i := range ae.args {
+		ae.list.Add(ae.rowWidget(i))
+	}
+	ae.list.Refresh()
+}
+
+// rowWidget crea il campo di un argomento con i bottoni per spostarlo e rimuoverlo
+func (ae *ArgsEditor) rowWidget(index int) fyne.CanvasObject {
+	entry := widget.NewEntry()
+	entry.Wrapping = fyne.TextWrapOff
+	entry.SetText(ae.args[index])
+	entry.OnChanged = func(text string) {
+		ae.args[index] = text
+		ae.changed()
+	}
+
+	upBtn := widget.NewButtonWithIcon("", theme.MoveUpIcon(), func() { ae.swap(index, index-1) })
+	upBtn.Importance = widget.LowImportance
+	if index == 0 {
+		upBtn.Disable()
+	}
+	downBtn := widget.NewButtonWithIcon("", theme.MoveDownIcon(), func() { ae.swap(index, index+1) })
+	downBtn.Importance = widget.LowImportance
+	if index == len(ae.args)-1 {
+		downBtn.Disable()
+	}
+	removeBtn := widget.NewButtonWithIcon("", theme.DeleteIcon(), func() {
+		ae.args = append(ae.args[:index], ae.args[index+1:]...)
+		ae.rebuild()
+		ae.changed()
+	})
+	removeBtn.Importance = widget.LowImportance
+
+	return container.NewBorder(nil, nil, nil, container.NewHBox(upBtn, downBtn, removeBtn), entry)
+}
+
+// swap scambia due argomenti
+func (ae *ArgsEditor) swap(i, j int) {
+	if j < 0 || j >= len(ae.args) {
+		return
+	}
+	ae.args[i], ae.args[j] = ae.args[j], ae.args[i]
+	ae.rebuild()
+	ae.changed()
+}
+
+// changed notifica una modifica
+func (ae *ArgsEditor) changed() {
+	if ae.onChanged != nil {
+		ae.onChanged()
+	}
+}
@@ -0,0 +1,282 @@
+package ui
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/container"
+	"fyne.io/fyne/v2/dialog"
+	"fyne.io/fyne/v2/widget"
+
+	"github.com/strawberry-code/mcp-curator/internal/domain"
+	"github.com/strawberry-code/mcp-curator/internal/i18n"
+	"github.com/strawberry-code/mcp-curator/internal/infrastructure"
+)
+
+// BackupView è la finestra che elenca i backup di ~/.claude.json con le differenze
+// rispetto al file attuale e permette di ripristinarli
+type BackupView struct {
+	mw     *MainWindow
+	window fyne.Window
+
+	backups  []infrastructure.Backup
+	selected int
+	changes  []domain.ServerChange
+	checks   []*widget.Check
+
+	list        *widget.List
+	diffBox     *fyne.Container
+	restoreAll  *widget.Button
+	restoreSome *widget.Button
+}
+
+// showBackupView apre la finestra dei backup
+func (mw *MainWindow) showBackupView() {
+	bv := &BackupView{
+		mw:       mw,
+		window:   mw.app.NewWindow(i18n.T("backup.title")),
+		selected: -1,
+	}
+	bv.window.SetContent(bv.build())
+	bv.window.Resize(fyne.NewSize(850, 600))
+	bv.reload()
+	bv.window.Show()
+}
+
+// build costruisce il contenuto della finestra
+func (bv *BackupView) build() fyne.CanvasObject {
+	bv.list = widget.NewList(
+		func() int { return len(bv.backups) },
+		func() fyne.CanvasObject { return widget.NewLabel("") },
+		func(id widget.ListItemID, obj fyne.CanvasObject) {
+			backup := bv.backups[id]
+			obj.(*widget.Label).SetText(fmt.Sprintf("%s  (%s)", backup.Time.Format("2006-01-02 15:04:05"), formatSize(backup.Size)))
+		},
+	)
+	bv.list.OnSelected = func(id widget.ListItemID) {
+		bv.selected = id
+		bv.showDiff()
+	}
+
+	bv.diffBox = container.NewVBox(widget.NewLabel(i18n.T("backup.select")))
+
+	bv.restoreSome = widget.NewButton(i18n.T("backup.restore_selected"), func() {
+		bv.confirmRestore(bv.checkedRefs())
+	})
+	bv.restoreAll = widget.NewButton(i18n.T("backup.restore_all"), func() {
+		bv.confirmRestore(nil)
+	})
+	bv.restoreAll.Importance = widget.HighImportance
+	bv.setRestoreEnabled(false)
+
+	right := container.NewBorder(nil,
+		container.NewHBox(bv.restoreSome, bv.restoreAll),
+		nil, nil,
+		container.NewVScroll(bv.diffBox),
+	)
+
+	split := container.NewHSplit(bv.list, right)
+	split.SetOffset(0.35)
+
+	return container.NewBorder(nil, bv.buildSettings(), nil, nil, split)
+}
+
+// buildSettings costruisce il form della politica di conservazione
+func (bv *BackupView) buildSettings() fyne.CanvasObject {
+	settings := bv.mw.service.GetSettings()
+
+	countEntry := widget.NewEntry()
+	countEntry.SetText(strconv.Itoa(settings.Backup.MaxCount))
+	ageEntry := widget.NewEntry()
+	ageEntry.SetText(strconv.Itoa(settings.Backup.MaxAgeDays))
+	dirEntry := widget.NewEntry()
+	dirEntry.SetText(settings.Backup.Dir)
+	dirEntry.SetPlaceHolder(i18n.T("backup.dir_hint"))
+
+	browseBtn := widget.NewButton("…", func() {
+		dialog.ShowFolderOpen(func(uri fyne.ListableURI, err error) {
+			if err == nil && uri != nil {
+				dirEntry.SetText(uri.Path())
+			}
+		}, bv.window)
+	})
+
+	saveBtn := widget.NewButton(i18n.T("btn.save"), func() {
+		maxCount, err := strconv.Atoi(strings.TrimSpace(countEntry.Text))
+		if err != nil {
+			dialog.ShowError(fmt.Errorf("%s: %w", i18n.T("backup.max_count"), err), bv.window)
+			return
+		}
+		maxAge, err := strconv.Atoi(strings.TrimSpace(ageEntry.Text))
+		if err != nil {
+			dialog.ShowError(fmt.Errorf("%s: %w", i18n.T("backup.max_age"), err), bv.window)
+			return
+		}
+
+		settings := bv.mw.service.GetSettings()
+		settings.Backup.MaxCount = maxCount
+		settings.Backup.MaxAgeDays = maxAge
+		settings.Backup.Dir = strings.TrimSpace(dirEntry.Text)
+		if err := bv.mw.service.SaveSettings(settings); err != nil {
+			dialog.ShowError(err, bv.window)
+			return
+		}
+		bv.reload()
+	})
+
+	form := widget.NewForm(
+		widget.NewFormItem(i18n.T("backup.max_count"), countEntry),
+		widget.NewFormItem(i18n.T("backup.max_age"), ageEntry),
+		widget.NewFormItem(i18n.T("backup.dir"), container.NewBorder(nil, nil, nil, browseBtn, dirEntry)),
+	)
+
+	return container.NewVBox(
+		widget.NewSeparator(),
+		widget.NewLabelWithStyle(i18n.T("backup.retention"), fyne.TextAlignLeading, fyne.TextStyle{Bold: true}),
+		form,
+		container.NewHBox(saveBtn),
+	)
+}
+
+// reload ricarica l'elenco dei backup
+func (bv *BackupView) reload() {
+	backups, err := bv.mw.service.ListBackups()
+	if err != nil {
+		dialog.ShowError(err, bv.window)
+		return
+	}
+	bv.backups = backups
+	bv.selected = -1
+	bv.list.UnselectAll()
+	bv.list.Refresh()
+
+	bv.diffBox.RemoveAll()
+	if len(backups) == 0 {
+		bv.diffBox.Add(widget.NewLabel(i18n.T("backup.none")))
+	} else {
+		bv.diffBox.Add(widget.NewLabel(i18n.T("backup.select")))
+	}
+	bv.setRestoreEnabled(false)
+}
+
+// showDiff mostra le differenze tra il backup selezionato e il file attuale
+func (bv *BackupView) showDiff() {
+	bv.diffBox.RemoveAll()
+	bv.checks = nil
+	if bv.selected < 0 || bv.selected >= len(bv.backups) {
+		return
+	}
+	backup := bv.backups[bv.selected]
+
+	changes, err := bv.mw.service.DiffBackup(backup.Path)
+	if err != nil {
+		bv.diffBox.Add(widget.NewLabel(err.Error()))
+		bv.setRestoreEnabled(false)
+		return
+	}
+	bv.changes = changes
+
+	bv.diffBox.Add(widget.NewLabelWithStyle(backup.Path, fyne.TextAlignLeading, fyne.TextStyle{Monospace: true}))
+	bv.diffBox.Add(widget.NewSeparator())
+
+	if len(changes) == 0 {
+		bv.diffBox.Add(widget.NewLabel(i18n.T("backup.no_changes")))
+		bv.setRestoreEnabled(false)
+		return
+	}
+
+	bv.diffBox.Add(widget.NewLabel(i18n.T("backup.diff_hint")))
+	for _, change := range changes {
+		check := widget.NewCheck(bv.changeLabel(change), nil)
+		bv.checks = append(bv.checks, check)
+		bv.diffBox.Add(check)
+	}
+	bv.setRestoreEnabled(true)
+}
+
+// changeLabel descrive l'effetto del ripristino su un server
+func (bv *BackupView) changeLabel(change domain.ServerChange) string {
+	return fmt.Sprintf("%s %s — %s (%s)",
+		changeSymbol(change.Kind), change.Ref.Name,
+		bv.mw.locationLabel(change.Ref.Location),
+		i18n.T("backup.change_"+string(change.Kind)))
+}
+
+// checkedRefs restituisce i server selezionati per il ripristino parziale
+func (bv *BackupView) checkedRefs() []domain.ServerRef {
+	var refs []domain.ServerRef
+	for i, check := range bv.checks {
+		if check.Checked {
+			refs = append(refs, bv.changes[i].Ref)
+		}
+	}
+	return refs
+}
+
+// confirmRestore chiede conferma e ripristina i server indicati (tutti se refs è nil)
+func (bv *BackupView) confirmRestore(refs []domain.ServerRef) {
+	if bv.selected < 0 {
+		return
+	}
+	if refs != nil && len(refs) == 0 {
+		dialog.ShowInformation(i18n.T("backup.title"), i18n.T("backup.nothing_selected"), bv.window)
+		return
+	}
+	backup := bv.backups[bv.selected]
+
+	count := len(refs)
+	if refs == nil {
+		count = len(bv.changes)
+	}
+
+	dialog.ShowConfirm(i18n.T("backup.title"),
+		fmt.Sprintf(i18n.T("backup.restore_confirm"), count, backup.Time.Format("2006-01-02 15:04:05")),
+		func(ok bool) {
+			if !ok {
+				return
+			}
+			if err := bv.mw.service.RestoreBackup(backup.Path, refs); err != nil {
+				bv.mw.showSaveError(err)
+				return
+			}
+			bv.mw.refresh()
+			bv.reload()
+		},
+		bv.window,
+	)
+}
+
+// setRestoreEnabled abilita o disabilita i bottoni di ripristino
+func (bv *BackupView) setRestoreEnabled(enabled bool) {
+	if enabled {
+		bv.restoreAll.Enable()
+		bv.restoreSome.Enable()
+		return
+	}
+	bv.restoreAll.Disable()
+	bv.restoreSome.Disable()
+}
+
+// changeSymbol restituisce il simbolo di un tipo di differenza
+func changeSymbol(kind domain.ChangeKind) string {
+	switch kind {
+	case domain.ChangeAdded:
+		return "+"
+	case domain.ChangeRemoved:
+		return "−"
+	}
+	return "~"
+}
+
+// formatSize formatta una dimensione in byte in forma leggibile
+func formatSize(size int64) string {
+	switch {
+	case size < 1024:
+		return fmt.Sprintf("%d B", size)
+	case size < 1024*1024:
+		return fmt.Sprintf("%.1f KB", float64(size)/1024)
+	}
+	return fmt.Sprintf("%.1f MB", float64(size)/(1024*1024))
+}
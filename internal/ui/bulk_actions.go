@@ -0,0 +1,337 @@
+package ui
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/container"
+	"fyne.io/fyne/v2/dialog"
+	"fyne.io/fyne/v2/theme"
+	"fyne.io/fyne/v2/widget"
+
+	"github.com/strawberry-code/mcp-curator/internal/application"
+	"github.com/strawberry-code/mcp-curator/internal/domain"
+	"github.com/strawberry-code/mcp-curator/internal/i18n"
+)
+
+// bulkSelection è lo stato della selezione multipla nel tree e della barra delle
+// operazioni di gruppo
+type bulkSelection struct {
+	enabled  bool
+	selected map[domain.ServerRef]bool
+
+	toggle    *widget.Check
+	bar       *fyne.Container
+	count     *widget.Label
+	deleteBtn *widget.Button
+	moveBtn   *widget.Button
+	cloneBtn  *widget.Button
+	exportBtn *widget.Button
+	clearBtn  *widget.Button
+}
+
+// createBulkToggle crea la casella che attiva la selezione multipla nel tree
+func (mw *MainWindow) createBulkToggle() fyne.CanvasObject {
+	bs := &mw.bulk
+	bs.selected = make(map[domain.ServerRef]bool)
+	bs.toggle = widget.NewCheck(i18n.T("tree.multi_select"), func(checked bool) {
+		bs.enabled = checked
+		bs.selected = make(map[domain.ServerRef]bool)
+		if checked {
+			bs.bar.Show()
+		} else {
+			bs.bar.Hide()
+		}
+		mw.updateBulkBar()
+		mw.tree.Refresh()
+	})
+	return bs.toggle
+}
+
+// createBulkBar crea la barra sotto il tree con il numero di server selezionati e le
+// operazioni di gruppo; è visibile solo in selezione multipla
+func (mw *MainWindow) createBulkBar() fyne.CanvasObject {
+	bs := &mw.bulk
+	bs.count = widget.NewLabel("")
+	bs.deleteBtn = widget.NewButtonWithIcon(i18n.T("bulk.delete"), theme.DeleteIcon(), func() {
+		mw.confirmBulk(application.BulkOperation{Action: application.BulkRemove, Servers: mw.bulkRefs()})
+	})
+	bs.deleteBtn.Importance = widget.DangerImportance
+	bs.moveBtn = widget.NewButtonWithIcon(i18n.T("bulk.move"), theme.ContentCutIcon(), mw.showBulkMoveDialog)
+	bs.cloneBtn = widget.NewButtonWithIcon(i18n.T("bulk.clone"), theme.ContentCopyIcon(), mw.showBulkCloneDialog)
+	bs.exportBtn = widget.NewButtonWithIcon(i18n.T("bulk.export"), theme.UploadIcon(), mw.showExportView)
+	bs.clearBtn = widget.NewButtonWithIcon(i18n.T("bulk.clear"), theme.ContentClearIcon(), func() {
+		bs.selected = make(map[domain.ServerRef]bool)
+		mw.updateBulkBar()
+		mw.tree.Refresh()
+	})
+
+	bs.bar = container.NewVBox(
+		widget.NewSeparator(),
+		bs.count,
+		container.NewGridWithColumns(3, bs.deleteBtn, bs.moveBtn, bs.cloneBtn, bs.exportBtn, bs.clearBtn),
+	)
+	bs.bar.Hide()
+	mw.updateBulkBar()
+	return bs.bar
+}
+
+// updateBulkStrings traduce la barra delle operazioni di gruppo
+func (mw *MainWindow) updateBulkStrings() {
+	bs := &mw.bulk
+	bs.toggle.SetText(i18n.T("tree.multi_select"))
+	bs.deleteBtn.SetText(i18n.T("bulk.delete"))
+	bs.moveBtn.SetText(i18n.T("bulk.move"))
+	bs.cloneBtn.SetText(i18n.T("bulk.clone"))
+	bs.exportBtn.SetText(i18n.T("bulk.export"))
+	bs.clearBtn.SetText(i18n.T("bulk.clear"))
+	mw.updateBulkBar()
+}
+
+// updateBulkBar toglie dalla selezione i server che non esistono più e aggiorna il
+// conteggio e i bottoni
+func (mw *MainWindow) updateBulkBar() {
+	bs := &mw.bulk
+	config := mw.service.GetConfiguration()
+	for ref := range bs.selected {
+		if _, ok := config.GetServer(ref.Location, ref.Name); !ok {
+			delete(bs.selected, ref)
+		}
+	}
+	bs.count.SetText(fmt.Sprintf(i18n.T("bulk.selected"), len(bs.selected)))
+	for _, btn := range []*widget.Button{bs.deleteBtn, bs.moveBtn, bs.cloneBtn, bs.exportBtn, bs.clearBtn} {
+		if len(bs.selected) > 0 {
+			btn.Enable()
+		} else {
+			btn.Disable()
+		}
+	}
+}
+
+// bulkRefs restituisce i server selezionati in ordine
+func (mw *MainWindow) bulkRefs() []domain.ServerRef {
+	refs := make([]domain.ServerRef, 0, len(mw.bulk.selected))
+	for ref := range mw.bulk.selected {
+		refs = append(refs, ref)
+	}
+	return domain.SortedServerRefs(refs)
+}
+
+// bulkNodeRefs restituisce i server rappresentati da un nodo del tree: il server stesso
+// o i server visibili di un progetto (nil per gli altri nodi)
+func (mw *MainWindow) bulkNodeRefs(id widget.TreeNodeID) []domain.ServerRef {
+	if ref, ok := parseServerNodeID(id); ok {
+		return []domain.ServerRef{ref}
+	}
+	if !strings.HasPrefix(id, "project:") {
+		return nil
+	}
+	project, ok := mw.service.GetConfiguration().Projects[id[len("project:"):]]
+	if !ok {
+		return nil
+	}
+	var refs []domain.ServerRef
+	for _, serverID := range mw.projectServerIDs(project) {
+		if ref, ok := parseServerNodeID(serverID); ok {
+			refs = append(refs, ref)
+		}
+	}
+	return refs
+}
+
+// updateBulkCheck aggiorna la casella di selezione di una riga del tree: visibile solo in
+// selezione multipla per server e progetti, spuntata se tutti i loro server sono selezionati
+func (mw *MainWindow) updateBulkCheck(check *widget.Check, id widget.TreeNodeID) {
+	refs := mw.bulkNodeRefs(id)
+	if !mw.bulk.enabled || len(refs) == 0 {
+		check.Hide()
+		return
+	}
+
+	all := true
+	for _, ref := range refs {
+		all = all && mw.bulk.selected[ref]
+	}
+	// Le righe del tree vengono riutilizzate: la callback va rimossa prima di aggiornare lo stato
+	check.OnChanged = nil
+	check.SetChecked(all)
+	check.OnChanged = func(checked bool) {
+		for _, ref := range mw.bulkNodeRefs(id) {
+			if checked {
+				mw.bulk.selected[ref] = true
+			} else {
+				delete(mw.bulk.selected, ref)
+			}
+		}
+		mw.updateBulkBar()
+		mw.tree.Refresh()
+	}
+	check.Show()
+}
+
+// showBulkMoveDialog chiede la destinazione in cui spostare i server selezionati
+func (mw *MainWindow) showBulkMoveDialog() {
+	picker := NewLocationPicker(mw.service.GetConfiguration(), domain.GlobalLocation())
+	d := dialog.NewCustomConfirm(fmt.Sprintf(i18n.T("bulk.move_title"), len(mw.bulk.selected)),
+		i18n.T("btn.move"), i18n.T("btn.cancel"),
+		container.NewVBox(
+			widget.NewLabel(i18n.T("dialog.move_to")),
+			picker.Container(),
+		),
+		func(ok bool) {
+			if !ok {
+				return
+			}
+			to, err := picker.Location()
+			if err != nil {
+				dialog.ShowError(err, mw.window)
+				return
+			}
+			mw.confirmBulk(application.BulkOperation{
+				Action:  application.BulkMove,
+				Servers: mw.bulkRefs(),
+				Targets: []domain.Location{to},
+			})
+		},
+		mw.window,
+	)
+	d.Resize(fyne.NewSize(450, 300))
+	d.Show()
+}
+
+// showBulkCloneDialog chiede le destinazioni (globale e/o progetti) in cui copiare i
+// server selezionati
+func (mw *MainWindow) showBulkCloneDialog() {
+	config := mw.service.GetConfiguration()
+	options := append([]string{i18n.T("form.scope_global")}, sortedProjectPaths(config)...)
+	checkGroup := widget.NewCheckGroup(options, nil)
+	fileSelect := newFileSelect()
+
+	content := container.NewVBox(
+		widget.NewLabel(i18n.T("dialog.clone_to")),
+		checkGroup,
+		widget.NewSeparator(),
+		widget.NewLabel(i18n.T("dialog.clone_file")),
+		fileSelect,
+	)
+	d := dialog.NewCustomConfirm(fmt.Sprintf(i18n.T("bulk.clone_title"), len(mw.bulk.selected)),
+		i18n.T("btn.save"), i18n.T("btn.cancel"),
+		container.NewScroll(content),
+		func(ok bool) {
+			if !ok || len(checkGroup.Selected) == 0 {
+				return
+			}
+			scope := fileScopeFromLabel(fileSelect.Selected)
+			var targets []domain.Location
+			for _, dest := range checkGroup.Selected {
+				if dest == i18n.T("form.scope_global") {
+					targets = append(targets, domain.GlobalLocation())
+				} else {
+					targets = append(targets, domain.ProjectLocation(dest, scope))
+				}
+			}
+			mw.confirmBulk(application.BulkOperation{
+				Action:  application.BulkClone,
+				Servers: mw.bulkRefs(),
+				Targets: targets,
+			})
+		},
+		mw.window,
+	)
+	d.Resize(fyne.NewSize(500, 450))
+	d.Show()
+}
+
+// confirmBulk mostra il riepilogo di tutto ciò che l'operazione cambierebbe e, alla
+// conferma, la esegue con un solo salvataggio. Se alcuni server esistono già nelle
+// destinazioni si può scegliere di sovrascriverli.
+func (mw *MainWindow) confirmBulk(op application.BulkOperation) {
+	plan, err := mw.service.PlanBulk(op)
+	if err != nil {
+		dialog.ShowError(err, mw.window)
+		return
+	}
+
+	summary := widget.NewLabel("")
+	changes := widget.NewLabelWithStyle("", fyne.TextAlignLeading, fyne.TextStyle{Monospace: true})
+	skipped := newIssueLabel()
+	render := func() {
+		summary.SetText(fmt.Sprintf(i18n.T("bulk.summary"), len(op.Servers), changeCounts(plan.Changes)))
+		lines := make([]string, len(plan.Changes))
+		for i, change := range plan.Changes {
+			lines[i] = changeSymbol(change.Kind) + " " + change.Ref.Name + " — " + mw.locationDescription(change.Ref.Location)
+		}
+		changes.SetText(strings.Join(lines, "\n"))
+		var issues []string
+		for _, err := range plan.Skipped {
+			issues = append(issues, "⚠ "+mw.bulkSkippedText(err))
+		}
+		skipped.SetText(strings.Join(issues, "\n"))
+		skipped.Importance = widget.WarningImportance
+		if len(issues) > 0 {
+			skipped.Refresh()
+			skipped.Show()
+		} else {
+			skipped.Hide()
+		}
+	}
+	render()
+
+	overwrite := widget.NewCheck(i18n.T("bulk.overwrite"), func(checked bool) {
+		op.Overwrite = checked
+		if updated, err := mw.service.PlanBulk(op); err == nil {
+			plan = updated
+			render()
+		}
+	})
+	if len(plan.Skipped) == 0 {
+		overwrite.Hide()
+	}
+
+	content := container.NewBorder(
+		summary,
+		container.NewVBox(skipped, overwrite),
+		nil, nil,
+		container.NewVScroll(changes),
+	)
+	d := dialog.NewCustomConfirm(i18n.T("bulk.title"), i18n.T("bulk.apply"), i18n.T("btn.cancel"), content,
+		func(ok bool) {
+			if ok {
+				mw.applyBulk(op)
+			}
+		},
+		mw.window,
+	)
+	d.Resize(fyne.NewSize(600, 450))
+	d.Show()
+}
+
+// applyBulk esegue l'operazione di gruppo; i server saltati sono già nel riepilogo
+// confermato, quindi solo gli errori di salvataggio vengono mostrati
+func (mw *MainWindow) applyBulk(op application.BulkOperation) {
+	plan, err := mw.service.ApplyBulk(op)
+	var exists *application.ServerExistsError
+	if err != nil && !errors.As(err, &exists) {
+		mw.showSaveError(err)
+		return
+	}
+	if len(plan.Changes) == 0 {
+		dialog.ShowInformation(i18n.T("bulk.title"), i18n.T("bulk.nothing"), mw.window)
+		return
+	}
+	if op.Action != application.BulkClone {
+		mw.bulk.selected = make(map[domain.ServerRef]bool)
+	}
+	mw.refresh()
+}
+
+// bulkSkippedText descrive perché un server è stato saltato
+func (mw *MainWindow) bulkSkippedText(err error) string {
+	var exists *application.ServerExistsError
+	if errors.As(err, &exists) {
+		return fmt.Sprintf(i18n.T("bulk.skipped_exists"), exists.Name, mw.locationDescription(exists.Location))
+	}
+	return errorMessage(err)
+}
@@ -0,0 +1,173 @@
+package ui
+
+import (
+	"fmt"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/container"
+	"fyne.io/fyne/v2/dialog"
+	"fyne.io/fyne/v2/theme"
+	"fyne.io/fyne/v2/widget"
+
+	"github.com/strawberry-code/mcp-curator/internal/domain"
+	"github.com/strawberry-code/mcp-curator/internal/i18n"
+)
+
+// CleanupView è la procedura guidata che rimuove da ~/.claude.json i progetti la cui
+// cartella non esiste più, con un archivio facoltativo delle voci rimosse
+type CleanupView struct {
+	mw     *MainWindow
+	window fyne.Window
+
+	projects []*domain.Project
+	checks   []*widget.Check
+
+	list      *fyne.Container
+	total     *widget.Label
+	archive   *widget.Check
+	secrets   *widget.Check
+	removeBtn *widget.Button
+}
+
+// showCleanupView apre la pulizia dei progetti non trovati
+func (mw *MainWindow) showCleanupView() {
+	cv := &CleanupView{
+		mw:     mw,
+		window: mw.app.NewWindow(i18n.T("cleanup.title")),
+	}
+	cv.window.SetContent(cv.build())
+	cv.window.Resize(fyne.NewSize(750, 550))
+	cv.window.Show()
+}
+
+// build costruisce il contenuto della finestra
+func (cv *CleanupView) build() fyne.CanvasObject {
+	hint := widget.NewLabel(i18n.T("cleanup.hint"))
+	hint.Wrapping = fyne.TextWrapWord
+
+	cv.list = container.NewVBox()
+	cv.total = widget.NewLabel("")
+	cv.archive = widget.NewCheck(i18n.T("cleanup.archive_first"), func(checked bool) {
+		if checked {
+			cv.secrets.Enable()
+		} else {
+			cv.secrets.Disable()
+		}
+	})
+	cv.secrets = widget.NewCheck(i18n.T("cleanup.include_secrets"), nil)
+	cv.archive.SetChecked(true)
+
+	exportBtn := widget.NewButtonWithIcon(i18n.T("cleanup.export_only"), theme.DocumentSaveIcon(), func() {
+		cv.chooseArchive(nil)
+	})
+	cv.removeBtn = widget.NewButtonWithIcon(i18n.T("cleanup.remove"), theme.DeleteIcon(), cv.confirmRemove)
+	cv.removeBtn.Importance = widget.DangerImportance
+
+	cv.reload()
+	bottom := container.NewVBox(
+		widget.NewSeparator(),
+		cv.total,
+		cv.archive,
+		cv.secrets,
+		container.NewHBox(exportBtn, cv.removeBtn),
+	)
+	return container.NewBorder(hint, bottom, nil, nil, container.NewVScroll(cv.list))
+}
+
+// reload rilegge i progetti non trovati; sono tutti selezionati
+func (cv *CleanupView) reload() {
+	cv.projects = cv.mw.service.StaleProjects()
+	cv.checks = make([]*widget.Check, len(cv.projects))
+	cv.list.RemoveAll()
+	for i, project := range cv.projects {
+		cv.checks[i] = widget.NewCheck(project.Path, func(bool) { cv.updateTotal() })
+		cv.checks[i].SetChecked(true)
+		details := widget.NewLabel(fmt.Sprintf(i18n.T("cleanup.project_details"), project.AllServerCount(), formatSize(project.DataSize)))
+		details.Importance = widget.LowImportance
+		cv.list.Add(container.NewBorder(nil, nil, nil, details, cv.checks[i]))
+	}
+	if len(cv.projects) == 0 {
+		cv.list.Add(widget.NewLabel(i18n.T("cleanup.none")))
+	}
+	cv.list.Refresh()
+	cv.updateTotal()
+}
+
+// selected restituisce i path dei progetti selezionati e lo spazio che occupano
+func (cv *CleanupView) selected() ([]string, int64) {
+	var paths []string
+	var size int64
+	for i, check := range cv.checks {
+		if check.Checked {
+			paths = append(paths, cv.projects[i].Path)
+			size += cv.projects[i].DataSize
+		}
+	}
+	return paths, size
+}
+
+// updateTotal aggiorna il riepilogo della selezione e il bottone di rimozione
+func (cv *CleanupView) updateTotal() {
+	paths, size := cv.selected()
+	cv.total.SetText(fmt.Sprintf(i18n.T("cleanup.total"), len(paths), formatSize(size)))
+	if len(paths) > 0 {
+		cv.removeBtn.Enable()
+	} else {
+		cv.removeBtn.Disable()
+	}
+}
+
+// confirmRemove chiede conferma e rimuove i progetti selezionati, salvando prima
+// l'archivio se richiesto
+func (cv *CleanupView) confirmRemove() {
+	paths, size := cv.selected()
+	message := fmt.Sprintf(i18n.T("cleanup.confirm"), len(paths), formatSize(size))
+	dialog.ShowConfirm(i18n.T("cleanup.title"), message, func(ok bool) {
+		if !ok {
+			return
+		}
+		if cv.archive.Checked {
+			cv.chooseArchive(func() { cv.remove(paths) })
+			return
+		}
+		cv.remove(paths)
+	}, cv.window)
+}
+
+// chooseArchive chiede dove salvare l'archivio dei progetti selezionati; then viene
+// eseguita solo se l'archivio è stato salvato
+func (cv *CleanupView) chooseArchive(then func()) {
+	paths, _ := cv.selected()
+	if len(paths) == 0 {
+		return
+	}
+	d := dialog.NewFileSave(func(file fyne.URIWriteCloser, err error) {
+		if err != nil || file == nil {
+			return
+		}
+		file.Close()
+		path := file.URI().Path()
+		if err := cv.mw.service.ArchiveProjects(paths, path, cv.secrets.Checked); err != nil {
+			dialog.ShowError(err, cv.window)
+			return
+		}
+		if then != nil {
+			then()
+			return
+		}
+		dialog.ShowInformation(i18n.T("cleanup.title"), fmt.Sprintf(i18n.T("cleanup.archived"), len(paths), path), cv.window)
+	}, cv.window)
+	d.SetFileName("claude-projects-archive.json")
+	d.Show()
+}
+
+// remove elimina le voci dei progetti da ~/.claude.json
+func (cv *CleanupView) remove(paths []string) {
+	if err := cv.mw.service.RemoveProjects(paths); err != nil {
+		dialog.ShowError(err, cv.window)
+		return
+	}
+	cv.mw.refreshView()
+	cv.reload()
+	dialog.ShowInformation(i18n.T("cleanup.title"), fmt.Sprintf(i18n.T("cleanup.removed"), len(paths)), cv.window)
+}
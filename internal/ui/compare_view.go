@@ -0,0 +1,292 @@
+package ui
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/container"
+	"fyne.io/fyne/v2/dialog"
+	"fyne.io/fyne/v2/theme"
+	"fyne.io/fyne/v2/widget"
+
+	"github.com/strawberry-code/mcp-curator/internal/application"
+	"github.com/strawberry-code/mcp-curator/internal/domain"
+	"github.com/strawberry-code/mcp-curator/internal/i18n"
+)
+
+// compareOption è un lato selezionabile nel confronto
+type compareOption struct {
+	label string
+	side  application.CompareSide
+}
+
+// CompareView è la finestra che confronta fianco a fianco due server, due progetti
+// (configurazioni effettive) o un progetto e lo scope globale, con la copia delle
+// differenze da un lato all'altro
+type CompareView struct {
+	mw     *MainWindow
+	window fyne.Window
+
+	options       []compareOption
+	leftSelect    *widget.Select
+	rightSelect   *widget.Select
+	showIdentical *widget.Check
+	summary       *widget.Label
+	content       *fyne.Container
+}
+
+// showCompareView apre il confronto partendo dalla selezione nel tree: un server a
+// sinistra, oppure un progetto a sinistra e lo scope globale a destra
+func (mw *MainWindow) showCompareView() {
+	cv := &CompareView{
+		mw:     mw,
+		window: mw.app.NewWindow(i18n.T("compare.title")),
+	}
+	cv.window.SetContent(cv.build())
+	cv.window.Resize(fyne.NewSize(950, 650))
+	cv.window.Show()
+}
+
+// build costruisce il contenuto della finestra
+func (cv *CompareView) build() fyne.CanvasObject {
+	cv.options = cv.mw.compareOptions()
+	labels := make([]string, len(cv.options))
+	for i, option := range cv.options {
+		labels[i] = option.label
+	}
+	cv.leftSelect = widget.NewSelect(labels, func(string) { cv.reload() })
+	cv.rightSelect = widget.NewSelect(labels, func(string) { cv.reload() })
+	cv.showIdentical = widget.NewCheck(i18n.T("compare.show_identical"), func(bool) { cv.reload() })
+	cv.summary = widget.NewLabel("")
+	cv.summary.Wrapping = fyne.TextWrapWord
+	cv.content = container.NewVBox()
+
+	hint := widget.NewLabel(i18n.T("compare.hint"))
+	hint.Wrapping = fyne.TextWrapWord
+	top := container.NewVBox(
+		hint,
+		widget.NewForm(
+			widget.NewFormItem(i18n.T("compare.left"), cv.leftSelect),
+			widget.NewFormItem(i18n.T("compare.right"), cv.rightSelect),
+		),
+		container.NewBorder(nil, nil, nil, cv.showIdentical, cv.summary),
+		widget.NewSeparator(),
+	)
+	cv.selectInitialSides()
+	return container.NewBorder(top, nil, nil, nil, container.NewVScroll(cv.content))
+}
+
+// compareOptions elenca i lati confrontabili: lo scope globale, la configurazione
+// effettiva di ogni progetto e ogni singolo server
+func (mw *MainWindow) compareOptions() []compareOption {
+	config := mw.service.GetConfiguration()
+	options := []compareOption{{
+		label: mw.locationLabel(domain.GlobalLocation()),
+		side:  application.CompareSide{Kind: application.CompareGlobal},
+	}}
+	for _, path := range sortedProjectPaths(config) {
+		options = append(options, compareOption{
+			label: fmt.Sprintf(i18n.T("compare.effective"), path),
+			side:  application.CompareSide{Kind: application.CompareProject, ProjectPath: path},
+		})
+	}
+	refs := make([]domain.ServerRef, 0)
+	for ref := range config.AllServers() {
+		refs = append(refs, ref)
+	}
+	for _, ref := range domain.SortedServerRefs(refs) {
+		options = append(options, compareOption{
+			label: ref.Name + " — " + mw.locationDescription(ref.Location),
+			side:  application.CompareSide{Kind: application.CompareServer, Ref: ref},
+		})
+	}
+	return options
+}
+
+// selectInitialSides sceglie i lati in base al nodo selezionato nel tree
+func (cv *CompareView) selectInitialSides() {
+	id := cv.mw.selectedID
+	if ref, ok := parseServerNodeID(id); ok {
+		for i, option := range cv.options {
+			if option.side.Kind == application.CompareServer && option.side.Ref == ref {
+				cv.leftSelect.SetSelectedIndex(i)
+				return
+			}
+		}
+	}
+	if strings.HasPrefix(id, "project:") {
+		for i, option := range cv.options {
+			if option.side.Kind == application.CompareProject && option.side.ProjectPath == id[len("project:"):] {
+				cv.leftSelect.SetSelectedIndex(i)
+				cv.rightSelect.SetSelectedIndex(0)
+				return
+			}
+		}
+	}
+	cv.reload()
+}
+
+// reload ricalcola il confronto tra i lati selezionati
+func (cv *CompareView) reload() {
+	if cv.content == nil {
+		return
+	}
+	cv.content.RemoveAll()
+	defer cv.content.Refresh()
+
+	left, right := cv.leftSelect.SelectedIndex(), cv.rightSelect.SelectedIndex()
+	if left < 0 || right < 0 {
+		cv.summary.SetText(i18n.T("compare.pick_sides"))
+		return
+	}
+	if left == right {
+		cv.summary.SetText(i18n.T("compare.same_side"))
+		return
+	}
+	leftSide, rightSide := cv.options[left].side, cv.options[right].side
+	if (leftSide.Kind == application.CompareServer) != (rightSide.Kind == application.CompareServer) {
+		cv.summary.SetText(i18n.T("compare.mismatch"))
+		return
+	}
+	comparisons, err := cv.mw.service.Compare(leftSide, rightSide)
+	if err != nil {
+		cv.summary.SetText(err.Error())
+		return
+	}
+
+	var identical, different, onlyLeft, onlyRight int
+	for _, comparison := range comparisons {
+		switch {
+		case comparison.OnlyLeft():
+			onlyLeft++
+		case comparison.OnlyRight():
+			onlyRight++
+		case comparison.Identical():
+			identical++
+			if !cv.showIdentical.Checked {
+				continue
+			}
+		default:
+			different++
+		}
+		cv.content.Add(cv.comparisonWidget(comparison, leftSide, rightSide))
+		cv.content.Add(widget.NewSeparator())
+	}
+	cv.summary.SetText(fmt.Sprintf(i18n.T("compare.summary"), identical, different, onlyLeft, onlyRight))
+	if len(comparisons) == 0 {
+		cv.content.Add(widget.NewLabel(i18n.T("compare.empty")))
+	}
+}
+
+// comparisonWidget crea il riquadro di un server: provenienza sui due lati, differenze
+// campo per campo e azioni per copiarle da un lato all'altro
+func (cv *CompareView) comparisonWidget(comparison domain.ServerComparison, leftSide, rightSide application.CompareSide) fyne.CanvasObject {
+	title := widget.NewLabelWithStyle(comparison.Name, fyne.TextAlignLeading, fyne.TextStyle{Bold: true})
+	box := container.NewVBox()
+
+	switch {
+	case comparison.OnlyLeft():
+		ref := layerRef(comparison.Left)
+		to := rightSide.Location()
+		status := widget.NewLabel(fmt.Sprintf(i18n.T("compare.only_left"), cv.mw.locationDescription(ref.Location)))
+		status.Importance = widget.WarningImportance
+		copyBtn := widget.NewButtonWithIcon(fmt.Sprintf(i18n.T("compare.clone_to"), cv.mw.locationDescription(to)), theme.NavigateNextIcon(), func() {
+			cv.clone(ref, to)
+		})
+		box.Add(container.NewBorder(nil, nil, title, copyBtn, status))
+		return box
+	case comparison.OnlyRight():
+		ref := layerRef(comparison.Right)
+		to := leftSide.Location()
+		status := widget.NewLabel(fmt.Sprintf(i18n.T("compare.only_right"), cv.mw.locationDescription(ref.Location)))
+		status.Importance = widget.WarningImportance
+		copyBtn := widget.NewButtonWithIcon(fmt.Sprintf(i18n.T("compare.clone_to"), cv.mw.locationDescription(to)), theme.NavigateBackIcon(), func() {
+			cv.clone(ref, to)
+		})
+		box.Add(container.NewBorder(nil, nil, title, copyBtn, status))
+		return box
+	}
+
+	leftRef, rightRef := layerRef(comparison.Left), layerRef(comparison.Right)
+	sources := widget.NewLabel(cv.mw.locationDescription(leftRef.Location) + "  ↔  " + cv.mw.locationDescription(rightRef.Location))
+	sources.Importance = widget.LowImportance
+	if comparison.Identical() {
+		status := widget.NewLabel(i18n.T("compare.identical"))
+		status.Importance = widget.SuccessImportance
+		box.Add(container.NewBorder(nil, nil, title, status, sources))
+		return box
+	}
+
+	toRight := widget.NewButtonWithIcon(i18n.T("compare.copy_definition"), theme.NavigateNextIcon(), func() {
+		cv.apply(cv.mw.service.CopyServerDefinition(leftRef, rightRef))
+	})
+	toLeft := widget.NewButtonWithIcon(i18n.T("compare.copy_definition"), theme.NavigateBackIcon(), func() {
+		cv.apply(cv.mw.service.CopyServerDefinition(rightRef, leftRef))
+	})
+	box.Add(container.NewBorder(nil, nil, title, container.NewHBox(toLeft, toRight), sources))
+
+	// I valori mostrati hanno i segreti mascherati; le copie usano quelli reali
+	maskedLeft := comparison.Left.Server.Redacted(domain.MaskedValue)
+	maskedRight := comparison.Right.Server.Redacted(domain.MaskedValue)
+	for _, diff := range comparison.Diffs {
+		field := diff.Field
+		fieldLabel := widget.NewLabelWithStyle(field, fyne.TextAlignLeading, fyne.TextStyle{Monospace: true})
+		leftValue := compareValueLabel(cv.mw.service.MaskText(domain.FieldValue(maskedLeft, field)))
+		rightValue := compareValueLabel(cv.mw.service.MaskText(domain.FieldValue(maskedRight, field)))
+
+		copyLeft := widget.NewButtonWithIcon("", theme.NavigateBackIcon(), func() {
+			cv.apply(cv.mw.service.CopyServerField(rightRef, leftRef, field))
+		})
+		copyRight := widget.NewButtonWithIcon("", theme.NavigateNextIcon(), func() {
+			cv.apply(cv.mw.service.CopyServerField(leftRef, rightRef, field))
+		})
+		copyLeft.Importance = widget.LowImportance
+		copyRight.Importance = widget.LowImportance
+
+		values := container.NewGridWithColumns(2,
+			container.NewBorder(nil, nil, nil, copyRight, leftValue),
+			container.NewBorder(nil, nil, copyLeft, nil, rightValue),
+		)
+		box.Add(container.NewBorder(nil, nil, fieldLabel, nil, values))
+	}
+	return box
+}
+
+// compareValueLabel crea l'etichetta di un valore del diff, indicando quelli assenti
+func compareValueLabel(value string) *widget.Label {
+	label := widget.NewLabelWithStyle(diffValue(value), fyne.TextAlignLeading, fyne.TextStyle{Monospace: true})
+	label.Wrapping = fyne.TextWrapBreak
+	if value == "" {
+		label.Importance = widget.LowImportance
+	}
+	return label
+}
+
+// layerRef restituisce il server in cui è scritta una definizione del confronto
+func layerRef(layer *domain.ServerLayer) domain.ServerRef {
+	return domain.ServerRef{Location: layer.Location, Name: layer.Server.Name}
+}
+
+// clone copia un server presente su un solo lato nella posizione dell'altro lato
+func (cv *CompareView) clone(ref domain.ServerRef, to domain.Location) {
+	_, err := cv.mw.service.CloneServer(ref.Name, ref.Location, []domain.Location{to})
+	cv.apply(err)
+}
+
+// apply aggiorna finestra e tree dopo una copia, mostrando l'eventuale errore
+func (cv *CompareView) apply(err error) {
+	var conflictErr *domain.ConflictError
+	switch {
+	case errors.As(err, &conflictErr):
+		// Il salvataggio attende la scelta dell'utente nel dialog dei conflitti
+		cv.mw.showSaveError(err)
+		cv.window.Close()
+		return
+	case err != nil:
+		dialog.ShowError(errors.New(errorMessage(err)), cv.window)
+	}
+	cv.mw.refreshView()
+	cv.reload()
+}
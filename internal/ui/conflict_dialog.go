@@ -0,0 +1,148 @@
+package ui
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/container"
+	"fyne.io/fyne/v2/dialog"
+	"fyne.io/fyne/v2/widget"
+
+	"github.com/strawberry-code/mcp-curator/internal/domain"
+	"github.com/strawberry-code/mcp-curator/internal/i18n"
+)
+
+// showSaveError mostra l'errore di un salvataggio, aprendo il dialog dei conflitti
+// se ~/.claude.json è stato modificato da un altro programma
+func (mw *MainWindow) showSaveError(err error) {
+	var conflictErr *domain.ConflictError
+	if errors.As(err, &conflictErr) {
+		mw.showConflictDialog(conflictErr)
+		return
+	}
+	var validationErr *domain.ValidationError
+	if errors.As(err, &validationErr) {
+		mw.showValidationError(validationErr)
+		return
+	}
+	dialog.ShowError(err, mw.window)
+}
+
+// showValidationError mostra gli errori di validazione tradotti
+func (mw *MainWindow) showValidationError(err *domain.ValidationError) {
+	dialog.ShowError(errors.New(validationMessage(err)), mw.window)
+}
+
+// validationMessage descrive gli errori di validazione di un server, tradotti
+func validationMessage(err *domain.ValidationError) string {
+	errs := err.Issues.Errors()
+	lines := make([]string, len(errs))
+	for i, issue := range errs {
+		lines[i] = "• " + issueText(issue)
+	}
+	return fmt.Sprintf("%s\n\n%s", fmt.Sprintf(i18n.T("validation.invalid_server"), err.Name), strings.Join(lines, "\n"))
+}
+
+// errorMessage descrive un errore con i problemi di validazione tradotti; gli errori
+// multipli (errors.Join) vanno uno per riga
+func errorMessage(err error) string {
+	if joined, ok := err.(interface{ Unwrap() []error }); ok {
+		lines := make([]string, 0, len(joined.Unwrap()))
+		for _, e := range joined.Unwrap() {
+			lines = append(lines, errorMessage(e))
+		}
+		return strings.Join(lines, "\n")
+	}
+	var validationErr *domain.ValidationError
+	if errors.As(err, &validationErr) {
+		return validationMessage(validationErr)
+	}
+	return err.Error()
+}
+
+// showConflictDialog chiede, per ogni server in conflitto, quale versione mantenere
+func (mw *MainWindow) showConflictDialog(conflictErr *domain.ConflictError) {
+	keepMine := i18n.T("conflict.keep_mine")
+	keepTheirs := i18n.T("conflict.keep_theirs")
+
+	choices := make(map[domain.ServerRef]*widget.RadioGroup, len(conflictErr.Conflicts))
+	rows := container.NewVBox()
+	for _, conflict := range conflictErr.Conflicts {
+		radio := widget.NewRadioGroup([]string{keepMine, keepTheirs}, nil)
+		radio.Horizontal = true
+		radio.SetSelected(keepTheirs)
+		radio.Required = true
+		choices[conflict.Ref] = radio
+
+		title := widget.NewLabel(fmt.Sprintf("%s — %s", conflict.Ref.Name, mw.locationDescription(conflict.Ref.Location)))
+		title.TextStyle = fyne.TextStyle{Bold: true}
+
+		rows.Add(title)
+		rows.Add(widget.NewForm(
+			widget.NewFormItem(i18n.T("conflict.mine"), conflictSummaryLabel(conflict.Ours)),
+			widget.NewFormItem(i18n.T("conflict.theirs"), conflictSummaryLabel(conflict.Theirs)),
+		))
+		rows.Add(radio)
+		rows.Add(widget.NewSeparator())
+	}
+
+	message := widget.NewLabel(fmt.Sprintf(i18n.T("conflict.message"), conflictErr.Path))
+	message.Wrapping = fyne.TextWrapWord
+
+	d := dialog.NewCustomConfirm(i18n.T("conflict.title"), i18n.T("conflict.apply"), i18n.T("btn.cancel"),
+		container.NewBorder(message, nil, nil, nil, container.NewVScroll(rows)),
+		func(ok bool) {
+			if !ok {
+				// Scarta le modifiche non salvate e mostra lo stato attuale del file
+				if err := mw.service.DiscardPendingChanges(); err != nil {
+					dialog.ShowError(err, mw.window)
+				}
+				mw.refreshView()
+				return
+			}
+
+			keepOurs := make(map[domain.ServerRef]bool, len(choices))
+			for ref, radio := range choices {
+				keepOurs[ref] = radio.Selected == keepMine
+			}
+			if err := mw.service.ResolveConflicts(conflictErr.Conflicts, keepOurs); err != nil {
+				mw.showSaveError(err)
+				return
+			}
+			mw.refresh()
+		},
+		mw.window,
+	)
+	d.Resize(fyne.NewSize(600, 450))
+	d.Show()
+}
+
+// conflictSummaryLabel crea l'etichetta con il riepilogo di una versione del server
+func conflictSummaryLabel(server *domain.MCPServer) *widget.Label {
+	label := widget.NewLabel(serverSummary(server))
+	label.Wrapping = fyne.TextWrapWord
+	return label
+}
+
+// serverSummary descrive in una riga una versione del server (nil se rimosso), con i segreti mascherati
+func serverSummary(server *domain.MCPServer) string {
+	if server == nil {
+		return i18n.T("conflict.removed")
+	}
+	masked := server.Redacted(domain.MaskedValue)
+	server = &masked
+
+	parts := []string{string(server.Type)}
+	if server.URL != "" {
+		parts = append(parts, server.URL)
+	} else {
+		parts = append(parts, server.Command)
+		parts = append(parts, server.Args...)
+	}
+	if len(server.Env) > 0 {
+		parts = append(parts, fmt.Sprintf("(env: %d)", len(server.Env)))
+	}
+	return strings.TrimSpace(strings.Join(parts, " "))
+}
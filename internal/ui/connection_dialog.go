@@ -0,0 +1,147 @@
+package ui
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/container"
+	"fyne.io/fyne/v2/dialog"
+	"fyne.io/fyne/v2/widget"
+
+	"github.com/strawberry-code/mcp-curator/internal/application"
+	"github.com/strawberry-code/mcp-curator/internal/domain"
+	"github.com/strawberry-code/mcp-curator/internal/i18n"
+	"github.com/strawberry-code/mcp-curator/internal/infrastructure"
+)
+
+// testServer esegue l'handshake MCP in background e mostra l'esito
+func (mw *MainWindow) testServer(name string, server domain.MCPServer) {
+	message := fmt.Sprintf(i18n.T("test.running"), name, infrastructure.ServerTimeout(server))
+	mw.runConnection(name, message,
+		func(ctx context.Context) application.ConnectionReport {
+			return mw.service.TestServer(ctx, server)
+		},
+		func(report application.ConnectionReport) {
+			mw.showTestReport(name, report)
+		},
+	)
+}
+
+// runConnection esegue work in background mostrando un dialog di attesa annullabile;
+// done viene chiamata nel thread UI, solo se l'utente non ha annullato
+func (mw *MainWindow) runConnection(name, message string, work func(ctx context.Context) application.ConnectionReport, done func(application.ConnectionReport)) {
+	ctx, cancel := context.WithCancel(context.Background())
+
+	progress := dialog.NewCustom(fmt.Sprintf(i18n.T("test.title"), name), i18n.T("btn.cancel"),
+		container.NewVBox(
+			widget.NewLabel(message),
+			widget.NewProgressBarInfinite(),
+		),
+		mw.window,
+	)
+	progress.SetOnClosed(cancel)
+	progress.Show()
+
+	go func() {
+		report := work(ctx)
+		fyne.Do(func() {
+			// Annullato dall'utente: niente da mostrare
+			if ctx.Err() == context.Canceled {
+				return
+			}
+			progress.Hide()
+			done(report)
+		})
+	}()
+}
+
+// showTestReport mostra il risultato del test di connessione
+func (mw *MainWindow) showTestReport(name string, report application.ConnectionReport) {
+	content := container.NewVBox()
+
+	if report.OK() {
+		result := report.Initialize
+		content.Add(widget.NewLabelWithStyle(
+			fmt.Sprintf(i18n.T("test.success"), report.Duration.Round(time.Millisecond)),
+			fyne.TextAlignLeading, fyne.TextStyle{Bold: true}))
+
+		serverInfo := strings.TrimSpace(result.ServerInfo.Name + " " + result.ServerInfo.Version)
+		form := widget.NewForm(
+			widget.NewFormItem(i18n.T("test.protocol"), widget.NewLabel(result.ProtocolVersion)),
+			widget.NewFormItem(i18n.T("test.server_info"), widget.NewLabel(serverInfo)),
+			widget.NewFormItem(i18n.T("test.capabilities"), widget.NewLabel(capabilityList(result.Capabilities))),
+		)
+		if result.Instructions != "" {
+			instructions := widget.NewLabel(mw.service.MaskText(result.Instructions))
+			instructions.Wrapping = fyne.TextWrapWord
+			form.Append(i18n.T("test.instructions"), instructions)
+		}
+		content.Add(form)
+	} else {
+		content.Add(widget.NewLabelWithStyle(i18n.T("test.failed"), fyne.TextAlignLeading, fyne.TextStyle{Bold: true}))
+
+		detail := widget.NewLabel(mw.service.MaskText(report.Err.Error()))
+		detail.Wrapping = fyne.TextWrapWord
+		content.Add(widget.NewForm(
+			widget.NewFormItem(i18n.T("test.reason"), widget.NewLabel(failureDescription(report))),
+			widget.NewFormItem(i18n.T("test.detail"), detail),
+		))
+	}
+
+	if stderr := strings.TrimSpace(report.Stderr); stderr != "" {
+		content.Add(widget.NewSeparator())
+		content.Add(widget.NewLabel(i18n.T("test.stderr")))
+		output := widget.NewLabelWithStyle(mw.service.MaskText(stderr), fyne.TextAlignLeading, fyne.TextStyle{Monospace: true})
+		output.Wrapping = fyne.TextWrapWord
+		output.Selectable = true
+		content.Add(output)
+	}
+
+	d := dialog.NewCustom(fmt.Sprintf(i18n.T("test.title"), name), i18n.T("btn.close"),
+		container.NewVScroll(content), mw.window)
+	d.Resize(fyne.NewSize(550, 400))
+	d.Show()
+}
+
+// failureDescription traduce il motivo del fallimento di un test
+func failureDescription(report application.ConnectionReport) string {
+	var connErr *infrastructure.ConnectionError
+	errors.As(report.Err, &connErr)
+
+	switch report.FailureReason() {
+	case infrastructure.FailureNotFound:
+		return i18n.T("test.failure_not_found")
+	case infrastructure.FailureExited:
+		return fmt.Sprintf(i18n.T("test.failure_exited"), connErr.ExitCode)
+	case infrastructure.FailureTimeout:
+		return fmt.Sprintf(i18n.T("test.failure_timeout"), report.Timeout)
+	case infrastructure.FailureTLS:
+		return i18n.T("test.failure_tls")
+	case infrastructure.FailureHTTPStatus:
+		return fmt.Sprintf(i18n.T("test.failure_http_status"), connErr.StatusCode)
+	case infrastructure.FailureNetwork:
+		return i18n.T("test.failure_network")
+	case infrastructure.FailureConfig:
+		return i18n.T("test.failure_config")
+	default:
+		return i18n.T("test.failure_protocol")
+	}
+}
+
+// capabilityList elenca le capability dichiarate dal server in ordine alfabetico
+func capabilityList(capabilities map[string]interface{}) string {
+	if len(capabilities) == 0 {
+		return i18n.T("test.none")
+	}
+	names := make([]string, 0, len(capabilities))
+	for name := range capabilities {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return strings.Join(names, ", ")
+}
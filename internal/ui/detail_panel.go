@@ -5,6 +5,7 @@ import (
 	"os/exec"
 	"path/filepath"
 	"sort"
+	"strings"
 
 	"fyne.io/fyne/v2"
 	"fyne.io/fyne/v2/container"
@@ -15,12 +16,12 @@ import (
 
 	"github.com/strawberry-code/mcp-curator/internal/domain"
 	"github.com/strawberry-code/mcp-curator/internal/i18n"
-	"github.com/strawberry-code/mcp-curator/internal/infrastructure"
 )
 
 // updateDetailPanel aggiorna il pannello dettagli in base all'elemento selezionato
 func (mw *MainWindow) updateDetailPanel(id string) {
 	config := mw.service.GetConfiguration()
+	mw.resetRevealed(id)
 
 	switch {
 	case len(id) > 8 && id[:8] == "project:":
@@ -29,27 +30,16 @@ func (mw *MainWindow) updateDetailPanel(id string) {
 			mw.showProjectDetails(projectPath, project)
 			return
 		}
-	case len(id) > 7 && id[:7] == "global:":
-		serverName := id[7:]
-		if s, ok := config.GlobalServers[serverName]; ok {
-			mw.showServerDetails(serverName, &s, i18n.T("tree.global"), "")
+	case strings.HasPrefix(id, discoveredPrefix):
+		if project, ok := mw.service.GetDiscovered(id[len(discoveredPrefix):]); ok {
+			mw.showDiscoveredDetails(project)
 			return
 		}
-	case len(id) > 14 && id[:14] == "projectserver:":
-		rest := id[14:]
-		for i := len(rest) - 1; i >= 0; i-- {
-			if rest[i] == ':' {
-				projectPath := rest[:i]
-				serverName := rest[i+1:]
-				if project, ok := config.Projects[projectPath]; ok {
-					// Cerca nei server locali
-					localServers := mw.getLocalServers(projectPath, project)
-					if s, ok := localServers[serverName]; ok {
-						mw.showServerDetails(serverName, &s, i18n.T("detail.project")+": "+project.Name, projectPath)
-						return
-					}
-				}
-				break
+	default:
+		if ref, ok := parseServerNodeID(id); ok {
+			if server, ok := config.GetServer(ref.Location, ref.Name); ok {
+				mw.showServerDetails(ref.Name, &server, ref.Location)
+				return
 			}
 		}
 	}
@@ -70,7 +60,7 @@ func (mw *MainWindow) showProjectDetails(path string, project *domain.Project) {
 	pathLabel := widget.NewLabel(i18n.T("detail.path") + ": " + path)
 
 	copyBtn := widget.NewButtonWithIcon("", theme.ContentCopyIcon(), func() {
-		mw.app.Clipboard().SetContent(path)
+		mw.copyToClipboard(path)
 	})
 	copyBtn.Importance = widget.LowImportance
 
@@ -82,47 +72,37 @@ func (mw *MainWindow) showProjectDetails(path string, project *domain.Project) {
 	pathRow := container.NewHBox(pathLabel, copyBtn, openBtn)
 	mw.detailPanel.Add(pathRow)
 
+	// Cartella cancellata o spostata: la voce resta solo in ~/.claude.json
+	if project.Missing {
+		missing := widget.NewLabel(fmt.Sprintf(i18n.T("detail.project_missing"), formatSize(project.DataSize)))
+		missing.Wrapping = fyne.TextWrapWord
+		missing.Importance = widget.WarningImportance
+		cleanupBtn := widget.NewButtonWithIcon(i18n.T("cleanup.title"), theme.DeleteIcon(), func() {
+			mw.showCleanupView()
+		})
+		mw.detailPanel.Add(missing)
+		mw.detailPanel.Add(container.NewHBox(cleanupBtn))
+	}
+
 	// Ottieni configurazione per contare i server globali
 	config := mw.service.GetConfiguration()
 	globalCount := len(config.GlobalServers)
 
-	// Carica server del progetto da tutte le sorgenti
-	localServers := make(map[string]domain.MCPServer)
-	var localFiles []string
-
-	// Server da ~/.claude.json projects.[path].mcpServers
-	projectSettingsCount := len(project.MCPServers)
-	for name, server := range project.MCPServers {
-		localServers[name] = server
-	}
-	if projectSettingsCount > 0 {
-		localFiles = append(localFiles, "~/.claude.json")
-	}
-
-	// Server da file .mcp.json
-	if project.HasMCPJson {
-		mcpJsonPath := filepath.Join(path, ".mcp.json")
-		servers := infrastructure.LoadMCPFileServers(mcpJsonPath)
-		for name, server := range servers {
-			localServers[name] = server
-		}
-		if len(servers) > 0 {
-			localFiles = append(localFiles, ".mcp.json")
+	// Configurazione effettiva: ogni server con il livello da cui proviene
+	effective := config.GetEffectiveConfig(path)
+	var localNames []string
+	for _, name := range effective.Names() {
+		if entry := effective[name]; !entry.Location.IsGlobal() {
+			localNames = append(localNames, name)
 		}
 	}
-
-	// Server da file .mcp.local.json
-	if project.HasMCPLocal {
-		mcpLocalPath := filepath.Join(path, ".mcp.local.json")
-		servers := infrastructure.LoadMCPFileServers(mcpLocalPath)
-		for name, server := range servers {
-			localServers[name] = server
-		}
-		if len(servers) > 0 {
-			localFiles = append(localFiles, ".mcp.local.json")
+	var localFiles []string
+	for _, scope := range domain.ProjectScopes {
+		if len(project.Servers(scope)) > 0 {
+			localFiles = append(localFiles, scopeFileDisplayName(scope))
 		}
 	}
-	localCount := len(localServers)
+	localCount := len(localNames)
 
 	// Sezione Configurazioni Globali (collassata di default)
 	mw.detailPanel.Add(widget.NewSeparator())
@@ -161,11 +141,6 @@ func (mw *MainWindow) showProjectDetails(path string, project *domain.Project) {
 			localContent.Add(mw.createConfigFileLink(file, filePath))
 		}
 		// Lista nomi server locali
-		var localNames []string
-		for name := range localServers {
-			localNames = append(localNames, name)
-		}
-		sort.Strings(localNames)
 		for _, name := range localNames {
 			localContent.Add(widget.NewLabel("  • " + name))
 		}
@@ -173,12 +148,12 @@ func (mw *MainWindow) showProjectDetails(path string, project *domain.Project) {
 		localContent.Add(widget.NewLabel("  " + i18n.T("detail.no_local_servers")))
 		// Mostra link ai file locali se esistono ma sono vuoti
 		if project.HasMCPJson {
-			mcpJsonPath := filepath.Join(path, ".mcp.json")
-			localContent.Add(mw.createConfigFileLink(".mcp.json (vuoto)", mcpJsonPath))
+			mcpJsonPath := filepath.Join(path, domain.MCPJsonFile)
+			localContent.Add(mw.createConfigFileLink(domain.MCPJsonFile+" (vuoto)", mcpJsonPath))
 		}
 		if project.HasMCPLocal {
-			mcpLocalPath := filepath.Join(path, ".mcp.local.json")
-			localContent.Add(mw.createConfigFileLink(".mcp.local.json (vuoto)", mcpLocalPath))
+			mcpLocalPath := filepath.Join(path, domain.MCPLocalFile)
+			localContent.Add(mw.createConfigFileLink(domain.MCPLocalFile+" (vuoto)", mcpLocalPath))
 		}
 	}
 	localAccordion := widget.NewAccordion(
@@ -191,25 +166,217 @@ func (mw *MainWindow) showProjectDetails(path string, project *domain.Project) {
 	localAccordion.Open(0)
 	mw.detailPanel.Add(localAccordion)
 
+	// Sezione Configurazione effettiva: provenienza di ogni server e definizioni sovrascritte
+	mw.detailPanel.Add(widget.NewSeparator())
+	effectiveContent := container.NewVBox()
+	for _, name := range effective.Names() {
+		effectiveContent.Add(mw.effectiveServerRow(effective[name]))
+	}
+	if len(effective) == 0 {
+		effectiveContent.Add(widget.NewLabel("  " + i18n.T("detail.no_effective_servers")))
+	}
+	title := fmt.Sprintf("%s (%d)", i18n.T("detail.effective_configs"), len(effective))
+	if shadowing := len(effective.Shadowing()); shadowing > 0 {
+		title += " — " + fmt.Sprintf(i18n.T("detail.shadowing_count"), shadowing)
+	}
+	effectiveAccordion := widget.NewAccordion(widget.NewAccordionItem(title, effectiveContent))
+	if len(effective.Shadowing()) > 0 {
+		effectiveAccordion.Open(0)
+	}
+	mw.detailPanel.Add(effectiveAccordion)
+
+	// Variabili ${VAR} mancanti, con i server che le usano
+	if missing := mw.service.ProjectMissingVariables(path); len(missing) > 0 {
+		mw.detailPanel.Add(widget.NewSeparator())
+		mw.detailPanel.Add(widget.NewLabelWithStyle(i18n.T("detail.missing_variables"), fyne.TextAlignLeading, fyne.TextStyle{Bold: true}))
+		hint := widget.NewLabel(i18n.T("detail.missing_variables_hint"))
+		hint.Wrapping = fyne.TextWrapWord
+		mw.detailPanel.Add(hint)
+		for _, variable := range domain.SortedKeys(missing) {
+			refs := missing[variable]
+			sort.Slice(refs, func(i, j int) bool { return refs[i].Name < refs[j].Name })
+			users := make([]string, len(refs))
+			for i, ref := range refs {
+				where := scopeFileDisplayName(ref.Scope)
+				if ref.IsGlobal() {
+					where = i18n.T("tree.global")
+				}
+				users[i] = ref.Name + " (" + where + ")"
+			}
+			label := widget.NewLabel("  ${" + variable + "}: " + strings.Join(users, ", "))
+			label.Wrapping = fyne.TextWrapWord
+			label.Importance = widget.DangerImportance
+			mw.detailPanel.Add(label)
+		}
+	}
+
 	// Bottone per aggiungere server al progetto
 	mw.detailPanel.Add(widget.NewSeparator())
 	addServerBtn := widget.NewButtonWithIcon(i18n.T("btn.add_server"), theme.ContentAddIcon(), func() {
-		mw.showAddServerToProjectDialog(path)
+		mw.showAddMethodDialog(domain.ProjectLocation(path, domain.ScopeProject))
 	})
 	mw.detailPanel.Add(container.NewCenter(addServerBtn))
 }
 
-// showServerDetails mostra i dettagli di un server MCP
-func (mw *MainWindow) showServerDetails(name string, server *domain.MCPServer, scope, projectPath string) {
+// showDiscoveredDetails mostra una cartella trovata dalla ricerca e non ancora registrata,
+// con i server dei suoi file e il bottone per registrarla
+func (mw *MainWindow) showDiscoveredDetails(project *domain.Project) {
 	mw.detailPanel.RemoveAll()
 
-	isGlobal := scope == i18n.T("tree.global")
+	mw.detailPanel.Add(widget.NewLabelWithStyle(i18n.T("detail.discovered_project")+": "+project.Name, fyne.TextAlignLeading, fyne.TextStyle{Bold: true}))
+	mw.detailPanel.Add(widget.NewSeparator())
+
+	openBtn := widget.NewButtonWithIcon("", theme.FolderOpenIcon(), func() {
+		mw.openFileWithDefaultApp(project.Path)
+	})
+	openBtn.Importance = widget.LowImportance
+	mw.detailPanel.Add(container.NewHBox(widget.NewLabel(i18n.T("detail.path")+": "+project.Path), openBtn))
+
+	hint := widget.NewLabel(i18n.T("detail.discovered_hint"))
+	hint.Wrapping = fyne.TextWrapWord
+	mw.detailPanel.Add(hint)
+
+	for _, scope := range domain.ProjectScopes {
+		if scope.FileName() == "" || !project.HasFile(scope) {
+			continue
+		}
+		mw.detailPanel.Add(widget.NewSeparator())
+		mw.detailPanel.Add(mw.createConfigFileLink(scopeFileDisplayName(scope), filepath.Join(project.Path, scope.FileName())))
+		servers := project.Servers(scope)
+		names := make([]string, 0, len(servers))
+		for name := range servers {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		for _, name := range names {
+			server := servers[name]
+			mw.detailPanel.Add(widget.NewLabel("  • " + name + "  —  " + serverSummary(&server)))
+		}
+	}
+
+	registerBtn := widget.NewButtonWithIcon(i18n.T("discovery.register_one"), theme.ContentAddIcon(), func() {
+		if err := mw.service.RegisterProjects([]string{project.Path}); err != nil {
+			dialog.ShowError(err, mw.window)
+			return
+		}
+		mw.selectedID = "project:" + project.Path
+		mw.refreshView()
+		mw.tree.Select(mw.selectedID)
+	})
+	registerBtn.Importance = widget.HighImportance
+	mw.detailPanel.Add(widget.NewSeparator())
+	mw.detailPanel.Add(container.NewHBox(registerBtn))
+}
+
+// effectiveServerRow crea la riga di un server effettivo: nome, badge del file da cui
+// proviene e, se sovrascrive altre definizioni, i livelli sovrascritti con il diff
+func (mw *MainWindow) effectiveServerRow(entry domain.EffectiveServer) fyne.CanvasObject {
+	name := widget.NewLabel("  • " + entry.Name)
+	badge := sourceBadge(entry.Location)
+	if !entry.IsShadowing() {
+		return container.NewHBox(name, badge)
+	}
+
+	shadowed := make([]string, len(entry.Shadows))
+	for i, layer := range entry.Shadows {
+		shadowed[i] = sourceDisplayName(layer.Location)
+	}
+	note := widget.NewLabel(fmt.Sprintf(i18n.T("detail.shadows"), strings.Join(shadowed, ", ")))
+	note.Importance = widget.WarningImportance
+	note.Wrapping = fyne.TextWrapWord
+
+	diffBtn := widget.NewButtonWithIcon(i18n.T("detail.show_diff"), theme.VisibilityIcon(), func() {
+		mw.showShadowDiff(entry)
+	})
+	diffBtn.Importance = widget.LowImportance
+	return container.NewVBox(container.NewHBox(name, badge, diffBtn), note)
+}
+
+// sourceDisplayName restituisce il nome breve del livello da cui proviene un server
+func sourceDisplayName(loc domain.Location) string {
+	if loc.IsGlobal() {
+		return i18n.T("tree.global")
+	}
+	return scopeFileDisplayName(loc.Scope)
+}
 
-	// Nome e scope
-	mw.detailPanel.Add(widget.NewLabelWithStyle(i18n.T("detail.server")+": "+name, fyne.TextAlignLeading, fyne.TextStyle{Bold: true}))
-	mw.detailPanel.Add(widget.NewLabel(i18n.T("detail.scope")+": "+scope))
+// sourceBadge crea il badge con il livello di provenienza di un server effettivo
+func sourceBadge(loc domain.Location) *widget.Label {
+	badge := widget.NewLabelWithStyle("["+sourceDisplayName(loc)+"]", fyne.TextAlignLeading, fyne.TextStyle{Monospace: true})
+	badge.Importance = widget.LowImportance
+	if !loc.IsGlobal() {
+		badge.Importance = widget.HighImportance
+	}
+	return badge
+}
+
+// showShadowDiff mostra, campo per campo, in cosa la definizione effettiva differisce
+// da ciascuna di quelle che sovrascrive; i segreti sono mascherati
+func (mw *MainWindow) showShadowDiff(entry domain.EffectiveServer) {
+	winner := entry.Server.Redacted(domain.MaskedValue)
+	content := container.NewVBox()
+	for _, layer := range entry.Shadows {
+		title := fmt.Sprintf(i18n.T("detail.diff_against"), sourceDisplayName(entry.Location), sourceDisplayName(layer.Location))
+		content.Add(widget.NewLabelWithStyle(title, fyne.TextAlignLeading, fyne.TextStyle{Bold: true}))
+
+		diffs := domain.DiffServerFields(layer.Server.Redacted(domain.MaskedValue), winner)
+		if len(diffs) == 0 {
+			content.Add(widget.NewLabel("  " + i18n.T("detail.diff_identical")))
+		}
+		form := widget.NewForm()
+		for _, diff := range diffs {
+			value := widget.NewLabelWithStyle(diffValue(diff.Old)+"  →  "+diffValue(diff.New), fyne.TextAlignLeading, fyne.TextStyle{Monospace: true})
+			value.Wrapping = fyne.TextWrapWord
+			form.Append(diff.Field, value)
+		}
+		content.Add(form)
+		content.Add(widget.NewSeparator())
+	}
+
+	d := dialog.NewCustom(fmt.Sprintf(i18n.T("detail.diff_title"), entry.Name), i18n.T("btn.close"), container.NewVScroll(content), mw.window)
+	d.Resize(fyne.NewSize(650, 450))
+	d.Show()
+}
+
+// diffValue rappresenta un valore del diff, indicando esplicitamente quelli assenti
+func diffValue(value string) string {
+	if value == "" {
+		return "∅"
+	}
+	return value
+}
+
+// showServerDetails mostra i dettagli di un server MCP
+func (mw *MainWindow) showServerDetails(name string, server *domain.MCPServer, loc domain.Location) {
+	mw.detailPanel.RemoveAll()
+
+	// Nome, scope e file in cui è definito
+	copyJSONBtn := widget.NewButtonWithIcon(i18n.T("btn.copy_json"), theme.ContentCopyIcon(), func() {
+		mw.copyServerJSON(name, *server)
+	})
+	copyJSONBtn.Importance = widget.LowImportance
+	mw.detailPanel.Add(container.NewBorder(nil, nil, nil, copyJSONBtn,
+		widget.NewLabelWithStyle(i18n.T("detail.server")+": "+name, fyne.TextAlignLeading, fyne.TextStyle{Bold: true})))
+	mw.detailPanel.Add(widget.NewLabel(i18n.T("detail.scope")+": "+mw.locationLabel(loc)))
+	mw.detailPanel.Add(mw.createConfigFileLink(scopeFileDisplayName(loc.Scope), mw.service.GetLocationPath(loc)))
 	mw.detailPanel.Add(widget.NewSeparator())
 
+	// Errori e avvisi di validazione, incluse le variabili d'ambiente mancanti
+	if issues := mw.service.ServerDiagnostics(*server); len(issues) > 0 {
+		mw.detailPanel.Add(widget.NewLabelWithStyle(i18n.T("validation.title"), fyne.TextAlignLeading, fyne.TextStyle{Bold: true}))
+		for _, issue := range issues {
+			label := newIssueLabel()
+			showIssues(label, domain.ValidationIssues{issue})
+			mw.detailPanel.Add(label)
+		}
+		mw.detailPanel.Add(widget.NewSeparator())
+	}
+
+	// Valori con ${VAR} espansi dall'ambiente corrente, mostrati accanto a quelli originali;
+	// i segreti restano mascherati finché non vengono rivelati campo per campo
+	expanded, _ := mw.service.ExpandServer(*server)
+	ref := domain.ServerRef{Location: loc, Name: name}
+
 	// Tipo
 	serverType := string(server.Type)
 	if serverType == "" {
@@ -223,28 +390,37 @@ func (mw *MainWindow) showServerDetails(name string, server *domain.MCPServer, s
 
 	// Comando (per stdio)
 	if server.Command != "" {
-		mw.detailPanel.Add(widget.NewLabel(i18n.T("detail.command")+": "+server.Command))
+		mw.addMaskedValue("command", i18n.T("detail.command")+": ", server.Command, expanded.Command, mw.service.MaskText, nil)
 	}
 
 	// Args
 	if len(server.Args) > 0 {
 		mw.detailPanel.Add(widget.NewLabel(i18n.T("detail.args")+":"))
-		for _, arg := range server.Args {
-			mw.detailPanel.Add(widget.NewLabel("  " + arg))
+		masks := mw.argMasks(server.Args, expanded.Args)
+		for i, arg := range server.Args {
+			mw.addMaskedValue(fmt.Sprintf("args:%d", i), "  ", arg, expanded.Args[i], masks[i], nil)
 		}
 	}
 
 	// URL (per http/sse)
 	if server.URL != "" {
-		mw.detailPanel.Add(widget.NewLabel(i18n.T("detail.url")+": "+server.URL))
+		mw.addMaskedValue("url", i18n.T("detail.url")+": ", server.URL, expanded.URL, mw.service.MaskText, nil)
+	}
+
+	// Headers
+	if len(server.Headers) > 0 {
+		mw.detailPanel.Add(widget.NewLabel(i18n.T("detail.headers")+":"))
+		for _, k := range domain.SortedKeys(server.Headers) {
+			mw.addSecretValue(ref, domain.FieldHeaders, k, "  "+k+": ", server.Headers[k], expanded.Headers[k])
+		}
 	}
 
 	// Env
 	if len(server.Env) > 0 {
 		mw.detailPanel.Add(widget.NewSeparator())
 		mw.detailPanel.Add(widget.NewLabel(i18n.T("detail.env")+":"))
-		for k, v := range server.Env {
-			mw.detailPanel.Add(widget.NewLabel("  " + k + " = " + v))
+		for _, k := range domain.SortedKeys(server.Env) {
+			mw.addSecretValue(ref, domain.FieldEnv, k, "  "+k+" = ", server.Env[k], expanded.Env[k])
 		}
 	}
 
@@ -257,22 +433,81 @@ func (mw *MainWindow) showServerDetails(name string, server *domain.MCPServer, s
 	mw.detailPanel.Add(widget.NewSeparator())
 
 	editBtn := widget.NewButtonWithIcon(i18n.T("btn.edit"), theme.DocumentCreateIcon(), func() {
-		mw.showEditServerDialog(name, server, isGlobal, projectPath)
+		mw.showEditServerDialog(name, server, loc)
 	})
 
 	deleteBtn := widget.NewButtonWithIcon(i18n.T("btn.delete"), theme.DeleteIcon(), func() {
-		mw.confirmDeleteServer(name, isGlobal, projectPath)
+		mw.confirmDeleteServer(name, loc)
 	})
 
 	moveBtn := widget.NewButtonWithIcon(i18n.T("btn.move"), theme.MoveDownIcon(), func() {
-		mw.showMoveServerDialog(name, isGlobal, projectPath)
+		mw.showMoveServerDialog(name, loc)
 	})
 
 	cloneBtn := widget.NewButtonWithIcon(i18n.T("btn.clone"), theme.ContentCopyIcon(), func() {
-		mw.showCloneServerDialog(name, server, isGlobal, projectPath)
+		mw.showCloneServerDialog(name, loc)
 	})
 
-	mw.detailPanel.Add(container.NewCenter(container.NewHBox(editBtn, moveBtn, cloneBtn, deleteBtn)))
+	testBtn := widget.NewButtonWithIcon(i18n.T("btn.test"), theme.MediaPlayIcon(), func() {
+		mw.testServer(name, *server)
+	})
+
+	mw.detailPanel.Add(container.NewCenter(container.NewHBox(editBtn, moveBtn, cloneBtn, deleteBtn, testBtn)))
+
+	// Strumenti, risorse e prompt esposti dal server
+	mw.detailPanel.Add(widget.NewSeparator())
+	mw.detailPanel.Add(mw.inventorySection(name, *server))
+}
+
+// addExpandedValue aggiunge sotto un valore la sua forma espansa
+func (mw *MainWindow) addExpandedValue(expanded string) {
+	label := widget.NewLabelWithStyle("    → "+expanded+"  ("+i18n.T("detail.expanded")+")",
+		fyne.TextAlignLeading, fyne.TextStyle{Italic: true})
+	label.Importance = widget.LowImportance
+	mw.detailPanel.Add(label)
+}
+
+// addSecretValue aggiunge una riga env o header, mascherata se contiene segreti: se il valore
+// usa segreti del vault mostra a quali (senza il valore espanso), altrimenti offre di spostarlo nel vault
+func (mw *MainWindow) addSecretValue(ref domain.ServerRef, field, key, prefix, raw, expanded string) {
+	id := field + ":" + key
+	bindings := mw.service.ValueBindings(raw)
+	if len(bindings) == 0 {
+		bindBtn := widget.NewButtonWithIcon(i18n.T("vault.bind"), theme.StorageIcon(), func() {
+			mw.showBindSecretDialog(ref, field, key)
+		})
+		bindBtn.Importance = widget.LowImportance
+		mw.addMaskedValue(id, prefix, raw, expanded, mw.keyValueMask(key), bindBtn)
+		return
+	}
+
+	mw.addMaskedValue(id, prefix, raw, raw, mw.keyValueMask(key), nil)
+	for _, binding := range bindings {
+		label := widget.NewLabelWithStyle("    "+fmt.Sprintf(i18n.T("vault.binding"), binding.Secret, i18n.T("vault.mode_"+string(binding.Mode))),
+			fyne.TextAlignLeading, fyne.TextStyle{Italic: true})
+		label.Importance = widget.SuccessImportance
+		mw.detailPanel.Add(label)
+	}
+}
+
+// locationLabel restituisce la descrizione di una posizione per il pannello dettagli
+func (mw *MainWindow) locationLabel(loc domain.Location) string {
+	if loc.IsGlobal() {
+		return i18n.T("tree.global")
+	}
+	name := loc.ProjectPath
+	if project, ok := mw.service.GetConfiguration().GetProject(loc.ProjectPath); ok {
+		name = project.Name
+	}
+	return i18n.T("detail.project") + ": " + name
+}
+
+// scopeFileDisplayName restituisce il nome breve del file in cui vive uno scope
+func scopeFileDisplayName(scope domain.Scope) string {
+	if file := scope.FileName(); file != "" {
+		return file
+	}
+	return "~/.claude.json"
 }
 
 // createConfigFileLink crea un link cliccabile per un file di configurazione
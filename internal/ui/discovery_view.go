@@ -0,0 +1,170 @@
+package ui
+
+import (
+	"fmt"
+	"strings"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/container"
+	"fyne.io/fyne/v2/dialog"
+	"fyne.io/fyne/v2/theme"
+	"fyne.io/fyne/v2/widget"
+
+	"github.com/strawberry-code/mcp-curator/internal/domain"
+	"github.com/strawberry-code/mcp-curator/internal/i18n"
+)
+
+// DiscoveryView è la finestra che cerca nelle cartelle radice i progetti con .mcp.json
+// o .mcp.local.json non ancora registrati in ~/.claude.json
+type DiscoveryView struct {
+	mw     *MainWindow
+	window fyne.Window
+
+	roots       *fyne.Container
+	results     *fyne.Container
+	checks      []*widget.Check
+	projects    []*domain.Project
+	registerBtn *widget.Button
+}
+
+// showDiscoveryView apre la ricerca dei progetti non registrati
+func (mw *MainWindow) showDiscoveryView() {
+	dv := &DiscoveryView{
+		mw:     mw,
+		window: mw.app.NewWindow(i18n.T("discovery.title")),
+	}
+	dv.window.SetContent(dv.build())
+	dv.window.Resize(fyne.NewSize(750, 600))
+	dv.window.Show()
+}
+
+// build costruisce il contenuto della finestra
+func (dv *DiscoveryView) build() fyne.CanvasObject {
+	hint := widget.NewLabel(i18n.T("discovery.hint"))
+	hint.Wrapping = fyne.TextWrapWord
+
+	dv.roots = container.NewVBox()
+	addRootBtn := widget.NewButtonWithIcon(i18n.T("discovery.add_root"), theme.FolderOpenIcon(), dv.chooseRoot)
+	scanBtn := widget.NewButtonWithIcon(i18n.T("discovery.scan"), theme.SearchIcon(), dv.scan)
+	scanBtn.Importance = widget.HighImportance
+
+	dv.results = container.NewVBox()
+	dv.registerBtn = widget.NewButtonWithIcon(i18n.T("discovery.register"), theme.ContentAddIcon(), dv.register)
+	dv.registerBtn.Disable()
+
+	dv.reloadRoots()
+	dv.showResults(dv.mw.service.Discovered())
+
+	top := container.NewVBox(
+		hint,
+		widget.NewLabelWithStyle(i18n.T("discovery.roots"), fyne.TextAlignLeading, fyne.TextStyle{Bold: true}),
+		dv.roots,
+		container.NewHBox(addRootBtn, scanBtn),
+		widget.NewSeparator(),
+	)
+	return container.NewBorder(top, container.NewHBox(dv.registerBtn), nil, nil, container.NewVScroll(dv.results))
+}
+
+// reloadRoots mostra le cartelle radice delle preferenze, ognuna con il bottone di rimozione
+func (dv *DiscoveryView) reloadRoots() {
+	dv.roots.RemoveAll()
+	roots := dv.mw.service.DiscoveryRoots()
+	if len(roots) == 0 {
+		dv.roots.Add(widget.NewLabel(i18n.T("discovery.no_roots")))
+	}
+	for _, root := range roots {
+		root := root
+		removeBtn := widget.NewButtonWithIcon("", theme.DeleteIcon(), func() {
+			if err := dv.mw.service.RemoveDiscoveryRoot(root); err != nil {
+				dialog.ShowError(err, dv.window)
+				return
+			}
+			dv.reloadRoots()
+		})
+		removeBtn.Importance = widget.LowImportance
+		dv.roots.Add(container.NewBorder(nil, nil, nil, removeBtn, widget.NewLabel(root)))
+	}
+	dv.roots.Refresh()
+}
+
+// chooseRoot aggiunge alle preferenze una cartella radice scelta dall'utente
+func (dv *DiscoveryView) chooseRoot() {
+	dialog.ShowFolderOpen(func(uri fyne.ListableURI, err error) {
+		if err != nil || uri == nil {
+			return
+		}
+		if err := dv.mw.service.AddDiscoveryRoot(uri.Path()); err != nil {
+			dialog.ShowError(err, dv.window)
+			return
+		}
+		dv.reloadRoots()
+	}, dv.window)
+}
+
+// scan cerca i progetti nelle cartelle radice e li mostra anche nel tree
+func (dv *DiscoveryView) scan() {
+	projects, err := dv.mw.service.DiscoverProjects(nil, 0)
+	if err != nil {
+		dialog.ShowError(err, dv.window)
+	}
+	dv.showResults(projects)
+	dv.mw.refreshView()
+}
+
+// showResults elenca i progetti trovati, tutti selezionati per la registrazione
+func (dv *DiscoveryView) showResults(projects []*domain.Project) {
+	dv.projects = projects
+	dv.checks = make([]*widget.Check, len(projects))
+	dv.results.RemoveAll()
+	if len(projects) == 0 {
+		dv.results.Add(widget.NewLabel(i18n.T("discovery.none")))
+	}
+	for i, project := range projects {
+		dv.checks[i] = widget.NewCheck(project.Path, func(bool) { dv.updateRegisterButton() })
+		dv.checks[i].SetChecked(true)
+		files := widget.NewLabel(projectFilesSummary(project))
+		files.Importance = widget.LowImportance
+		dv.results.Add(container.NewBorder(nil, nil, nil, files, dv.checks[i]))
+	}
+	dv.results.Refresh()
+	dv.updateRegisterButton()
+}
+
+// updateRegisterButton abilita la registrazione se almeno un progetto è selezionato
+func (dv *DiscoveryView) updateRegisterButton() {
+	for _, check := range dv.checks {
+		if check.Checked {
+			dv.registerBtn.Enable()
+			return
+		}
+	}
+	dv.registerBtn.Disable()
+}
+
+// register registra i progetti selezionati
+func (dv *DiscoveryView) register() {
+	var paths []string
+	for i, check := range dv.checks {
+		if check.Checked {
+			paths = append(paths, dv.projects[i].Path)
+		}
+	}
+	if err := dv.mw.service.RegisterProjects(paths); err != nil {
+		dialog.ShowError(err, dv.window)
+		return
+	}
+	dv.mw.refreshView()
+	dv.showResults(dv.mw.service.Discovered())
+	dialog.ShowInformation(i18n.T("discovery.title"), fmt.Sprintf(i18n.T("discovery.registered"), len(paths)), dv.window)
+}
+
+// projectFilesSummary riassume i server dei file di un progetto, es. ".mcp.json: 2"
+func projectFilesSummary(project *domain.Project) string {
+	var parts []string
+	for _, scope := range domain.ProjectScopes {
+		if scope.FileName() != "" && project.HasFile(scope) {
+			parts = append(parts, fmt.Sprintf("%s: %d", scope.FileName(), len(project.Servers(scope))))
+		}
+	}
+	return strings.Join(parts, " · ")
+}
@@ -0,0 +1,164 @@
+package ui
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/container"
+	"fyne.io/fyne/v2/dialog"
+	"fyne.io/fyne/v2/theme"
+	"fyne.io/fyne/v2/widget"
+
+	"github.com/strawberry-code/mcp-curator/internal/domain"
+	"github.com/strawberry-code/mcp-curator/internal/i18n"
+)
+
+// DuplicatesView è la finestra che elenca i server presenti in più copie e li promuove a globali
+type DuplicatesView struct {
+	mw      *MainWindow
+	window  fyne.Window
+	content *fyne.Container
+}
+
+// showDuplicatesView apre l'analisi dei duplicati
+func (mw *MainWindow) showDuplicatesView() {
+	dv := &DuplicatesView{
+		mw:      mw,
+		window:  mw.app.NewWindow(i18n.T("duplicates.title")),
+		content: container.NewVBox(),
+	}
+	dv.reload()
+	dv.window.SetContent(container.NewVScroll(dv.content))
+	dv.window.Resize(fyne.NewSize(800, 600))
+	dv.window.Show()
+}
+
+// reload ricalcola i gruppi di duplicati sulla configurazione attuale
+func (dv *DuplicatesView) reload() {
+	dv.content.RemoveAll()
+	groups := dv.mw.service.FindDuplicates()
+
+	hint := widget.NewLabel(i18n.T("duplicates.hint"))
+	hint.Wrapping = fyne.TextWrapWord
+	dv.content.Add(hint)
+	if len(groups) == 0 {
+		dv.content.Add(widget.NewLabel(i18n.T("duplicates.none")))
+	}
+	for _, group := range groups {
+		dv.content.Add(widget.NewSeparator())
+		dv.content.Add(dv.groupWidget(group))
+	}
+	dv.content.Refresh()
+}
+
+// groupWidget crea il riquadro di un gruppo: definizione da promuovere, nome globale e,
+// per ogni copia, la selezione per la rimozione e le differenze dalla definizione scelta
+func (dv *DuplicatesView) groupWidget(group domain.DuplicateGroup) fyne.CanvasObject {
+	different := 0
+	for i := range group.Copies {
+		if !group.Copies[i].Identical() {
+			different++
+		}
+	}
+	title := widget.NewLabelWithStyle(dv.mw.service.MaskText(group.Key), fyne.TextAlignLeading, fyne.TextStyle{Bold: true, Monospace: true})
+	title.Truncation = fyne.TextTruncateEllipsis
+	summary := widget.NewLabel(fmt.Sprintf(i18n.T("duplicates.summary"), len(group.Copies), different))
+
+	options := make([]string, len(group.Copies))
+	for i, dup := range group.Copies {
+		options[i] = dup.Ref.Name + " — " + dv.mw.locationDescription(dup.Ref.Location)
+	}
+	definition := widget.NewSelect(options, nil)
+	nameEntry := widget.NewEntry()
+	nameEntry.SetText(group.Name)
+
+	checks := make([]*widget.Check, len(group.Copies))
+	copiesBox := container.NewVBox()
+	promoteBtn := widget.NewButtonWithIcon(i18n.T("duplicates.promote"), theme.UploadIcon(), nil)
+	promoteBtn.Importance = widget.HighImportance
+
+	// Alla scelta della definizione vengono proposte per la rimozione le copie identiche
+	definition.OnChanged = func(string) {
+		chosen := group.Copies[definition.SelectedIndex()]
+		nameEntry.SetText(chosen.Ref.Name)
+		if chosen.Ref.IsGlobal() {
+			nameEntry.Disable()
+		} else {
+			nameEntry.Enable()
+		}
+
+		copiesBox.RemoveAll()
+		for i, dup := range group.Copies {
+			checks[i] = widget.NewCheck(options[i], nil)
+			if dup.Ref.IsGlobal() {
+				checks[i].Disable()
+			} else {
+				checks[i].SetChecked(domain.SameDefinition(dup.Server, chosen.Server))
+			}
+			copiesBox.Add(checks[i])
+
+			diffs := domain.DiffServerFields(chosen.Server.Redacted(domain.MaskedValue), dup.Server.Redacted(domain.MaskedValue))
+			if len(diffs) > 0 {
+				lines := make([]string, len(diffs))
+				for j, diff := range diffs {
+					lines[j] = fmt.Sprintf("      %s: %s → %s", diff.Field, diffValue(diff.Old), diffValue(diff.New))
+				}
+				label := widget.NewLabelWithStyle(strings.Join(lines, "\n"), fyne.TextAlignLeading, fyne.TextStyle{Monospace: true})
+				label.Importance = widget.WarningImportance
+				copiesBox.Add(label)
+			}
+		}
+		copiesBox.Refresh()
+	}
+
+	promoteBtn.OnTapped = func() {
+		chosen := group.Copies[definition.SelectedIndex()]
+		var remove []domain.ServerRef
+		for i, dup := range group.Copies {
+			if checks[i].Checked && !checks[i].Disabled() {
+				remove = append(remove, dup.Ref)
+			}
+		}
+		dv.promote(strings.TrimSpace(nameEntry.Text), chosen.Server, remove)
+	}
+
+	// Definizione iniziale: la prima copia identica a quella di riferimento
+	for i := range group.Copies {
+		if group.Copies[i].Identical() {
+			definition.SetSelectedIndex(i)
+			break
+		}
+	}
+
+	form := widget.NewForm(
+		widget.NewFormItem(i18n.T("duplicates.definition"), definition),
+		widget.NewFormItem(i18n.T("duplicates.global_name"), nameEntry),
+	)
+	return container.NewVBox(
+		container.NewBorder(nil, nil, nil, summary, title),
+		form,
+		widget.NewLabel(i18n.T("duplicates.remove_copies")),
+		copiesBox,
+		container.NewHBox(promoteBtn),
+	)
+}
+
+// promote rende globale la definizione scelta e rimuove le copie selezionate
+func (dv *DuplicatesView) promote(name string, server domain.MCPServer, remove []domain.ServerRef) {
+	err := dv.mw.service.PromoteToGlobal(name, server, remove)
+	var conflictErr *domain.ConflictError
+	switch {
+	case errors.As(err, &conflictErr):
+		// Il salvataggio attende la scelta dell'utente nel dialog dei conflitti
+		dv.mw.showSaveError(err)
+		dv.window.Close()
+		return
+	case err != nil:
+		dialog.ShowError(errors.New(errorMessage(err)), dv.window)
+		return
+	}
+	dv.mw.refreshView()
+	dv.reload()
+}
@@ -0,0 +1,312 @@
+package ui
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/container"
+	"fyne.io/fyne/v2/dialog"
+	"fyne.io/fyne/v2/theme"
+	"fyne.io/fyne/v2/widget"
+
+	"github.com/strawberry-code/mcp-curator/internal/domain"
+	"github.com/strawberry-code/mcp-curator/internal/i18n"
+)
+
+// exportSource è un insieme di server esportabile: una posizione, i server
+// effettivi di un progetto o i server selezionati nel tree
+type exportSource struct {
+	label     string
+	loc       domain.Location
+	effective bool
+	refs      []domain.ServerRef
+}
+
+// ExportView è la finestra che esporta i server nel formato di un altro client
+type ExportView struct {
+	mw     *MainWindow
+	window fyne.Window
+
+	sources []exportSource
+	servers map[string]domain.MCPServer
+	checks  map[string]*widget.Check
+
+	sourceSelect *widget.Select
+	formatSelect *widget.Select
+	serverBox    *fyne.Container
+	issues       *widget.Label
+	preview      *widget.Label
+	secrets      *widget.Check
+	copyBtn      *widget.Button
+	saveBtn      *widget.Button
+}
+
+// showExportView apre l'esportazione partendo dalla selezione nel tree: i server della
+// selezione multipla, un server, i server effettivi di un progetto o, in mancanza, i
+// server globali
+func (mw *MainWindow) showExportView() {
+	ev := &ExportView{
+		mw:     mw,
+		window: mw.app.NewWindow(i18n.T("export.title")),
+	}
+	ev.window.SetContent(ev.build())
+	ev.window.Resize(fyne.NewSize(850, 650))
+	ev.window.Show()
+}
+
+// build costruisce il contenuto della finestra
+func (ev *ExportView) build() fyne.CanvasObject {
+	ev.sources = ev.mw.exportSources()
+	options := make([]string, len(ev.sources))
+	for i, source := range ev.sources {
+		options[i] = source.label
+	}
+	ev.sourceSelect = widget.NewSelect(options, func(string) { ev.load("") })
+
+	formats := make([]string, 0, len(domain.ExportFormats()))
+	for _, format := range domain.ExportFormats() {
+		formats = append(formats, i18n.T("export.format_"+string(format)))
+	}
+	ev.formatSelect = widget.NewSelect(formats, func(string) { ev.render() })
+
+	ev.serverBox = container.NewVBox()
+	ev.issues = newIssueLabel()
+	ev.preview = widget.NewLabelWithStyle("", fyne.TextAlignLeading, fyne.TextStyle{Monospace: true})
+
+	ev.secrets = widget.NewCheck(i18n.T("export.include_secrets"), nil)
+	ev.copyBtn = widget.NewButtonWithIcon(i18n.T("export.copy"), theme.ContentCopyIcon(), ev.copy)
+	ev.saveBtn = widget.NewButtonWithIcon(i18n.T("export.save"), theme.DocumentSaveIcon(), ev.save)
+	ev.saveBtn.Importance = widget.HighImportance
+
+	top := widget.NewForm(
+		widget.NewFormItem(i18n.T("export.source"), ev.sourceSelect),
+		widget.NewFormItem(i18n.T("export.format"), ev.formatSelect),
+	)
+	bottom := container.NewVBox(
+		widget.NewSeparator(),
+		ev.secrets,
+		container.NewHBox(ev.copyBtn, ev.saveBtn),
+	)
+	left := container.NewBorder(
+		widget.NewLabelWithStyle(i18n.T("export.servers"), fyne.TextAlignLeading, fyne.TextStyle{Bold: true}),
+		nil, nil, nil,
+		container.NewVScroll(ev.serverBox),
+	)
+	right := container.NewBorder(
+		container.NewVBox(widget.NewLabelWithStyle(i18n.T("export.preview"), fyne.TextAlignLeading, fyne.TextStyle{Bold: true}), ev.issues),
+		nil, nil, nil,
+		container.NewScroll(ev.preview),
+	)
+	split := container.NewHSplit(left, right)
+	split.SetOffset(0.3)
+
+	ev.formatSelect.SetSelectedIndex(0)
+	ev.selectInitialSource()
+	return container.NewBorder(top, bottom, nil, nil, split)
+}
+
+// exportSources elenca gli insiemi esportabili: la selezione multipla se presente, i
+// server globali, gli effettivi di ogni progetto e ogni posizione di progetto che contiene server
+func (mw *MainWindow) exportSources() []exportSource {
+	config := mw.service.GetConfiguration()
+	var sources []exportSource
+	if refs := mw.bulkRefs(); mw.bulk.enabled && len(refs) > 0 {
+		sources = append(sources, exportSource{label: fmt.Sprintf(i18n.T("export.selection"), len(refs)), refs: refs})
+	}
+	sources = append(sources, exportSource{label: mw.locationLabel(domain.GlobalLocation()), loc: domain.GlobalLocation()})
+	for _, path := range config.ProjectPaths() {
+		project, _ := config.GetProject(path)
+		if project.AllServerCount() == 0 && len(config.GlobalServers) == 0 {
+			continue
+		}
+		loc := domain.ProjectLocation(path, domain.ScopeProject)
+		sources = append(sources, exportSource{
+			label:     fmt.Sprintf(i18n.T("export.effective"), mw.locationLabel(loc)),
+			loc:       loc,
+			effective: true,
+		})
+		for _, scope := range domain.ProjectScopes {
+			if len(project.Servers(scope)) > 0 {
+				loc := domain.ProjectLocation(path, scope)
+				sources = append(sources, exportSource{label: mw.locationDescription(loc), loc: loc})
+			}
+		}
+	}
+	return sources
+}
+
+// selectInitialSource seleziona l'insieme corrispondente al nodo selezionato nel tree;
+// se è un server, solo quel server resta selezionato
+func (ev *ExportView) selectInitialSource() {
+	if ev.sources[0].refs != nil {
+		ev.sourceSelect.SetSelectedIndex(0)
+		return
+	}
+	id := ev.mw.selectedID
+	if ref, ok := parseServerNodeID(id); ok {
+		for i, source := range ev.sources {
+			if !source.effective && source.loc == ref.Location {
+				ev.sourceSelect.SetSelectedIndex(i)
+				ev.load(ref.Name)
+				return
+			}
+		}
+	}
+	if strings.HasPrefix(id, "project:") {
+		for i, source := range ev.sources {
+			if source.effective && source.loc.ProjectPath == id[len("project:"):] {
+				ev.sourceSelect.SetSelectedIndex(i)
+				return
+			}
+		}
+	}
+	ev.sourceSelect.SetSelectedIndex(0)
+}
+
+// load carica i server dell'insieme selezionato; con only non vuoto seleziona solo quel server
+func (ev *ExportView) load(only string) {
+	index := ev.sourceSelect.SelectedIndex()
+	if index < 0 || ev.serverBox == nil {
+		return
+	}
+	source := ev.sources[index]
+	switch {
+	case source.refs != nil:
+		ev.servers = ev.mw.selectionServers(source.refs)
+	case source.effective:
+		ev.servers, _ = ev.mw.service.GetEffectiveServers(source.loc.ProjectPath)
+	default:
+		ev.servers, _ = ev.mw.service.GetConfiguration().Servers(source.loc)
+	}
+
+	names := make([]string, 0, len(ev.servers))
+	for name := range ev.servers {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	ev.serverBox.RemoveAll()
+	ev.checks = make(map[string]*widget.Check, len(names))
+	for _, name := range names {
+		check := widget.NewCheck(name, func(bool) { ev.render() })
+		check.Checked = only == "" || only == name
+		ev.checks[name] = check
+		ev.serverBox.Add(check)
+	}
+	if len(names) == 0 {
+		ev.serverBox.Add(widget.NewLabel(i18n.T("export.empty")))
+	}
+	ev.serverBox.Refresh()
+	ev.render()
+}
+
+// selectionServers restituisce i server selezionati nel tree per nome; tra server omonimi
+// di posizioni diverse resta il primo in ordine (il globale, poi per progetto e file)
+func (mw *MainWindow) selectionServers(refs []domain.ServerRef) map[string]domain.MCPServer {
+	config := mw.service.GetConfiguration()
+	servers := make(map[string]domain.MCPServer, len(refs))
+	for _, ref := range domain.SortedServerRefs(refs) {
+		if _, taken := servers[ref.Name]; taken {
+			continue
+		}
+		if server, ok := config.GetServer(ref.Location, ref.Name); ok {
+			servers[ref.Name] = server
+		}
+	}
+	return servers
+}
+
+// format restituisce il formato selezionato
+func (ev *ExportView) format() domain.ExportFormat {
+	index := ev.formatSelect.SelectedIndex()
+	if index < 0 {
+		return domain.ExportMCPServers
+	}
+	return domain.ExportFormats()[index]
+}
+
+// selected restituisce i server selezionati
+func (ev *ExportView) selected() map[string]domain.MCPServer {
+	servers := make(map[string]domain.MCPServer)
+	for name, check := range ev.checks {
+		if check.Checked {
+			servers[name] = ev.servers[name]
+		}
+	}
+	return servers
+}
+
+// export converte i server selezionati nel formato scelto
+func (ev *ExportView) export(includeSecrets bool) (domain.ExportResult, error) {
+	return ev.mw.service.ExportServers(ev.format(), ev.selected(), includeSecrets)
+}
+
+// render aggiorna anteprima (sempre senza segreti) e avvisi del formato
+func (ev *ExportView) render() {
+	if ev.preview == nil || ev.checks == nil {
+		return
+	}
+	result, err := ev.export(false)
+	if err != nil {
+		ev.preview.SetText("")
+		showIssues(ev.issues, nil)
+		ev.copyBtn.Disable()
+		ev.saveBtn.Disable()
+		return
+	}
+	ev.preview.SetText(string(result.Data))
+	ev.copyBtn.Enable()
+	ev.saveBtn.Enable()
+
+	// Gli avvisi sono per server: il nome precede ogni messaggio
+	var lines []string
+	for _, name := range result.IssueNames() {
+		for _, issue := range result.Issues[name] {
+			lines = append(lines, "⚠ "+name+": "+issueText(issue))
+		}
+	}
+	ev.issues.SetText(strings.Join(lines, "\n"))
+	ev.issues.Importance = widget.WarningImportance
+	if len(lines) > 0 {
+		ev.issues.Refresh()
+		ev.issues.Show()
+	} else {
+		ev.issues.Hide()
+	}
+}
+
+// copy copia l'esportazione negli appunti; i segreti non finiscono mai negli appunti
+func (ev *ExportView) copy() {
+	result, err := ev.export(false)
+	if err != nil {
+		dialog.ShowError(err, ev.window)
+		return
+	}
+	ev.mw.copyToClipboard(string(result.Data))
+}
+
+// save salva l'esportazione su file, con i segreti solo se richiesto esplicitamente
+func (ev *ExportView) save() {
+	result, err := ev.export(ev.secrets.Checked)
+	if err != nil {
+		dialog.ShowError(err, ev.window)
+		return
+	}
+	d := dialog.NewFileSave(func(file fyne.URIWriteCloser, err error) {
+		if err != nil || file == nil {
+			return
+		}
+		file.Close()
+		path := file.URI().Path()
+		if err := ev.mw.service.WriteExport(path, result); err != nil {
+			dialog.ShowError(err, ev.window)
+			return
+		}
+		exported := len(ev.selected()) - len(result.Skipped)
+		dialog.ShowInformation(i18n.T("export.title"), fmt.Sprintf(i18n.T("export.done"), exported, path), ev.window)
+	}, ev.window)
+	d.SetFileName(ev.format().FileName())
+	d.Show()
+}
@@ -0,0 +1,109 @@
+package ui
+
+import (
+	"fmt"
+	"strings"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/container"
+	"fyne.io/fyne/v2/theme"
+	"fyne.io/fyne/v2/widget"
+
+	"github.com/strawberry-code/mcp-curator/internal/domain"
+	"github.com/strawberry-code/mcp-curator/internal/i18n"
+	"github.com/strawberry-code/mcp-curator/internal/infrastructure"
+)
+
+// bannerMaxNames è il numero massimo di server elencati nel banner
+const bannerMaxNames = 5
+
+// startFileWatcher avvia l'osservazione dei file di configurazione per l'aggiornamento automatico
+func (mw *MainWindow) startFileWatcher() {
+	watcher, err := infrastructure.NewFileWatcher(func(paths []string) {
+		fyne.Do(mw.reloadFromDisk)
+	})
+	if err != nil {
+		// Senza watcher resta disponibile il bottone Aggiorna
+		return
+	}
+
+	watcher.SetFiles(mw.service.WatchedFiles())
+	mw.watcher = watcher
+	mw.window.SetOnClosed(func() {
+		watcher.Close()
+	})
+}
+
+// reloadFromDisk ricarica la configurazione dopo una modifica su disco
+// e segnala i server cambiati da altri programmi
+func (mw *MainWindow) reloadFromDisk() {
+	changes, err := mw.service.Reload()
+	if err != nil {
+		// File scritto a metà o JSON non valido: si riprova al prossimo evento
+		return
+	}
+	mw.watcher.SetFiles(mw.service.WatchedFiles())
+
+	// Il Reload ricrea la configurazione: la vista va aggiornata anche senza server cambiati
+	// (es. salvataggio del curator stesso o modifiche non MCP), che non meritano il banner
+	mw.refreshView()
+	if len(changes) > 0 {
+		mw.showExternalChangeBanner(changes)
+	}
+}
+
+// createBanner crea il banner delle modifiche esterne, inizialmente nascosto
+func (mw *MainWindow) createBanner() *fyne.Container {
+	mw.bannerLabel = widget.NewLabel("")
+	mw.bannerLabel.Wrapping = fyne.TextWrapWord
+
+	var banner *fyne.Container
+	closeBtn := widget.NewButtonWithIcon("", theme.CancelIcon(), func() {
+		banner.Hide()
+	})
+	closeBtn.Importance = widget.LowImportance
+
+	banner = container.NewBorder(nil, nil,
+		widget.NewIcon(theme.InfoIcon()), closeBtn,
+		mw.bannerLabel,
+	)
+	banner.Hide()
+	return banner
+}
+
+// showExternalChangeBanner mostra il riepilogo dei server cambiati esternamente
+func (mw *MainWindow) showExternalChangeBanner(changes []domain.ServerChange) {
+	mw.bannerLabel.SetText(fmt.Sprintf(i18n.T("banner.changed_externally"), summarizeChanges(changes)))
+	mw.banner.Show()
+}
+
+// summarizeChanges riassume le differenze: conteggi per tipo e primi nomi coinvolti
+func summarizeChanges(changes []domain.ServerChange) string {
+	names := make([]string, 0, bannerMaxNames)
+	for _, change := range changes {
+		if len(names) < bannerMaxNames {
+			names = append(names, change.Ref.Name)
+		}
+	}
+
+	list := strings.Join(names, ", ")
+	if len(changes) > bannerMaxNames {
+		list += ", …"
+	}
+	return changeCounts(changes) + " (" + list + ")"
+}
+
+// changeCounts riassume le differenze con i conteggi per tipo (es. "2 aggiunti, 1 rimossi")
+func changeCounts(changes []domain.ServerChange) string {
+	counts := make(map[domain.ChangeKind]int)
+	for _, change := range changes {
+		counts[change.Kind]++
+	}
+	var parts []string
+	for _, kind := range []domain.ChangeKind{domain.ChangeAdded, domain.ChangeModified, domain.ChangeRemoved} {
+		if counts[kind] > 0 {
+			parts = append(parts, fmt.Sprintf(i18n.T("banner."+string(kind)), counts[kind]))
+		}
+	}
+	return strings.Join(parts, ", ")
+}
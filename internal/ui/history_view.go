@@ -0,0 +1,99 @@
+package ui
+
+import (
+	"fmt"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/container"
+	"fyne.io/fyne/v2/dialog"
+	"fyne.io/fyne/v2/driver/desktop"
+	"fyne.io/fyne/v2/widget"
+
+	"github.com/strawberry-code/mcp-curator/internal/application"
+	"github.com/strawberry-code/mcp-curator/internal/i18n"
+)
+
+// registerHistoryShortcuts registra le scorciatoie di annulla (Cmd/Ctrl+Z)
+// e ripeti (Cmd/Ctrl+Shift+Z o Ctrl+Y)
+func (mw *MainWindow) registerHistoryShortcuts() {
+	canvas := mw.window.Canvas()
+	canvas.AddShortcut(&desktop.CustomShortcut{KeyName: fyne.KeyZ, Modifier: fyne.KeyModifierShortcutDefault},
+		func(fyne.Shortcut) { mw.undo() })
+	canvas.AddShortcut(&desktop.CustomShortcut{KeyName: fyne.KeyZ, Modifier: fyne.KeyModifierShortcutDefault | fyne.KeyModifierShift},
+		func(fyne.Shortcut) { mw.redo() })
+	canvas.AddShortcut(&desktop.CustomShortcut{KeyName: fyne.KeyY, Modifier: fyne.KeyModifierControl},
+		func(fyne.Shortcut) { mw.redo() })
+}
+
+// undo annulla l'ultima operazione
+func (mw *MainWindow) undo() {
+	if !mw.service.CanUndo() {
+		return
+	}
+	if _, err := mw.service.Undo(); err != nil {
+		mw.showSaveError(err)
+		mw.updateHistoryButtons()
+		return
+	}
+	mw.refresh()
+}
+
+// redo ripete l'ultima operazione annullata
+func (mw *MainWindow) redo() {
+	if !mw.service.CanRedo() {
+		return
+	}
+	if _, err := mw.service.Redo(); err != nil {
+		mw.showSaveError(err)
+		mw.updateHistoryButtons()
+		return
+	}
+	mw.refresh()
+}
+
+// updateHistoryButtons abilita i bottoni annulla/ripeti in base alla cronologia
+func (mw *MainWindow) updateHistoryButtons() {
+	if mw.service.CanUndo() {
+		mw.undoBtn.Enable()
+	} else {
+		mw.undoBtn.Disable()
+	}
+	if mw.service.CanRedo() {
+		mw.redoBtn.Enable()
+	} else {
+		mw.redoBtn.Disable()
+	}
+}
+
+// showHistoryDialog mostra le operazioni eseguite e quelle annullate ripetibili
+func (mw *MainWindow) showHistoryDialog() {
+	done, undone := mw.service.HistoryEntries()
+
+	content := container.NewVBox()
+	if len(done) == 0 && len(undone) == 0 {
+		content.Add(widget.NewLabel(i18n.T("history.empty")))
+	}
+
+	// Le operazioni annullate sono "sopra" quelle eseguite: si ripetono in quest'ordine
+	for i := len(undone) - 1; i >= 0; i-- {
+		label := widget.NewLabel(fmt.Sprintf("↷ %s  %s  (%s)", undone[i].Time.Format("15:04:05"), operationLabel(undone[i]), i18n.T("history.undone")))
+		label.Importance = widget.LowImportance
+		content.Add(label)
+	}
+	for i := len(done) - 1; i >= 0; i-- {
+		content.Add(widget.NewLabel(fmt.Sprintf("✓ %s  %s", done[i].Time.Format("15:04:05"), operationLabel(done[i]))))
+	}
+
+	d := dialog.NewCustom(i18n.T("history.title"), i18n.T("btn.close"), container.NewVScroll(content), mw.window)
+	d.Resize(fyne.NewSize(500, 400))
+	d.Show()
+}
+
+// operationLabel descrive un'operazione della cronologia
+func operationLabel(op application.Operation) string {
+	label := fmt.Sprintf(i18n.T("history.op_"+string(op.Kind)), op.Name)
+	if len(op.Changes) > 1 {
+		label += fmt.Sprintf(" (%d)", len(op.Changes))
+	}
+	return label
+}
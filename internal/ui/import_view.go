@@ -0,0 +1,267 @@
+package ui
+
+import (
+	"errors"
+	"fmt"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/container"
+	"fyne.io/fyne/v2/dialog"
+	"fyne.io/fyne/v2/theme"
+	"fyne.io/fyne/v2/widget"
+
+	"github.com/strawberry-code/mcp-curator/internal/domain"
+	"github.com/strawberry-code/mcp-curator/internal/i18n"
+)
+
+// ImportView è la procedura guidata che importa i server dai file di configurazione
+// degli altri client MCP (Claude Desktop, Cursor, VS Code, Codex, Goose)
+type ImportView struct {
+	mw     *MainWindow
+	window fyne.Window
+
+	sources    []domain.ImportSource
+	path       string
+	servers    []domain.ImportedServer
+	candidates []domain.ImportCandidate
+	checks     map[string]*widget.Check
+
+	sourceSelect *widget.Select
+	formatSelect *widget.Select
+	picker       *LocationPicker
+	readIssue    *widget.Label
+	serverBox    *fyne.Container
+	overwrite    *widget.Check
+	importBtn    *widget.Button
+}
+
+// showImportView apre la procedura di importazione
+func (mw *MainWindow) showImportView() {
+	iv := &ImportView{
+		mw:      mw,
+		window:  mw.app.NewWindow(i18n.T("import.title")),
+		sources: mw.service.ImportSources(),
+	}
+	iv.window.SetContent(iv.build())
+	iv.window.Resize(fyne.NewSize(850, 650))
+	iv.window.Show()
+}
+
+// build costruisce il contenuto della finestra
+func (iv *ImportView) build() fyne.CanvasObject {
+	options := make([]string, len(iv.sources))
+	for i, source := range iv.sources {
+		options[i] = sourceLabel(source)
+	}
+	iv.sourceSelect = widget.NewSelect(options, func(string) {
+		source := iv.sources[iv.sourceSelect.SelectedIndex()]
+		iv.formatSelect.SetSelected(string(source.Format))
+		if source.ProjectPath != "" {
+			iv.picker.SetLocation(domain.ProjectLocation(source.ProjectPath, domain.ScopeProject))
+		}
+		iv.load(source.Path)
+	})
+	iv.sourceSelect.PlaceHolder = i18n.T("import.select_source")
+	if len(iv.sources) == 0 {
+		iv.sourceSelect.PlaceHolder = i18n.T("import.no_sources")
+		iv.sourceSelect.Disable()
+	}
+
+	formats := make([]string, 0, len(domain.ImportFormats()))
+	for _, format := range domain.ImportFormats() {
+		formats = append(formats, string(format))
+	}
+	iv.formatSelect = widget.NewSelect(formats, func(string) {
+		if iv.path != "" {
+			iv.load(iv.path)
+		}
+	})
+	iv.formatSelect.PlaceHolder = i18n.T("import.format")
+
+	browseBtn := widget.NewButtonWithIcon(i18n.T("import.browse"), theme.FolderOpenIcon(), func() {
+		dialog.ShowFileOpen(func(file fyne.URIReadCloser, err error) {
+			if err != nil || file == nil {
+				return
+			}
+			file.Close()
+			path := file.URI().Path()
+			if format := iv.mw.service.DetectImportFormat(path); format != "" {
+				iv.formatSelect.SetSelected(string(format))
+			}
+			iv.load(path)
+		}, iv.window)
+	})
+
+	iv.readIssue = newIssueLabel()
+	iv.serverBox = container.NewVBox(widget.NewLabel(i18n.T("import.select_source")))
+
+	iv.picker = NewLocationPicker(iv.mw.service.GetConfiguration(), domain.GlobalLocation())
+	iv.picker.SetOnChanged(iv.plan)
+	iv.overwrite = widget.NewCheck(i18n.T("import.overwrite"), func(bool) { iv.plan() })
+	iv.importBtn = widget.NewButtonWithIcon(i18n.T("import.run"), theme.DownloadIcon(), iv.runImport)
+	iv.importBtn.Importance = widget.HighImportance
+	iv.importBtn.Disable()
+
+	top := container.NewVBox(
+		widget.NewLabelWithStyle(i18n.T("import.source"), fyne.TextAlignLeading, fyne.TextStyle{Bold: true}),
+		container.NewBorder(nil, nil, nil, container.NewHBox(iv.formatSelect, browseBtn), iv.sourceSelect),
+		iv.readIssue,
+		widget.NewSeparator(),
+	)
+	bottom := container.NewVBox(
+		widget.NewSeparator(),
+		widget.NewLabelWithStyle(i18n.T("import.target"), fyne.TextAlignLeading, fyne.TextStyle{Bold: true}),
+		iv.picker.Container(),
+		iv.overwrite,
+		container.NewHBox(iv.importBtn),
+	)
+	return container.NewBorder(top, bottom, nil, nil, container.NewVScroll(iv.serverBox))
+}
+
+// sourceLabel descrive un file di un altro client nel selettore
+func sourceLabel(source domain.ImportSource) string {
+	return fmt.Sprintf("%s — %s", i18n.T("import.format_"+string(source.Format)), source.Path)
+}
+
+// load legge i server dal file con il formato selezionato
+func (iv *ImportView) load(path string) {
+	iv.path = path
+	iv.servers = nil
+	servers, err := iv.mw.service.ReadImport(domain.ImportFormat(iv.formatSelect.Selected), path)
+	if err != nil {
+		showIssues(iv.readIssue, domain.ValidationIssues{{Severity: domain.SeverityError, Code: "import_read", Detail: err.Error()}})
+	} else {
+		showIssues(iv.readIssue, nil)
+		iv.servers = servers
+	}
+	iv.plan()
+}
+
+// plan confronta i server letti con la destinazione e ricrea l'elenco da selezionare:
+// i nuovi sono selezionati, quelli già presenti non sono selezionabili
+func (iv *ImportView) plan() {
+	if iv.serverBox == nil || iv.importBtn == nil {
+		return
+	}
+	iv.serverBox.RemoveAll()
+	iv.candidates = nil
+	iv.checks = make(map[string]*widget.Check)
+
+	loc, err := iv.picker.Location()
+	if err == nil && len(iv.servers) > 0 {
+		iv.candidates, err = iv.mw.service.PlanImport(loc, iv.servers)
+	}
+	if err != nil {
+		iv.serverBox.Add(widget.NewLabel(err.Error()))
+	} else if iv.path != "" && len(iv.servers) == 0 {
+		iv.serverBox.Add(widget.NewLabel(i18n.T("import.empty")))
+	}
+
+	for _, c := range iv.candidates {
+		iv.serverBox.Add(iv.candidateWidget(c))
+	}
+	iv.serverBox.Refresh()
+	iv.updateImportButton()
+}
+
+// candidateWidget crea la riga di un server: selezione, stato rispetto alla destinazione,
+// comando o URL (segreti mascherati) e avvisi di conversione
+func (iv *ImportView) candidateWidget(c domain.ImportCandidate) fyne.CanvasObject {
+	check := widget.NewCheck(c.Name, func(bool) { iv.updateImportButton() })
+	switch c.Status {
+	case domain.ImportNew:
+		check.SetChecked(true)
+	case domain.ImportIdentical:
+		check.Disable()
+	case domain.ImportConflict:
+		check.SetChecked(iv.overwrite.Checked)
+	}
+	// Una voce che non si è potuta leggere si mostra solo con il suo errore
+	if c.Issues.HasErrors() {
+		check.SetChecked(false)
+		check.Disable()
+	}
+	iv.checks[c.Name] = check
+
+	status := widget.NewLabel(i18n.T("import.status_" + string(c.Status)))
+	switch c.Status {
+	case domain.ImportConflict:
+		status.Importance = widget.WarningImportance
+	case domain.ImportIdentical:
+		status.Importance = widget.LowImportance
+	}
+
+	server := iv.mw.service.RedactServer(c.Server)
+	target := server.URL
+	if server.Command != "" {
+		target = domain.JoinCommandLine(append([]string{server.Command}, server.Args...))
+	}
+	summary := widget.NewLabel(target)
+	summary.Truncation = fyne.TextTruncateEllipsis
+
+	row := container.NewVBox(container.NewBorder(nil, nil, check, status, summary))
+	issues := c.Issues
+	if c.Status == domain.ImportConflict {
+		issues = append(domain.ValidationIssues{{Severity: domain.SeverityWarning, Code: "import_conflict"}}, issues...)
+	}
+	if !c.Issues.HasErrors() {
+		issues = append(issues, c.Server.Validate()...)
+	}
+	if len(issues) > 0 {
+		label := newIssueLabel()
+		showIssues(label, issues)
+		row.Add(label)
+	}
+	return row
+}
+
+// selected restituisce i server selezionati
+func (iv *ImportView) selected() []domain.ImportedServer {
+	var servers []domain.ImportedServer
+	for _, c := range iv.candidates {
+		if check := iv.checks[c.Name]; check != nil && check.Checked && !check.Disabled() {
+			servers = append(servers, c.ImportedServer)
+		}
+	}
+	return servers
+}
+
+// updateImportButton abilita l'importazione se almeno un server è selezionato
+func (iv *ImportView) updateImportButton() {
+	if len(iv.selected()) > 0 {
+		iv.importBtn.Enable()
+	} else {
+		iv.importBtn.Disable()
+	}
+}
+
+// runImport importa i server selezionati con un solo salvataggio
+func (iv *ImportView) runImport() {
+	loc, err := iv.picker.Location()
+	if err != nil {
+		dialog.ShowError(err, iv.window)
+		return
+	}
+	imported, err := iv.mw.service.ImportServers(loc, iv.selected(), iv.overwrite.Checked, iv.path)
+	if imported > 0 {
+		iv.mw.refreshView()
+	}
+
+	var conflictErr *domain.ConflictError
+	switch {
+	case errors.As(err, &conflictErr):
+		// Il salvataggio attende la scelta dell'utente nel dialog dei conflitti
+		iv.mw.showSaveError(err)
+		iv.window.Close()
+		return
+	case err != nil && imported == 0:
+		iv.mw.showSaveError(err)
+		return
+	case err != nil:
+		dialog.ShowError(fmt.Errorf("%s\n\n%s", fmt.Sprintf(i18n.T("import.partial"), imported), errorMessage(err)), iv.window)
+		iv.plan()
+		return
+	}
+	dialog.ShowInformation(i18n.T("import.title"), fmt.Sprintf(i18n.T("import.done"), imported, iv.mw.locationDescription(loc)), iv.mw.window)
+	iv.window.Close()
+}
@@ -0,0 +1,145 @@
+package ui
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/container"
+	"fyne.io/fyne/v2/theme"
+	"fyne.io/fyne/v2/widget"
+
+	"github.com/strawberry-code/mcp-curator/internal/application"
+	"github.com/strawberry-code/mcp-curator/internal/domain"
+	"github.com/strawberry-code/mcp-curator/internal/i18n"
+	"github.com/strawberry-code/mcp-curator/internal/infrastructure"
+)
+
+// inventorySection crea la sezione del pannello dettagli con strumenti, risorse e prompt
+// del server, letti dalla cache; se manca l'inventario propone di leggerlo
+func (mw *MainWindow) inventorySection(name string, server domain.MCPServer) fyne.CanvasObject {
+	section := container.NewVBox(
+		widget.NewLabelWithStyle(i18n.T("inventory.title"), fyne.TextAlignLeading, fyne.TextStyle{Bold: true}),
+	)
+
+	inventory, ok := mw.service.CachedInventory(server)
+	if !ok {
+		hint := widget.NewLabel(i18n.T("inventory.not_loaded"))
+		hint.Wrapping = fyne.TextWrapWord
+		section.Add(hint)
+		section.Add(container.NewHBox(widget.NewButtonWithIcon(i18n.T("inventory.load"), theme.DownloadIcon(), func() {
+			mw.loadInventory(name, server)
+		})))
+		return section
+	}
+
+	refreshBtn := widget.NewButtonWithIcon(i18n.T("inventory.refresh"), theme.ViewRefreshIcon(), func() {
+		mw.loadInventory(name, server)
+	})
+	info := fmt.Sprintf(i18n.T("inventory.fetched_at"), inventory.FetchedAt.Format("2006-01-02 15:04"))
+	if serverInfo := strings.TrimSpace(inventory.Initialize.ServerInfo.Name + " " + inventory.Initialize.ServerInfo.Version); serverInfo != "" {
+		info = serverInfo + " — " + info
+	}
+	section.Add(container.NewBorder(nil, nil, nil, refreshBtn, widget.NewLabel(info)))
+
+	for _, method := range domain.SortedKeys(inventory.Errors) {
+		msg := widget.NewLabel(fmt.Sprintf(i18n.T("inventory.list_error"), method, inventory.Errors[method]))
+		msg.Wrapping = fyne.TextWrapWord
+		msg.Importance = widget.DangerImportance
+		section.Add(msg)
+	}
+
+	// Strumenti: descrizione e schema di input espandibili
+	section.Add(widget.NewLabel(fmt.Sprintf(i18n.T("inventory.tools"), len(inventory.Tools))))
+	if len(inventory.Tools) > 0 {
+		accordion := widget.NewAccordion()
+		for _, tool := range inventory.Tools {
+			accordion.Append(widget.NewAccordionItem(tool.Name, toolDetails(tool)))
+		}
+		section.Add(accordion)
+	}
+
+	section.Add(widget.NewLabel(fmt.Sprintf(i18n.T("inventory.resources"), len(inventory.Resources))))
+	for _, resource := range inventory.Resources {
+		text := resource.URI
+		if resource.Name != "" && resource.Name != resource.URI {
+			text = resource.Name + " — " + text
+		}
+		if resource.MimeType != "" {
+			text += " (" + resource.MimeType + ")"
+		}
+		section.Add(descriptionLabel("  "+text, resource.Description))
+	}
+
+	section.Add(widget.NewLabel(fmt.Sprintf(i18n.T("inventory.prompts"), len(inventory.Prompts))))
+	for _, prompt := range inventory.Prompts {
+		args := make([]string, 0, len(prompt.Arguments))
+		for _, arg := range prompt.Arguments {
+			if arg.Required {
+				args = append(args, arg.Name+"*")
+			} else {
+				args = append(args, arg.Name)
+			}
+		}
+		section.Add(descriptionLabel(fmt.Sprintf("  %s(%s)", prompt.Name, strings.Join(args, ", ")), prompt.Description))
+	}
+
+	return section
+}
+
+// toolDetails mostra descrizione e schema di input di uno strumento
+func toolDetails(tool infrastructure.Tool) fyne.CanvasObject {
+	description := tool.Description
+	if description == "" {
+		description = i18n.T("inventory.no_description")
+	}
+	if tool.Title != "" {
+		description = tool.Title + "\n\n" + description
+	}
+	descLabel := widget.NewLabel(description)
+	descLabel.Wrapping = fyne.TextWrapWord
+
+	details := container.NewVBox(descLabel)
+	if len(tool.InputSchema) > 0 {
+		var schema bytes.Buffer
+		if err := json.Indent(&schema, tool.InputSchema, "", "  "); err != nil {
+			schema.Reset()
+			schema.Write(tool.InputSchema)
+		}
+		schemaLabel := widget.NewLabelWithStyle(schema.String(), fyne.TextAlignLeading, fyne.TextStyle{Monospace: true})
+		schemaLabel.Selectable = true
+		details.Add(widget.NewLabel(i18n.T("inventory.schema") + ":"))
+		details.Add(schemaLabel)
+	}
+	return details
+}
+
+// descriptionLabel crea un'etichetta con titolo e, se presente, la descrizione sotto
+func descriptionLabel(title, description string) fyne.CanvasObject {
+	if description == "" {
+		return widget.NewLabel(title)
+	}
+	label := widget.NewLabel(title + "\n    " + strings.ReplaceAll(strings.TrimSpace(description), "\n", "\n    "))
+	label.Wrapping = fyne.TextWrapWord
+	return label
+}
+
+// loadInventory si connette al server, ne legge l'inventario e aggiorna tree e dettagli
+func (mw *MainWindow) loadInventory(name string, server domain.MCPServer) {
+	message := fmt.Sprintf(i18n.T("inventory.loading"), name, infrastructure.ServerTimeout(server))
+	mw.runConnection(name, message,
+		func(ctx context.Context) application.ConnectionReport {
+			return mw.service.InspectServer(ctx, server)
+		},
+		func(report application.ConnectionReport) {
+			if !report.OK() {
+				mw.showTestReport(name, report)
+				return
+			}
+			mw.refreshView()
+		},
+	)
+}
@@ -0,0 +1,174 @@
+package ui
+
+import (
+	"sort"
+	"strings"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/container"
+	"fyne.io/fyne/v2/theme"
+	"fyne.io/fyne/v2/widget"
+
+	"github.com/strawberry-code/mcp-curator/internal/i18n"
+)
+
+// keyValueRow è una riga chiave/valore dell'editor
+type keyValueRow struct {
+	key      string
+	value    string
+	secret   bool
+	revealed bool
+}
+
+// KeyValueEditor modifica una mappa chiave/valore (env o headers) con una riga per voce.
+// I valori riconosciuti come segreti restano mascherati finché non vengono rivelati.
+type KeyValueEditor struct {
+	rows      []*keyValueRow
+	keyHint   string
+	valueHint string
+	isSecret  func(key, value string) bool
+	onChanged func()
+
+	list      *fyne.Container
+	container *fyne.Container
+}
+
+// NewKeyValueEditor crea un editor inizializzato con values (righe ordinate per chiave).
+// isSecret decide quali valori mascherare.
+func NewKeyValueEditor(values map[string]string, keyHint, valueHint string, isSecret func(key, value string) bool) *KeyValueEditor {
+	kv := &KeyValueEditor{keyHint: keyHint, valueHint: valueHint, isSecret: isSecret}
+
+	keys := make([]string, 0, len(values))
+	for k := range values {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		kv.rows = append(kv.rows, &keyValueRow{key: k, value: values[k], secret: isSecret(k, values[k])})
+	}
+
+	kv.list = container.NewVBox()
+	addBtn := widget.NewButtonWithIcon(i18n.T("form.add_row"), theme.ContentAddIcon(), func() {
+		kv.rows = append(kv.rows, &keyValueRow{})
+		kv.rebuild()
+		kv.changed()
+	})
+	addBtn.Importance = widget.LowImportance
+
+	kv.container = container.NewVBox(kv.list, container.NewHBox(addBtn))
+	kv.rebuild()
+	return kv
+}
+
+// Container restituisce il container dell'editor
+func (kv *KeyValueEditor) Container() *fyne.Container {
+	return kv.container
+}
+
+// SetOnChanged registra la funzione chiamata a ogni modifica
+func (kv *KeyValueEditor) SetOnChanged(fn func()) {
+	kv.onChanged = fn
+}
+
+// Values restituisce la mappa delle voci (nil se vuota). Le righe del tutto vuote sono
+// ignorate; con chiavi ripetute vale l'ultima riga (vedi Duplicates).
+func (kv *KeyValueEditor) Values() map[string]string {
+	var values map[string]string
+	for _, row := range kv.rows {
+		if row.key == "" && row.value == "" {
+			continue
+		}
+		if values == nil {
+			values = make(map[string]string)
+		}
+		values[strings.TrimSpace(row.key)] = row.value
+	}
+	return values
+}
+
+// Duplicates restituisce le chiavi presenti in più righe
+func (kv *KeyValueEditor) Duplicates() []string {
+	seen := make(map[string]int)
+	var duplicates []string
+	for _, row := range kv.rows {
+		key := strings.TrimSpace(row.key)
+		if key == "" {
+			continue
+		}
+		seen[key]++
+		if seen[key] == 2 {
+			duplicates = append(duplicates, key)
+		}
+	}
+	return duplicates
+}
+
+// rebuild ricrea le righe dopo un'aggiunta, una rimozione o un cambio di mascheramento
+func (kv *KeyValueEditor) rebuild() {
+	kv.list.RemoveAll()
+	for i, row := range kv.rows {
+		kv.list.Add(kv.rowWidget(i, row))
+	}
+	kv.list.Refresh()
+}
+
+// rowWidget crea i campi di una riga con i bottoni per rivelare e rimuovere
+func (kv *KeyValueEditor) rowWidget(index int, row *keyValueRow) fyne.CanvasObject {
+	keyEntry := widget.NewEntry()
+	keyEntry.SetPlaceHolder(kv.keyHint)
+	keyEntry.SetText(row.key)
+
+	valueEntry := widget.NewEntry()
+	valueEntry.SetPlaceHolder(kv.valueHint)
+	valueEntry.SetText(row.value)
+	valueEntry.Password = row.secret && !row.revealed
+
+	revealBtn := widget.NewButtonWithIcon("", theme.VisibilityIcon(), func() {
+		row.revealed = !row.revealed
+		kv.rebuild()
+	})
+	if row.revealed {
+		revealBtn.SetIcon(theme.VisibilityOffIcon())
+	}
+	revealBtn.Importance = widget.LowImportance
+	if !row.secret {
+		revealBtn.Hide()
+	}
+
+	keyEntry.OnChanged = func(text string) {
+		row.key = text
+		// Una chiave da segreto (es. API_KEY) maschera il valore appena digitata
+		if secret := kv.isSecret(text, row.value); secret != row.secret {
+			row.secret = secret
+			valueEntry.Password = secret && !row.revealed
+			valueEntry.Refresh()
+			if secret {
+				revealBtn.Show()
+			} else {
+				revealBtn.Hide()
+			}
+		}
+		kv.changed()
+	}
+	valueEntry.OnChanged = func(text string) {
+		row.value = text
+		kv.changed()
+	}
+
+	removeBtn := widget.NewButtonWithIcon("", theme.DeleteIcon(), func() {
+		kv.rows = append(kv.rows[:index], kv.rows[index+1:]...)
+		kv.rebuild()
+		kv.changed()
+	})
+	removeBtn.Importance = widget.LowImportance
+
+	return container.NewBorder(nil, nil, nil, container.NewHBox(revealBtn, removeBtn),
+		container.NewGridWithColumns(2, keyEntry, valueEntry))
+}
+
+// changed notifica una modifica
+func (kv *KeyValueEditor) changed() {
+	if kv.onChanged != nil {
+		kv.onChanged()
+	}
+}
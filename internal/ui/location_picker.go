@@ -0,0 +1,139 @@
+package ui
+
+import (
+	"errors"
+	"sort"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/container"
+	"fyne.io/fyne/v2/widget"
+
+	"github.com/strawberry-code/mcp-curator/internal/domain"
+	"github.com/strawberry-code/mcp-curator/internal/i18n"
+)
+
+// LocationPicker permette di scegliere scope, progetto e file di destinazione di un server
+type LocationPicker struct {
+	scopeRadio    *widget.RadioGroup
+	projectSelect *widget.Select
+	fileSelect    *widget.Select
+	container     *fyne.Container
+	onChanged     func()
+}
+
+// NewLocationPicker crea un selettore di posizione inizializzato su initial
+func NewLocationPicker(config *domain.Configuration, initial domain.Location) *LocationPicker {
+	lp := &LocationPicker{}
+
+	lp.projectSelect = widget.NewSelect(sortedProjectPaths(config), nil)
+	lp.fileSelect = newFileSelect()
+	lp.scopeRadio = widget.NewRadioGroup([]string{i18n.T("form.scope_global"), i18n.T("form.scope_project")}, func(selected string) {
+		if selected == i18n.T("form.scope_global") {
+			lp.projectSelect.Disable()
+			lp.fileSelect.Disable()
+		} else {
+			lp.projectSelect.Enable()
+			lp.fileSelect.Enable()
+		}
+		lp.changed()
+	})
+	lp.scopeRadio.Horizontal = true
+	lp.projectSelect.OnChanged = func(string) { lp.changed() }
+	lp.fileSelect.OnChanged = func(string) { lp.changed() }
+
+	lp.SetLocation(initial)
+
+	lp.container = container.NewVBox(
+		lp.scopeRadio,
+		lp.projectSelect,
+		widget.NewLabel(i18n.T("form.file")+":"),
+		lp.fileSelect,
+	)
+	return lp
+}
+
+// Container restituisce il container del selettore
+func (lp *LocationPicker) Container() *fyne.Container {
+	return lp.container
+}
+
+// SetOnChanged registra la funzione chiamata quando cambia la posizione selezionata
+func (lp *LocationPicker) SetOnChanged(fn func()) {
+	lp.onChanged = fn
+}
+
+// SetLocation seleziona una posizione
+func (lp *LocationPicker) SetLocation(loc domain.Location) {
+	if loc.IsGlobal() {
+		lp.scopeRadio.SetSelected(i18n.T("form.scope_global"))
+		lp.fileSelect.SetSelected(fileScopeLabel(domain.ScopeProject))
+		return
+	}
+	lp.scopeRadio.SetSelected(i18n.T("form.scope_project"))
+	lp.projectSelect.SetSelected(loc.ProjectPath)
+	lp.fileSelect.SetSelected(fileScopeLabel(loc.Scope))
+}
+
+// changed notifica un cambio di posizione
+func (lp *LocationPicker) changed() {
+	if lp.onChanged != nil {
+		lp.onChanged()
+	}
+}
+
+// Disable impedisce di modificare la posizione
+func (lp *LocationPicker) Disable() {
+	lp.scopeRadio.Disable()
+	lp.projectSelect.Disable()
+	lp.fileSelect.Disable()
+}
+
+// Location restituisce la posizione selezionata
+func (lp *LocationPicker) Location() (domain.Location, error) {
+	if lp.scopeRadio.Selected == i18n.T("form.scope_global") {
+		return domain.GlobalLocation(), nil
+	}
+	if lp.projectSelect.Selected == "" {
+		return domain.Location{}, errors.New(i18n.T("form.project_required"))
+	}
+	return domain.ProjectLocation(lp.projectSelect.Selected, fileScopeFromLabel(lp.fileSelect.Selected)), nil
+}
+
+// newFileSelect crea il selettore del file di progetto (~/.claude.json, .mcp.json, .mcp.local.json)
+func newFileSelect() *widget.Select {
+	options := make([]string, 0, len(domain.ProjectScopes))
+	for _, scope := range domain.ProjectScopes {
+		options = append(options, fileScopeLabel(scope))
+	}
+	sel := widget.NewSelect(options, nil)
+	sel.SetSelected(fileScopeLabel(domain.ScopeProject))
+	return sel
+}
+
+// fileScopeLabel restituisce l'etichetta del file di uno scope di progetto
+func fileScopeLabel(scope domain.Scope) string {
+	switch scope {
+	case domain.ScopeProjectFile:
+		return i18n.T("form.file_mcp_json")
+	case domain.ScopeProjectLocal:
+		return i18n.T("form.file_mcp_local")
+	}
+	return i18n.T("form.file_settings")
+}
+
+// fileScopeFromLabel converte l'etichetta del file nello scope di progetto corrispondente
+func fileScopeFromLabel(label string) domain.Scope {
+	for _, scope := range domain.ProjectScopes {
+		if fileScopeLabel(scope) == label {
+			return scope
+		}
+	}
+	return domain.ScopeProject
+}
+
+// sortedProjectPaths restituisce i path dei progetti in ordine alfabetico
+func sortedProjectPaths(config *domain.Configuration) []string {
+	paths := config.ProjectPaths()
+	sort.Strings(paths)
+	return paths
+}
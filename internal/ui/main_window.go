@@ -9,22 +9,44 @@ import (
 
 	"github.com/strawberry-code/mcp-curator/internal/application"
 	"github.com/strawberry-code/mcp-curator/internal/i18n"
+	"github.com/strawberry-code/mcp-curator/internal/infrastructure"
 	"github.com/strawberry-code/mcp-curator/internal/version"
 )
 
 // MainWindow è la finestra principale dell'applicazione
 type MainWindow struct {
-	app         fyne.App
-	window      fyne.Window
-	service     *application.MCPService
-	tree        *widget.Tree
-	detailPanel *fyne.Container
-	selectedID  string
-	mainContent fyne.CanvasObject
+	app          fyne.App
+	window       fyne.Window
+	service      *application.MCPService
+	tree         *widget.Tree
+	treeScroll   *container.Scroll
+	detailPanel  *fyne.Container
+	detailScroll *container.Scroll
+	selectedID   string
+	treeFilter   treeFilter
+	bulk         bulkSelection
+	treeRows     []*treeRow
+	drag         treeDrag
+	mainContent  fyne.CanvasObject
+
+	// Campi con segreti rivelati nel pannello dettagli, validi per l'elemento revealedFor
+	revealed    map[string]bool
+	revealedFor string
+
+	// Osservazione dei file di configurazione
+	watcher     *infrastructure.FileWatcher
+	banner      *fyne.Container
+	bannerLabel *widget.Label
 
 	// Elementi UI che richiedono aggiornamento su cambio lingua
 	addBtn     *widget.Button
+	importBtn  *widget.Button
+	exportBtn  *widget.Button
 	refreshBtn *widget.Button
+	toolsBtn   *widget.Button
+	undoBtn    *widget.Button
+	redoBtn    *widget.Button
+	historyBtn *widget.Button
 	langSelect *widget.Select
 }
 
@@ -70,15 +92,23 @@ func (mw *MainWindow) buildUI() {
 	toolbar := mw.createToolbar()
 
 	// Split view
-	split := container.NewHSplit(
-		container.NewScroll(mw.tree),
-		container.NewScroll(mw.detailPanel),
+	mw.treeScroll = container.NewScroll(mw.tree)
+	mw.detailScroll = container.NewScroll(mw.detailPanel)
+	treePane := container.NewBorder(
+		container.NewVBox(mw.createTreeFilterBar(), mw.createBulkToggle()),
+		mw.createBulkBar(),
+		nil, nil,
+		mw.treeScroll,
 	)
+	split := container.NewHSplit(treePane, mw.detailScroll)
 	split.SetOffset(0.35)
 
+	// Banner per le modifiche esterne (nascosto finché non serve)
+	mw.banner = mw.createBanner()
+
 	// Layout principale
 	mw.mainContent = container.NewBorder(
-		toolbar,
+		container.NewVBox(toolbar, mw.banner),
 		nil,
 		nil,
 		nil,
@@ -89,6 +119,9 @@ func (mw *MainWindow) buildUI() {
 	i18n.OnChange(func(lang i18n.Lang) {
 		mw.updateUIStrings()
 	})
+
+	mw.registerHistoryShortcuts()
+	mw.startFileWatcher()
 }
 
 // createToolbar crea la toolbar con i bottoni principali
@@ -97,10 +130,34 @@ func (mw *MainWindow) createToolbar() *fyne.Container {
 		mw.showAddServerDialog()
 	})
 
+	mw.importBtn = widget.NewButtonWithIcon(i18n.T("toolbar.import"), theme.DownloadIcon(), func() {
+		mw.showImportView()
+	})
+
+	mw.exportBtn = widget.NewButtonWithIcon(i18n.T("toolbar.export"), theme.UploadIcon(), func() {
+		mw.showExportView()
+	})
+
 	mw.refreshBtn = widget.NewButtonWithIcon(i18n.T("toolbar.refresh"), theme.ViewRefreshIcon(), func() {
 		mw.refresh()
 	})
 
+	mw.undoBtn = widget.NewButtonWithIcon("", theme.ContentUndoIcon(), func() {
+		mw.undo()
+	})
+	mw.redoBtn = widget.NewButtonWithIcon("", theme.ContentRedoIcon(), func() {
+		mw.redo()
+	})
+	mw.historyBtn = widget.NewButton(i18n.T("toolbar.history"), func() {
+		mw.showHistoryDialog()
+	})
+	mw.updateHistoryButtons()
+
+	// Le viste di manutenzione stanno in un menu, così la toolbar non viene tagliata
+	mw.toolsBtn = widget.NewButtonWithIcon(i18n.T("toolbar.tools"), theme.MoreHorizontalIcon(), func() {
+		mw.showToolsMenu()
+	})
+
 	// Selettore lingua compatto
 	langs := []string{"IT", "EN", "FR", "DE", "ES", "PT", "JA", "KO", "CN", "UK"}
 	mw.langSelect = widget.NewSelect(langs, func(selected string) {
@@ -110,12 +167,41 @@ func (mw *MainWindow) createToolbar() *fyne.Container {
 
 	return container.NewHBox(
 		mw.addBtn,
+		mw.importBtn,
+		mw.exportBtn,
 		mw.refreshBtn,
 		widget.NewSeparator(),
+		mw.undoBtn,
+		mw.redoBtn,
+		mw.historyBtn,
+		widget.NewSeparator(),
+		mw.toolsBtn,
+		widget.NewSeparator(),
 		mw.langSelect,
 	)
 }
 
+// showToolsMenu apre sotto il bottone Strumenti il menu delle viste di manutenzione
+func (mw *MainWindow) showToolsMenu() {
+	item := func(key string, icon fyne.Resource, action func()) *fyne.MenuItem {
+		menuItem := fyne.NewMenuItem(i18n.T(key), action)
+		menuItem.Icon = icon
+		return menuItem
+	}
+	menu := fyne.NewMenu("",
+		item("toolbar.vault", theme.StorageIcon(), mw.showVaultView),
+		item("toolbar.backups", theme.HistoryIcon(), mw.showBackupView),
+		fyne.NewMenuItemSeparator(),
+		item("toolbar.duplicates", theme.ContentCopyIcon(), mw.showDuplicatesView),
+		item("toolbar.compare", theme.ViewRestoreIcon(), mw.showCompareView),
+		item("toolbar.discover", theme.SearchIcon(), mw.showDiscoveryView),
+		item("toolbar.cleanup", theme.DeleteIcon(), mw.showCleanupView),
+	)
+	canvas := mw.window.Canvas()
+	pos := fyne.CurrentApp().Driver().AbsolutePositionForObject(mw.toolsBtn)
+	widget.ShowPopUpMenuAtPosition(menu, canvas, pos.Add(fyne.NewPos(0, mw.toolsBtn.Size().Height)))
+}
+
 // changeLanguage cambia la lingua dell'interfaccia
 func (mw *MainWindow) changeLanguage(lang string) {
 	i18n.SetLang(lang)
@@ -125,9 +211,15 @@ func (mw *MainWindow) changeLanguage(lang string) {
 func (mw *MainWindow) updateUIStrings() {
 	// Aggiorna bottoni toolbar
 	mw.addBtn.SetText(i18n.T("toolbar.add_server"))
+	mw.importBtn.SetText(i18n.T("toolbar.import"))
+	mw.exportBtn.SetText(i18n.T("toolbar.export"))
 	mw.refreshBtn.SetText(i18n.T("toolbar.refresh"))
+	mw.toolsBtn.SetText(i18n.T("toolbar.tools"))
+	mw.historyBtn.SetText(i18n.T("toolbar.history"))
 
-	// Aggiorna tree
+	// Aggiorna filtri e tree
+	mw.updateTreeFilterStrings()
+	mw.updateBulkStrings()
 	mw.tree.Refresh()
 
 	// Aggiorna pannello dettagli
@@ -145,12 +237,26 @@ func (mw *MainWindow) refresh() {
 		dialog.ShowError(err, mw.window)
 		return
 	}
+	mw.refreshView()
+}
 
-	// Aggiorna solo il tree senza ricrearlo
+// refreshView aggiorna tree e dettagli mantenendo selezione e posizione di scroll.
+// Il tree conserva da sé la propria posizione: va salvata solo quella dei dettagli.
+func (mw *MainWindow) refreshView() {
+	detailOffset := mw.detailScroll.Offset
+
+	// Aggiorna solo il tree senza ricrearlo (l'uso dei server va ricalcolato)
+	mw.treeFilter.usage = nil
 	mw.tree.Refresh()
 
 	// Aggiorna il pannello dettagli se c'è una selezione
 	if mw.selectedID != "" {
 		mw.updateDetailPanel(mw.selectedID)
 	}
+
+	mw.updateHistoryButtons()
+	mw.updateBulkBar()
+
+	mw.detailScroll.Offset = detailOffset
+	mw.detailScroll.Refresh()
 }
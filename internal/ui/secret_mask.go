@@ -0,0 +1,109 @@
+package ui
+
+import (
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/container"
+	"fyne.io/fyne/v2/dialog"
+	"fyne.io/fyne/v2/theme"
+	"fyne.io/fyne/v2/widget"
+
+	"github.com/strawberry-code/mcp-curator/internal/domain"
+	"github.com/strawberry-code/mcp-curator/internal/i18n"
+)
+
+// addMaskedValue aggiunge una riga del pannello dettagli con il valore originale e quello espanso.
+// Se mask nasconde qualcosa, la riga mostra il valore mascherato e un bottone per rivelarlo
+// (lo stato vale per il solo campo id finché resta selezionato lo stesso elemento).
+// trailing, se presente, viene affiancato in fondo alla riga.
+func (mw *MainWindow) addMaskedValue(id, prefix, raw, expanded string, mask func(string) string, trailing fyne.CanvasObject) {
+	maskedRaw, maskedExpanded := mask(raw), mask(expanded)
+	sensitive := maskedRaw != raw || maskedExpanded != expanded
+	if sensitive && !mw.revealed[id] {
+		raw, expanded = maskedRaw, maskedExpanded
+	}
+
+	var buttons []fyne.CanvasObject
+	if sensitive {
+		buttons = append(buttons, mw.revealButton(id))
+	}
+	if trailing != nil {
+		buttons = append(buttons, trailing)
+	}
+
+	label := widget.NewLabel(prefix + raw)
+	if len(buttons) == 0 {
+		mw.detailPanel.Add(label)
+	} else {
+		mw.detailPanel.Add(container.NewBorder(nil, nil, nil, container.NewHBox(buttons...), label))
+	}
+	if expanded != raw {
+		mw.addExpandedValue(expanded)
+	}
+}
+
+// revealButton crea il bottone che mostra o nasconde il valore di un campo
+func (mw *MainWindow) revealButton(id string) *widget.Button {
+	label, icon := i18n.T("detail.reveal"), theme.VisibilityIcon()
+	if mw.revealed[id] {
+		label, icon = i18n.T("detail.hide"), theme.VisibilityOffIcon()
+	}
+	btn := widget.NewButtonWithIcon(label, icon, func() {
+		if mw.revealed == nil {
+			mw.revealed = make(map[string]bool)
+		}
+		mw.revealed[id] = !mw.revealed[id]
+		mw.updateDetailPanel(mw.selectedID)
+	})
+	btn.Importance = widget.LowImportance
+	return btn
+}
+
+// resetRevealed nasconde di nuovo tutti i valori quando cambia l'elemento selezionato
+func (mw *MainWindow) resetRevealed(id string) {
+	if id != mw.revealedFor {
+		mw.revealed = nil
+		mw.revealedFor = id
+	}
+}
+
+// keyValueMask nasconde per intero i valori env/header da segreto e le credenziali negli altri
+func (mw *MainWindow) keyValueMask(key string) func(string) string {
+	return func(value string) string {
+		if mw.service.IsSecret(key, value) {
+			return domain.MaskedValue
+		}
+		return mw.service.MaskText(value)
+	}
+}
+
+// argMasks restituisce la maschera di ciascun argomento: i valori di flag da segreto
+// (--token X) sono riconoscibili solo guardando l'argomento precedente
+func (mw *MainWindow) argMasks(raw, expanded []string) []func(string) string {
+	rawSecret, expandedSecret := domain.SecretArgs(raw), domain.SecretArgs(expanded)
+	masks := make([]func(string) string, len(raw))
+	for i := range raw {
+		i := i
+		masks[i] = func(value string) string {
+			if value == raw[i] && rawSecret[i] || value == expanded[i] && expandedSecret[i] {
+				return domain.RedactArg(value, domain.MaskedValue)
+			}
+			return mw.service.MaskText(value)
+		}
+	}
+	return masks
+}
+
+// copyToClipboard copia un testo negli appunti dopo aver rimosso i segreti
+func (mw *MainWindow) copyToClipboard(text string) {
+	mw.app.Clipboard().SetContent(mw.service.RedactText(text))
+}
+
+// copyServerJSON copia negli appunti la definizione JSON del server, senza segreti
+func (mw *MainWindow) copyServerJSON(name string, server domain.MCPServer) {
+	data, err := mw.service.ServerJSON(name, mw.service.RedactServer(server))
+	if err != nil {
+		dialog.ShowError(err, mw.window)
+		return
+	}
+	mw.copyToClipboard(data)
+}
@@ -11,75 +11,101 @@ import (
 	"fyne.io/fyne/v2/theme"
 	"fyne.io/fyne/v2/widget"
 
+	"github.com/strawberry-code/mcp-curator/internal/application"
 	"github.com/strawberry-code/mcp-curator/internal/domain"
 	"github.com/strawberry-code/mcp-curator/internal/i18n"
 )
 
 // showAddServerDialog mostra la scelta del metodo di aggiunta (form o JSON)
 func (mw *MainWindow) showAddServerDialog() {
-	mw.showAddMethodDialog(true, "")
+	mw.showAddMethodDialog(domain.GlobalLocation())
 }
 
 // showAddMethodDialog mostra la scelta tra form e JSON per aggiungere un server
-func (mw *MainWindow) showAddMethodDialog(isGlobal bool, projectPath string) {
+func (mw *MainWindow) showAddMethodDialog(loc domain.Location) {
 	formBtn := widget.NewButtonWithIcon(i18n.T("dialog.add_via_form"), theme.DocumentCreateIcon(), func() {})
 	jsonBtn := widget.NewButtonWithIcon(i18n.T("dialog.add_via_json"), theme.ContentPasteIcon(), func() {})
+	templateBtn := widget.NewButtonWithIcon(i18n.T("dialog.add_via_template"), theme.ListIcon(), func() {})
 
 	content := container.NewVBox(
 		widget.NewLabel(i18n.T("dialog.add_method")),
 		widget.NewSeparator(),
-		container.NewGridWithColumns(2, formBtn, jsonBtn),
+		container.NewGridWithColumns(3, formBtn, jsonBtn, templateBtn),
 	)
 
 	d := dialog.NewCustomWithoutButtons(i18n.T("dialog.add_server"), content, mw.window)
 
 	formBtn.OnTapped = func() {
 		d.Hide()
-		mw.showAddServerFormDialog(isGlobal, projectPath)
+		mw.showAddServerFormDialog(loc)
 	}
 
 	jsonBtn.OnTapped = func() {
 		d.Hide()
-		mw.showAddServerJSONDialog(isGlobal, projectPath)
+		mw.showAddServerJSONDialog(loc)
 	}
 
-	d.Resize(fyne.NewSize(400, 150))
+	templateBtn.OnTapped = func() {
+		d.Hide()
+		mw.showTemplateView(loc)
+	}
+
+	d.Resize(fyne.NewSize(520, 150))
 	d.Show()
 }
 
 // showAddServerFormDialog mostra il dialog form per aggiungere un server
-func (mw *MainWindow) showAddServerFormDialog(isGlobal bool, projectPath string) {
-	form := NewServerForm(mw.service, nil, "", isGlobal, projectPath)
+func (mw *MainWindow) showAddServerFormDialog(loc domain.Location) {
+	form := NewServerForm(mw.service, nil, "", loc)
 
 	title := i18n.T("dialog.add_server")
-	if !isGlobal && projectPath != "" {
+	if !loc.IsGlobal() {
 		title = i18n.T("dialog.add_server_to_project")
 	}
+	mw.showServerFormDialog(title, form, form.Save)
+}
 
-	d := dialog.NewCustomConfirm(title, i18n.T("btn.save"), i18n.T("btn.cancel"),
-		form.Container(),
-		func(ok bool) {
-			if ok {
-				if err := form.Save(); err != nil {
-					dialog.ShowError(err, mw.window)
-					return
-				}
-				mw.refresh()
+// showServerFormDialog mostra un form server con il bottone Salva disabilitato
+// finché ci sono errori di validazione
+func (mw *MainWindow) showServerFormDialog(title string, form *ServerForm, submit func() error) {
+	d := dialog.NewCustomWithoutButtons(title, container.NewVScroll(form.Container()), mw.window)
+
+	saveBtn := widget.NewButtonWithIcon(i18n.T("btn.save"), theme.ConfirmIcon(), func() {
+		if err := submit(); err != nil {
+			// Gli errori di validazione restano visibili nel form: il dialog resta aperto
+			var validationErr *domain.ValidationError
+			if errors.As(err, &validationErr) {
+				form.Validate()
+				mw.showValidationError(validationErr)
+				return
 			}
-		},
-		mw.window,
-	)
-	d.Resize(fyne.NewSize(500, 400))
-	d.Show()
-}
+			d.Hide()
+			mw.showSaveError(err)
+			return
+		}
+		d.Hide()
+		mw.refresh()
+	})
+	saveBtn.Importance = widget.HighImportance
+	cancelBtn := widget.NewButtonWithIcon(i18n.T("btn.cancel"), theme.CancelIcon(), func() {
+		d.Hide()
+	})
 
-// showAddServerToProjectDialog mostra la scelta del metodo di aggiunta per un progetto
-func (mw *MainWindow) showAddServerToProjectDialog(projectPath string) {
-	mw.showAddMethodDialog(false, projectPath)
+	form.SetOnValidation(func(valid bool) {
+		if valid {
+			saveBtn.Enable()
+		} else {
+			saveBtn.Disable()
+		}
+	})
+
+	d.SetButtons([]fyne.CanvasObject{cancelBtn, saveBtn})
+	d.Resize(fyne.NewSize(640, 640))
+	d.Show()
 }
 
 // showAddServerJSONDialog mostra il dialog per aggiungere un server tramite JSON raw
-func (mw *MainWindow) showAddServerJSONDialog(isGlobal bool, projectPath string) {
+func (mw *MainWindow) showAddServerJSONDialog(loc domain.Location) {
 	jsonEntry := widget.NewMultiLineEntry()
 	jsonEntry.SetPlaceHolder(i18n.T("dialog.add_json_hint"))
 	jsonEntry.Wrapping = fyne.TextWrapWord
@@ -103,8 +129,8 @@ func (mw *MainWindow) showAddServerJSONDialog(isGlobal bool, projectPath string)
 				return
 			}
 
-			if err := mw.saveServer(name, server, isGlobal, projectPath); err != nil {
-				dialog.ShowError(err, mw.window)
+			if err := mw.service.AddServer(loc, name, server); err != nil {
+				mw.showSaveError(err)
 				return
 			}
 
@@ -135,49 +161,20 @@ func (mw *MainWindow) parseServerJSON(jsonText string) (string, domain.MCPServer
 	return name, server, nil
 }
 
-// saveServer salva un server nello scope appropriato
-func (mw *MainWindow) saveServer(name string, server domain.MCPServer, isGlobal bool, projectPath string) error {
-	if isGlobal {
-		return mw.service.AddGlobalServer(name, server)
-	}
-	return mw.service.AddProjectServer(projectPath, name, server)
-}
-
 // showEditServerDialog mostra il dialog per modificare un server
-func (mw *MainWindow) showEditServerDialog(name string, server *domain.MCPServer, isGlobal bool, projectPath string) {
-	form := NewServerForm(mw.service, server, name, isGlobal, projectPath)
-
-	d := dialog.NewCustomConfirm(i18n.T("dialog.edit_server"), i18n.T("btn.save"), i18n.T("btn.cancel"),
-		form.Container(),
-		func(ok bool) {
-			if ok {
-				if err := form.Update(); err != nil {
-					dialog.ShowError(err, mw.window)
-					return
-				}
-				mw.refresh()
-			}
-		},
-		mw.window,
-	)
-	d.Resize(fyne.NewSize(500, 400))
-	d.Show()
+func (mw *MainWindow) showEditServerDialog(name string, server *domain.MCPServer, loc domain.Location) {
+	form := NewServerForm(mw.service, server, name, loc)
+	mw.showServerFormDialog(i18n.T("dialog.edit_server"), form, form.Update)
 }
 
 // confirmDeleteServer conferma l'eliminazione di un server
-func (mw *MainWindow) confirmDeleteServer(name string, isGlobal bool, projectPath string) {
+func (mw *MainWindow) confirmDeleteServer(name string, loc domain.Location) {
 	dialog.ShowConfirm(i18n.T("dialog.delete_server"),
 		fmt.Sprintf(i18n.T("dialog.delete_confirm"), name),
 		func(ok bool) {
 			if ok {
-				var err error
-				if isGlobal {
-					err = mw.service.RemoveGlobalServer(name)
-				} else {
-					err = mw.service.RemoveProjectServer(projectPath, name)
-				}
-				if err != nil {
-					dialog.ShowError(err, mw.window)
+				if err := mw.service.RemoveServer(loc, name); err != nil {
+					mw.showSaveError(err)
 					return
 				}
 				mw.refresh()
@@ -187,81 +184,82 @@ func (mw *MainWindow) confirmDeleteServer(name string, isGlobal bool, projectPat
 	)
 }
 
-// showMoveServerDialog mostra il dialog per spostare un server
-func (mw *MainWindow) showMoveServerDialog(name string, isGlobal bool, projectPath string) {
+// showMoveServerDialog mostra il dialog per spostare un server in un altro scope o file
+func (mw *MainWindow) showMoveServerDialog(name string, from domain.Location) {
 	config := mw.service.GetConfiguration()
 
-	if isGlobal {
-		// Sposta da globale a progetto
-		var options []string
-		for path := range config.Projects {
-			options = append(options, path)
-		}
-
-		if len(options) == 0 {
-			dialog.ShowInformation(i18n.T("dialog.no_projects"),
-				i18n.T("dialog.no_projects_msg"),
-				mw.window)
-			return
-		}
+	// Proponi come destinazione lo scope "opposto" a quello di origine
+	initial := domain.GlobalLocation()
+	if from.IsGlobal() {
+		initial = domain.ProjectLocation("", domain.ScopeProject)
+	}
+	picker := NewLocationPicker(config, initial)
+
+	d := dialog.NewCustomConfirm(fmt.Sprintf("%s: %s", i18n.T("dialog.move_server"), name),
+		i18n.T("btn.move"), i18n.T("btn.cancel"),
+		container.NewVBox(
+			widget.NewLabel(i18n.T("dialog.move_to")),
+			picker.Container(),
+		),
+		func(ok bool) {
+			if !ok {
+				return
+			}
+			to, err := picker.Location()
+			if err != nil {
+				dialog.ShowError(err, mw.window)
+				return
+			}
+			mw.moveServer(name, from, to)
+		},
+		mw.window,
+	)
+	d.Resize(fyne.NewSize(450, 300))
+	d.Show()
+}
 
-		selectEntry := widget.NewSelect(options, nil)
+// moveServer sposta un server chiedendo conferma se la destinazione ha già un server con lo stesso nome
+func (mw *MainWindow) moveServer(name string, from, to domain.Location) {
+	err := mw.service.MoveServer(name, from, to, false)
 
-		d := dialog.NewCustomConfirm(i18n.T("dialog.move_server"),
-			i18n.T("btn.move"), i18n.T("btn.cancel"),
-			container.NewVBox(
-				widget.NewLabel(i18n.T("dialog.move_to_project")),
-				selectEntry,
-			),
+	var exists *application.ServerExistsError
+	if errors.As(err, &exists) {
+		dialog.ShowConfirm(i18n.T("dialog.overwrite_title"),
+			fmt.Sprintf(i18n.T("dialog.overwrite_confirm"), name, mw.locationDescription(to)),
 			func(ok bool) {
-				if ok && selectEntry.Selected != "" {
-					if err := mw.service.MoveServerToProject(name, selectEntry.Selected); err != nil {
-						dialog.ShowError(err, mw.window)
-						return
-					}
-					mw.refresh()
+				if !ok {
+					return
 				}
-			},
-			mw.window,
-		)
-		d.Show()
-	} else {
-		// Sposta da progetto a globale
-		dialog.ShowConfirm(i18n.T("dialog.move_server"),
-			fmt.Sprintf(i18n.T("dialog.move_to_global"), name),
-			func(ok bool) {
-				if ok {
-					if err := mw.service.MoveServerToGlobal(projectPath, name); err != nil {
-						dialog.ShowError(err, mw.window)
-						return
-					}
-					mw.refresh()
+				if err := mw.service.MoveServer(name, from, to, true); err != nil {
+					mw.showSaveError(err)
+					return
 				}
+				mw.refresh()
 			},
 			mw.window,
 		)
+		return
 	}
+
+	if err != nil {
+		mw.showSaveError(err)
+		return
+	}
+	mw.refresh()
 }
 
 // showCloneServerDialog mostra il dialog per clonare un server su altri scope
-func (mw *MainWindow) showCloneServerDialog(name string, server *domain.MCPServer, isGlobal bool, projectPath string) {
+func (mw *MainWindow) showCloneServerDialog(name string, from domain.Location) {
 	config := mw.service.GetConfiguration()
 
 	// Costruisci lista destinazioni: Globale + tutti i progetti
 	var options []string
 
 	// Aggiungi "Globale" solo se il server non è già globale
-	if !isGlobal {
+	if !from.IsGlobal() {
 		options = append(options, i18n.T("form.scope_global"))
 	}
-
-	// Aggiungi tutti i progetti (escluso quello corrente se il server è di progetto)
-	for path := range config.Projects {
-		if !isGlobal && path == projectPath {
-			continue // Salta il progetto corrente
-		}
-		options = append(options, path)
-	}
+	options = append(options, sortedProjectPaths(config)...)
 
 	if len(options) == 0 {
 		dialog.ShowInformation(i18n.T("dialog.no_projects"),
@@ -273,44 +271,53 @@ func (mw *MainWindow) showCloneServerDialog(name string, server *domain.MCPServe
 	// Usa CheckGroup per selezione multipla
 	checkGroup := widget.NewCheckGroup(options, nil)
 
+	// File di destinazione per i progetti selezionati
+	fileSelect := newFileSelect()
+
 	content := container.NewVBox(
 		widget.NewLabel(i18n.T("dialog.clone_to")),
 		checkGroup,
+		widget.NewSeparator(),
+		widget.NewLabel(i18n.T("dialog.clone_file")),
+		fileSelect,
 	)
 
-	// Copia il server per evitare problemi con closure
-	serverCopy := *server
-
 	d := dialog.NewCustomConfirm(i18n.T("dialog.clone_server"),
 		i18n.T("btn.save"), i18n.T("btn.cancel"),
 		container.NewScroll(content),
 		func(ok bool) {
-			if ok && len(checkGroup.Selected) > 0 {
-				var clonedCount int
-				var lastErr error
-				for _, dest := range checkGroup.Selected {
-					var err error
-					if dest == i18n.T("form.scope_global") {
-						// Clona su globale
-						err = mw.service.AddGlobalServer(name, serverCopy)
-					} else {
-						// Clona su progetto
-						err = mw.service.AddProjectServer(dest, name, serverCopy)
-					}
-					if err != nil {
-						lastErr = err
-						continue
-					}
-					clonedCount++
+			if !ok || len(checkGroup.Selected) == 0 {
+				return
+			}
+
+			scope := fileScopeFromLabel(fileSelect.Selected)
+			var targets []domain.Location
+			for _, dest := range checkGroup.Selected {
+				var to domain.Location
+				if dest == i18n.T("form.scope_global") {
+					to = domain.GlobalLocation()
+				} else {
+					to = domain.ProjectLocation(dest, scope)
 				}
-				if clonedCount > 0 {
-					mw.refresh()
-					dialog.ShowInformation(i18n.T("dialog.clone_server"),
-						fmt.Sprintf(i18n.T("dialog.clone_success"), name),
-						mw.window)
-				} else if lastErr != nil {
-					dialog.ShowError(lastErr, mw.window)
+				// Salta l'origine stessa
+				if to == from {
+					continue
 				}
+				targets = append(targets, to)
+			}
+
+			clonedCount, err := mw.service.CloneServer(name, from, targets)
+			if clonedCount > 0 {
+				mw.refresh()
+			}
+			if err != nil {
+				mw.showSaveError(err)
+				return
+			}
+			if clonedCount > 0 {
+				dialog.ShowInformation(i18n.T("dialog.clone_server"),
+					fmt.Sprintf(i18n.T("dialog.clone_success"), name),
+					mw.window)
 			}
 		},
 		mw.window,
@@ -318,3 +325,11 @@ func (mw *MainWindow) showCloneServerDialog(name string, server *domain.MCPServe
 	d.Resize(fyne.NewSize(500, 400))
 	d.Show()
 }
+
+// locationDescription descrive una posizione includendo il file di destinazione
+func (mw *MainWindow) locationDescription(loc domain.Location) string {
+	if loc.IsGlobal() {
+		return mw.locationLabel(loc)
+	}
+	return mw.locationLabel(loc) + " (" + scopeFileDisplayName(loc.Scope) + ")"
+}
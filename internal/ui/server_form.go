@@ -1,10 +1,12 @@
 package ui
 
 import (
+	"strconv"
 	"strings"
 
 	"fyne.io/fyne/v2"
 	"fyne.io/fyne/v2/container"
+	"fyne.io/fyne/v2/theme"
 	"fyne.io/fyne/v2/widget"
 
 	"github.com/strawberry-code/mcp-curator/internal/application"
@@ -12,34 +14,47 @@ import (
 	"github.com/strawberry-code/mcp-curator/internal/i18n"
 )
 
-// ServerForm è il form per aggiungere/modificare un server
+// ServerForm è il form per aggiungere/modificare un server.
+// I campi mostrati dipendono dal tipo: comando e args per stdio, URL e headers per http/sse.
 type ServerForm struct {
-	service     *application.MCPService
-	server      *domain.MCPServer
-	name        string
-	isGlobal    bool
-	projectPath string
-
-	nameEntry     *widget.Entry
-	typeSelect    *widget.Select
-	commandEntry  *widget.Entry
-	argsEntry     *widget.Entry
-	urlEntry      *widget.Entry
-	envEntry      *widget.Entry
-	globalRadio   *widget.RadioGroup
-	projectSelect *widget.Select
+	service  *application.MCPService
+	server   *domain.MCPServer
+	name     string
+	location domain.Location
+
+	nameEntry      *widget.Entry
+	typeSelect     *widget.Select
+	commandEntry   *widget.Entry
+	argsEditor     *ArgsEditor
+	urlEntry       *widget.Entry
+	headersEditor  *KeyValueEditor
+	envEditor      *KeyValueEditor
+	timeoutEntry   *widget.Entry
+	locationPicker *LocationPicker
+
+	// Sezioni mostrate in base al tipo di server
+	stdioSection  *fyne.Container
+	remoteSection *fyne.Container
+
+	// Anteprima del JSON che verrà scritto, con i segreti mascherati salvo previewRevealed
+	preview         *widget.Label
+	previewRevealed bool
+
+	// Problemi di validazione mostrati sotto ogni campo
+	issueLabels  map[string]*widget.Label
+	otherIssues  *widget.Label
+	onValidation func(valid bool)
 
 	container *fyne.Container
 }
 
 // NewServerForm crea un nuovo form
-func NewServerForm(service *application.MCPService, server *domain.MCPServer, name string, isGlobal bool, projectPath string) *ServerForm {
+func NewServerForm(service *application.MCPService, server *domain.MCPServer, name string, location domain.Location) *ServerForm {
 	sf := &ServerForm{
-		service:     service,
-		server:      server,
-		name:        name,
-		isGlobal:    isGlobal,
-		projectPath: projectPath,
+		service:  service,
+		server:   server,
+		name:     name,
+		location: location,
 	}
 
 	sf.build()
@@ -48,6 +63,8 @@ func NewServerForm(service *application.MCPService, server *domain.MCPServer, na
 
 // build costruisce il form
 func (sf *ServerForm) build() {
+	initial := domain.NewServerEdit(sf.server)
+
 	// Nome
 	sf.nameEntry = widget.NewEntry()
 	sf.nameEntry.SetPlaceHolder(i18n.T("form.name_hint"))
@@ -57,100 +74,244 @@ func (sf *ServerForm) build() {
 		sf.nameEntry.Disable()
 	}
 
-	// Tipo
+	// Tipo: senza type esplicito un server con solo URL è remoto
 	sf.typeSelect = widget.NewSelect([]string{"stdio", "http", "sse"}, nil)
-	sf.typeSelect.SetSelected("stdio")
+	sf.typeSelect.SetSelected(string(initial.Type))
 
 	// Command
 	sf.commandEntry = widget.NewEntry()
 	sf.commandEntry.SetPlaceHolder(i18n.T("form.command_hint"))
 	sf.commandEntry.Wrapping = fyne.TextWrapOff
+	sf.commandEntry.SetText(initial.Command)
 
-	// Args
-	sf.argsEntry = widget.NewEntry()
-	sf.argsEntry.SetPlaceHolder(i18n.T("form.args_hint"))
-	sf.argsEntry.Wrapping = fyne.TextWrapOff
+	// Args: uno per riga; una riga di comando incollata riempie anche il comando se vuoto
+	sf.argsEditor = NewArgsEditor(initial.Args)
+	sf.argsEditor.SetOnPaste(func(words []string) []string {
+		if len(words) > 0 && strings.TrimSpace(sf.commandEntry.Text) == "" {
+			sf.commandEntry.SetText(words[0])
+			return words[1:]
+		}
+		return words
+	})
 
 	// URL
 	sf.urlEntry = widget.NewEntry()
 	sf.urlEntry.SetPlaceHolder(i18n.T("form.url_hint"))
 	sf.urlEntry.Wrapping = fyne.TextWrapOff
+	sf.urlEntry.SetText(initial.URL)
 
-	// Env
-	sf.envEntry = widget.NewEntry()
-	sf.envEntry.SetPlaceHolder(i18n.T("form.env_hint"))
-	sf.envEntry.Wrapping = fyne.TextWrapOff
+	// Headers ed env
+	sf.headersEditor = NewKeyValueEditor(initial.Headers, i18n.T("form.header_name_hint"), i18n.T("form.value_hint"), sf.service.IsSecret)
+	sf.envEditor = NewKeyValueEditor(initial.Env, i18n.T("form.env_name_hint"), i18n.T("form.value_hint"), sf.service.IsSecret)
 
-	// Scope
-	config := sf.service.GetConfiguration()
-	var projectOptions []string
-	for path := range config.Projects {
-		projectOptions = append(projectOptions, path)
+	// Timeout in millisecondi (vuoto: default di Claude Code)
+	sf.timeoutEntry = widget.NewEntry()
+	sf.timeoutEntry.SetPlaceHolder(i18n.T("form.timeout_hint"))
+	if initial.Timeout > 0 {
+		sf.timeoutEntry.SetText(strconv.Itoa(initial.Timeout))
 	}
 
-	sf.projectSelect = widget.NewSelect(projectOptions, nil)
-	sf.globalRadio = widget.NewRadioGroup([]string{i18n.T("form.scope_global"), i18n.T("form.scope_project")}, func(selected string) {
-		sf.projectSelect.Enable()
-		if selected == i18n.T("form.scope_global") {
-			sf.projectSelect.Disable()
-		}
-	})
-	sf.globalRadio.Horizontal = true
+	// Scope e file di destinazione
+	sf.locationPicker = NewLocationPicker(sf.service.GetConfiguration(), sf.location)
 
-	// Popola se modifica
+	// Se modifica, disabilita cambio scope
 	if sf.server != nil {
-		if sf.server.Type != "" {
-			sf.typeSelect.SetSelected(string(sf.server.Type))
-		}
-		sf.commandEntry.SetText(sf.server.Command)
-		if len(sf.server.Args) > 0 {
-			sf.argsEntry.SetText(strings.Join(sf.server.Args, " "))
-		}
-		sf.urlEntry.SetText(sf.server.URL)
-		if len(sf.server.Env) > 0 {
-			var envPairs []string
-			for k, v := range sf.server.Env {
-				envPairs = append(envPairs, k+"="+v)
-			}
-			sf.envEntry.SetText(strings.Join(envPairs, ", "))
-		}
+		sf.locationPicker.Disable()
 	}
 
-	// Imposta scope
-	if sf.isGlobal {
-		sf.globalRadio.SetSelected(i18n.T("form.scope_global"))
-		sf.projectSelect.Disable()
-	} else {
-		sf.globalRadio.SetSelected(i18n.T("form.scope_project"))
-		sf.projectSelect.SetSelected(sf.projectPath)
+	// Etichette per gli errori inline, nascoste finché il campo è valido
+	sf.issueLabels = make(map[string]*widget.Label)
+	for _, field := range []string{domain.FieldName, domain.FieldType, domain.FieldCommand, domain.FieldArgs, domain.FieldURL, domain.FieldHeaders, domain.FieldEnv, domain.FieldTimeout} {
+		sf.issueLabels[field] = newIssueLabel()
 	}
+	sf.otherIssues = newIssueLabel()
 
-	// Se modifica, disabilita cambio scope
-	if sf.server != nil {
-		sf.globalRadio.Disable()
-		sf.projectSelect.Disable()
-	}
+	// Anteprima JSON
+	sf.preview = widget.NewLabelWithStyle("", fyne.TextAlignLeading, fyne.TextStyle{Monospace: true})
+	var revealBtn *widget.Button
+	revealBtn = widget.NewButtonWithIcon(i18n.T("detail.reveal"), theme.VisibilityIcon(), func() {
+		sf.previewRevealed = !sf.previewRevealed
+		if sf.previewRevealed {
+			revealBtn.SetText(i18n.T("detail.hide"))
+			revealBtn.SetIcon(theme.VisibilityOffIcon())
+		} else {
+			revealBtn.SetText(i18n.T("detail.reveal"))
+			revealBtn.SetIcon(theme.VisibilityIcon())
+		}
+		sf.updatePreview()
+	})
+	revealBtn.Importance = widget.LowImportance
+
+	sf.stdioSection = container.NewVBox(
+		widget.NewLabel(i18n.T("form.command")+":"),
+		sf.commandEntry,
+		sf.issueLabels[domain.FieldCommand],
+		widget.NewLabel(i18n.T("form.args")+":"),
+		sf.argsEditor.Container(),
+		sf.issueLabels[domain.FieldArgs],
+	)
+	sf.remoteSection = container.NewVBox(
+		widget.NewLabel(i18n.T("form.url")+":"),
+		sf.urlEntry,
+		sf.issueLabels[domain.FieldURL],
+		widget.NewLabel(i18n.T("form.headers")+":"),
+		sf.headersEditor.Container(),
+		sf.issueLabels[domain.FieldHeaders],
+	)
 
-	// Costruisci container senza scroll (dialog sarà abbastanza alto)
 	sf.container = container.NewVBox(
 		widget.NewLabel(i18n.T("form.name")+":"),
 		sf.nameEntry,
+		sf.issueLabels[domain.FieldName],
 		widget.NewSeparator(),
 		widget.NewLabel(i18n.T("form.scope")+":"),
-		sf.globalRadio,
-		sf.projectSelect,
+		sf.locationPicker.Container(),
 		widget.NewSeparator(),
 		widget.NewLabel(i18n.T("form.type")+":"),
 		sf.typeSelect,
-		widget.NewLabel(i18n.T("form.command")+":"),
-		sf.commandEntry,
-		widget.NewLabel(i18n.T("form.args")+":"),
-		sf.argsEntry,
-		widget.NewLabel(i18n.T("form.url")+":"),
-		sf.urlEntry,
+		sf.issueLabels[domain.FieldType],
+		sf.stdioSection,
+		sf.remoteSection,
 		widget.NewLabel(i18n.T("form.env")+":"),
-		sf.envEntry,
+		sf.envEditor.Container(),
+		sf.issueLabels[domain.FieldEnv],
+		widget.NewLabel(i18n.T("form.timeout")+":"),
+		sf.timeoutEntry,
+		sf.issueLabels[domain.FieldTimeout],
+		sf.otherIssues,
+		widget.NewSeparator(),
+		container.NewBorder(nil, nil, widget.NewLabel(i18n.T("form.preview")+":"), revealBtn),
+		sf.preview,
 	)
+
+	// Rivalida a ogni modifica
+	revalidate := func(string) { sf.Validate() }
+	sf.nameEntry.OnChanged = revalidate
+	sf.typeSelect.OnChanged = func(string) {
+		sf.updateSections()
+		sf.Validate()
+	}
+	sf.commandEntry.OnChanged = revalidate
+	sf.urlEntry.OnChanged = revalidate
+	sf.timeoutEntry.OnChanged = revalidate
+	sf.argsEditor.SetOnChanged(func() { sf.Validate() })
+	sf.headersEditor.SetOnChanged(func() { sf.Validate() })
+	sf.envEditor.SetOnChanged(func() { sf.Validate() })
+	sf.updateSections()
+	sf.Validate()
+}
+
+// isRemote indica se il tipo selezionato è un trasporto remoto (http o sse)
+func (sf *ServerForm) isRemote() bool {
+	return domain.ServerEdit{Type: domain.ServerType(sf.typeSelect.Selected)}.IsRemote()
+}
+
+// updateSections mostra i campi del tipo selezionato
+func (sf *ServerForm) updateSections() {
+	if sf.isRemote() {
+		sf.stdioSection.Hide()
+		sf.remoteSection.Show()
+	} else {
+		sf.remoteSection.Hide()
+		sf.stdioSection.Show()
+	}
+}
+
+// updatePreview mostra il JSON della voce di mcpServers che verrà scritta
+func (sf *ServerForm) updatePreview() {
+	name := strings.TrimSpace(sf.nameEntry.Text)
+	if name == "" {
+		name = i18n.T("form.name_hint")
+	}
+	data, err := sf.service.ServerJSON(name, sf.getServer())
+	if err != nil {
+		sf.preview.SetText(err.Error())
+		return
+	}
+	if !sf.previewRevealed {
+		data = sf.service.MaskText(data)
+	}
+	sf.preview.SetText(data)
+}
+
+// newIssueLabel crea un'etichetta per gli errori di validazione di un campo
+func newIssueLabel() *widget.Label {
+	label := widget.NewLabel("")
+	label.Wrapping = fyne.TextWrapWord
+	label.Hide()
+	return label
+}
+
+// SetOnValidation registra la funzione chiamata dopo ogni validazione
+// (valid è false se ci sono errori bloccanti)
+func (sf *ServerForm) SetOnValidation(fn func(valid bool)) {
+	sf.onValidation = fn
+	fn(!sf.Validate().HasErrors())
+}
+
+// Validate valida i dati del form e mostra errori e avvisi sotto i campi
+func (sf *ServerForm) Validate() domain.ValidationIssues {
+	server := sf.getServer()
+	server.Name = ""
+	issues := server.Validate()
+	// Il nome si valida solo in creazione: in modifica non è modificabile
+	if sf.server == nil {
+		issues = append(domain.ValidateServerName(strings.TrimSpace(sf.nameEntry.Text)), issues...)
+	}
+	// Problemi che la mappa del server non può rappresentare: chiavi ripetute e timeout non numerico
+	for field, editor := range map[string]*KeyValueEditor{domain.FieldEnv: sf.envEditor, domain.FieldHeaders: sf.headersEditor} {
+		if field == domain.FieldHeaders && !sf.isRemote() {
+			continue
+		}
+		for _, key := range editor.Duplicates() {
+			issues = append(issues, domain.ValidationIssue{Field: field, Severity: domain.SeverityError, Code: "key_duplicate", Detail: key})
+		}
+	}
+	if _, ok := sf.timeout(); !ok {
+		issues = append(issues, domain.ValidationIssue{Field: domain.FieldTimeout, Severity: domain.SeverityError, Code: "timeout_invalid"})
+	}
+	sf.updatePreview()
+
+	var other domain.ValidationIssues
+	for _, issue := range issues {
+		if _, ok := sf.issueLabels[issue.Field]; !ok {
+			other = append(other, issue)
+		}
+	}
+	for field, label := range sf.issueLabels {
+		showIssues(label, issues.ForField(field))
+	}
+	showIssues(sf.otherIssues, other)
+
+	if sf.onValidation != nil {
+		sf.onValidation(!issues.HasErrors())
+	}
+	return issues
+}
+
+// showIssues mostra i problemi in un'etichetta, colorata in base alla gravità
+func showIssues(label *widget.Label, issues domain.ValidationIssues) {
+	if len(issues) == 0 {
+		label.Hide()
+		return
+	}
+	lines := make([]string, len(issues))
+	for i, issue := range issues {
+		lines[i] = issueText(issue)
+	}
+	label.SetText(strings.Join(lines, "\n"))
+	if issues.HasErrors() {
+		label.Importance = widget.DangerImportance
+	} else {
+		label.Importance = widget.WarningImportance
+	}
+	label.Refresh()
+	label.Show()
+}
+
+// issueText traduce un problema di validazione
+func issueText(issue domain.ValidationIssue) string {
+	return i18n.Issue(issue.Code, issue.Detail)
 }
 
 // Container restituisce il container del form
@@ -158,35 +319,31 @@ func (sf *ServerForm) Container() *fyne.Container {
 	return sf.container
 }
 
-// getServer costruisce il server dai dati del form
+// getServer costruisce il server dai dati del form.
+// In modifica parte dal server esistente, così i campi non presenti nel form restano invariati.
 func (sf *ServerForm) getServer() domain.MCPServer {
-	server := domain.MCPServer{
-		Type:    domain.ServerType(sf.typeSelect.Selected),
-		Command: sf.commandEntry.Text,
-		URL:     sf.urlEntry.Text,
-	}
-
-	// Parse args (separati da spazio)
-	argsText := strings.TrimSpace(sf.argsEntry.Text)
-	if argsText != "" {
-		server.Args = strings.Fields(argsText)
-	}
-
-	// Parse env (separati da virgola)
-	envText := strings.TrimSpace(sf.envEntry.Text)
-	if envText != "" {
-		server.Env = make(map[string]string)
-		for _, pair := range strings.Split(envText, ",") {
-			pair = strings.TrimSpace(pair)
-			if idx := strings.Index(pair, "="); idx > 0 {
-				key := strings.TrimSpace(pair[:idx])
-				value := strings.TrimSpace(pair[idx+1:])
-				server.Env[key] = value
-			}
-		}
+	edit := domain.NewServerEdit(sf.server)
+	edit.Type = domain.ServerType(sf.typeSelect.Selected)
+	edit.Command = sf.commandEntry.Text
+	edit.Args = sf.argsEditor.Args()
+	edit.URL = sf.urlEntry.Text
+	edit.Headers = sf.headersEditor.Values()
+	edit.Env = sf.envEditor.Values()
+	// Un timeout non numerico lascia quello attuale: il form lo segnala come errore
+	if timeout, ok := sf.timeout(); ok {
+		edit.Timeout = timeout
 	}
+	return edit.Apply(sf.server)
+}
 
-	return server
+// timeout restituisce il timeout in millisecondi (0 se vuoto) e se il valore è un intero
+func (sf *ServerForm) timeout() (int, bool) {
+	text := strings.TrimSpace(sf.timeoutEntry.Text)
+	if text == "" {
+		return 0, true
+	}
+	timeout, err := strconv.Atoi(text)
+	return timeout, err == nil
 }
 
 // Save salva un nuovo server
@@ -194,21 +351,14 @@ func (sf *ServerForm) Save() error {
 	name := strings.TrimSpace(sf.nameEntry.Text)
 	server := sf.getServer()
 
-	if sf.globalRadio.Selected == i18n.T("form.scope_global") {
-		return sf.service.AddGlobalServer(name, server)
+	location, err := sf.locationPicker.Location()
+	if err != nil {
+		return err
 	}
-
-	projectPath := sf.projectSelect.Selected
-	return sf.service.AddProjectServer(projectPath, name, server)
+	return sf.service.AddServer(location, name, server)
 }
 
 // Update aggiorna un server esistente
 func (sf *ServerForm) Update() error {
-	server := sf.getServer()
-
-	if sf.isGlobal {
-		return sf.service.UpdateGlobalServer(sf.name, server)
-	}
-
-	return sf.service.UpdateProjectServer(sf.projectPath, sf.name, server)
+	return sf.service.UpdateServer(sf.location, sf.name, sf.getServer())
 }
@@ -0,0 +1,298 @@
+package ui
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/container"
+	"fyne.io/fyne/v2/dialog"
+	"fyne.io/fyne/v2/theme"
+	"fyne.io/fyne/v2/widget"
+
+	"github.com/strawberry-code/mcp-curator/internal/domain"
+	"github.com/strawberry-code/mcp-curator/internal/i18n"
+)
+
+// TemplateView è la finestra del catalogo di template: elenco filtrabile, parametri
+// del template selezionato, anteprima del server e gestione dei cataloghi del team
+type TemplateView struct {
+	mw       *MainWindow
+	window   fyne.Window
+	location domain.Location
+
+	templates []domain.ServerTemplate
+	filtered  []domain.ServerTemplate
+	filter    *widget.Entry
+	list      *widget.List
+	loadIssue *widget.Label
+	detail    *fyne.Container
+}
+
+// showTemplateView apre il catalogo per aggiungere un server in loc
+func (mw *MainWindow) showTemplateView(loc domain.Location) {
+	tv := &TemplateView{
+		mw:       mw,
+		window:   mw.app.NewWindow(i18n.T("template.title")),
+		location: loc,
+		detail:   container.NewVBox(widget.NewLabel(i18n.T("template.select"))),
+	}
+
+	tv.filter = widget.NewEntry()
+	tv.filter.SetPlaceHolder(i18n.T("template.filter"))
+	tv.filter.OnChanged = func(string) { tv.applyFilter() }
+
+	tv.list = widget.NewList(
+		func() int { return len(tv.filtered) },
+		func() fyne.CanvasObject {
+			return container.NewVBox(
+				widget.NewLabelWithStyle("", fyne.TextAlignLeading, fyne.TextStyle{Bold: true}),
+				widget.NewLabel(""),
+			)
+		},
+		func(id widget.ListItemID, obj fyne.CanvasObject) {
+			t := tv.filtered[id]
+			box := obj.(*fyne.Container)
+			box.Objects[0].(*widget.Label).SetText(t.DisplayName())
+			box.Objects[1].(*widget.Label).SetText(t.Category)
+		},
+	)
+	tv.list.OnSelected = func(id widget.ListItemID) {
+		tv.showTemplate(tv.filtered[id])
+	}
+
+	catalogsBtn := widget.NewButtonWithIcon(i18n.T("template.catalogs"), theme.FolderIcon(), tv.showCatalogs)
+	tv.loadIssue = newIssueLabel()
+
+	left := container.NewBorder(tv.filter, catalogsBtn, nil, nil, tv.list)
+	split := container.NewHSplit(left, container.NewVScroll(tv.detail))
+	split.Offset = 0.35
+
+	tv.window.SetContent(container.NewBorder(tv.loadIssue, nil, nil, nil, split))
+	tv.window.Resize(fyne.NewSize(900, 600))
+	tv.reload()
+	tv.window.Show()
+}
+
+// reload rilegge i template dai cataloghi
+func (tv *TemplateView) reload() {
+	templates, err := tv.mw.service.Templates()
+	tv.templates = templates
+	if err != nil {
+		showIssues(tv.loadIssue, domain.ValidationIssues{{Severity: domain.SeverityWarning, Code: "template_catalog", Detail: err.Error()}})
+	} else {
+		showIssues(tv.loadIssue, nil)
+	}
+	tv.applyFilter()
+}
+
+// applyFilter mostra i template il cui nome, ID, categoria o descrizione contiene il filtro
+func (tv *TemplateView) applyFilter() {
+	query := strings.ToLower(strings.TrimSpace(tv.filter.Text))
+	tv.filtered = nil
+	for _, t := range tv.templates {
+		text := strings.ToLower(strings.Join([]string{t.ID, t.Name, t.Category, t.Description}, " "))
+		if query == "" || strings.Contains(text, query) {
+			tv.filtered = append(tv.filtered, t)
+		}
+	}
+	tv.list.UnselectAll()
+	tv.list.Refresh()
+}
+
+// showTemplate mostra descrizione e parametri di un template con nome e posizione del server
+func (tv *TemplateView) showTemplate(t domain.ServerTemplate) {
+	tv.detail.RemoveAll()
+	tv.detail.Add(widget.NewLabelWithStyle(t.DisplayName(), fyne.TextAlignLeading, fyne.TextStyle{Bold: true}))
+	if t.Description != "" {
+		description := widget.NewLabel(t.Description)
+		description.Wrapping = fyne.TextWrapWord
+		tv.detail.Add(description)
+	}
+	if link, err := url.Parse(t.Homepage); err == nil && t.Homepage != "" {
+		tv.detail.Add(widget.NewHyperlink(t.Homepage, link))
+	}
+	if t.Source != "" {
+		source := widget.NewLabel(fmt.Sprintf(i18n.T("template.source"), t.Source))
+		source.Importance = widget.LowImportance
+		tv.detail.Add(source)
+	}
+	tv.detail.Add(widget.NewSeparator())
+
+	nameEntry := widget.NewEntry()
+	nameEntry.SetText(t.ID)
+	picker := NewLocationPicker(tv.mw.service.GetConfiguration(), tv.location)
+
+	items := []*widget.FormItem{widget.NewFormItem(i18n.T("form.name"), nameEntry)}
+	values := make(map[string]*widget.Entry, len(t.Parameters))
+	for _, p := range t.Parameters {
+		entry, field := tv.parameterField(p)
+		values[p.Name] = entry
+		item := widget.NewFormItem(parameterLabel(p), field)
+		item.HintText = p.Description
+		items = append(items, item)
+	}
+	tv.detail.Add(widget.NewForm(items...))
+	tv.detail.Add(widget.NewLabel(i18n.T("form.scope") + ":"))
+	tv.detail.Add(picker.Container())
+
+	issues := newIssueLabel()
+	preview := widget.NewLabelWithStyle("", fyne.TextAlignLeading, fyne.TextStyle{Monospace: true})
+	saveBtn := widget.NewButtonWithIcon(i18n.T("template.add"), theme.ContentAddIcon(), nil)
+	saveBtn.Importance = widget.HighImportance
+
+	// instantiate crea il server con i valori correnti e aggiorna anteprima ed errori
+	instantiate := func() (string, domain.MCPServer, bool) {
+		params := make(map[string]string, len(values))
+		for name, entry := range values {
+			params[name] = entry.Text
+		}
+		name := strings.TrimSpace(nameEntry.Text)
+		server, err := t.Instantiate(params)
+		if err != nil {
+			showIssues(issues, domain.ValidationIssues{{Severity: domain.SeverityError, Code: "template_parameters", Detail: err.Error()}})
+			preview.SetText("")
+			saveBtn.Disable()
+			return name, server, false
+		}
+
+		problems := append(domain.ValidateServerName(name), tv.mw.service.ServerDiagnostics(server)...)
+		showIssues(issues, problems)
+		if data, err := tv.mw.service.ServerJSON(name, server); err == nil {
+			preview.SetText(tv.mw.service.MaskText(data))
+		}
+		if problems.HasErrors() {
+			saveBtn.Disable()
+		} else {
+			saveBtn.Enable()
+		}
+		return name, server, !problems.HasErrors()
+	}
+	nameEntry.OnChanged = func(string) { instantiate() }
+	for _, entry := range values {
+		entry.OnChanged = func(string) { instantiate() }
+	}
+
+	saveBtn.OnTapped = func() {
+		name, server, ok := instantiate()
+		if !ok {
+			return
+		}
+		loc, err := picker.Location()
+		if err != nil {
+			dialog.ShowError(err, tv.window)
+			return
+		}
+		if err := tv.mw.service.AddServer(loc, name, server); err != nil {
+			tv.mw.showSaveError(err)
+			return
+		}
+		tv.mw.refresh()
+		tv.window.Close()
+	}
+
+	tv.detail.Add(issues)
+	tv.detail.Add(widget.NewSeparator())
+	tv.detail.Add(widget.NewLabel(i18n.T("form.preview") + ":"))
+	tv.detail.Add(preview)
+	tv.detail.Add(container.NewHBox(saveBtn))
+	instantiate()
+}
+
+// parameterField crea il campo di un parametro in base al tipo: i percorsi hanno
+// un bottone per scegliere la cartella, i segreti sono mascherati
+func (tv *TemplateView) parameterField(p domain.TemplateParameter) (*widget.Entry, fyne.CanvasObject) {
+	var entry *widget.Entry
+	if p.Kind == domain.ParameterSecret {
+		entry = widget.NewPasswordEntry()
+	} else {
+		entry = widget.NewEntry()
+	}
+	entry.SetText(p.Default)
+	if p.Kind == domain.ParameterPort {
+		entry.SetPlaceHolder("1-65535")
+	}
+	if p.Kind != domain.ParameterPath {
+		return entry, entry
+	}
+
+	browseBtn := widget.NewButtonWithIcon("", theme.FolderOpenIcon(), func() {
+		dialog.ShowFolderOpen(func(dir fyne.ListableURI, err error) {
+			if err == nil && dir != nil {
+				entry.SetText(dir.Path())
+			}
+		}, tv.window)
+	})
+	return entry, container.NewBorder(nil, nil, nil, browseBtn, entry)
+}
+
+// parameterLabel restituisce l'etichetta del campo, con l'asterisco se obbligatorio
+func parameterLabel(p domain.TemplateParameter) string {
+	if p.Required {
+		return p.DisplayLabel() + " *"
+	}
+	return p.DisplayLabel()
+}
+
+// showCatalogs mostra i cataloghi aggiuntivi con i bottoni per aggiungerne o rimuoverne
+func (tv *TemplateView) showCatalogs() {
+	content := container.NewVBox()
+
+	var build func()
+	build = func() {
+		content.RemoveAll()
+		hint := widget.NewLabel(i18n.T("template.catalogs_hint"))
+		hint.Wrapping = fyne.TextWrapWord
+		content.Add(hint)
+
+		catalogs := tv.mw.service.GetSettings().Templates.Catalogs
+		if len(catalogs) == 0 {
+			content.Add(widget.NewLabel(i18n.T("template.no_catalogs")))
+		}
+		for _, path := range catalogs {
+			path := path
+			removeBtn := widget.NewButtonWithIcon("", theme.DeleteIcon(), func() {
+				if err := tv.mw.service.RemoveTemplateCatalog(path); err != nil {
+					dialog.ShowError(err, tv.window)
+					return
+				}
+				build()
+				tv.reload()
+			})
+			removeBtn.Importance = widget.LowImportance
+			content.Add(container.NewBorder(nil, nil, nil, removeBtn, widget.NewLabel(path)))
+		}
+
+		add := func(path string) {
+			if err := tv.mw.service.AddTemplateCatalog(path); err != nil {
+				dialog.ShowError(err, tv.window)
+				return
+			}
+			build()
+			tv.reload()
+		}
+		addFileBtn := widget.NewButtonWithIcon(i18n.T("template.add_file"), theme.FileIcon(), func() {
+			dialog.ShowFileOpen(func(file fyne.URIReadCloser, err error) {
+				if err == nil && file != nil {
+					file.Close()
+					add(file.URI().Path())
+				}
+			}, tv.window)
+		})
+		addDirBtn := widget.NewButtonWithIcon(i18n.T("template.add_folder"), theme.FolderIcon(), func() {
+			dialog.ShowFolderOpen(func(dir fyne.ListableURI, err error) {
+				if err == nil && dir != nil {
+					add(dir.Path())
+				}
+			}, tv.window)
+		})
+		content.Add(container.NewHBox(addFileBtn, addDirBtn))
+		content.Refresh()
+	}
+	build()
+
+	d := dialog.NewCustom(i18n.T("template.catalogs"), i18n.T("btn.close"), content, tv.window)
+	d.Resize(fyne.NewSize(600, 300))
+	d.Show()
+}
@@ -0,0 +1,166 @@
+package ui
+
+import (
+	"fmt"
+	"strings"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/container"
+	"fyne.io/fyne/v2/dialog"
+	"fyne.io/fyne/v2/driver/desktop"
+	"fyne.io/fyne/v2/widget"
+
+	"github.com/strawberry-code/mcp-curator/internal/domain"
+	"github.com/strawberry-code/mcp-curator/internal/i18n"
+)
+
+// treeRow è una riga del tree: casella della selezione multipla, icona ed etichetta.
+// Le righe dei server si possono trascinare sul nodo Globale o su un progetto.
+type treeRow struct {
+	widget.BaseWidget
+	mw    *MainWindow
+	id    widget.TreeNodeID
+	check *widget.Check
+	icon  *widget.Icon
+	label *widget.Label
+}
+
+// treeDrag è lo stato del trascinamento di un server nel tree
+type treeDrag struct {
+	active bool
+	source domain.ServerRef
+	// target è il nodo su cui verrebbe rilasciato il server ("" se nessuno valido)
+	target widget.TreeNodeID
+}
+
+// newTreeRow crea una riga del tree e la registra per individuare il nodo sotto il puntatore
+func (mw *MainWindow) newTreeRow() *treeRow {
+	row := &treeRow{
+		mw:    mw,
+		check: widget.NewCheck("", nil),
+		icon:  widget.NewIcon(nil),
+		label: widget.NewLabel(""),
+	}
+	row.ExtendBaseWidget(row)
+	mw.treeRows = append(mw.treeRows, row)
+	return row
+}
+
+// CreateRenderer implementa fyne.Widget
+func (r *treeRow) CreateRenderer() fyne.WidgetRenderer {
+	return widget.NewSimpleRenderer(container.NewHBox(r.check, r.icon, r.label))
+}
+
+// Dragged implementa fyne.Draggable: evidenzia il nodo su cui verrebbe rilasciato il server
+func (r *treeRow) Dragged(event *fyne.DragEvent) {
+	r.mw.dragServer(r.id, event.AbsolutePosition)
+}
+
+// DragEnd implementa fyne.Draggable: sposta o, con il modificatore, clona il server
+func (r *treeRow) DragEnd() {
+	r.mw.dropServer()
+}
+
+// dragServer aggiorna il trascinamento del server del nodo id con il puntatore in pos
+func (mw *MainWindow) dragServer(id widget.TreeNodeID, pos fyne.Position) {
+	if !mw.drag.active {
+		ref, ok := parseServerNodeID(id)
+		if !ok {
+			// Solo i server si trascinano
+			return
+		}
+		mw.drag = treeDrag{active: true, source: ref}
+	}
+
+	target := mw.treeNodeAt(pos)
+	if to, ok := dropLocation(target); !ok || to == mw.drag.source.Location {
+		target = ""
+	}
+	if target != mw.drag.target {
+		previous := mw.drag.target
+		mw.drag.target = target
+		mw.tree.RefreshItem(previous)
+		mw.tree.RefreshItem(target)
+	}
+}
+
+// dropServer conclude il trascinamento: il server viene spostato sul nodo evidenziato o,
+// tenendo premuto Ctrl/Cmd o Alt, clonato
+func (mw *MainWindow) dropServer() {
+	drag := mw.drag
+	mw.drag = treeDrag{}
+	if !drag.active || drag.target == "" {
+		return
+	}
+	mw.tree.RefreshItem(drag.target)
+
+	to, _ := dropLocation(drag.target)
+	if cloneModifierPressed() {
+		mw.cloneServerTo(drag.source.Name, drag.source.Location, to)
+		return
+	}
+	mw.moveServer(drag.source.Name, drag.source.Location, to)
+}
+
+// cloneServerTo clona un server in una posizione, come il dialog di clonazione
+func (mw *MainWindow) cloneServerTo(name string, from, to domain.Location) {
+	clonedCount, err := mw.service.CloneServer(name, from, []domain.Location{to})
+	if clonedCount > 0 {
+		mw.refresh()
+	}
+	if err != nil {
+		mw.showSaveError(err)
+		return
+	}
+	dialog.ShowInformation(i18n.T("dialog.clone_server"),
+		fmt.Sprintf(i18n.T("dialog.clone_success"), name),
+		mw.window)
+}
+
+// cloneModifierPressed verifica se è premuto il modificatore che trasforma lo spostamento
+// in una copia (Ctrl o Cmd, oppure Alt/Option)
+func cloneModifierPressed() bool {
+	d, ok := fyne.CurrentApp().Driver().(desktop.Driver)
+	if !ok {
+		return false
+	}
+	return d.CurrentKeyModifiers()&(fyne.KeyModifierShortcutDefault|fyne.KeyModifierAlt) != 0
+}
+
+// dropLocation restituisce la posizione in cui finisce un server rilasciato su un nodo:
+// globale per il nodo Globale e i suoi server, ~/.claude.json per un progetto, lo stesso
+// file per un server di progetto
+func dropLocation(id widget.TreeNodeID) (domain.Location, bool) {
+	if id == "global" {
+		return domain.GlobalLocation(), true
+	}
+	if strings.HasPrefix(id, "project:") {
+		return domain.ProjectLocation(id[len("project:"):], domain.ScopeProject), true
+	}
+	if ref, ok := parseServerNodeID(id); ok {
+		return ref.Location, true
+	}
+	return domain.Location{}, false
+}
+
+// treeNodeAt restituisce il nodo della riga del tree sotto la posizione assoluta pos
+// ("" se il puntatore è fuori dal tree)
+func (mw *MainWindow) treeNodeAt(pos fyne.Position) widget.TreeNodeID {
+	d := fyne.CurrentApp().Driver()
+	treePos := d.AbsolutePositionForObject(mw.treeScroll)
+	treeSize := mw.treeScroll.Size()
+	if pos.X < treePos.X || pos.Y < treePos.Y || pos.X > treePos.X+treeSize.Width || pos.Y > treePos.Y+treeSize.Height {
+		return ""
+	}
+	// Le righe fuori vista restano nel pool del tree con posizione nulla, sopra l'area del tree
+	for _, row := range mw.treeRows {
+		if !row.Visible() || row.id == "" {
+			continue
+		}
+		rowPos := d.AbsolutePositionForObject(row)
+		if pos.Y >= rowPos.Y && pos.Y < rowPos.Y+row.Size().Height {
+			return row.id
+		}
+	}
+	return ""
+}
@@ -0,0 +1,162 @@
+package ui
+
+import (
+	"sort"
+	"strings"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/container"
+	"fyne.io/fyne/v2/widget"
+
+	"github.com/strawberry-code/mcp-curator/internal/domain"
+	"github.com/strawberry-code/mcp-curator/internal/i18n"
+)
+
+// Valori dei filtri del tree, nello stesso ordine delle opzioni dei select
+var (
+	filterTypes   = []domain.ServerType{"", domain.ServerTypeStdio, domain.ServerTypeHTTP, domain.ServerTypeSSE}
+	filterSources = []domain.Scope{"", domain.ScopeGlobal, domain.ScopeProject, domain.ScopeProjectFile, domain.ScopeProjectLocal}
+)
+
+// treeFilter è lo stato di ricerca, filtri e ordinamento del tree
+type treeFilter struct {
+	search       *widget.Entry
+	typeSelect   *widget.Select
+	sourceSelect *widget.Select
+	sortSelect   *widget.Select
+
+	filter domain.ServerFilter
+	order  domain.ServerSort
+	// usage è il conteggio dei progetti per server, calcolato solo quando serve
+	usage map[string]int
+}
+
+// createTreeFilterBar crea la barra sopra il tree con ricerca, filtri per tipo e file
+// di origine e ordinamento
+func (mw *MainWindow) createTreeFilterBar() fyne.CanvasObject {
+	tf := &mw.treeFilter
+	tf.order = domain.SortByName
+
+	tf.search = widget.NewEntry()
+	tf.search.SetPlaceHolder(i18n.T("tree.search_placeholder"))
+	tf.search.OnChanged = func(query string) {
+		tf.filter.Query = query
+		mw.applyTreeFilter()
+	}
+
+	tf.typeSelect = widget.NewSelect(nil, func(string) {
+		tf.filter.Type = filterTypes[max(tf.typeSelect.SelectedIndex(), 0)]
+		mw.applyTreeFilter()
+	})
+	tf.sourceSelect = widget.NewSelect(nil, func(string) {
+		tf.filter.Scope = filterSources[max(tf.sourceSelect.SelectedIndex(), 0)]
+		mw.applyTreeFilter()
+	})
+	tf.sortSelect = widget.NewSelect(nil, func(string) {
+		tf.order = domain.ServerSorts[max(tf.sortSelect.SelectedIndex(), 0)]
+		tf.usage = nil
+		mw.applyTreeFilter()
+	})
+	mw.updateTreeFilterStrings()
+
+	return container.NewVBox(
+		tf.search,
+		container.NewGridWithColumns(3, tf.typeSelect, tf.sourceSelect, tf.sortSelect),
+	)
+}
+
+// updateTreeFilterStrings traduce le opzioni dei filtri mantenendo la selezione
+func (mw *MainWindow) updateTreeFilterStrings() {
+	tf := &mw.treeFilter
+	tf.search.SetPlaceHolder(i18n.T("tree.search_placeholder"))
+
+	types := []string{i18n.T("tree.filter_all_types")}
+	for _, t := range filterTypes[1:] {
+		types = append(types, string(t))
+	}
+	sources := []string{i18n.T("tree.filter_all_sources")}
+	for _, scope := range filterSources[1:] {
+		sources = append(sources, i18n.T("tree.filter_source_"+strings.ReplaceAll(string(scope), "-", "_")))
+	}
+	sorts := make([]string, len(domain.ServerSorts))
+	for i, order := range domain.ServerSorts {
+		sorts[i] = i18n.T("tree.sort_" + string(order))
+	}
+
+	setSelectOptions(tf.typeSelect, types)
+	setSelectOptions(tf.sourceSelect, sources)
+	setSelectOptions(tf.sortSelect, sorts)
+}
+
+// setSelectOptions sostituisce le opzioni di un select mantenendo l'indice selezionato
+// (la prima opzione se non c'era selezione), senza richiamare OnChanged
+func setSelectOptions(s *widget.Select, options []string) {
+	index := max(s.SelectedIndex(), 0)
+	onChanged := s.OnChanged
+	s.OnChanged = nil
+	s.Options = options
+	s.SetSelectedIndex(index)
+	s.OnChanged = onChanged
+}
+
+// applyTreeFilter aggiorna il tree dopo un cambio di ricerca, filtri o ordinamento;
+// con un filtro attivo apre i rami che contengono risultati
+func (mw *MainWindow) applyTreeFilter() {
+	mw.tree.Refresh()
+	if mw.treeFilter.filter.IsEmpty() {
+		return
+	}
+	config := mw.service.GetConfiguration()
+	mw.tree.OpenBranch("global")
+	mw.tree.OpenBranch("projects")
+	for _, id := range mw.visibleProjectIDs(config) {
+		if mw.getChildCount(id, config) > 0 {
+			mw.tree.OpenBranch(id)
+		}
+	}
+}
+
+// filterServers restituisce gli ID dei nodi dei server delle posizioni indicate che
+// rispettano il filtro, ordinati secondo il criterio scelto
+func (mw *MainWindow) filterServers(config *domain.Configuration, locs ...domain.Location) []widget.TreeNodeID {
+	tf := &mw.treeFilter
+	var refs []domain.ServerRef
+	for _, loc := range locs {
+		servers, _ := config.Servers(loc)
+		for name, server := range servers {
+			ref := domain.ServerRef{Location: loc, Name: name}
+			if tf.filter.Matches(ref, server) {
+				refs = append(refs, ref)
+			}
+		}
+	}
+	if tf.order == domain.SortByUsage && tf.usage == nil {
+		tf.usage = config.ProjectUsage()
+	}
+
+	ids := make([]widget.TreeNodeID, 0, len(refs))
+	for _, ref := range config.SortServers(refs, tf.order, tf.usage) {
+		if ref.IsGlobal() {
+			ids = append(ids, globalServerPrefix+ref.Name)
+		} else {
+			ids = append(ids, projectServerID(ref.Location, ref.Name))
+		}
+	}
+	return ids
+}
+
+// visibleProjectIDs restituisce gli ID dei progetti con almeno un server che rispetta il
+// filtro, o il cui path corrisponde alla ricerca; senza filtro tutti i progetti
+func (mw *MainWindow) visibleProjectIDs(config *domain.Configuration) []widget.TreeNodeID {
+	filter := mw.treeFilter.filter
+	paths := config.ProjectPaths()
+	sort.Strings(paths)
+	ids := make([]widget.TreeNodeID, 0, len(paths))
+	for _, path := range paths {
+		project := config.Projects[path]
+		if filter.IsEmpty() || filter.MatchesProject(path) || len(mw.projectServerIDs(project)) > 0 {
+			ids = append(ids, "project:"+path)
+		}
+	}
+	return ids
+}
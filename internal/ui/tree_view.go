@@ -2,19 +2,49 @@ package ui
 
 import (
 	"fmt"
-	"path/filepath"
 	"sort"
+	"strings"
 
 	"fyne.io/fyne/v2"
-	"fyne.io/fyne/v2/container"
 	"fyne.io/fyne/v2/theme"
 	"fyne.io/fyne/v2/widget"
 
 	"github.com/strawberry-code/mcp-curator/internal/domain"
 	"github.com/strawberry-code/mcp-curator/internal/i18n"
-	"github.com/strawberry-code/mcp-curator/internal/infrastructure"
 )
 
+// Prefissi degli ID dei nodi server nel tree
+const (
+	globalServerPrefix  = "global:"
+	projectServerPrefix = "projectserver:"
+	discoveredPrefix    = "discovered:"
+)
+
+// projectServerID costruisce l'ID di un nodo server di progetto: projectserver:<scope>:<path>:<nome>
+func projectServerID(loc domain.Location, name string) widget.TreeNodeID {
+	return projectServerPrefix + string(loc.Scope) + ":" + loc.ProjectPath + ":" + name
+}
+
+// parseServerNodeID estrae posizione e nome da un nodo server (globale o di progetto)
+func parseServerNodeID(id widget.TreeNodeID) (domain.ServerRef, bool) {
+	if strings.HasPrefix(id, globalServerPrefix) {
+		return domain.ServerRef{Location: domain.GlobalLocation(), Name: id[len(globalServerPrefix):]}, true
+	}
+	if !strings.HasPrefix(id, projectServerPrefix) {
+		return domain.ServerRef{}, false
+	}
+
+	// Lo scope non contiene ':', il nome è dopo l'ultimo ':' e il path sta in mezzo
+	rest := id[len(projectServerPrefix):]
+	scopeEnd := strings.Index(rest, ":")
+	nameStart := strings.LastIndex(rest, ":")
+	if scopeEnd < 0 || nameStart <= scopeEnd {
+		return domain.ServerRef{}, false
+	}
+	loc := domain.ProjectLocation(rest[scopeEnd+1:nameStart], domain.Scope(rest[:scopeEnd]))
+	return domain.ServerRef{Location: loc, Name: rest[nameStart+1:]}, true
+}
+
 // createTree crea il widget tree per navigare scope e server
 func (mw *MainWindow) createTree() *widget.Tree {
 	tree := widget.NewTree(
@@ -23,35 +53,32 @@ func (mw *MainWindow) createTree() *widget.Tree {
 			config := mw.service.GetConfiguration()
 
 			if id == "" {
+				// I progetti trovati dalla ricerca compaiono solo finché non sono registrati
+				if len(mw.service.Discovered()) > 0 {
+					return []string{"global", "projects", "discovered"}
+				}
 				return []string{"global", "projects"}
 			}
 			if id == "global" {
-				ids := make([]string, 0, len(config.GlobalServers))
-				for name := range config.GlobalServers {
-					ids = append(ids, "global:"+name)
-				}
-				sort.Strings(ids)
-				return ids
+				return mw.filterServers(config, domain.GlobalLocation())
 			}
 			if id == "projects" {
-				ids := make([]string, 0, len(config.Projects))
-				for path := range config.Projects {
-					ids = append(ids, "project:"+path)
+				return mw.visibleProjectIDs(config)
+			}
+			if id == "discovered" {
+				discovered := mw.service.Discovered()
+				ids := make([]string, 0, len(discovered))
+				for _, project := range discovered {
+					ids = append(ids, discoveredPrefix+project.Path)
 				}
 				sort.Strings(ids)
 				return ids
 			}
-			// Server locali di un progetto (da .mcp.json e .mcp.local.json)
+			// Server di un progetto da tutti i file (~/.claude.json, .mcp.json, .mcp.local.json)
 			if len(id) > 8 && id[:8] == "project:" {
 				projectPath := id[8:]
 				if project, ok := config.Projects[projectPath]; ok {
-					localServers := mw.getLocalServers(projectPath, project)
-					ids := make([]string, 0, len(localServers))
-					for name := range localServers {
-						ids = append(ids, "projectserver:"+projectPath+":"+name)
-					}
-					sort.Strings(ids)
-					return ids
+					return mw.projectServerIDs(project)
 				}
 			}
 			return nil
@@ -62,17 +89,22 @@ func (mw *MainWindow) createTree() *widget.Tree {
 		},
 		// create
 		func(branch bool) fyne.CanvasObject {
-			return container.NewHBox(
-				widget.NewIcon(nil),
-				widget.NewLabel(""),
-			)
+			return mw.newTreeRow()
 		},
 		// update
 		func(id widget.TreeNodeID, branch bool, o fyne.CanvasObject) {
 			config := mw.service.GetConfiguration()
-			box := o.(*fyne.Container)
-			icon := box.Objects[0].(*widget.Icon)
-			label := box.Objects[1].(*widget.Label)
+			row := o.(*treeRow)
+			row.id = id
+			icon := row.icon
+			label := row.label
+			mw.updateBulkCheck(row.check, id)
+
+			// Il nodo su cui verrebbe rilasciato il server trascinato è evidenziato
+			label.Importance = widget.MediumImportance
+			if mw.drag.active && id == mw.drag.target {
+				label.Importance = widget.HighImportance
+			}
 
 			text := mw.getNodeText(id, config)
 			nodeIcon := mw.getNodeIcon(id, config)
@@ -83,7 +115,7 @@ func (mw *MainWindow) createTree() *widget.Tree {
 				count := mw.getChildCount(id, config)
 				label.SetText(fmt.Sprintf("%s (%d)", text, count))
 			} else {
-				label.SetText(text)
+				label.SetText(text + mw.toolCountSuffix(id, config))
 			}
 		},
 	)
@@ -112,8 +144,8 @@ func (mw *MainWindow) toggleBranchIfNeeded(tree *widget.Tree, id widget.TreeNode
 
 // isBranchWithChildren verifica se un nodo è un branch con almeno un figlio
 func (mw *MainWindow) isBranchWithChildren(id widget.TreeNodeID) bool {
-	// Root, global e projects sono sempre branch
-	if id == "" || id == "global" || id == "projects" {
+	// Root, global, projects e discovered sono sempre branch
+	if id == "" || id == "global" || id == "projects" || id == "discovered" {
 		return true
 	}
 
@@ -133,17 +165,33 @@ func (mw *MainWindow) getNodeIcon(id widget.TreeNodeID, config *domain.Configura
 		return theme.HomeIcon()
 	case id == "projects":
 		return theme.FolderIcon()
+	case id == "discovered":
+		return theme.SearchIcon()
+	case strings.HasPrefix(id, discoveredPrefix):
+		return theme.FolderNewIcon()
 	case len(id) > 8 && id[:8] == "project:":
-		// Progetto: cartella piena o vuota in base ai server
+		// Progetto: avviso se la cartella non esiste più, altrimenti piena o vuota in base ai server
+		if project, ok := config.Projects[id[8:]]; ok && project.Missing {
+			return theme.WarningIcon()
+		}
 		if mw.getChildCount(id, config) > 0 {
 			return theme.FolderIcon()
 		}
 		return theme.FolderOpenIcon()
 	case len(id) > 7 && id[:7] == "global:":
-		// Server globale
+		// Server globale (badge di avviso se la configurazione non è valida)
+		if mw.serverIssues(id, config).HasErrors() {
+			return theme.WarningIcon()
+		}
 		return theme.ComputerIcon()
 	case len(id) > 14 && id[:14] == "projectserver:":
-		// Server di progetto
+		if mw.serverIssues(id, config).HasErrors() {
+			return theme.WarningIcon()
+		}
+		// Server di progetto: icona documento se definito in un file del progetto
+		if ref, ok := parseServerNodeID(id); ok && ref.Scope.FileName() != "" {
+			return theme.FileIcon()
+		}
 		return theme.ComputerIcon()
 	}
 	return nil
@@ -156,70 +204,90 @@ func (mw *MainWindow) getNodeText(id widget.TreeNodeID, config *domain.Configura
 		return i18n.T("tree.global")
 	case id == "projects":
 		return i18n.T("tree.projects")
+	case id == "discovered":
+		return i18n.T("tree.discovered")
+	case strings.HasPrefix(id, discoveredPrefix):
+		if project, ok := mw.service.GetDiscovered(id[len(discoveredPrefix):]); ok {
+			return project.Name + "  (" + projectFilesSummary(project) + ")"
+		}
+		return id[len(discoveredPrefix):]
 	case len(id) > 7 && id[:7] == "global:":
 		return id[7:]
 	case len(id) > 8 && id[:8] == "project:":
 		path := id[8:]
 		if project, ok := config.Projects[path]; ok {
+			if project.Missing {
+				return project.Name + "  (" + i18n.T("tree.missing") + ")"
+			}
 			return project.Name
 		}
 		return path
 	case len(id) > 14 && id[:14] == "projectserver:":
-		rest := id[14:]
-		for i := len(rest) - 1; i >= 0; i-- {
-			if rest[i] == ':' {
-				return rest[i+1:]
+		if ref, ok := parseServerNodeID(id); ok {
+			if file := ref.Scope.FileName(); file != "" {
+				return ref.Name + "  (" + file + ")"
 			}
+			return ref.Name
 		}
 	}
 	return id
 }
 
+// serverIssues valida il server di un nodo del tree, incluse le variabili mancanti
+func (mw *MainWindow) serverIssues(id widget.TreeNodeID, config *domain.Configuration) domain.ValidationIssues {
+	ref, ok := parseServerNodeID(id)
+	if !ok {
+		return nil
+	}
+	server, ok := config.GetServer(ref.Location, ref.Name)
+	if !ok {
+		return nil
+	}
+	return mw.service.ServerDiagnostics(server)
+}
+
+// toolCountSuffix restituisce il numero di strumenti in cache per un nodo server ("" se non noto)
+func (mw *MainWindow) toolCountSuffix(id widget.TreeNodeID, config *domain.Configuration) string {
+	ref, ok := parseServerNodeID(id)
+	if !ok {
+		return ""
+	}
+	server, ok := config.GetServer(ref.Location, ref.Name)
+	if !ok {
+		return ""
+	}
+	count, ok := mw.service.ToolCount(server)
+	if !ok {
+		return ""
+	}
+	return "  · " + fmt.Sprintf(i18n.T("tree.tool_count"), count)
+}
+
 // getChildCount restituisce il numero di figli di un nodo branch
 func (mw *MainWindow) getChildCount(id widget.TreeNodeID, config *domain.Configuration) int {
 	switch {
 	case id == "global":
-		return len(config.GlobalServers)
+		return len(mw.filterServers(config, domain.GlobalLocation()))
 	case id == "projects":
-		return len(config.Projects)
+		return len(mw.visibleProjectIDs(config))
+	case id == "discovered":
+		return len(mw.service.Discovered())
 	case len(id) > 8 && id[:8] == "project:":
 		path := id[8:]
 		if project, ok := config.Projects[path]; ok {
-			// Conta solo i server locali (da .mcp.json e .mcp.local.json)
-			return mw.countLocalServers(path, project)
+			// Conta i server di tutti i file del progetto che rispettano il filtro
+			return len(mw.projectServerIDs(project))
 		}
 	}
 	return 0
 }
 
-// countLocalServers conta i server MCP locali di un progetto
-func (mw *MainWindow) countLocalServers(path string, project *domain.Project) int {
-	return len(mw.getLocalServers(path, project))
-}
-
-// getLocalServers restituisce i server MCP di un progetto (da ~/.claude.json e file locali)
-func (mw *MainWindow) getLocalServers(path string, project *domain.Project) map[string]domain.MCPServer {
-	localServers := make(map[string]domain.MCPServer)
-
-	// Prima aggiungi i server da ~/.claude.json projects.[path].mcpServers
-	for name, server := range project.MCPServers {
-		localServers[name] = server
-	}
-
-	// Poi aggiungi/sovrascrivi con i server dai file locali (.mcp.json e .mcp.local.json)
-	if project.HasMCPJson {
-		mcpJsonPath := filepath.Join(path, ".mcp.json")
-		servers := infrastructure.LoadMCPFileServers(mcpJsonPath)
-		for name, server := range servers {
-			localServers[name] = server
-		}
-	}
-	if project.HasMCPLocal {
-		mcpLocalPath := filepath.Join(path, ".mcp.local.json")
-		servers := infrastructure.LoadMCPFileServers(mcpLocalPath)
-		for name, server := range servers {
-			localServers[name] = server
-		}
+// projectServerIDs restituisce gli ID dei server di tutti i file di un progetto che
+// rispettano il filtro, ordinati secondo il criterio scelto e, a parità, per file
+func (mw *MainWindow) projectServerIDs(project *domain.Project) []widget.TreeNodeID {
+	locs := make([]domain.Location, len(domain.ProjectScopes))
+	for i, scope := range domain.ProjectScopes {
+		locs[i] = domain.ProjectLocation(project.Path, scope)
 	}
-	return localServers
+	return mw.filterServers(mw.service.GetConfiguration(), locs...)
 }
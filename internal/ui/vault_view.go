@@ -0,0 +1,353 @@
+package ui
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/container"
+	"fyne.io/fyne/v2/dialog"
+	"fyne.io/fyne/v2/theme"
+	"fyne.io/fyne/v2/widget"
+
+	"github.com/strawberry-code/mcp-curator/internal/application"
+	"github.com/strawberry-code/mcp-curator/internal/domain"
+	"github.com/strawberry-code/mcp-curator/internal/i18n"
+)
+
+// VaultView è la finestra del vault dei segreti: creazione, sblocco, elenco dei segreti
+// con i server che li usano, rotazione e generazione del launcher
+type VaultView struct {
+	mw      *MainWindow
+	window  fyne.Window
+	content *fyne.Container
+}
+
+// showVaultView apre la finestra del vault
+func (mw *MainWindow) showVaultView() {
+	vv := &VaultView{
+		mw:      mw,
+		window:  mw.app.NewWindow(i18n.T("vault.title")),
+		content: container.NewStack(),
+	}
+	vv.window.SetContent(vv.content)
+	vv.window.Resize(fyne.NewSize(700, 500))
+	vv.reload()
+	vv.window.Show()
+}
+
+// reload ricostruisce il contenuto in base allo stato del vault
+func (vv *VaultView) reload() {
+	var content fyne.CanvasObject
+	switch {
+	case !vv.mw.service.VaultExists():
+		content = vv.buildCreate()
+	case !vv.mw.service.VaultUnlocked():
+		content = vv.buildUnlock()
+	default:
+		content = vv.buildSecrets()
+	}
+	vv.content.Objects = []fyne.CanvasObject{content}
+	vv.content.Refresh()
+}
+
+// intro descrive il funzionamento del vault
+func (vv *VaultView) intro() *widget.Label {
+	label := widget.NewLabel(fmt.Sprintf(i18n.T("vault.intro"), vv.mw.service.GetVaultPath()))
+	label.Wrapping = fyne.TextWrapWord
+	return label
+}
+
+// buildCreate costruisce il form di creazione del vault
+func (vv *VaultView) buildCreate() fyne.CanvasObject {
+	passphrase := widget.NewPasswordEntry()
+	confirm := widget.NewPasswordEntry()
+
+	createBtn := widget.NewButtonWithIcon(i18n.T("vault.create"), theme.ConfirmIcon(), func() {
+		if passphrase.Text != confirm.Text {
+			dialog.ShowError(errors.New(i18n.T("vault.passphrase_mismatch")), vv.window)
+			return
+		}
+		if err := vv.mw.service.CreateVault(passphrase.Text); err != nil {
+			dialog.ShowError(err, vv.window)
+			return
+		}
+		vv.changed()
+	})
+	createBtn.Importance = widget.HighImportance
+
+	form := widget.NewForm(
+		widget.NewFormItem(i18n.T("vault.passphrase"), passphrase),
+		widget.NewFormItem(i18n.T("vault.passphrase_confirm"), confirm),
+	)
+	return container.NewVBox(vv.intro(), widget.NewSeparator(), form, container.NewHBox(createBtn))
+}
+
+// buildUnlock costruisce il form di sblocco
+func (vv *VaultView) buildUnlock() fyne.CanvasObject {
+	passphrase := widget.NewPasswordEntry()
+	unlock := func() {
+		if err := vv.mw.service.UnlockVault(passphrase.Text); err != nil {
+			dialog.ShowError(err, vv.window)
+			return
+		}
+		vv.changed()
+	}
+	passphrase.OnSubmitted = func(string) { unlock() }
+
+	unlockBtn := widget.NewButtonWithIcon(i18n.T("vault.unlock"), theme.ConfirmIcon(), unlock)
+	unlockBtn.Importance = widget.HighImportance
+
+	hint := widget.NewLabel(i18n.T("vault.locked_hint"))
+	hint.Wrapping = fyne.TextWrapWord
+
+	content := container.NewVBox(
+		vv.intro(),
+		widget.NewSeparator(),
+		hint,
+		widget.NewForm(widget.NewFormItem(i18n.T("vault.passphrase"), passphrase)),
+		container.NewHBox(unlockBtn),
+	)
+	// Anche a vault bloccato si vedono i nomi dei segreti e chi li referenzia
+	content.Add(widget.NewSeparator())
+	content.Add(vv.secretList(false))
+	return container.NewVScroll(content)
+}
+
+// buildSecrets costruisce l'elenco dei segreti con le azioni
+func (vv *VaultView) buildSecrets() fyne.CanvasObject {
+	addBtn := widget.NewButtonWithIcon(i18n.T("vault.add_secret"), theme.ContentAddIcon(), func() {
+		vv.showAddSecret()
+	})
+	launcherBtn := widget.NewButtonWithIcon(i18n.T("vault.launcher"), theme.DocumentSaveIcon(), func() {
+		vv.writeLauncher()
+	})
+	passphraseBtn := widget.NewButton(i18n.T("vault.change_passphrase"), func() {
+		vv.showChangePassphrase()
+	})
+	lockBtn := widget.NewButtonWithIcon(i18n.T("vault.lock"), theme.CancelIcon(), func() {
+		vv.mw.service.LockVault()
+		vv.changed()
+	})
+
+	return container.NewBorder(
+		container.NewVBox(vv.intro(), widget.NewSeparator()),
+		container.NewVBox(widget.NewSeparator(), container.NewHBox(addBtn, launcherBtn, passphraseBtn, lockBtn)),
+		nil, nil,
+		container.NewVScroll(vv.secretList(true)),
+	)
+}
+
+// secretList elenca i segreti con i valori env/header che li usano; con actions
+// aggiunge i bottoni di rotazione ed eliminazione
+func (vv *VaultView) secretList(actions bool) fyne.CanvasObject {
+	names := vv.mw.service.SecretNames()
+	if len(names) == 0 {
+		return widget.NewLabel(i18n.T("vault.no_secrets"))
+	}
+
+	list := container.NewVBox()
+	for _, name := range names {
+		name := name
+		usages := vv.mw.service.SecretUsages(name)
+		title := widget.NewLabelWithStyle(fmt.Sprintf("%s  (%s)", name, fmt.Sprintf(i18n.T("vault.usages"), len(usages))),
+			fyne.TextAlignLeading, fyne.TextStyle{Bold: true, Monospace: true})
+
+		if actions {
+			rotateBtn := widget.NewButtonWithIcon(i18n.T("vault.rotate"), theme.ViewRefreshIcon(), func() {
+				vv.showRotate(name)
+			})
+			deleteBtn := widget.NewButtonWithIcon("", theme.DeleteIcon(), func() {
+				vv.confirmDelete(name)
+			})
+			deleteBtn.Importance = widget.LowImportance
+			list.Add(container.NewBorder(nil, nil, nil, container.NewHBox(rotateBtn, deleteBtn), title))
+		} else {
+			list.Add(title)
+		}
+
+		for _, usage := range usages {
+			label := widget.NewLabel("    " + vv.usageLabel(usage))
+			label.Importance = widget.LowImportance
+			list.Add(label)
+		}
+	}
+	return list
+}
+
+// usageLabel descrive un uso di un segreto
+func (vv *VaultView) usageLabel(binding application.SecretBinding) string {
+	return fmt.Sprintf("%s — %s · %s %s (%s)", binding.Ref.Name, vv.mw.locationLabel(binding.Ref.Location),
+		binding.Field, binding.Key, i18n.T("vault.mode_"+string(binding.Mode)))
+}
+
+// showAddSecret chiede nome e valore di un nuovo segreto
+func (vv *VaultView) showAddSecret() {
+	nameEntry := widget.NewEntry()
+	nameEntry.SetPlaceHolder("GITHUB_TOKEN")
+	valueEntry := widget.NewPasswordEntry()
+
+	items := []*widget.FormItem{
+		widget.NewFormItem(i18n.T("vault.secret_name"), nameEntry),
+		widget.NewFormItem(i18n.T("vault.secret_value"), valueEntry),
+	}
+	dialog.ShowForm(i18n.T("vault.add_secret"), i18n.T("btn.save"), i18n.T("btn.cancel"), items, func(ok bool) {
+		if !ok {
+			return
+		}
+		if err := vv.mw.service.SetSecret(strings.TrimSpace(nameEntry.Text), valueEntry.Text); err != nil {
+			dialog.ShowError(err, vv.window)
+			return
+		}
+		vv.changed()
+	}, vv.window)
+}
+
+// showRotate chiede il nuovo valore di un segreto e aggiorna i server che lo contengono
+func (vv *VaultView) showRotate(name string) {
+	valueEntry := widget.NewPasswordEntry()
+	items := []*widget.FormItem{widget.NewFormItem(i18n.T("vault.new_value"), valueEntry)}
+
+	dialog.ShowForm(fmt.Sprintf(i18n.T("vault.rotate_title"), name), i18n.T("vault.rotate"), i18n.T("btn.cancel"), items, func(ok bool) {
+		if !ok {
+			return
+		}
+		refs, err := vv.mw.service.RotateSecret(name, valueEntry.Text)
+		if err != nil {
+			vv.mw.showSaveError(err)
+		} else {
+			dialog.ShowInformation(i18n.T("vault.title"), fmt.Sprintf(i18n.T("vault.rotated"), name, len(refs)), vv.window)
+		}
+		vv.changed()
+	}, vv.window)
+}
+
+// confirmDelete elimina un segreto dopo conferma, elencando chi lo referenzia ancora
+func (vv *VaultView) confirmDelete(name string) {
+	dialog.ShowConfirm(i18n.T("vault.title"), fmt.Sprintf(i18n.T("vault.delete_confirm"), name), func(ok bool) {
+		if !ok {
+			return
+		}
+		err := vv.mw.service.DeleteSecret(name)
+		var inUse *application.SecretInUseError
+		if errors.As(err, &inUse) {
+			lines := make([]string, len(inUse.Bindings))
+			for i, binding := range inUse.Bindings {
+				lines[i] = "• " + vv.usageLabel(binding)
+			}
+			err = fmt.Errorf("%s\n\n%s", fmt.Sprintf(i18n.T("vault.in_use"), name, name), strings.Join(lines, "\n"))
+		}
+		if err != nil {
+			dialog.ShowError(err, vv.window)
+			return
+		}
+		vv.changed()
+	}, vv.window)
+}
+
+// showChangePassphrase cifra il vault con una nuova passphrase
+func (vv *VaultView) showChangePassphrase() {
+	passphrase := widget.NewPasswordEntry()
+	confirm := widget.NewPasswordEntry()
+	items := []*widget.FormItem{
+		widget.NewFormItem(i18n.T("vault.passphrase"), passphrase),
+		widget.NewFormItem(i18n.T("vault.passphrase_confirm"), confirm),
+	}
+	dialog.ShowForm(i18n.T("vault.change_passphrase"), i18n.T("btn.save"), i18n.T("btn.cancel"), items, func(ok bool) {
+		if !ok {
+			return
+		}
+		if passphrase.Text != confirm.Text {
+			dialog.ShowError(errors.New(i18n.T("vault.passphrase_mismatch")), vv.window)
+			return
+		}
+		if err := vv.mw.service.ChangeVaultPassphrase(passphrase.Text); err != nil {
+			dialog.ShowError(err, vv.window)
+		}
+	}, vv.window)
+}
+
+// writeLauncher genera lo script di avvio di Claude Code con i segreti
+func (vv *VaultView) writeLauncher() {
+	path, err := vv.mw.service.WriteLauncher("")
+	if err != nil {
+		dialog.ShowError(err, vv.window)
+		return
+	}
+	message := widget.NewLabel(fmt.Sprintf(i18n.T("vault.launcher_created"), path))
+	message.Wrapping = fyne.TextWrapWord
+	copyBtn := widget.NewButtonWithIcon(i18n.T("vault.copy_path"), theme.ContentCopyIcon(), func() {
+		vv.mw.copyToClipboard(path)
+	})
+	d := dialog.NewCustom(i18n.T("vault.launcher"), i18n.T("btn.close"), container.NewVBox(message, container.NewHBox(copyBtn)), vv.window)
+	d.Resize(fyne.NewSize(500, 200))
+	d.Show()
+}
+
+// changed aggiorna la finestra e la finestra principale (marcatori e valori espansi)
+func (vv *VaultView) changed() {
+	vv.reload()
+	vv.mw.refreshView()
+}
+
+// showBindSecretDialog collega un valore env o header di un server a un segreto del vault
+func (mw *MainWindow) showBindSecretDialog(ref domain.ServerRef, field, key string) {
+	if !mw.service.VaultUnlocked() {
+		dialog.ShowInformation(i18n.T("vault.title"), i18n.T("vault.needs_unlock"), mw.window)
+		mw.showVaultView()
+		return
+	}
+
+	nameEntry := widget.NewSelectEntry(mw.service.SecretNames())
+	nameEntry.SetText(secretNameFor(key))
+
+	referenceLabel := i18n.T("vault.mode_reference")
+	inlineLabel := i18n.T("vault.mode_inline")
+	mode := widget.NewRadioGroup([]string{referenceLabel, inlineLabel}, nil)
+	mode.Required = true
+	mode.SetSelected(referenceLabel)
+
+	hint := widget.NewLabel(i18n.T("vault.bind_hint"))
+	hint.Wrapping = fyne.TextWrapWord
+
+	items := []*widget.FormItem{
+		widget.NewFormItem(i18n.T("vault.secret_name"), nameEntry),
+		widget.NewFormItem(i18n.T("vault.mode"), mode),
+		widget.NewFormItem("", hint),
+	}
+	d := dialog.NewForm(fmt.Sprintf(i18n.T("vault.bind_title"), key), i18n.T("btn.save"), i18n.T("btn.cancel"), items, func(ok bool) {
+		if !ok {
+			return
+		}
+		bindMode := application.BindReference
+		if mode.Selected == inlineLabel {
+			bindMode = application.BindInline
+		}
+		if err := mw.service.BindSecret(ref, field, key, strings.TrimSpace(nameEntry.Text), bindMode); err != nil {
+			mw.showSaveError(err)
+			return
+		}
+		mw.refreshView()
+	}, mw.window)
+	d.Resize(fyne.NewSize(500, 300))
+	d.Show()
+}
+
+// secretNameFor propone il nome di un segreto a partire dalla chiave env o header
+// (es. "Authorization" → "AUTHORIZATION", "x-api-key" → "X_API_KEY")
+func secretNameFor(key string) string {
+	name := strings.Map(func(r rune) rune {
+		switch {
+		case r >= 'a' && r <= 'z':
+			return r - 'a' + 'A'
+		case r >= 'A' && r <= 'Z', r >= '0' && r <= '9', r == '_':
+			return r
+		}
+		return '_'
+	}, key)
+	if !domain.ValidateSecretName(name) {
+		return "SECRET_" + name
+	}
+	return name
+}